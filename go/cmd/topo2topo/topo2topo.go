@@ -17,9 +17,13 @@ limitations under the License.
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"context"
 	"vitess.io/vitess/go/exit"
@@ -44,6 +48,16 @@ var (
 	doShardReplications = flag.Bool("do-shard-replications", false, "copies the shard replication information")
 	doTablets           = flag.Bool("do-tablets", false, "copies the tablet information")
 	doRoutingRules      = flag.Bool("do-routing-rules", false, "copies the routing rules")
+
+	exportFile = flag.String("export", "", "exports the data of the 'from' topology to this file, as YAML if it ends with .yaml or .yml, and as JSON otherwise")
+	importFile = flag.String("import", "", "imports the data of this file, written by -export, into the 'to' topology")
+	diff       = flag.Bool("diff", false, "lists the differences between the files of the 'from' and 'to' topologies")
+	cells      = flag.String("cells", "", "comma-separated list of cells to export, import or diff, 'global' being the global cell. Defaults to all the cells")
+	subtree    = flag.String("subtree", "", "directory to export or diff, relative to the root of each cell, like keyspaces/ks. Defaults to everything")
+	fromCell   = flag.String("from_cell", "", "with -diff, cell of the 'from' topology to compare to -to_cell, instead of comparing the same cells")
+	toCell     = flag.String("to_cell", "", "with -diff, cell of the 'to' topology to compare to -from_cell")
+	dryRun     = flag.Bool("dry_run", false, "with -import, only reports what would be done")
+	overwrite  = flag.Bool("overwrite", false, "with -import, overwrites the files that already exist with different contents, instead of failing")
 )
 
 func main() {
@@ -57,22 +71,123 @@ func main() {
 		log.Exitf("topo2topo doesn't take any parameter.")
 	}
 
-	fromTS, err := topo.OpenServer(*fromImplementation, *fromServerAddress, *fromRoot)
+	ctx := context.Background()
+
+	switch {
+	case *exportFile != "":
+		exportTopo(ctx, openFromTopo())
+		return
+	case *importFile != "":
+		importTopo(ctx, openToTopo())
+		return
+	}
+
+	fromTS := openFromTopo()
+	toTS := openToTopo()
+	if *diff {
+		diffTopos(ctx, fromTS, toTS)
+		return
+	}
+	if *compare {
+		compareTopos(ctx, fromTS, toTS)
+		return
+	}
+	copyTopos(ctx, fromTS, toTS)
+}
+
+func openFromTopo() *topo.Server {
+	ts, err := topo.OpenServer(*fromImplementation, *fromServerAddress, *fromRoot)
 	if err != nil {
 		log.Exitf("Cannot open 'from' topo %v: %v", *fromImplementation, err)
 	}
-	toTS, err := topo.OpenServer(*toImplementation, *toServerAddress, *toRoot)
+	return ts
+}
+
+func openToTopo() *topo.Server {
+	ts, err := topo.OpenServer(*toImplementation, *toServerAddress, *toRoot)
 	if err != nil {
 		log.Exitf("Cannot open 'to' topo %v: %v", *toImplementation, err)
 	}
+	return ts
+}
 
-	ctx := context.Background()
+func cellList() []string {
+	if *cells == "" {
+		return nil
+	}
+	return strings.Split(*cells, ",")
+}
 
-	if *compare {
-		compareTopos(ctx, fromTS, toTS)
+func exportTopo(ctx context.Context, fromTS *topo.Server) {
+	export, err := helpers.ExportTopo(ctx, fromTS, cellList(), *subtree)
+	if err != nil {
+		log.Exitf("Export failed: %v", err)
+	}
+	ext := filepath.Ext(*exportFile)
+	data, err := helpers.MarshalTopoExport(export, ext == ".yaml" || ext == ".yml")
+	if err != nil {
+		log.Exitf("Cannot encode the export: %v", err)
+	}
+	if err := ioutil.WriteFile(*exportFile, data, 0644); err != nil {
+		log.Exitf("Cannot write %v: %v", *exportFile, err)
+	}
+}
+
+func importTopo(ctx context.Context, toTS *topo.Server) {
+	data, err := ioutil.ReadFile(*importFile)
+	if err != nil {
+		log.Exitf("Cannot read %v: %v", *importFile, err)
+	}
+	export, err := helpers.UnmarshalTopoExport(data)
+	if err != nil {
+		log.Exitf("Cannot read %v: %v", *importFile, err)
+	}
+	if names := cellList(); names != nil {
+		selected := make(map[string][]*helpers.ExportFile)
+		for _, cell := range names {
+			selected[cell] = export.Cells[cell]
+		}
+		export.Cells = selected
+	}
+
+	results, err := helpers.ImportTopo(ctx, toTS, export, helpers.ImportOptions{
+		DryRun:    *dryRun,
+		Overwrite: *overwrite,
+	})
+	for _, r := range results {
+		if r.Action != helpers.ImportUnchanged {
+			fmt.Printf("%v %v/%v\n", r.Action, r.Cell, r.Path)
+		}
+	}
+	if err != nil {
+		log.Exitf("Import failed: %v", err)
+	}
+}
+
+func diffTopos(ctx context.Context, fromTS, toTS *topo.Server) {
+	var diffs []*helpers.TopoDiff
+	var err error
+	if *fromCell != "" || *toCell != "" {
+		if *fromCell == "" || *toCell == "" {
+			log.Exitf("-from_cell and -to_cell must be used together")
+		}
+		diffs, err = helpers.DiffCells(ctx, fromTS, *fromCell, toTS, *toCell, *subtree)
+	} else {
+		diffs, err = helpers.DiffTopos(ctx, fromTS, toTS, cellList(), *subtree)
+	}
+	if err != nil {
+		log.Exitf("Diff failed: %v", err)
+	}
+	if len(diffs) == 0 {
+		fmt.Println("Topologies are in sync")
 		return
 	}
-	copyTopos(ctx, fromTS, toTS)
+	data, err := json.MarshalIndent(diffs, "", "  ")
+	if err != nil {
+		log.Exitf("Cannot encode the differences: %v", err)
+	}
+	fmt.Println(string(data))
+	os.Exit(1)
 }
 
 func copyTopos(ctx context.Context, fromTS, toTS *topo.Server) {
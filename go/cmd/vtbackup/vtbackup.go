@@ -42,6 +42,12 @@ The process vtbackup follows to take a new backup is as follows:
    don't move.
 5. Wait until replication is caught up to the goal position or beyond.
 6. Stop mysqld and take a new backup.
+7. With -verify_backup, restore the new backup into a scratch mysqld and check
+   the restored data against its MANIFEST.
+
+With -verify_backup_name, vtbackup doesn't take a backup, nor prune old ones:
+it only restores the named backup of the shard into a scratch mysqld, and
+checks it the same way.
 
 Aside from additional replication load while vtbackup's mysqld catches up on
 new transactions, the shard should be otherwise unaffected. Existing tablets
@@ -66,7 +72,6 @@ import (
 	"math/big"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
@@ -108,11 +113,16 @@ var (
 	_ = flag.Duration("replication_timeout", 1*time.Hour, "DEPRECATED AND UNUSED")
 
 	minBackupInterval = flag.Duration("min_backup_interval", 0, "Only take a new backup if it's been at least this long since the most recent backup.")
-	minRetentionTime  = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0 to disable pruning of old backups.")
-	minRetentionCount = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	minRetentionTime   = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0 to disable pruning of old backups.")
+	minRetentionCount  = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	dailyRetentionDays = flag.Int("daily_retention_days", 0, "Keep the most recent backup of each day (in UTC) for this many days, even if it is older than the min_retention_time. Pruning is enabled if this or min_retention_time is set.")
+	pruneDryRun        = flag.Bool("prune_dry_run", false, "Only log the old backups that would be pruned, without removing them.")
 
-	initialBackup    = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
-	allowFirstBackup = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
+	initialBackup     = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
+	allowFirstBackup  = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
+	verifyBackup      = flag.Bool("verify_backup", false, "After taking a backup, restore it into a scratch mysqld started in a temporary directory, then run CHECK TABLE on every table, and check their row counts and the GTID position against the MANIFEST. The result is stored next to the backup, and ListBackups -verification shows it. Row counts are only compared if the backup recorded them, see -builtinbackup_row_counts.")
+	verifyBackupName  = flag.String("verify_backup_name", "", "Instead of taking a backup and pruning old ones, only verify this backup of the shard, like -verify_backup does for a new backup.")
+	incrementalBackup = flag.Bool("incremental_backup", false, "Take an incremental backup, that only contains the binlogs since the most recent backup, instead of a full one. A full backup is taken if the shard has no backups yet. The backups incremental backups are based on are only pruned along with them, so full backups must still be taken from time to time. Only supported by the builtin backup engine.")
 
 	// vttablet-like flags
 	initDbNameOverride = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet")
@@ -168,6 +178,14 @@ func main() {
 	topoServer := topo.Open()
 	defer topoServer.Close()
 
+	if *verifyBackupName != "" {
+		if err := verifyBackupByName(ctx, *verifyBackupName); err != nil {
+			log.Errorf("Failed to verify backup: %v", err)
+			exit.Return(1)
+		}
+		return
+	}
+
 	// Try to take a backup, if it's been long enough since the last one.
 	// Skip pruning if backup wasn't fully successful. We don't want to be
 	// deleting things if the backup process is not healthy.
@@ -182,6 +200,12 @@ func main() {
 			log.Errorf("Failed to take backup: %v", err)
 			exit.Return(1)
 		}
+		if *verifyBackup {
+			if err := verifyBackupByName(ctx, ""); err != nil {
+				log.Errorf("Failed to verify backup: %v", err)
+				exit.Return(1)
+			}
+		}
 	}
 
 	// Prune old backups.
@@ -392,7 +416,10 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 		return fmt.Errorf("not taking backup: replication did not make any progress from restore point: %v", restorePos)
 	}
 
-	// Now we can take a new backup.
+	// Now we can take a new backup. mysqld logs the replicated transactions
+	// in its own binlogs since the replication reset, so they start at the
+	// position of the backup we restored from.
+	backupParams.Incremental = *incrementalBackup && !restorePos.IsZero()
 	if err := mysqlctl.Backup(ctx, backupParams); err != nil {
 		return fmt.Errorf("error taking backup: %v", err)
 	}
@@ -406,6 +433,32 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	return nil
 }
 
+// verifyBackupByName verifies a backup of the shard, or its most recent
+// complete backup if backupName is empty, and logs the failed checks.
+func verifyBackupByName(ctx context.Context, backupName string) error {
+	v, err := mysqlctl.VerifyBackup(ctx, mysqlctl.VerifyBackupParams{
+		Logger:       logutil.NewConsoleLogger(),
+		Concurrency:  *concurrency,
+		HookExtraEnv: map[string]string{},
+		Keyspace:     *initKeyspace,
+		Shard:        *initShard,
+		BackupName:   backupName,
+	})
+	if err != nil {
+		return err
+	}
+	for _, check := range v.Checks {
+		if !check.Success {
+			log.Errorf("Backup %v failed check %v: %v", v.BackupName, check.Name, check.Detail)
+		}
+	}
+	if !v.Success {
+		return fmt.Errorf("backup %v failed verification: %v", v.BackupName, v.Error)
+	}
+	log.Infof("Backup %v passed verification at position %v", v.BackupName, v.Position)
+	return nil
+}
+
 func resetReplication(ctx context.Context, pos mysql.Position, mysqld mysqlctl.MysqlDaemon) error {
 	cmds := []string{
 		"STOP SLAVE",
@@ -503,60 +556,25 @@ func retryOnError(ctx context.Context, fn func() error) error {
 }
 
 func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage, backupDir string) error {
-	if *minRetentionTime == 0 {
+	if *minRetentionTime == 0 && *dailyRetentionDays == 0 {
 		log.Info("Pruning of old backups is disabled.")
 		return nil
 	}
-	backups, err := backupStorage.ListBackups(ctx, backupDir)
-	if err != nil {
-		return fmt.Errorf("can't list backups: %v", err)
+	policy := mysqlctl.RetentionPolicy{
+		KeepCount:     *minRetentionCount,
+		KeepDailyDays: *dailyRetentionDays,
+		MinAge:        *minRetentionTime,
 	}
-	numBackups := len(backups)
-	if numBackups <= *minRetentionCount {
-		log.Infof("Found %v backups. Not pruning any since this is within the min_retention_count of %v.", numBackups, *minRetentionCount)
-		return nil
+	pruned, err := mysqlctl.PruneBackups(ctx, backupStorage, backupDir, policy, *pruneDryRun, logutil.NewConsoleLogger())
+	if err != nil {
+		return fmt.Errorf("couldn't prune backups: %v", err)
 	}
-	// We have more than the minimum retention count, so we could afford to
-	// prune some. See if any are beyond the minimum retention time.
-	// ListBackups returns them sorted by oldest first.
-	for _, backup := range backups {
-		backupTime, err := parseBackupTime(backup.Name())
-		if err != nil {
-			return err
-		}
-		if time.Since(backupTime) < *minRetentionTime {
-			// The oldest remaining backup is not old enough to prune.
-			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
-			break
-		}
-		// Remove the backup.
-		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
-		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
-			return fmt.Errorf("couldn't remove backup %v from %v: %v", backup.Name(), backupDir, err)
-		}
-		// We successfully removed one backup. Can we afford to prune any more?
-		numBackups--
-		if numBackups == *minRetentionCount {
-			log.Infof("Successfully pruned backup count to min_retention_count of %v.", *minRetentionCount)
-			break
-		}
+	if len(pruned) == 0 {
+		log.Info("No backup to prune.")
 	}
 	return nil
 }
 
-func parseBackupTime(name string) (time.Time, error) {
-	// Backup names are formatted as "date.time.tablet-alias".
-	parts := strings.Split(name, ".")
-	if len(parts) != 3 {
-		return time.Time{}, fmt.Errorf("backup name not in expected format (date.time.tablet-alias): %v", name)
-	}
-	backupTime, err := time.Parse(mysqlctl.BackupTimestampFormat, fmt.Sprintf("%s.%s", parts[0], parts[1]))
-	if err != nil {
-		return time.Time{}, fmt.Errorf("can't parse timestamp from backup %q: %v", name, err)
-	}
-	return backupTime, nil
-}
-
 func shouldBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage, backupDir string) (bool, error) {
 	// Look for the most recent, complete backup.
 	backups, err := backupStorage.ListBackups(ctx, backupDir)
@@ -624,7 +642,7 @@ func shouldBackup(ctx context.Context, topoServer *topo.Server, backupStorage ba
 		// No minimum interval is set, so always backup.
 		return true, nil
 	}
-	lastBackupTime, err := parseBackupTime(lastBackup.Name())
+	lastBackupTime, err := mysqlctl.ParseBackupName(lastBackup.Name())
 	if err != nil {
 		return false, fmt.Errorf("can't check last backup time: %v", err)
 	}
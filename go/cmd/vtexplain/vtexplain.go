@@ -41,7 +41,9 @@ var (
 	executionMode      = flag.String("execution-mode", "multi", "The execution mode to simulate -- must be set to multi, legacy-autocommit, or twopc")
 	replicationMode    = flag.String("replication-mode", "ROW", "The replication mode to simulate -- must be set to either ROW or STATEMENT")
 	normalize          = flag.Bool("normalize", false, "Whether to enable vtgate normalization")
-	outputMode         = flag.String("output-mode", "text", "Output in human-friendly text or json")
+	outputMode         = flag.String("output-mode", "text", "Output in human-friendly text or json, or output the vtgate plan trees with their cost estimates with plan (text) or plan-json")
+	tableStatsFlag     = flag.String("table-stats", "", "JSON map of table name or keyspace.table name -> table statistics, like {\"user\": {\"Rows\": 1000}}, used to estimate the cost of plans")
+	tableStatsFileFlag = flag.String("table-stats-file", "", "File containing the JSON map of table statistics")
 	dbName             = flag.String("dbname", "", "Optional database target to override normal routing")
 
 	// vtexplainFlags lists all the flags that should show in usage
@@ -59,6 +61,8 @@ var (
 		"ks-shard-map",
 		"ks-shard-map-file",
 		"dbname",
+		"table-stats",
+		"table-stats-file",
 		"queryserver-config-passthrough-dmls",
 	}
 )
@@ -165,12 +169,22 @@ func parseAndRun() error {
 		return err
 	}
 
+	tableStatsStr, err := getFileParam(*tableStatsFlag, *tableStatsFileFlag, "table-stats", false)
+	if err != nil {
+		return err
+	}
+	tableStats, err := vtexplain.ParseTableStats(tableStatsStr)
+	if err != nil {
+		return err
+	}
+
 	opts := &vtexplain.Options{
 		ExecutionMode:   *executionMode,
 		ReplicationMode: *replicationMode,
 		NumShards:       *numShards,
 		Normalize:       *normalize,
 		Target:          *dbName,
+		TableStats:      tableStats,
 	}
 
 	log.V(100).Infof("sql %s\n", sql)
@@ -187,9 +201,14 @@ func parseAndRun() error {
 		return err
 	}
 
-	if *outputMode == "text" {
+	switch *outputMode {
+	case "text":
 		fmt.Print(vtexplain.ExplainsAsText(plans))
-	} else {
+	case "plan":
+		fmt.Print(vtexplain.PlansAsText(vtexplain.ExplainPlans(plans)))
+	case "plan-json":
+		fmt.Print(vtexplain.PlansAsJSON(vtexplain.ExplainPlans(plans)))
+	default:
 		fmt.Print(vtexplain.ExplainsAsJSON(plans))
 	}
 
@@ -19,8 +19,12 @@ package mysql
 import (
 	"bytes"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/hex"
+	"encoding/pem"
 	"net"
 	"strings"
 
@@ -42,14 +46,19 @@ import (
 // can authenticate using any method. If SSL is not used, it means the
 // password is sent in the clear. That may not be suitable for some
 // use cases.
+//
+// AuthServers that also implement Sha2AuthServer can use the
+// caching_sha2_password and sha256_password methods.
 type AuthServer interface {
 	// AuthMethod returns the authentication method to use for the
 	// given user. If this returns MysqlNativePassword
 	// (mysql_native_password), then ValidateHash() will be
 	// called, and no further roundtrip with the client is
-	// expected. If anything else is returned, Negotiate()
-	// will be called on the connection, and the AuthServer
-	// needs to handle the packets.
+	// expected. If this returns CachingSha2Password or
+	// MysqlSha256Password, the Listener handles the packets and
+	// calls the Sha2AuthServer methods. If anything else is
+	// returned, Negotiate() will be called on the connection, and
+	// the AuthServer needs to handle the packets.
 	AuthMethod(user string) (string, error)
 
 	// Salt returns the salt to use for a connection.
@@ -79,6 +88,27 @@ type AuthServer interface {
 	Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error)
 }
 
+// Sha2AuthServer is implemented by the AuthServers that support the
+// caching_sha2_password and sha256_password methods.
+type Sha2AuthServer interface {
+	// ValidateCachingSha2Hash validates the scramble sent by the
+	// client for the fast authentication of caching_sha2_password.
+	// If the server can't validate it, for instance because it
+	// doesn't have the SHA2 hash of the password, it returns
+	// ErrCachingSha2FullAuth, and the password is asked for.
+	ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error)
+
+	// ValidatePassword validates a password received during the
+	// full authentication of caching_sha2_password, or with
+	// sha256_password. The password was sent over TLS or a unix
+	// socket, or encrypted with the server RSA public key.
+	ValidatePassword(user, password string, remoteAddr net.Addr) (Getter, error)
+}
+
+// ErrCachingSha2FullAuth is returned by ValidateCachingSha2Hash when the
+// full authentication needs to be performed.
+var ErrCachingSha2FullAuth = vterrors.New(vtrpc.Code_UNAUTHENTICATED, "caching_sha2_password full authentication required")
+
 // authServers is a registry of AuthServer implementations.
 var authServers = make(map[string]AuthServer)
 
@@ -189,6 +219,160 @@ func isPassScrambleMysqlNativePassword(reply, salt []byte, mysqlNativePassword s
 	return bytes.Equal(candidateHash2, hash)
 }
 
+// ScrambleCachingSha2Password computes the hash of the password using
+// the caching_sha2_password method.
+func ScrambleCachingSha2Password(salt, password []byte) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+
+	// stage1 = SHA256(password)
+	crypt := sha256.New()
+	crypt.Write(password)
+	stage1 := crypt.Sum(nil)
+
+	// scramble = SHA256(SHA256(stage1) + salt)
+	crypt.Reset()
+	crypt.Write(stage1)
+	stage2 := crypt.Sum(nil)
+	crypt.Reset()
+	crypt.Write(stage2)
+	crypt.Write(salt)
+	scramble := crypt.Sum(nil)
+
+	// token = scramble XOR stage1
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return scramble
+}
+
+// CachingSha2Hash returns the hex encoded SHA256(SHA256(password)),
+// which is what AuthServers store to validate caching_sha2_password
+// scrambles. It is the same as the MySQL expression
+// SHA2(UNHEX(SHA2('password', 256)), 256).
+func CachingSha2Hash(password string) string {
+	stage1 := sha256.Sum256([]byte(password))
+	stage2 := sha256.Sum256(stage1[:])
+	return strings.ToUpper(hex.EncodeToString(stage2[:]))
+}
+
+func isPassScrambleCachingSha2Password(reply, salt []byte, cachingSha2Hash string) bool {
+	/*
+		SERVER:  recv(reply)
+				 stage1=xor(reply, sha256(hash,salt))
+				 candidate_hash=sha256(stage1)
+				 check(candidate_hash==hash)
+	*/
+	if len(reply) != sha256.Size {
+		return false
+	}
+
+	hash, err := hex.DecodeString(cachingSha2Hash)
+	if err != nil || len(hash) != sha256.Size {
+		return false
+	}
+
+	// scramble = SHA256(hash+salt)
+	crypt := sha256.New()
+	crypt.Write(hash)
+	crypt.Write(salt)
+	scramble := crypt.Sum(nil)
+
+	// stage1 = scramble XOR reply
+	for i := range scramble {
+		scramble[i] ^= reply[i]
+	}
+	candidateHash := sha256.Sum256(scramble)
+
+	return bytes.Equal(candidateHash[:], hash)
+}
+
+// isPassMysqlNativePassword checks a clear text password against a
+// mysql_native_password hash.
+func isPassMysqlNativePassword(password, mysqlNativePassword string) bool {
+	stage1 := sha1.Sum([]byte(password))
+	stage2 := sha1.Sum(stage1[:])
+	return strings.EqualFold(strings.TrimPrefix(mysqlNativePassword, "*"), hex.EncodeToString(stage2[:]))
+}
+
+// xorPassword XORs the zero terminated password with the salt, repeated
+// as needed. This is done before encrypting the password with RSA, and
+// after decrypting it.
+func xorPassword(password, salt []byte) []byte {
+	result := make([]byte, len(password))
+	for i := range password {
+		result[i] = password[i] ^ salt[i%len(salt)]
+	}
+	return result
+}
+
+// EncryptPasswordWithPublicKey encrypts the password with the server
+// RSA public key, in the PEM format. This is how clients send the
+// password when using caching_sha2_password or sha256_password without
+// TLS.
+func EncryptPasswordWithPublicKey(salt, password, pemKey []byte) ([]byte, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot decode the server public key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot parse the server public key")
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "the server public key is not an RSA key")
+	}
+	plain := xorPassword(append(append([]byte{}, password...), 0), salt)
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, rsaPub, plain, nil)
+}
+
+// decryptPassword decrypts a password encrypted with
+// EncryptPasswordWithPublicKey.
+func decryptPassword(salt, encrypted []byte, key *rsa.PrivateKey) (string, error) {
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, encrypted, nil)
+	if err != nil {
+		return "", vterrors.Wrapf(err, "cannot decrypt the password")
+	}
+	plain = xorPassword(plain, salt)
+	if len(plain) == 0 || plain[len(plain)-1] != 0 {
+		return "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "decrypted password is not zero terminated")
+	}
+	return string(plain[:len(plain)-1]), nil
+}
+
+// ParseRSAKey parses an RSA private key in the PEM format, in either the
+// PKCS #1 or the PKCS #8 form.
+func ParseRSAKey(pemKey []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot decode the RSA key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot parse the RSA key")
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "the key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// publicKeyPEM returns the public key of an RSA key pair, in the PEM
+// format the clients expect.
+func publicKeyPEM(key *rsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
 // Constants for the dialog plugin.
 const (
 	mysqlDialogMessage = "Enter password: "
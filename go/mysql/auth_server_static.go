@@ -24,6 +24,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -38,6 +39,7 @@ var (
 	mysqlAuthServerStaticFile           = flag.String("mysql_auth_server_static_file", "", "JSON File to read the users/passwords from.")
 	mysqlAuthServerStaticString         = flag.String("mysql_auth_server_static_string", "", "JSON representation of the users/passwords config.")
 	mysqlAuthServerStaticReloadInterval = flag.Duration("mysql_auth_static_reload_interval", 0, "Ticker to reload credentials")
+	mysqlAuthServerStaticMethod         = flag.String("mysql_auth_server_static_method", MysqlNativePassword, "Authentication method to use with the static users: mysql_native_password, caching_sha2_password or sha256_password.")
 )
 
 const (
@@ -52,6 +54,8 @@ type AuthServerStatic struct {
 	// - MysqlNativePassword
 	// - MysqlClearPassword
 	// - MysqlDialog
+	// - CachingSha2Password
+	// - MysqlSha256Password
 	// It defaults to MysqlNativePassword.
 	method string
 	// This mutex helps us prevent data races between the multiple updates of entries.
@@ -77,6 +81,11 @@ type AuthServerStaticEntry struct {
 	// MysqlNativePassword's format looks like "*6C8989366EAF75BB670AD8EA7A7FC1176A95CEF4", it store a hashing value.
 	// Use MysqlNativePassword in auth config, maybe more secure. After all, it is cryptographic storage.
 	MysqlNativePassword string
+	// CachingSha2Password is the hex encoded SHA256(SHA256(password)), as
+	// returned by CachingSha2Hash. It is used to validate the
+	// caching_sha2_password scrambles without storing the password.
+	// mysql> SELECT SHA2(UNHEX(SHA2('mypass', 256)), 256);
+	CachingSha2Password string
 	Password            string
 	UserData            string
 	SourceHost          string
@@ -95,9 +104,15 @@ func InitAuthServerStatic() {
 		// Both parameters specified, can only use one.
 		log.Exitf("Both mysql_auth_server_static_file and mysql_auth_server_static_string specified, can only use one.")
 	}
+	switch *mysqlAuthServerStaticMethod {
+	case MysqlNativePassword, CachingSha2Password, MysqlSha256Password:
+		// Valid flag value.
+	default:
+		log.Exitf("Invalid mysql_auth_server_static_method value: only support mysql_native_password, caching_sha2_password or sha256_password")
+	}
 
 	// Create and register auth server.
-	RegisterAuthServerStaticFromParams(*mysqlAuthServerStaticFile, *mysqlAuthServerStaticString, *mysqlAuthServerStaticReloadInterval)
+	registerAuthServerStatic(*mysqlAuthServerStaticFile, *mysqlAuthServerStaticString, *mysqlAuthServerStaticReloadInterval, *mysqlAuthServerStaticMethod)
 }
 
 // RegisterAuthServerStaticFromParams creates and registers a new
@@ -105,10 +120,15 @@ func InitAuthServerStatic() {
 // it uses file. Otherwise, load the string. It log.Exits out in case
 // of error.
 func RegisterAuthServerStaticFromParams(file, jsonConfig string, reloadInterval time.Duration) {
+	registerAuthServerStatic(file, jsonConfig, reloadInterval, MysqlNativePassword)
+}
+
+func registerAuthServerStatic(file, jsonConfig string, reloadInterval time.Duration, method string) {
 	authServerStatic := NewAuthServerStatic(file, jsonConfig, reloadInterval)
 	if len(authServerStatic.entries) <= 0 {
 		log.Exitf("Failed to populate entries from file: %v", file)
 	}
+	authServerStatic.method = method
 	RegisterAuthServerImpl("static", authServerStatic)
 }
 
@@ -243,6 +263,10 @@ func (a *AuthServerStatic) ValidateHash(salt []byte, user string, authResponse [
 			if matchSourceHost(remoteAddr, entry.SourceHost) && isPass {
 				return &StaticUserData{entry.UserData, entry.Groups}, nil
 			}
+		} else if entry.CachingSha2Password != "" {
+			// The SHA2 hash can't validate a mysql_native_password
+			// scramble.
+			continue
 		} else {
 			computedAuthResponse := ScramblePassword(salt, []byte(entry.Password))
 			// Validate the password.
@@ -263,7 +287,12 @@ func (a *AuthServerStatic) Negotiate(c *Conn, user string, remoteAddr net.Addr)
 	if err != nil {
 		return nil, err
 	}
+	return a.ValidatePassword(user, password, remoteAddr)
+}
 
+// ValidateCachingSha2Hash is part of the Sha2AuthServer interface.
+// It uses the CachingSha2Password hashes, or the clear text passwords.
+func (a *AuthServerStatic) ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error) {
 	a.mu.Lock()
 	entries, ok := a.entries[user]
 	a.mu.Unlock()
@@ -271,9 +300,53 @@ func (a *AuthServerStatic) Negotiate(c *Conn, user string, remoteAddr net.Addr)
 	if !ok {
 		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
 	}
+
+	canValidate := false
 	for _, entry := range entries {
-		// Validate the password.
-		if matchSourceHost(remoteAddr, entry.SourceHost) && entry.Password == password {
+		var isPass bool
+		switch {
+		case entry.CachingSha2Password != "":
+			isPass = isPassScrambleCachingSha2Password(authResponse, salt, strings.TrimPrefix(entry.CachingSha2Password, "*"))
+		case entry.MysqlNativePassword == "":
+			isPass = bytes.Equal(authResponse, ScrambleCachingSha2Password(salt, []byte(entry.Password)))
+		default:
+			// Only the mysql_native_password hash is known, which
+			// needs the password.
+			continue
+		}
+		canValidate = true
+		if matchSourceHost(remoteAddr, entry.SourceHost) && isPass {
+			return &StaticUserData{entry.UserData, entry.Groups}, nil
+		}
+	}
+	if !canValidate {
+		return nil, ErrCachingSha2FullAuth
+	}
+	return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
+// ValidatePassword is part of the Sha2AuthServer interface.
+// It checks the password against any of the stored hashes.
+func (a *AuthServerStatic) ValidatePassword(user, password string, remoteAddr net.Addr) (Getter, error) {
+	a.mu.Lock()
+	entries, ok := a.entries[user]
+	a.mu.Unlock()
+
+	if !ok {
+		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	for _, entry := range entries {
+		var isPass bool
+		switch {
+		case entry.CachingSha2Password != "":
+			isPass = strings.EqualFold(strings.TrimPrefix(entry.CachingSha2Password, "*"), CachingSha2Hash(password))
+		case entry.MysqlNativePassword != "":
+			isPass = isPassMysqlNativePassword(password, entry.MysqlNativePassword)
+		default:
+			isPass = entry.Password == password
+		}
+		if matchSourceHost(remoteAddr, entry.SourceHost) && isPass {
 			return &StaticUserData{entry.UserData, entry.Groups}, nil
 		}
 	}
@@ -285,3 +285,98 @@ func TestStaticPasswords(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticCachingSha2Passwords(t *testing.T) {
+	jsonConfig := `
+{
+	"user01": [{ "Password": "user01" }],
+	"user02": [{
+		"MysqlNativePassword": "*B3AD996B12F211BEA47A7C666CC136FB26DC96AF"
+	}],
+	"user05": [{
+		"CachingSha2Password": "C62C2467CD4430CB0C387207E8D28A8A9AA432EEC7F799ED88AF3D6AD4233F68"
+	}],
+	"user06": [
+		{ "CachingSha2Password": "` + CachingSha2Hash("password1") + `" },
+		{ "Password": "password2" }
+	]
+}`
+
+	tests := []struct {
+		user     string
+		password string
+		// fastAuth is the expected result of ValidateCachingSha2Hash:
+		// "ok", "denied" or "full" for ErrCachingSha2FullAuth.
+		fastAuth string
+		success  bool
+	}{
+		{"user01", "user01", "ok", true},
+		{"user01", "password", "denied", false},
+		{"user02", "user02", "full", true},
+		{"user02", "password", "full", false},
+		{"user05", "user05", "ok", true},
+		{"user05", "password", "denied", false},
+		{"user05", "", "denied", false},
+		{"user06", "password1", "ok", true},
+		{"user06", "password2", "ok", true},
+		{"user06", "password3", "denied", false},
+		{"userXX", "password", "denied", false},
+	}
+
+	auth := NewAuthServerStatic("", jsonConfig, 0)
+	defer auth.close()
+	ip := net.ParseIP("127.0.0.1")
+	addr := &net.IPAddr{IP: ip, Zone: ""}
+
+	for _, c := range tests {
+		t.Run(fmt.Sprintf("%s-%s", c.user, c.password), func(t *testing.T) {
+			salt, err := NewSalt()
+			if err != nil {
+				t.Fatalf("error generating salt: %v", err)
+			}
+
+			scrambled := ScrambleCachingSha2Password(salt, []byte(c.password))
+			_, err = auth.ValidateCachingSha2Hash(salt, c.user, scrambled, addr)
+			switch {
+			case err == nil:
+				if c.fastAuth != "ok" {
+					t.Errorf("fast authentication got ok, want %v", c.fastAuth)
+				}
+			case err == ErrCachingSha2FullAuth:
+				if c.fastAuth != "full" {
+					t.Errorf("fast authentication got full, want %v", c.fastAuth)
+				}
+			default:
+				if c.fastAuth != "denied" {
+					t.Errorf("fast authentication got %v, want %v", err, c.fastAuth)
+				}
+			}
+
+			_, err = auth.ValidatePassword(c.user, c.password, addr)
+			if c.success && err != nil {
+				t.Errorf("authentication should have succeeded: %v", err)
+			}
+			if !c.success && err == nil {
+				t.Errorf("authentication should have failed")
+			}
+		})
+	}
+}
+
+func TestStaticSha2HashesWithNativePassword(t *testing.T) {
+	// An entry with only a SHA2 hash can't be used with
+	// mysql_native_password, even with an empty password.
+	auth := NewAuthServerStatic("", `{"user05": [{"CachingSha2Password": "`+CachingSha2Hash("user05")+`"}]}`, 0)
+	defer auth.close()
+	addr := &net.IPAddr{IP: net.ParseIP("127.0.0.1"), Zone: ""}
+
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatalf("error generating salt: %v", err)
+	}
+	for _, password := range []string{"user05", ""} {
+		if _, err := auth.ValidateHash(salt, "user05", ScramblePassword(salt, []byte(password)), addr); err == nil {
+			t.Errorf("authentication with %q should have failed", password)
+		}
+	}
+}
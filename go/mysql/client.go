@@ -176,7 +176,7 @@ func Connect(ctx context.Context, params *ConnParams) (*Conn, error) {
 // Ping implements mysql ping command.
 func (c *Conn) Ping() error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 	data, pos := c.startEphemeralPacketWithHeader(1)
 	data[pos] = ComPing
 
@@ -247,6 +247,18 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		c.Capabilities = capabilities & (CapabilityClientDeprecateEOF)
 	}
 
+	// Compression, if the server supports an algorithm we want.
+	compression, err := chooseCompression(params.Compression, capabilities)
+	if err != nil {
+		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "%v", err)
+	}
+	c.Capabilities |= compressionCapabilities([]string{compression})
+	zstdLevel := params.ZstdCompressionLevel
+	if zstdLevel == 0 {
+		zstdLevel = DefaultZstdCompressionLevel
+	}
+	c.zstdCompressionLevel = zstdLevel
+
 	// Handle switch to SSL if necessary.
 	if params.Flags&CapabilityClientSSL > 0 {
 		// If client asked for SSL, but server doesn't support it,
@@ -318,24 +330,40 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		// OK packet, we are authenticated. Save the user, keep going.
 		c.User = params.Uname
 	case AuthSwitchRequestPacket:
-		// Server is asking to use a different auth method. We
-		// only support cleartext plugin.
+		// Server is asking to use a different auth method.
 		pluginName, salt, err := parseAuthSwitchRequest(response)
 		if err != nil {
 			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse auth switch request: %v", err)
 		}
 
-		if pluginName == MysqlClearPassword {
+		switch pluginName {
+		case MysqlClearPassword:
 			// Write the cleartext password packet.
 			if err := c.writeClearTextPassword(params); err != nil {
 				return err
 			}
-		} else if pluginName == MysqlNativePassword {
+		case MysqlNativePassword:
 			// Write the mysql_native_password packet.
 			if err := c.writeMysqlNativePassword(params, salt); err != nil {
 				return err
 			}
-		} else {
+		case CachingSha2Password:
+			// Write the caching_sha2_password scramble.
+			if err := c.writeAuthResponse(ScrambleCachingSha2Password(salt, []byte(params.Pass))); err != nil {
+				return err
+			}
+		case MysqlSha256Password:
+			// Send the password if the connection is secure,
+			// ask for the server public key otherwise.
+			if c.isSecure(params) {
+				err = c.writeClearTextPassword(params)
+			} else {
+				err = c.writeAuthResponse([]byte{Sha256RequestPublicKey})
+			}
+			if err != nil {
+				return err
+			}
+		default:
 			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for unsupported auth method: %v", pluginName)
 		}
 
@@ -344,6 +372,12 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		if err != nil {
 			return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
 		}
+		if response[0] == AuthMoreDataPacket {
+			response, err = c.handleAuthMoreData(params, pluginName, salt, response)
+			if err != nil {
+				return err
+			}
+		}
 		switch response[0] {
 		case OKPacket:
 			// OK packet, we are authenticated. Save the user, keep going.
@@ -359,6 +393,14 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "initial server response cannot be parsed: %v", response)
 	}
 
+	// Switch to the compressed packets if we asked for them.
+	if compression != CompressionNone {
+		if err := c.enableCompression(compression, zstdLevel); err != nil {
+			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "%v", err)
+		}
+	}
+	clientConnCountByCompression.Add(compression, 1)
+
 	// If the server didn't support DbName in its handshake, set
 	// it now. This is what the 'mysql' client does.
 	if capabilities&CapabilityClientConnectWithDB == 0 && params.DbName != "" {
@@ -532,7 +574,9 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 		// CapabilityClientDeprecateEOF, we also support it.
 		c.Capabilities&CapabilityClientDeprecateEOF |
 		// Pass-through ClientFoundRows flag.
-		CapabilityClientFoundRows&uint32(params.Flags)
+		CapabilityClientFoundRows&uint32(params.Flags) |
+		// The compression we picked.
+		c.Capabilities&(CapabilityClientCompress|CapabilityClientZstdCompressionAlgorithm)
 
 	length :=
 		4 + // Client capability flags.
@@ -590,7 +634,9 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		CapabilityClientFoundRows&uint32(params.Flags) |
 		// If the server supported
 		// CapabilityClientSessionTrack, we also support it.
-		c.Capabilities&CapabilityClientSessionTrack
+		c.Capabilities&CapabilityClientSessionTrack |
+		// The compression we picked.
+		c.Capabilities&(CapabilityClientCompress|CapabilityClientZstdCompressionAlgorithm)
 
 	// FIXME(alainjobart) add multi statement.
 
@@ -617,6 +663,11 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		length++
 	}
 
+	// The zstd compression level.
+	if capabilityFlags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		length++
+	}
+
 	data, pos := c.startEphemeralPacketWithHeader(length)
 
 	// Client capability flags.
@@ -653,6 +704,11 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 	// Assume native client during response
 	pos = writeNullString(data, pos, MysqlNativePassword)
 
+	// The zstd compression level.
+	if capabilityFlags&CapabilityClientZstdCompressionAlgorithm != 0 {
+		pos = writeByte(data, pos, byte(c.zstdCompressionLevel))
+	}
+
 	// Sanity-check the length.
 	if pos != len(data) {
 		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "writeHandshakeResponse41: only packed %v bytes, out of %v allocated", pos, len(data))
@@ -679,6 +735,64 @@ func parseAuthSwitchRequest(data []byte) (string, []byte, error) {
 	return pluginName, salt, nil
 }
 
+// handleAuthMoreData answers the extra authentication data sent by the
+// server for the caching_sha2_password and sha256_password methods. It
+// returns the first packet that isn't extra authentication data.
+// Returns a SQLError.
+func (c *Conn) handleAuthMoreData(params *ConnParams, pluginName string, salt, response []byte) ([]byte, error) {
+	for len(response) > 0 && response[0] == AuthMoreDataPacket {
+		var err error
+		switch {
+		case pluginName == CachingSha2Password && len(response) == 2 && response[1] == CachingSha2FastAuth:
+			// The scramble was accepted, the OK packet follows.
+		case pluginName == CachingSha2Password && len(response) == 2 && response[1] == CachingSha2FullAuth:
+			// The server needs the password.
+			if c.isSecure(params) {
+				err = c.writeClearTextPassword(params)
+			} else {
+				err = c.writeAuthResponse([]byte{CachingSha2RequestPublicKey})
+			}
+		case pluginName == CachingSha2Password || pluginName == MysqlSha256Password:
+			// This is the server public key.
+			var encrypted []byte
+			encrypted, err = EncryptPasswordWithPublicKey(salt, []byte(params.Pass), response[1:])
+			if err != nil {
+				return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "%v", err)
+			}
+			err = c.writeAuthResponse(encrypted)
+		default:
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "unexpected auth data for %v: %v", pluginName, response)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		response, err = c.readPacket()
+		if err != nil {
+			return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+		}
+	}
+	return response, nil
+}
+
+// isSecure returns true if the password can be sent in the clear, over
+// TLS or a unix socket.
+func (c *Conn) isSecure(params *ConnParams) bool {
+	return c.Capabilities&CapabilityClientSSL != 0 || params.UnixSocket != ""
+}
+
+// writeAuthResponse writes authentication data as a packet.
+// Returns a SQLError.
+func (c *Conn) writeAuthResponse(authResponse []byte) error {
+	data, pos := c.startEphemeralPacketWithHeader(len(authResponse))
+	pos += copy(data[pos:], authResponse)
+	// Sanity check.
+	if pos != len(data) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "error building auth response packet: got %v bytes expected %v", pos, len(data))
+	}
+	return c.writeEphemeralPacket()
+}
+
 // writeClearTextPassword writes the clear text password.
 // Returns a SQLError.
 func (c *Conn) writeClearTextPassword(params *ConnParams) error {
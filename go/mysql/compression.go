@@ -0,0 +1,395 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// compressedPacketHeaderSize is the size of the header of the
+	// compressed packets: the length of the payload on 3 bytes, the
+	// sequence number, and the length of the uncompressed payload on
+	// 3 bytes, or 0 if the payload is not compressed.
+	compressedPacketHeaderSize = 7
+
+	// minCompressLength is the length under which payloads are sent
+	// uncompressed. It is the value MySQL uses.
+	minCompressLength = 50
+)
+
+var (
+	clientConnCountByCompression = stats.NewCountersWithSingleLabel("MysqlClientConnCountByCompression", "MySQL client connections established by compression algorithm", "compression")
+
+	// zstdDecoder is shared by all the connections, as DecodeAll can
+	// be called concurrently. It never decodes more than a packet.
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+
+	// zstdEncoders has a shared *zstd.Encoder per compression level.
+	zstdEncodersMu sync.Mutex
+	zstdEncoders   = make(map[int]*zstd.Encoder)
+)
+
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(MaxPacketSize))
+	})
+	return zstdDecoder, zstdDecoderErr
+}
+
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	zstdEncodersMu.Lock()
+	defer zstdEncodersMu.Unlock()
+	if encoder, ok := zstdEncoders[level]; ok {
+		return encoder, nil
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	if err != nil {
+		return nil, err
+	}
+	zstdEncoders[level] = encoder
+	return encoder, nil
+}
+
+// compressionCapabilities returns the capability flags for a list of
+// compression algorithms.
+func compressionCapabilities(algorithms []string) uint32 {
+	var capabilities uint32
+	for _, algorithm := range algorithms {
+		switch algorithm {
+		case CompressionZlib:
+			capabilities |= CapabilityClientCompress
+		case CompressionZstd:
+			capabilities |= CapabilityClientZstdCompressionAlgorithm
+		}
+	}
+	return capabilities
+}
+
+// negotiatedCompression returns the compression algorithm for the
+// capability flags both sides agreed on. zstd is preferred.
+func negotiatedCompression(capabilities uint32) string {
+	switch {
+	case capabilities&CapabilityClientZstdCompressionAlgorithm != 0:
+		return CompressionZstd
+	case capabilities&CapabilityClientCompress != 0:
+		return CompressionZlib
+	default:
+		return CompressionNone
+	}
+}
+
+// ParseCompressionAlgorithms parses a comma separated list of
+// compression algorithms, and checks they are supported.
+func ParseCompressionAlgorithms(value string) ([]string, error) {
+	var algorithms []string
+	for _, algorithm := range strings.Split(value, ",") {
+		algorithm = strings.TrimSpace(algorithm)
+		switch algorithm {
+		case "", CompressionNone:
+		case CompressionZlib, CompressionZstd:
+			algorithms = append(algorithms, algorithm)
+		default:
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unsupported compression algorithm %v, valid values are %v and %v", algorithm, CompressionZlib, CompressionZstd)
+		}
+	}
+	return algorithms, nil
+}
+
+// chooseCompression returns the first of the compression algorithms
+// the client wants that the server supports, or CompressionNone.
+func chooseCompression(wanted string, serverCapabilities uint32) (string, error) {
+	algorithms, err := ParseCompressionAlgorithms(wanted)
+	if err != nil {
+		return "", err
+	}
+	for _, algorithm := range algorithms {
+		if serverCapabilities&compressionCapabilities([]string{algorithm}) != 0 {
+			return algorithm, nil
+		}
+	}
+	return CompressionNone, nil
+}
+
+// compressedConn implements the compressed packets on top of a
+// connection. The regular packets are a stream of bytes inside the
+// compressed packets: a compressed packet can contain several
+// regular packets, or only part of one.
+type compressedConn struct {
+	net.Conn
+
+	algorithm string
+	zstdLevel int
+
+	reader *bufio.Reader
+	// readBuffer has the uncompressed data Read hasn't returned yet.
+	readBuffer []byte
+
+	// sequence is the sequence number of the next compressed packet.
+	// It is reset with the sequence of the regular packets at the
+	// start of each command, and follows the sequence of the
+	// compressed packets we read.
+	sequence uint8
+
+	// zlibWriter and zlibBuffer are reused to compress with zlib.
+	zlibWriter *zlib.Writer
+	zlibBuffer bytes.Buffer
+}
+
+func newCompressedConn(conn net.Conn, algorithm string, zstdLevel int) *compressedConn {
+	return &compressedConn{
+		Conn:      conn,
+		algorithm: algorithm,
+		zstdLevel: zstdLevel,
+		reader:    bufio.NewReaderSize(conn, connBufferSize),
+	}
+}
+
+// Read is part of the net.Conn interface. It returns uncompressed data.
+func (cc *compressedConn) Read(data []byte) (int, error) {
+	for len(cc.readBuffer) == 0 {
+		if err := cc.readCompressedPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(data, cc.readBuffer)
+	cc.readBuffer = cc.readBuffer[n:]
+	return n, nil
+}
+
+// readCompressedPacket reads the next compressed packet into readBuffer.
+// It returns io.EOF as is, like readHeaderFrom expects.
+func (cc *compressedConn) readCompressedPacket() error {
+	var header [compressedPacketHeaderSize]byte
+	if _, err := io.ReadFull(cc.reader, header[:]); err != nil {
+		return err
+	}
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	cc.sequence = header[3] + 1
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(cc.reader, payload); err != nil {
+		return vterrors.Wrapf(err, "io.ReadFull(compressed packet body of length %v) failed", length)
+	}
+	if uncompressedLength == 0 {
+		// This payload was too small to be compressed.
+		cc.readBuffer = payload
+		return nil
+	}
+
+	data, err := cc.decompress(payload, uncompressedLength)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot decompress %v packet", cc.algorithm)
+	}
+	if len(data) != uncompressedLength {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid %v packet, got %v uncompressed bytes expected %v", cc.algorithm, len(data), uncompressedLength)
+	}
+	cc.readBuffer = data
+	return nil
+}
+
+func (cc *compressedConn) decompress(payload []byte, uncompressedLength int) ([]byte, error) {
+	switch cc.algorithm {
+	case CompressionZlib:
+		r, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		data := make([]byte, uncompressedLength)
+		n, err := io.ReadFull(r, data)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		return data[:n], nil
+	case CompressionZstd:
+		// Like with zlib, we don't decompress more than the packet
+		// announces. Frames that don't declare their size, like the
+		// small ones, are bounded by the decoder.
+		if size, ok := zstdFrameContentSize(payload); ok && size != uint64(uncompressedLength) {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid zstd frame, its content size is %v instead of %v", size, uncompressedLength)
+		}
+		decoder, err := getZstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		return decoder.DecodeAll(payload, make([]byte, 0, uncompressedLength))
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "unknown compression algorithm %v", cc.algorithm)
+	}
+}
+
+// zstdFrameContentSize returns the content size declared by the header
+// of the zstd frame at the start of payload. It returns false if the
+// header can't be read, or doesn't declare the content size.
+func zstdFrameContentSize(payload []byte) (uint64, bool) {
+	// The magic number, then the frame header descriptor.
+	if len(payload) < 5 || payload[0] != 0x28 || payload[1] != 0xb5 || payload[2] != 0x2f || payload[3] != 0xfd {
+		return 0, false
+	}
+	descriptor := payload[4]
+	singleSegment := descriptor&0x20 != 0
+	pos := 5
+	if !singleSegment {
+		// The window descriptor.
+		pos++
+	}
+	pos += [4]int{0, 1, 2, 4}[descriptor&0x3] // the dictionary ID
+	var fcsSize int
+	switch descriptor >> 6 {
+	case 0:
+		if !singleSegment {
+			return 0, false
+		}
+		fcsSize = 1
+	case 1:
+		fcsSize = 2
+	case 2:
+		fcsSize = 4
+	case 3:
+		fcsSize = 8
+	}
+	if len(payload) < pos+fcsSize {
+		return 0, false
+	}
+	var size uint64
+	for i := fcsSize - 1; i >= 0; i-- {
+		size = size<<8 | uint64(payload[pos+i])
+	}
+	if fcsSize == 2 {
+		size += 256
+	}
+	return size, true
+}
+
+// Write is part of the net.Conn interface. It compresses the data,
+// in as many compressed packets as needed.
+func (cc *compressedConn) Write(data []byte) (int, error) {
+	written := 0
+	for len(data) > 0 {
+		chunk := data
+		if len(chunk) > MaxPacketSize {
+			chunk = chunk[:MaxPacketSize]
+		}
+		if err := cc.writeCompressedPacket(chunk); err != nil {
+			return written, err
+		}
+		written += len(chunk)
+		data = data[len(chunk):]
+	}
+	return written, nil
+}
+
+func (cc *compressedConn) writeCompressedPacket(data []byte) error {
+	payload := data
+	uncompressedLength := 0
+	if len(data) >= minCompressLength {
+		compressed, err := cc.compress(data)
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot compress %v packet", cc.algorithm)
+		}
+		// Only use the compressed payload if it's smaller.
+		if len(compressed) < len(data) {
+			payload = compressed
+			uncompressedLength = len(data)
+		}
+	}
+
+	packet := make([]byte, compressedPacketHeaderSize+len(payload))
+	packet[0] = byte(len(payload))
+	packet[1] = byte(len(payload) >> 8)
+	packet[2] = byte(len(payload) >> 16)
+	packet[3] = cc.sequence
+	packet[4] = byte(uncompressedLength)
+	packet[5] = byte(uncompressedLength >> 8)
+	packet[6] = byte(uncompressedLength >> 16)
+	copy(packet[compressedPacketHeaderSize:], payload)
+	cc.sequence++
+
+	if n, err := cc.Conn.Write(packet); err != nil {
+		return err
+	} else if n != len(packet) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "Write(compressed packet) returned a short write: %v < %v", n, len(packet))
+	}
+	return nil
+}
+
+func (cc *compressedConn) compress(data []byte) ([]byte, error) {
+	switch cc.algorithm {
+	case CompressionZlib:
+		cc.zlibBuffer.Reset()
+		if cc.zlibWriter == nil {
+			cc.zlibWriter = zlib.NewWriter(&cc.zlibBuffer)
+		} else {
+			cc.zlibWriter.Reset(&cc.zlibBuffer)
+		}
+		if _, err := cc.zlibWriter.Write(data); err != nil {
+			return nil, err
+		}
+		if err := cc.zlibWriter.Close(); err != nil {
+			return nil, err
+		}
+		return cc.zlibBuffer.Bytes(), nil
+	case CompressionZstd:
+		encoder, err := getZstdEncoder(cc.zstdLevel)
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeAll(data, nil), nil
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "unknown compression algorithm %v", cc.algorithm)
+	}
+}
+
+// enableCompression switches the connection to the compressed packets.
+// It is called by both sides once the handshake is done, if they
+// agreed on a compression algorithm.
+func (c *Conn) enableCompression(algorithm string, zstdLevel int) error {
+	if c.bufferedReader != nil && c.bufferedReader.Buffered() > 0 {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "cannot enable compression with %v bytes left to read", c.bufferedReader.Buffered())
+	}
+	cc := newCompressedConn(c.conn, algorithm, zstdLevel)
+	c.conn = cc
+	if c.bufferedReader != nil {
+		c.bufferedReader.Reset(cc)
+	}
+	return nil
+}
+
+// Compression returns the compression algorithm used by the
+// connection, or CompressionNone.
+func (c *Conn) Compression() string {
+	if cc, ok := c.conn.(*compressedConn); ok {
+		return cc.algorithm
+	}
+	return CompressionNone
+}
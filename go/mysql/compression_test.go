@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedConn(t *testing.T) {
+	for _, algorithm := range []string{CompressionZlib, CompressionZstd} {
+		t.Run(algorithm, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+			writer := newCompressedConn(client, algorithm, DefaultZstdCompressionLevel)
+			reader := newCompressedConn(server, algorithm, DefaultZstdCompressionLevel)
+
+			// A payload too small to be compressed, one that
+			// compresses well, and one that doesn't.
+			random := make([]byte, 1000)
+			for i := range random {
+				random[i] = byte(i * 7919 >> 3)
+			}
+			payloads := [][]byte{
+				[]byte("small"),
+				[]byte(strings.Repeat("compressible ", 10000)),
+				random,
+			}
+			go func() {
+				for _, payload := range payloads {
+					_, err := writer.Write(payload)
+					assert.NoError(t, err)
+				}
+			}()
+			for i, payload := range payloads {
+				got := make([]byte, len(payload))
+				_, err := io.ReadFull(reader, got)
+				require.NoError(t, err)
+				assert.Equal(t, payload, got, "payload %v", i)
+			}
+			assert.Equal(t, uint8(len(payloads)), reader.sequence)
+			assert.Equal(t, writer.sequence, reader.sequence)
+		})
+	}
+}
+
+func TestParseCompressionAlgorithms(t *testing.T) {
+	algorithms, err := ParseCompressionAlgorithms("zstd, zlib,uncompressed")
+	require.NoError(t, err)
+	assert.Equal(t, []string{CompressionZstd, CompressionZlib}, algorithms)
+
+	algorithms, err = ParseCompressionAlgorithms("")
+	require.NoError(t, err)
+	assert.Empty(t, algorithms)
+
+	_, err = ParseCompressionAlgorithms("lz4")
+	assert.Error(t, err)
+}
+
+func TestCompressionServer(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	defer authServer.close()
+
+	tests := []struct {
+		server []string
+		client string
+		want   string
+	}{
+		{[]string{CompressionZlib}, "zlib", CompressionZlib},
+		{[]string{CompressionZlib, CompressionZstd}, "zstd", CompressionZstd},
+		{[]string{CompressionZlib, CompressionZstd}, "zstd,zlib", CompressionZstd},
+		{[]string{CompressionZlib}, "zstd,zlib", CompressionZlib},
+		{[]string{CompressionZstd}, "zlib", CompressionNone},
+		{nil, "zstd,zlib", CompressionNone},
+		{[]string{CompressionZlib, CompressionZstd}, "", CompressionNone},
+	}
+	for _, test := range tests {
+		t.Run(strings.Join(test.server, ",")+"/"+test.client, func(t *testing.T) {
+			l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+			require.NoError(t, err)
+			defer l.Close()
+			l.Compression = test.server
+			go l.Accept()
+
+			host, port := getHostPort(t, l.Addr())
+			params := &ConnParams{
+				Host:        host,
+				Port:        port,
+				Uname:       "user1",
+				Pass:        "password1",
+				Compression: test.client,
+			}
+			c, err := Connect(context.Background(), params)
+			require.NoError(t, err)
+			defer c.Close()
+			assert.Equal(t, test.want, c.Compression())
+
+			// A large query, and a large result.
+			query := benchmarkQueryPrefix + strings.Repeat("x", 100000)
+			result, err := c.ExecuteFetch(query, 10, true)
+			require.NoError(t, err)
+			require.Len(t, result.Rows, 1)
+			assert.Equal(t, query, result.Rows[0][0].ToString())
+
+			// Several commands in a row.
+			for i := 0; i < 3; i++ {
+				result, err = c.ExecuteFetch("select rows", 10000, true)
+				require.NoError(t, err)
+				assert.Equal(t, selectRowsResult.Rows, result.Rows)
+			}
+			assert.Equal(t, test.want, th.LastConn().Compression())
+			assert.NotZero(t, connCountByCompression.Counts()[test.want])
+		})
+	}
+}
+
+func TestZstdFrameContentSize(t *testing.T) {
+	encoder, err := getZstdEncoder(DefaultZstdCompressionLevel)
+	require.NoError(t, err)
+	// Small frames don't declare their size.
+	frame := encoder.EncodeAll([]byte(strings.Repeat("a", 10)), nil)
+	_, ok := zstdFrameContentSize(frame)
+	assert.False(t, ok)
+	for _, size := range []int{300, 70000, 1 << 20} {
+		frame := encoder.EncodeAll([]byte(strings.Repeat("a", size)), nil)
+		got, ok := zstdFrameContentSize(frame)
+		assert.True(t, ok, "size %v", size)
+		assert.Equal(t, uint64(size), got)
+	}
+	_, ok = zstdFrameContentSize([]byte("not a zstd frame"))
+	assert.False(t, ok)
+
+	// A frame that doesn't announce the size of the packet is rejected
+	// before being decompressed.
+	cc := newCompressedConn(nil, CompressionZstd, DefaultZstdCompressionLevel)
+	frame = encoder.EncodeAll([]byte(strings.Repeat("a", 1000)), nil)
+	_, err = cc.decompress(frame, 10)
+	assert.EqualError(t, err, "invalid zstd frame, its content size is 1000 instead of 10")
+	data, err := cc.decompress(frame, 1000)
+	require.NoError(t, err)
+	assert.Len(t, data, 1000)
+}
+
+func TestParseHandshakeZstdLevel(t *testing.T) {
+	l := &Listener{Compression: []string{CompressionZstd}}
+	packet := func(attrs []byte) []byte {
+		var data []byte
+		flags := CapabilityClientProtocol41 | CapabilityClientSecureConnection | CapabilityClientConnAttr | CapabilityClientZstdCompressionAlgorithm
+		data = append(data, byte(flags), byte(flags>>8), byte(flags>>16), byte(flags>>24))
+		data = append(data, 0, 0, 0, 0, CharacterSetUtf8)
+		data = append(data, make([]byte, 23)...)
+		data = append(data, "user\x00"...)
+		data = append(data, 0)
+		data = append(data, attrs...)
+		return append(data, 7)
+	}
+
+	c := newConn(nil)
+	_, _, _, err := l.parseClientHandshakePacket(c, true, packet([]byte{4, 1, 'k', 1, 'v'}))
+	require.NoError(t, err)
+	assert.Equal(t, 7, c.zstdCompressionLevel)
+
+	// The level can't be found after invalid connection attributes.
+	c = newConn(nil)
+	_, _, _, err = l.parseClientHandshakePacket(c, true, packet([]byte{4, 5, 'k'}))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can't read connection attributes")
+}
@@ -149,8 +149,8 @@ type Conn struct {
 	// the client and the server, and currently in use.
 	// It is set during the initial handshake.
 	//
-	// It is only used for CapabilityClientDeprecateEOF
-	// and CapabilityClientFoundRows.
+	// It is only used for CapabilityClientDeprecateEOF,
+	// CapabilityClientFoundRows and the compression capabilities.
 	Capabilities uint32
 
 	// closed is set to true when Close() is called on the connection.
@@ -180,6 +180,10 @@ type Conn struct {
 
 	// Packet encoding variables.
 	sequence uint8
+
+	// zstdCompressionLevel is the level of the zstd compression, sent
+	// by the client with CapabilityClientZstdCompressionAlgorithm.
+	zstdCompressionLevel int
 }
 
 // splitStatementFunciton is the function that is used to split the statement in cas ef a multi-statement query.
@@ -636,13 +640,21 @@ func (c *Conn) recycleWritePacket() {
 	c.currentEphemeralPolicy = ephemeralUnused
 }
 
+// resetSequence resets the sequence numbers at the start of a command.
+func (c *Conn) resetSequence() {
+	c.sequence = 0
+	if cc, ok := c.conn.(*compressedConn); ok {
+		cc.sequence = 0
+	}
+}
+
 // writeComQuit writes a Quit message for the server, to indicate we
 // want to close the connection.
 // Client -> Server.
 // Returns SQLError(CRServerGone) if it can't.
 func (c *Conn) writeComQuit() error {
 	// This is a new command, need to reset the sequence.
-	c.sequence = 0
+	c.resetSequence()
 
 	data, pos := c.startEphemeralPacketWithHeader(1)
 	data[pos] = ComQuit
@@ -844,7 +856,7 @@ func (c *Conn) writeEOFPacket(flags uint16, warnings uint16) error {
 // handleNextCommand is called in the server loop to process
 // incoming packets.
 func (c *Conn) handleNextCommand(handler Handler) bool {
-	c.sequence = 0
+	c.resetSequence()
 	data, err := c.readEphemeralPacket()
 	if err != nil {
 		// Don't log EOF errors. They cause too much spam.
@@ -1469,7 +1481,11 @@ func ParseErrorPacket(data []byte) error {
 
 // GetTLSClientCerts gets TLS certificates.
 func (c *Conn) GetTLSClientCerts() []*x509.Certificate {
-	if tlsConn, ok := c.conn.(*tls.Conn); ok {
+	conn := c.conn
+	if cc, ok := conn.(*compressedConn); ok {
+		conn = cc.Conn
+	}
+	if tlsConn, ok := conn.(*tls.Conn); ok {
 		return tlsConn.ConnectionState().PeerCertificates
 	}
 	return nil
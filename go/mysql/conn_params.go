@@ -37,6 +37,14 @@ type ConnParams struct {
 	ServerName       string `json:"server_name"`
 	ConnectTimeoutMs uint64 `json:"connect_timeout_ms"`
 
+	// Compression is a comma separated list of the compression
+	// algorithms the client can use, in order of preference:
+	// zstd and zlib. The first one the server supports is used.
+	Compression string `json:"compression"`
+	// ZstdCompressionLevel is the level of the zstd compression.
+	// It defaults to DefaultZstdCompressionLevel.
+	ZstdCompressionLevel int `json:"zstd_compression_level"`
+
 	// The following is only set when the deprecated "dbname" flags are
 	// supplied and will be removed.
 	DeprecatedDBName string
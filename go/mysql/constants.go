@@ -39,6 +39,34 @@ const (
 	// MysqlDialog uses the dialog plugin on the client side.
 	// It transmits data in the clear.
 	MysqlDialog = "dialog"
+
+	// CachingSha2Password uses a salt and transmits a SHA256 hash on the
+	// wire. If the server can't validate the hash, the password is sent
+	// over TLS, or encrypted with the server RSA public key.
+	CachingSha2Password = "caching_sha2_password"
+
+	// MysqlSha256Password sends the password over TLS, or encrypted with
+	// the server RSA public key.
+	MysqlSha256Password = "sha256_password"
+)
+
+// Values used by the caching_sha2_password and sha256_password methods.
+const (
+	// CachingSha2FastAuth is sent by the server when the scramble
+	// sent by the client was validated.
+	CachingSha2FastAuth = 0x03
+
+	// CachingSha2FullAuth is sent by the server when it needs the
+	// password to validate the client.
+	CachingSha2FullAuth = 0x04
+
+	// CachingSha2RequestPublicKey is sent by a caching_sha2_password
+	// client to ask for the server RSA public key.
+	CachingSha2RequestPublicKey = 0x02
+
+	// Sha256RequestPublicKey is sent by a sha256_password client to ask
+	// for the server RSA public key.
+	Sha256RequestPublicKey = 0x01
 )
 
 // Capability flags.
@@ -64,8 +92,11 @@ const (
 	// CLIENT_NO_SCHEMA 1 << 4
 	// Do not permit database.table.column. We do permit it.
 
-	// CLIENT_COMPRESS 1 << 5
-	// We do not support compression. CPU is usually our bottleneck.
+	// CapabilityClientCompress is CLIENT_COMPRESS.
+	// Use the zlib compressed packets after the handshake. We only
+	// advertise it if compression is enabled, as CPU is usually our
+	// bottleneck.
+	CapabilityClientCompress = 1 << 5
 
 	// CLIENT_ODBC 1 << 6
 	// No special behavior since 3.22.
@@ -136,6 +167,29 @@ const (
 	// CapabilityClientDeprecateEOF is CLIENT_DEPRECATE_EOF
 	// Expects an OK (instead of EOF) after the resultset rows of a Text Resultset.
 	CapabilityClientDeprecateEOF = 1 << 24
+
+	// CapabilityClientZstdCompressionAlgorithm is
+	// CLIENT_ZSTD_COMPRESSION_ALGORITHM.
+	// Use the zstd compressed packets after the handshake. The client
+	// sends the compression level at the end of its handshake response.
+	CapabilityClientZstdCompressionAlgorithm = 1 << 26
+)
+
+// Compression algorithms of the compressed packets.
+const (
+	// CompressionNone means the packets are not compressed.
+	CompressionNone = "uncompressed"
+
+	// CompressionZlib uses zlib, negotiated with CapabilityClientCompress.
+	CompressionZlib = "zlib"
+
+	// CompressionZstd uses zstd, negotiated with
+	// CapabilityClientZstdCompressionAlgorithm.
+	CompressionZstd = "zstd"
+
+	// DefaultZstdCompressionLevel is the zstd compression level used if
+	// the client doesn't specify one.
+	DefaultZstdCompressionLevel = 3
 )
 
 // Status flags. They are returned by the server in a few cases.
@@ -233,6 +287,10 @@ const (
 	// AuthSwitchRequestPacket is used to switch auth method.
 	AuthSwitchRequestPacket = 0xfe
 
+	// AuthMoreDataPacket is the header of the extra data sent by the
+	// server during the authentication.
+	AuthMoreDataPacket = 0x01
+
 	// ErrPacket is the header of the error packet.
 	ErrPacket = 0xff
 
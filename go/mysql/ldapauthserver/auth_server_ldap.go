@@ -36,7 +36,7 @@ import (
 var (
 	ldapAuthConfigFile   = flag.String("mysql_ldap_auth_config_file", "", "JSON File from which to read LDAP server config.")
 	ldapAuthConfigString = flag.String("mysql_ldap_auth_config_string", "", "JSON representation of LDAP server config.")
-	ldapAuthMethod       = flag.String("mysql_ldap_auth_method", mysql.MysqlClearPassword, "client-side authentication method to use. Supported values: mysql_clear_password, dialog.")
+	ldapAuthMethod       = flag.String("mysql_ldap_auth_method", mysql.MysqlClearPassword, "client-side authentication method to use. Supported values: mysql_clear_password, dialog, caching_sha2_password, sha256_password.")
 )
 
 // AuthServerLdap implements AuthServer with an LDAP backend
@@ -61,8 +61,11 @@ func Init() {
 		log.Infof("Both mysql_ldap_auth_config_file and mysql_ldap_auth_config_string are non-empty, can only use one.")
 		return
 	}
-	if *ldapAuthMethod != mysql.MysqlClearPassword && *ldapAuthMethod != mysql.MysqlDialog {
-		log.Exitf("Invalid mysql_ldap_auth_method value: only support mysql_clear_password or dialog")
+	switch *ldapAuthMethod {
+	case mysql.MysqlClearPassword, mysql.MysqlDialog, mysql.CachingSha2Password, mysql.MysqlSha256Password:
+		// Valid flag value.
+	default:
+		log.Exitf("Invalid mysql_ldap_auth_method value: only support mysql_clear_password, dialog, caching_sha2_password or sha256_password")
 	}
 	ldapAuthServer := &AuthServerLdap{
 		Client:       &ClientImpl{},
@@ -109,6 +112,17 @@ func (asl *AuthServerLdap) Negotiate(c *mysql.Conn, user string, remoteAddr net.
 	return asl.validate(user, password)
 }
 
+// ValidateCachingSha2Hash is part of the Sha2AuthServer interface.
+// LDAP needs the password, so the full authentication is always used.
+func (asl *AuthServerLdap) ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (mysql.Getter, error) {
+	return nil, mysql.ErrCachingSha2FullAuth
+}
+
+// ValidatePassword is part of the Sha2AuthServer interface.
+func (asl *AuthServerLdap) ValidatePassword(user, password string, remoteAddr net.Addr) (mysql.Getter, error) {
+	return asl.validate(user, password)
+}
+
 func (asl *AuthServerLdap) validate(username, password string) (mysql.Getter, error) {
 	if err := asl.Client.Connect("tcp", &asl.ServerConfig); err != nil {
 		return nil, err
@@ -17,10 +17,13 @@ limitations under the License.
 package mysql
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"io"
 	"net"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -62,9 +65,10 @@ var (
 	connRefuse = stats.NewCounter("MysqlServerConnRefused", "Connections refused by MySQL server")
 	connSlow   = stats.NewCounter("MysqlServerConnSlow", "Connections that took more than the configured mysql_slow_connect_warn_threshold to establish")
 
-	connCountByTLSVer = stats.NewGaugesWithSingleLabel("MysqlServerConnCountByTLSVer", "Active MySQL server connections by TLS version", "tls")
-	connCountPerUser  = stats.NewGaugesWithSingleLabel("MysqlServerConnCountPerUser", "Active MySQL server connections per user", "count")
-	_                 = stats.NewGaugeFunc("MysqlServerConnCountUnauthenticated", "Active MySQL server connections that haven't authenticated yet", func() int64 {
+	connCountByTLSVer      = stats.NewGaugesWithSingleLabel("MysqlServerConnCountByTLSVer", "Active MySQL server connections by TLS version", "tls")
+	connCountByCompression = stats.NewGaugesWithSingleLabel("MysqlServerConnCountByCompression", "Active MySQL server connections by compression algorithm", "compression")
+	connCountPerUser       = stats.NewGaugesWithSingleLabel("MysqlServerConnCountPerUser", "Active MySQL server connections per user", "count")
+	_                      = stats.NewGaugeFunc("MysqlServerConnCountUnauthenticated", "Active MySQL server connections that haven't authenticated yet", func() int64 {
 		totalUsers := int64(0)
 		for _, v := range connCountPerUser.Counts() {
 			totalUsers += v
@@ -153,6 +157,21 @@ type Listener struct {
 	// beyond which a warning is logged to identify the slow connection
 	SlowConnectWarnThreshold sync2.AtomicDuration
 
+	// RSAKey is the key pair used to receive passwords with the
+	// caching_sha2_password and sha256_password methods over non-TLS
+	// connections. If it is not set, a key pair is generated the
+	// first time it is needed.
+	RSAKey *rsa.PrivateKey
+
+	// Compression lists the compression algorithms we advertise:
+	// CompressionZlib and CompressionZstd. The client may ask to
+	// use one of them once the handshake is done.
+	Compression []string
+
+	// rsaKeyOnce and rsaKeyErr protect the generation of RSAKey.
+	rsaKeyOnce sync.Once
+	rsaKeyErr  error
+
 	// The following parameters are changed by the Accept routine.
 
 	// Incrementing ID for connection id.
@@ -295,7 +314,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	defer connCount.Add(-1)
 
 	// First build and send the server handshake packet.
-	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig.Load() != nil)
+	salt, err := c.writeHandshakeV10(l.ServerVersion, l.authServer, l.TLSConfig.Load() != nil, l.Compression)
 	if err != nil {
 		if err != io.EOF {
 			log.Errorf("Cannot send HandshakeV10 packet to %s: %v", c, err)
@@ -406,6 +425,46 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		c.User = user
 		c.UserData = userData
 
+	case authServerMethod == CachingSha2Password || authServerMethod == MysqlSha256Password:
+		// The server wants to use one of the SHA2 methods. We
+		// handle the packets, and the AuthServer validates the
+		// credentials.
+		sha2AuthServer, ok := l.authServer.(Sha2AuthServer)
+		if !ok {
+			c.writeErrorPacket(CRServerHandshakeErr, SSUnknownSQLState, "Auth server doesn't support %v.", authServerMethod)
+			return
+		}
+
+		if authMethod != authServerMethod {
+			// The client returned a result for something else,
+			// switch to what the server wants with a new salt.
+			salt, err = l.authServer.Salt()
+			if err != nil {
+				return
+			}
+			// The binary protocol requires padding with 0
+			data := append(salt, byte(0x00))
+			if err := c.writeAuthSwitchRequest(authServerMethod, data); err != nil {
+				log.Errorf("Error writing auth switch packet for %s: %v", c, err)
+				return
+			}
+
+			authResponse, err = c.ReadPacket()
+			if err != nil {
+				log.Errorf("Error reading auth switch response for %s: %v", c, err)
+				return
+			}
+		}
+
+		userData, err := l.negotiateSha2(c, sha2AuthServer, authServerMethod, user, salt, authResponse)
+		if err != nil {
+			log.Warningf("Error authenticating user using %v: %v", authServerMethod, err)
+			c.writeErrorPacketFromError(err)
+			return
+		}
+		c.User = user
+		c.UserData = userData
+
 	default:
 		// The server wants to use something else, re-negotiate.
 
@@ -459,6 +518,17 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		return
 	}
 
+	// Switch to the compressed packets if the client asked for them.
+	compression := negotiatedCompression(c.Capabilities)
+	if compression != CompressionNone {
+		if err := c.enableCompression(compression, c.zstdCompressionLevel); err != nil {
+			log.Errorf("Cannot enable %v compression for %s: %v", compression, c, err)
+			return
+		}
+	}
+	connCountByCompression.Add(compression, 1)
+	defer connCountByCompression.Add(compression, -1)
+
 	// Record how long we took to establish the connection
 	timings.Record(connectTimingKey, acceptTime)
 
@@ -496,7 +566,7 @@ func (l *Listener) isShutdown() bool {
 
 // writeHandshakeV10 writes the Initial Handshake Packet, server side.
 // It returns the salt data.
-func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool) ([]byte, error) {
+func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool, compression []string) ([]byte, error) {
 	capabilities := CapabilityClientLongPassword |
 		CapabilityClientFoundRows |
 		CapabilityClientLongFlag |
@@ -513,6 +583,7 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 	if enableTLS {
 		capabilities |= CapabilityClientSSL
 	}
+	capabilities |= int(compressionCapabilities(compression))
 
 	length :=
 		1 + // protocol version
@@ -623,6 +694,11 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 		c.Capabilities |= CapabilityClientMultiStatements
 	}
 
+	// Remember the compression the client asked for, among the
+	// algorithms we advertised.
+	c.Capabilities &^= CapabilityClientCompress | CapabilityClientZstdCompressionAlgorithm
+	c.Capabilities |= clientFlags & compressionCapabilities(l.Compression)
+
 	// Max packet size. Don't do anything with this now.
 	// See doc.go for more information.
 	_, pos, ok = readUint32(data, pos)
@@ -715,11 +791,27 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 
 	// Decode connection attributes send by the client
 	if clientFlags&CapabilityClientConnAttr != 0 {
-		if _, _, err := parseConnAttrs(data, pos); err != nil {
+		_, attrsEnd, err := parseConnAttrs(data, pos)
+		switch {
+		case err == nil:
+			pos = attrsEnd
+		case c.Capabilities&CapabilityClientZstdCompressionAlgorithm != 0:
+			// The zstd compression level can't be found without them.
+			return "", "", nil, vterrors.Wrapf(err, "parseClientHandshakePacket: can't read connection attributes")
+		default:
 			log.Warningf("Decode connection attributes send by the client: %v", err)
 		}
 	}
 
+	// The zstd compression level comes last.
+	if c.Capabilities&CapabilityClientZstdCompressionAlgorithm != 0 {
+		level, _, ok := readByte(data, pos)
+		if !ok {
+			return "", "", nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read zstd compression level")
+		}
+		c.zstdCompressionLevel = int(level)
+	}
+
 	return username, authMethod, authResponse, nil
 }
 
@@ -769,6 +861,113 @@ func parseConnAttrs(data []byte, pos int) (map[string]string, int, error) {
 
 }
 
+// negotiateSha2 finishes the authentication with the
+// caching_sha2_password or sha256_password method. authResponse is
+// the first thing the client sent for that method.
+func (l *Listener) negotiateSha2(c *Conn, authServer Sha2AuthServer, method, user string, salt, authResponse []byte) (Getter, error) {
+	remoteAddr := c.conn.RemoteAddr()
+
+	if method == CachingSha2Password {
+		// An empty response means an empty password, anything
+		// else is a scramble we may be able to validate.
+		if len(authResponse) == 0 {
+			return authServer.ValidatePassword(user, "", remoteAddr)
+		}
+		userData, err := authServer.ValidateCachingSha2Hash(salt, user, authResponse, remoteAddr)
+		if err != ErrCachingSha2FullAuth {
+			if err != nil {
+				return nil, err
+			}
+			if err := c.writeAuthMoreData([]byte{CachingSha2FastAuth}); err != nil {
+				return nil, err
+			}
+			return userData, nil
+		}
+
+		// We need the password.
+		if err := c.writeAuthMoreData([]byte{CachingSha2FullAuth}); err != nil {
+			return nil, err
+		}
+		authResponse, err = c.ReadPacket()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	password, err := l.readSha2Password(c, method, salt, authResponse)
+	if err != nil {
+		return nil, err
+	}
+	return authServer.ValidatePassword(user, password, remoteAddr)
+}
+
+// readSha2Password returns the password sent by the client for the
+// caching_sha2_password full authentication, or for sha256_password.
+// Over TLS or a unix socket, the password is sent in the clear.
+// Otherwise it is encrypted with the server RSA public key, which the
+// client may have to ask for first.
+func (l *Listener) readSha2Password(c *Conn, method string, salt, data []byte) (string, error) {
+	_, isUnixSocket := c.conn.RemoteAddr().(*net.UnixAddr)
+	if c.Capabilities&CapabilityClientSSL != 0 || isUnixSocket {
+		if len(data) == 0 || data[len(data)-1] != 0 {
+			return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "received invalid response packet, datalen=%v", len(data))
+		}
+		return string(data[:len(data)-1]), nil
+	}
+
+	// An empty sha256_password is sent as a single zero byte.
+	if method == MysqlSha256Password && len(data) == 1 && data[0] == 0 {
+		return "", nil
+	}
+
+	key, err := l.rsaKey()
+	if err != nil {
+		return "", err
+	}
+	requestPublicKey := byte(CachingSha2RequestPublicKey)
+	if method == MysqlSha256Password {
+		requestPublicKey = Sha256RequestPublicKey
+	}
+	if len(data) == 1 && data[0] == requestPublicKey {
+		pemKey, err := publicKeyPEM(key)
+		if err != nil {
+			return "", err
+		}
+		if err := c.writeAuthMoreData(pemKey); err != nil {
+			return "", err
+		}
+		data, err = c.ReadPacket()
+		if err != nil {
+			return "", err
+		}
+	}
+	return decryptPassword(salt, data, key)
+}
+
+// rsaKey returns the RSA key pair of the listener, and generates it
+// if needed.
+func (l *Listener) rsaKey() (*rsa.PrivateKey, error) {
+	l.rsaKeyOnce.Do(func() {
+		if l.RSAKey == nil {
+			l.RSAKey, l.rsaKeyErr = rsa.GenerateKey(rand.Reader, 2048)
+		}
+	})
+	return l.RSAKey, l.rsaKeyErr
+}
+
+// writeAuthMoreData writes extra authentication data, prefixed with
+// the AuthMoreDataPacket header.
+func (c *Conn) writeAuthMoreData(data []byte) error {
+	packet, pos := c.startEphemeralPacketWithHeader(1 + len(data))
+	pos = writeByte(packet, pos, AuthMoreDataPacket)
+	pos += copy(packet[pos:], data)
+	// Sanity check.
+	if pos != len(packet) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "error building AuthMoreData packet: got %v bytes expected %v", pos, len(packet))
+	}
+	return c.writeEphemeralPacket()
+}
+
 // writeAuthSwitchRequest writes an auth switch request packet.
 func (c *Conn) writeAuthSwitchRequest(pluginName string, pluginData []byte) error {
 	length := 1 + // AuthSwitchRequestPacket
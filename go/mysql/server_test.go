@@ -900,6 +900,101 @@ func TestDialogServer(t *testing.T) {
 	}
 }
 
+// TestSha2Servers creates Servers that use caching_sha2_password and
+// sha256_password, and connects to them over TCP without TLS, so the
+// passwords are exchanged with RSA, and over a unix socket.
+func TestSha2Servers(t *testing.T) {
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		CachingSha2Password: CachingSha2Hash("password1"),
+		UserData:            "userData1",
+	}}
+	authServer.entries["user2"] = []*AuthServerStaticEntry{{
+		// Only the mysql_native_password hash of "password2", so
+		// caching_sha2_password needs the full authentication.
+		MysqlNativePassword: "*DC52755F3C09F5923046BD42AFA76BD1D80DF2E9",
+		UserData:            "userData2",
+	}}
+	defer authServer.close()
+
+	unixSocket, err := ioutil.TempFile("", "mysql_vitess_test.sock")
+	if err != nil {
+		t.Fatalf("Failed to create temp file")
+	}
+	os.Remove(unixSocket.Name())
+
+	th := &testHandler{}
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	go l.Accept()
+	unixListener, err := NewListener("unix", unixSocket.Name(), authServer, th, 0, 0, false)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer unixListener.Close()
+	go unixListener.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+
+	for _, method := range []string{CachingSha2Password, MysqlSha256Password} {
+		authServer.method = method
+		for _, unix := range []bool{false, true} {
+			for _, test := range []struct {
+				user, password string
+				success        bool
+			}{
+				{"user1", "password1", true},
+				{"user1", "bad", false},
+				{"user2", "password2", true},
+				{"user2", "", false},
+				{"user3", "password3", false},
+			} {
+				params := &ConnParams{
+					Host:  host,
+					Port:  port,
+					Uname: test.user,
+					Pass:  test.password,
+				}
+				if unix {
+					params = &ConnParams{
+						UnixSocket: unixSocket.Name(),
+						Uname:      test.user,
+						Pass:       test.password,
+					}
+				}
+
+				c, err := Connect(context.Background(), params)
+				if !test.success {
+					if err == nil || !strings.Contains(err.Error(), "Access denied for user '"+test.user+"'") {
+						t.Errorf("%v unix=%v %v/%v: got %v, want access denied", method, unix, test.user, test.password, err)
+					}
+					if c != nil {
+						c.Close()
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("%v unix=%v %v/%v: got %v", method, unix, test.user, test.password, err)
+					continue
+				}
+				result, err := c.ExecuteFetch("select rows", 10000, true)
+				if err != nil {
+					t.Errorf("ExecuteFetch failed: %v", err)
+				} else if len(result.Rows) != 2 {
+					t.Errorf("Unexpected result: %v", result)
+				}
+				if got, want := th.LastConn().UserData.Get().Username, "userData"+test.user[len("user"):]; got != want {
+					t.Errorf("UserData got %v, want %v", got, want)
+				}
+				c.Close()
+			}
+		}
+	}
+}
+
 // TestTLSServer creates a Server with TLS support, then uses mysql
 // client to connect to it.
 func TestTLSServer(t *testing.T) {
@@ -82,6 +82,7 @@ type DBConfigs struct {
 	ServerName                 string `json:"serverName,omitempty"`
 	ConnectTimeoutMilliseconds int    `json:"connectTimeoutMilliseconds,omitempty"`
 	DBName                     string `json:"dbName,omitempty"`
+	Compression                string `json:"compression,omitempty"`
 
 	App          UserConfig `json:"app,omitempty"`
 	Dba          UserConfig `json:"dba,omitempty"`
@@ -132,6 +133,7 @@ func registerBaseFlags() {
 	flag.StringVar(&GlobalDBConfigs.SslKey, "db_ssl_key", "", "connection ssl key")
 	flag.StringVar(&GlobalDBConfigs.ServerName, "db_server_name", "", "server name of the DB we are connecting to.")
 	flag.IntVar(&GlobalDBConfigs.ConnectTimeoutMilliseconds, "db_connect_timeout_ms", 0, "connection timeout to mysqld in milliseconds (0 for no timeout)")
+	flag.StringVar(&GlobalDBConfigs.Compression, "db_compression", "", "Comma separated list of the compression algorithms to use with mysqld, in order of preference: zstd, zlib. Empty to not compress.")
 }
 
 // The flags will change the global singleton
@@ -359,6 +361,9 @@ func (dbcfgs *DBConfigs) InitWithSocket(defaultSocketFile string) {
 			cp.Flavor = dbcfgs.Flavor
 		}
 		cp.ConnectTimeoutMs = uint64(dbcfgs.ConnectTimeoutMilliseconds)
+		if dbcfgs.Compression != "" {
+			cp.Compression = dbcfgs.Compression
+		}
 
 		cp.Uname = uc.User
 		cp.Pass = uc.Password
@@ -256,6 +256,30 @@ type HealthCheckImpl struct {
 // callback.
 //   A function to call when there is a master change. Used to notify vtgate's buffer to stop buffering.
 func NewHealthCheck(ctx context.Context, retryDelay, healthCheckTimeout time.Duration, topoServer *topo.Server, localCell, cellsToWatch string) *HealthCheckImpl {
+	var filter TabletFilter
+	if len(TabletFilters) > 0 {
+		if len(KeyspacesToWatch) > 0 {
+			log.Exitf("Only one of -keyspaces_to_watch and -tablet_filters may be specified at a time")
+		}
+
+		fbs, err := NewFilterByShard(TabletFilters)
+		if err != nil {
+			log.Exitf("Cannot parse tablet_filters parameter: %v", err)
+		}
+		filter = fbs
+	} else if len(KeyspacesToWatch) > 0 {
+		filter = NewFilterByKeyspace(KeyspacesToWatch)
+	}
+	return NewHealthCheckWithFilter(ctx, retryDelay, healthCheckTimeout, topoServer, localCell, cellsToWatch, filter)
+}
+
+// NewHealthCheckWithFilter creates a new HealthCheck object, that only
+// checks the tablets that filter includes, if it is not nil. The
+// parameters are the ones of NewHealthCheck, which uses the filter of
+// the -keyspaces_to_watch and -tablet_filters flags. If localCell is
+// empty, the healthy tablets of all of cellsToWatch are returned, instead
+// of only the ones of the local cell.
+func NewHealthCheckWithFilter(ctx context.Context, retryDelay, healthCheckTimeout time.Duration, topoServer *topo.Server, localCell, cellsToWatch string, filter TabletFilter) *HealthCheckImpl {
 	log.Infof("loading tablets for cells: %v", cellsToWatch)
 
 	hc := &HealthCheckImpl{
@@ -270,7 +294,6 @@ func NewHealthCheck(ctx context.Context, retryDelay, healthCheckTimeout time.Dur
 		cellAliases:        make(map[string]string),
 	}
 	var topoWatchers []*TopologyWatcher
-	var filter TabletFilter
 	cells := strings.Split(cellsToWatch, ",")
 	if len(cells) == 0 {
 		cells = append(cells, localCell)
@@ -280,19 +303,6 @@ func NewHealthCheck(ctx context.Context, retryDelay, healthCheckTimeout time.Dur
 		if c == "" {
 			continue
 		}
-		if len(TabletFilters) > 0 {
-			if len(KeyspacesToWatch) > 0 {
-				log.Exitf("Only one of -keyspaces_to_watch and -tablet_filters may be specified at a time")
-			}
-
-			fbs, err := NewFilterByShard(TabletFilters)
-			if err != nil {
-				log.Exitf("Cannot parse tablet_filters parameter: %v", err)
-			}
-			filter = fbs
-		} else if len(KeyspacesToWatch) > 0 {
-			filter = NewFilterByKeyspace(KeyspacesToWatch)
-		}
 		topoWatchers = append(topoWatchers, NewCellTabletsWatcher(ctx, topoServer, hc, filter, c, *RefreshInterval, *RefreshKnownTablets, *TopoReadConcurrency))
 	}
 
@@ -724,6 +734,10 @@ func (hc *HealthCheckImpl) isIncluded(tabletType topodata.TabletType, tabletAlia
 	if tabletType == topodata.TabletType_MASTER {
 		return true
 	}
+	if hc.cell == "" {
+		// Without a local cell, all the watched cells are included.
+		return true
+	}
 	if tabletAlias.Cell == hc.cell {
 		return true
 	}
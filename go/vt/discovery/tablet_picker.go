@@ -17,14 +17,18 @@ limitations under the License.
 package discovery
 
 import (
+	"flag"
 	"fmt"
+	"io"
 	"math/rand"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
@@ -40,6 +44,11 @@ import (
 var (
 	tabletPickerRetryDelay   = 30 * time.Second
 	muTabletPickerRetryDelay sync.Mutex
+
+	tabletPickerHealthTimeout    = flag.Duration("tablet_picker_health_timeout", 5*time.Second, "how long the tablet picker waits for the health of a tablet it may pick")
+	tabletPickerHealthRetryDelay = flag.Duration("tablet_picker_health_retry_delay", 5*time.Second, "how long the tablet picker waits before watching again the health of a picked tablet, when its health stream ends")
+
+	tabletPickerHealthCheckTimeout = flag.Duration("tablet_picker_healthcheck_timeout", 1*time.Minute, "how long the health check of the tablet pickers waits for a health response before it considers a tablet unhealthy")
 )
 
 // GetTabletPickerRetryDelay synchronizes changes to tabletPickerRetryDelay. Used in tests only at the moment
@@ -63,10 +72,45 @@ type TabletPicker struct {
 	keyspace    string
 	shard       string
 	tabletTypes []topodatapb.TabletType
+	options     TabletPickerOptions
+}
+
+// TabletPickerOptions tune how a TabletPicker ranks the tablets.
+type TabletPickerOptions struct {
+	// HealthCheck provides the health of the tablets, if set.
+	// Otherwise, the TabletPicker asks each tablet for its health.
+	HealthCheck HealthCheck
+
+	// LocalCell is preferred to the other cells, if set.
+	LocalCell string
+
+	// Exclude has the tablets that must not be picked.
+	Exclude []*topodatapb.TabletAlias
+}
+
+// NewTabletPickerHealthCheck returns a HealthCheck for the
+// TabletPickerOptions of the pickers of keyspace and shards in cells.
+// It only checks the tablets of those shards. The caller must close it.
+func NewTabletPickerHealthCheck(ctx context.Context, ts *topo.Server, cells []string, keyspace string, shards ...string) (HealthCheck, error) {
+	filters := make([]string, 0, len(shards))
+	for _, shard := range shards {
+		filters = append(filters, keyspace+"|"+shard)
+	}
+	filter, err := NewFilterByShard(filters)
+	if err != nil {
+		return nil, err
+	}
+	cellsToWatch := strings.Join(resolveCells(ctx, ts, cells), ",")
+	return NewHealthCheckWithFilter(ctx, *tabletPickerHealthRetryDelay, *tabletPickerHealthCheckTimeout, ts, "", cellsToWatch, filter), nil
 }
 
 // NewTabletPicker returns a TabletPicker.
 func NewTabletPicker(ts *topo.Server, cells []string, keyspace, shard, tabletTypesStr string) (*TabletPicker, error) {
+	return NewTabletPickerWithOptions(ts, cells, keyspace, shard, tabletTypesStr, TabletPickerOptions{})
+}
+
+// NewTabletPickerWithOptions returns a TabletPicker that uses options.
+func NewTabletPickerWithOptions(ts *topo.Server, cells []string, keyspace, shard, tabletTypesStr string, options TabletPickerOptions) (*TabletPicker, error) {
 	tabletTypes, err := topoproto.ParseTabletTypes(tabletTypesStr)
 	if err != nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "failed to parse list of tablet types: %v", tabletTypesStr)
@@ -91,13 +135,35 @@ func NewTabletPicker(ts *topo.Server, cells []string, keyspace, shard, tabletTyp
 		keyspace:    keyspace,
 		shard:       shard,
 		tabletTypes: tabletTypes,
+		options:     options,
 	}, nil
 }
 
-// PickForStreaming picks an available tablet
-// All tablets that belong to tp.cells are evaluated and one is
-// chosen at random
+// WithExclude returns a copy of tp that doesn't pick the given tablets,
+// in addition to the ones it already excludes.
+func (tp *TabletPicker) WithExclude(aliases ...*topodatapb.TabletAlias) *TabletPicker {
+	excluding := *tp
+	excluding.options.Exclude = append(append([]*topodatapb.TabletAlias(nil), tp.options.Exclude...), aliases...)
+	return &excluding
+}
+
+// PickForStreaming picks an available tablet.
+// All tablets that belong to tp.cells are evaluated. The ones that are
+// not serving, report a health error, lag more than
+// discovery_high_replication_lag_minimum_serving or are excluded are
+// ignored. The others are ranked: tablets with a low replication lag
+// come first, then tablets of the local cell, then the least lagging
+// ones. One of the best ranked tablets is chosen at random.
 func (tp *TabletPicker) PickForStreaming(ctx context.Context) (*topodatapb.Tablet, error) {
+	// With a health check, try again as soon as the health of a tablet of
+	// the shard changes: a health check that was just created may not
+	// know the tablets yet.
+	var updates chan *TabletHealth
+	if hc := tp.options.HealthCheck; hc != nil {
+		updates = hc.Subscribe()
+		defer hc.Unsubscribe(updates)
+	}
+
 	// keep trying at intervals (tabletPickerRetryDelay) until a tablet is found
 	// or the context is canceled
 	for {
@@ -106,44 +172,275 @@ func (tp *TabletPicker) PickForStreaming(ctx context.Context) (*topodatapb.Table
 			return nil, vterrors.Errorf(vtrpcpb.Code_CANCELED, "context has expired")
 		default:
 		}
-		candidates := tp.getMatchingTablets(ctx)
+		candidates := tp.getHealthyCandidates(ctx, tp.getMatchingTablets(ctx))
 
 		if len(candidates) == 0 {
 			// if no candidates were found, sleep and try again
-			log.Infof("No tablet found for streaming, shard %s.%s, cells %v, tabletTypes %v, sleeping for %d seconds",
+			log.Infof("No healthy tablet found for streaming, shard %s.%s, cells %v, tabletTypes %v, sleeping for %d seconds",
 				tp.keyspace, tp.shard, tp.cells, tp.tabletTypes, int(GetTabletPickerRetryDelay()/1e9))
-			timer := time.NewTimer(GetTabletPickerRetryDelay())
-			select {
-			case <-ctx.Done():
-				timer.Stop()
-				return nil, vterrors.Errorf(vtrpcpb.Code_CANCELED, "context has expired")
-			case <-timer.C:
+			if err := tp.waitForCandidates(ctx, updates); err != nil {
+				return nil, err
 			}
 			continue
 		}
-		// try at most len(candidate) times to find a healthy tablet
-		for i := 0; i < len(candidates); i++ {
-			idx := rand.Intn(len(candidates))
-			ti := candidates[idx]
-			// get tablet
-			// try to connect to tablet
-			conn, err := tabletconn.GetDialer()(ti.Tablet, true)
-			if err != nil {
-				log.Warningf("unable to connect to tablet for alias %v", ti.Alias)
-				candidates = append(candidates[:idx], candidates[idx+1:]...)
-				if len(candidates) == 0 {
-					break
-				}
+
+		// Pick at random among the best ranked tablets.
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return tp.less(candidates[i], candidates[j])
+		})
+		best := 1
+		for best < len(candidates) && !tp.less(candidates[0], candidates[best]) {
+			best++
+		}
+		th := candidates[rand.Intn(best)]
+		log.Infof("tablet picker found tablet %s", th.Tablet.String())
+		return th.Tablet, nil
+	}
+}
+
+// waitForCandidates waits for tabletPickerRetryDelay, or until the
+// health check has a healthy tablet of the shard. The health check drops
+// the updates a subscriber doesn't read in time, so every update is a
+// cue to look at the healthy tablets, whatever its shard.
+func (tp *TabletPicker) waitForCandidates(ctx context.Context, updates chan *TabletHealth) error {
+	timer := time.NewTimer(GetTabletPickerRetryDelay())
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return vterrors.Errorf(vtrpcpb.Code_CANCELED, "context has expired")
+		case <-timer.C:
+			return nil
+		case _, ok := <-updates:
+			if !ok {
+				// The health check was closed, only wait for the timer.
+				updates = nil
 				continue
 			}
-			// OK to use ctx here because it is not actually used by the underlying Close implementation
+			for _, th := range tp.healthCheckStats() {
+				if tp.unhealthyReason(th) == "" {
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// WatchHealth watches the health of a tablet returned by
+// PickForStreaming, until ctx is done. If the tablet becomes unhealthy,
+// by the criteria of PickForStreaming, it returns an error, so the
+// caller can pick another tablet. It returns nil when ctx is done.
+func (tp *TabletPicker) WatchHealth(ctx context.Context, tablet *topodatapb.Tablet) error {
+	if tp.options.HealthCheck != nil {
+		return tp.watchHealthCheck(ctx, tablet)
+	}
+	for {
+		var reason string
+		conn, err := tabletconn.GetDialer()(tablet, true)
+		if err != nil {
+			reason = fmt.Sprintf("cannot connect: %v", err)
+		} else {
+			err = conn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+				reason = tp.unhealthyReason(healthFromResponse(tablet, shr))
+				if reason != "" {
+					return io.EOF
+				}
+				return nil
+			})
 			_ = conn.Close(ctx)
-			log.Infof("tablet picker found tablet %s", ti.Tablet.String())
-			return ti.Tablet, nil
+			if err != nil && err != io.EOF && reason == "" {
+				reason = fmt.Sprintf("health stream failed: %v", err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		if reason != "" {
+			return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "tablet %v became unhealthy: %v", topoproto.TabletAliasString(tablet.Alias), reason)
+		}
+
+		// The stream ended without an error, start another one.
+		timer := time.NewTimer(*tabletPickerHealthRetryDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
 		}
 	}
 }
 
+// watchHealthCheck is WatchHealth, using the updates of the health check.
+func (tp *TabletPicker) watchHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
+	updates := tp.options.HealthCheck.Subscribe()
+	defer tp.options.HealthCheck.Unsubscribe(updates)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case th, ok := <-updates:
+			if !ok {
+				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "the health check of tablet %v was closed", topoproto.TabletAliasString(tablet.Alias))
+			}
+			if !topoproto.TabletAliasEqual(th.Tablet.Alias, tablet.Alias) {
+				continue
+			}
+			if reason := tp.unhealthyReason(th); reason != "" {
+				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "tablet %v became unhealthy: %v", topoproto.TabletAliasString(tablet.Alias), reason)
+			}
+		}
+	}
+}
+
+// getHealthyCandidates returns the health of the tablets that can be
+// picked.
+func (tp *TabletPicker) getHealthyCandidates(ctx context.Context, tablets []*topo.TabletInfo) []*TabletHealth {
+	var candidates []*TabletHealth
+	for _, th := range tp.getHealth(ctx, tablets) {
+		if reason := tp.unhealthyReason(th); reason != "" {
+			log.Infof("tablet picker ignores tablet %v: %v", topoproto.TabletAliasString(th.Tablet.Alias), reason)
+			continue
+		}
+		candidates = append(candidates, th)
+	}
+	return candidates
+}
+
+// getHealth returns the health of tablets, from the health check if
+// there is one, or by asking the tablets otherwise. The master is picked
+// in any cell, so it is asked if it is not healthy in the health check,
+// which may not watch its cell.
+func (tp *TabletPicker) getHealth(ctx context.Context, tablets []*topo.TabletInfo) []*TabletHealth {
+	result := make([]*TabletHealth, len(tablets))
+	if hc := tp.options.HealthCheck; hc != nil {
+		masterOnly := len(tp.tabletTypes) == 1 && tp.tabletTypes[0] == topodatapb.TabletType_MASTER
+		healthy := tp.healthCheckStats()
+		for i, ti := range tablets {
+			th, ok := healthy[ti.AliasString()]
+			switch {
+			case ok:
+			case masterOnly:
+				th = probeHealth(ctx, ti.Tablet)
+			default:
+				th = &TabletHealth{
+					Tablet:    ti.Tablet,
+					LastError: vterrors.New(vtrpcpb.Code_UNAVAILABLE, "not healthy in the health check"),
+				}
+			}
+			result[i] = th
+		}
+		return result
+	}
+
+	var wg sync.WaitGroup
+	for i, ti := range tablets {
+		wg.Add(1)
+		go func(i int, tablet *topodatapb.Tablet) {
+			defer wg.Done()
+			result[i] = probeHealth(ctx, tablet)
+		}(i, ti.Tablet)
+	}
+	wg.Wait()
+	return result
+}
+
+// probeHealth asks a tablet for its health.
+func probeHealth(ctx context.Context, tablet *topodatapb.Tablet) *TabletHealth {
+	conn, err := tabletconn.GetDialer()(tablet, true)
+	if err != nil {
+		return &TabletHealth{Tablet: tablet, LastError: err}
+	}
+	// OK to use ctx here because it is not actually used by the underlying Close implementation
+	defer conn.Close(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, *tabletPickerHealthTimeout)
+	defer cancel()
+	var th *TabletHealth
+	err = conn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+		th = healthFromResponse(tablet, shr)
+		return io.EOF
+	})
+	switch {
+	case th != nil:
+		return th
+	case err == nil || err == io.EOF:
+		err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no health response")
+	}
+	return &TabletHealth{Tablet: tablet, LastError: err}
+}
+
+// healthFromResponse returns the health of a tablet from a
+// StreamHealth response.
+func healthFromResponse(tablet *topodatapb.Tablet, shr *querypb.StreamHealthResponse) *TabletHealth {
+	return &TabletHealth{
+		Tablet:  tablet,
+		Target:  shr.Target,
+		Stats:   shr.RealtimeStats,
+		Serving: shr.Serving,
+	}
+}
+
+// healthCheckStats returns the healthy tablets of the shard in the
+// health check, by alias.
+func (tp *TabletPicker) healthCheckStats() map[string]*TabletHealth {
+	healthy := make(map[string]*TabletHealth)
+	for _, tabletType := range tp.tabletTypes {
+		target := &querypb.Target{Keyspace: tp.keyspace, Shard: tp.shard, TabletType: tabletType}
+		for _, th := range tp.options.HealthCheck.GetHealthyTabletStats(target) {
+			healthy[topoproto.TabletAliasString(th.Tablet.Alias)] = th
+		}
+	}
+	return healthy
+}
+
+// unhealthyReason returns why a tablet can't be picked, or an empty
+// string if it can.
+func (tp *TabletPicker) unhealthyReason(th *TabletHealth) string {
+	for _, alias := range tp.options.Exclude {
+		if topoproto.TabletAliasEqual(alias, th.Tablet.Alias) {
+			return "excluded"
+		}
+	}
+	switch {
+	case th.LastError != nil:
+		return th.LastError.Error()
+	case !th.Serving:
+		return "not serving"
+	case th.Target != nil && !topoproto.IsTypeInList(th.Target.TabletType, tp.tabletTypes):
+		return fmt.Sprintf("tablet type is %v", th.Target.TabletType)
+	case th.Stats == nil:
+		return ""
+	case th.Stats.HealthError != "":
+		return fmt.Sprintf("health error: %v", th.Stats.HealthError)
+	case th.Target != nil && th.Target.TabletType != topodatapb.TabletType_MASTER && IsReplicationLagVeryHigh(th):
+		return fmt.Sprintf("replication lag is %vs", th.Stats.SecondsBehindMaster)
+	}
+	return ""
+}
+
+// less returns true if tablet a is ranked before tablet b.
+func (tp *TabletPicker) less(a, b *TabletHealth) bool {
+	aLagging, bLagging := isLagging(a), isLagging(b)
+	if aLagging != bLagging {
+		return bLagging
+	}
+	if tp.options.LocalCell != "" {
+		aLocal, bLocal := a.Tablet.Alias.Cell == tp.options.LocalCell, b.Tablet.Alias.Cell == tp.options.LocalCell
+		if aLocal != bLocal {
+			return aLocal
+		}
+	}
+	return aLagging && a.Stats.SecondsBehindMaster < b.Stats.SecondsBehindMaster
+}
+
+// isLagging returns true if a tablet's replication lag is higher than
+// discovery_low_replication_lag.
+func isLagging(th *TabletHealth) bool {
+	return th.Stats != nil && IsReplicationLagHigh(th)
+}
+
 // getMatchingTablets returns a list of TabletInfo for tablets
 // that match the cells, keyspace, shard and tabletTypes for this TabletPicker
 func (tp *TabletPicker) getMatchingTablets(ctx context.Context) []*topo.TabletInfo {
@@ -159,27 +456,7 @@ func (tp *TabletPicker) getMatchingTablets(ctx context.Context) []*topo.TabletIn
 		}
 		aliases = append(aliases, si.MasterAlias)
 	} else {
-		actualCells := make([]string, 0)
-		for _, cell := range tp.cells {
-			// check if cell is actually an alias
-			// non-blocking read so that this is fast
-			shortCtx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
-			defer cancel()
-			_, err := tp.ts.GetCellInfo(shortCtx, cell, false)
-			if err != nil {
-				// not a valid cell, check whether it is a cell alias
-				shortCtx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
-				defer cancel()
-				alias, err := tp.ts.GetCellsAlias(shortCtx, cell, false)
-				// if we get an error, either cellAlias doesn't exist or it isn't a cell alias at all. Ignore and continue
-				if err == nil {
-					actualCells = append(actualCells, alias.Cells...)
-				}
-			} else {
-				// valid cell, add it to our list
-				actualCells = append(actualCells, cell)
-			}
-		}
+		actualCells := resolveCells(ctx, tp.ts, tp.cells)
 		for _, cell := range actualCells {
 			shortCtx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
 			defer cancel()
@@ -222,6 +499,33 @@ func (tp *TabletPicker) getMatchingTablets(ctx context.Context) []*topo.TabletIn
 	return tablets
 }
 
+// resolveCells returns the cells of cells, where cell aliases are
+// replaced by their cells.
+func resolveCells(ctx context.Context, ts *topo.Server, cells []string) []string {
+	actualCells := make([]string, 0)
+	for _, cell := range cells {
+		// check if cell is actually an alias
+		// non-blocking read so that this is fast
+		shortCtx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+		defer cancel()
+		_, err := ts.GetCellInfo(shortCtx, cell, false)
+		if err != nil {
+			// not a valid cell, check whether it is a cell alias
+			shortCtx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+			defer cancel()
+			alias, err := ts.GetCellsAlias(shortCtx, cell, false)
+			// if we get an error, either cellAlias doesn't exist or it isn't a cell alias at all. Ignore and continue
+			if err == nil {
+				actualCells = append(actualCells, alias.Cells...)
+			}
+		} else {
+			// valid cell, add it to our list
+			actualCells = append(actualCells, cell)
+		}
+	}
+	return actualCells
+}
+
 func init() {
 	// TODO(sougou): consolidate this call to be once per process.
 	rand.Seed(time.Now().UnixNano())
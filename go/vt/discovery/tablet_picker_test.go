@@ -329,6 +329,151 @@ func TestPickError(t *testing.T) {
 	require.EqualError(t, err, "context has expired")
 }
 
+func TestPickSkipsUnhealthy(t *testing.T) {
+	te := newPickerTestEnv(t, []string{"cell"})
+	want := addTablet(te, 100, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, want)
+	notServing := addTablet(te, 101, topodatapb.TabletType_REPLICA, "cell", false, true)
+	defer deleteTablet(te, notServing)
+	healthError := addTablet(te, 102, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, healthError)
+	setTabletHealth(te, healthError, true, 0, "replication is not running")
+	lagging := addTablet(te, 103, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, lagging)
+	setTabletHealth(te, lagging, true, uint32((3 * time.Hour).Seconds()), "")
+
+	tp, err := NewTabletPicker(te.topoServ, te.cells, te.keyspace, te.shard, "replica")
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		tablet, err := tp.PickForStreaming(context.Background())
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(want, tablet), "Pick: %v, want %v", tablet, want)
+	}
+}
+
+func TestPickPrefersLowLagAndLocalCell(t *testing.T) {
+	te := newPickerTestEnv(t, []string{"cell", "otherCell"})
+	localLagging := addTablet(te, 100, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, localLagging)
+	setTabletHealth(te, localLagging, true, 120, "")
+	remote := addTablet(te, 101, topodatapb.TabletType_REPLICA, "otherCell", true, true)
+	defer deleteTablet(te, remote)
+	setTabletHealth(te, remote, true, 1, "")
+
+	tp, err := NewTabletPickerWithOptions(te.topoServ, te.cells, te.keyspace, te.shard, "replica", TabletPickerOptions{LocalCell: "cell"})
+	require.NoError(t, err)
+
+	// A tablet with a low lag wins over a lagging local one.
+	tablet, err := tp.PickForStreaming(context.Background())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(remote, tablet), "Pick: %v, want %v", tablet, remote)
+
+	// Among tablets with a low lag, the local one wins.
+	local := addTablet(te, 102, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, local)
+	for i := 0; i < 20; i++ {
+		tablet, err = tp.PickForStreaming(context.Background())
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(local, tablet), "Pick: %v, want %v", tablet, local)
+	}
+
+	// Among lagging tablets, the least lagging one wins.
+	setTabletHealth(te, local, true, 300, "")
+	setTabletHealth(te, remote, true, 200, "")
+	tablet, err = tp.PickForStreaming(context.Background())
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(localLagging, tablet), "Pick: %v, want %v", tablet, localLagging)
+}
+
+func TestPickExcluded(t *testing.T) {
+	te := newPickerTestEnv(t, []string{"cell"})
+	want := addTablet(te, 100, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, want)
+	excluded := addTablet(te, 101, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, excluded)
+
+	tp, err := NewTabletPickerWithOptions(te.topoServ, te.cells, te.keyspace, te.shard, "replica", TabletPickerOptions{
+		Exclude: []*topodatapb.TabletAlias{excluded.Alias},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		tablet, err := tp.PickForStreaming(context.Background())
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(want, tablet), "Pick: %v, want %v", tablet, want)
+	}
+}
+
+func TestPickWithExclude(t *testing.T) {
+	te := newPickerTestEnv(t, []string{"cell"})
+	want := addTablet(te, 100, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, want)
+	unhealthy := addTablet(te, 101, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, unhealthy)
+
+	tp, err := NewTabletPicker(te.topoServ, te.cells, te.keyspace, te.shard, "replica")
+	require.NoError(t, err)
+	excluding := tp.WithExclude(unhealthy.Alias)
+	assert.Empty(t, tp.options.Exclude)
+
+	for i := 0; i < 20; i++ {
+		tablet, err := excluding.PickForStreaming(context.Background())
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(want, tablet), "Pick: %v, want %v", tablet, want)
+	}
+}
+
+func TestPickUsingHealthCheck(t *testing.T) {
+	te := newPickerTestEnv(t, []string{"cell"})
+	want := addTablet(te, 100, topodatapb.TabletType_REPLICA, "cell", true, false)
+	defer deleteTablet(te, want)
+	unknown := addTablet(te, 101, topodatapb.TabletType_REPLICA, "cell", true, true)
+	defer deleteTablet(te, unknown)
+
+	// Only the tablets that are healthy in the health check are
+	// picked, whether they are reachable from here or not.
+	hc := NewFakeHealthCheck()
+	hc.AddTablet(want)
+	tp, err := NewTabletPickerWithOptions(te.topoServ, te.cells, te.keyspace, te.shard, "replica", TabletPickerOptions{HealthCheck: hc})
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		tablet, err := tp.PickForStreaming(context.Background())
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(want, tablet), "Pick: %v, want %v", tablet, want)
+	}
+}
+
+func TestWatchHealth(t *testing.T) {
+	te := newPickerTestEnv(t, []string{"cell"})
+	tablet := addTablet(te, 100, topodatapb.TabletType_REPLICA, "cell", true, false)
+	defer deleteTablet(te, tablet)
+	input := make(chan *querypb.StreamHealthResponse)
+	createFakeConn(tablet, input)
+
+	tp, err := NewTabletPicker(te.topoServ, te.cells, te.keyspace, te.shard, "replica")
+	require.NoError(t, err)
+
+	result := make(chan error)
+	go func() {
+		result <- tp.WatchHealth(context.Background(), tablet)
+	}()
+	target := &querypb.Target{Keyspace: te.keyspace, Shard: te.shard, TabletType: topodatapb.TabletType_REPLICA}
+	input <- &querypb.StreamHealthResponse{Serving: true, Target: target, RealtimeStats: &querypb.RealtimeStats{}}
+	input <- &querypb.StreamHealthResponse{Serving: false, Target: target, RealtimeStats: &querypb.RealtimeStats{}}
+	assert.EqualError(t, <-result, "tablet cell-0000000100 became unhealthy: not serving")
+
+	// WatchHealth returns nil when its context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		result <- tp.WatchHealth(ctx, tablet)
+	}()
+	input <- &querypb.StreamHealthResponse{Serving: true, Target: target, RealtimeStats: &querypb.RealtimeStats{}}
+	cancel()
+	assert.NoError(t, <-result)
+}
+
 type pickerTestEnv struct {
 	t        *testing.T
 	keyspace string
@@ -407,3 +552,19 @@ func deleteTablet(te *pickerTestEnv, tablet *topodatapb.Tablet) {
 		log.Errorf("failed to automatically remove from shard replication: %v", err)
 	}
 }
+
+// setTabletHealth changes the health that a tablet reports.
+func setTabletHealth(te *pickerTestEnv, tablet *topodatapb.Tablet, serving bool, lag uint32, healthError string) {
+	_ = createFixedHealthConn(tablet, &querypb.StreamHealthResponse{
+		Serving: serving,
+		Target: &querypb.Target{
+			Keyspace:   te.keyspace,
+			Shard:      te.shard,
+			TabletType: tablet.Type,
+		},
+		RealtimeStats: &querypb.RealtimeStats{
+			HealthError:         healthError,
+			SecondsBehindMaster: lag,
+		},
+	})
+}
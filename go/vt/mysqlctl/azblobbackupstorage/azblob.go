@@ -249,6 +249,9 @@ func (bh *AZBlobBackupHandle) ReadFile(ctx context.Context, filename string) (io
 	blobURL := containerURL.NewBlobURL(obj)
 
 	resp, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false)
+	if serr, ok := err.(azblob.StorageError); ok && serr.ServiceCode() == azblob.ServiceCodeBlobNotFound {
+		return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+	}
 	if err != nil {
 		return nil, err
 	}
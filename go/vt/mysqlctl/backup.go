@@ -36,10 +36,11 @@ import (
 // This file handles the backup and restore related code
 
 const (
-	// the three bases for files to restore
+	// the four bases for files to restore
 	backupInnodbDataHomeDir     = "InnoDBData"
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
+	backupBinlog                = "BinLog"
 
 	// backupManifestFileName is the MANIFEST file name within a backup.
 	backupManifestFileName = "MANIFEST"
@@ -265,12 +266,19 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
-	re, err := GetRestoreEngine(ctx, bh)
+	// An incremental backup is restored on top of the backups it is
+	// based on: start from the full backup of the chain.
+	chain, err := FindBackupChain(ctx, bhs, bh)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := GetRestoreEngine(ctx, chain[0])
 	if err != nil {
 		return nil, vterrors.Wrap(err, "Failed to find restore engine")
 	}
 
-	manifest, err := re.ExecuteRestore(ctx, params, bh)
+	manifest, err := re.ExecuteRestore(ctx, params, chain[0])
 	if err != nil {
 		return nil, err
 	}
@@ -318,6 +326,40 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, err
 	}
 
+	// Apply the incremental backups of the chain, then for a point-in-time
+	// restore, replay the archived binlogs on top of them. mysqld runs with
+	// its grant tables by now, so account management statements in the
+	// binlogs apply normally.
+	pointInTime := !params.RestoreToTime.IsZero() || !params.RestoreToPosition.IsZero()
+	if len(chain) > 1 || pointInTime {
+		for _, ibh := range chain[1:] {
+			re, err := GetRestoreEngine(ctx, ibh)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "Failed to find restore engine")
+			}
+			ire, ok := re.(IncrementalRestoreEngine)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the engine of backup %v can't restore incremental backups", ibh.Name())
+			}
+			params.Logger.Infof("Restore: applying incremental backup %v", ibh.Name())
+			if manifest, err = ire.ExecuteIncrementalRestore(ctx, params, ibh); err != nil {
+				return nil, err
+			}
+		}
+		if pointInTime {
+			pos, err := restoreFromBinlogArchive(ctx, params, bs, manifest.Position)
+			if err != nil {
+				return nil, err
+			}
+			manifest.Position = pos
+		}
+		params.LocalMetadata["RestoredBackupTime"] = manifest.BackupTime
+		params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(manifest.Position)
+		if err := PopulateMetadataTables(params.Mysqld, params.LocalMetadata, params.DbName); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = removeStateFile(params.Cnf); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the retention of the backups of a shard.
+
+// RetentionPolicy describes which backups of a shard are kept when old
+// ones are pruned. A backup is kept if any of the rules keeps it.
+// Regardless of the policy, the most recent complete backup is always
+// kept, and so are the backups that the kept incremental backups are
+// based on.
+type RetentionPolicy struct {
+	// KeepCount is the number of most recent complete backups to keep.
+	KeepCount int
+
+	// KeepDailyDays is the number of days for which the most recent
+	// complete backup of each day (in UTC) is kept.
+	KeepDailyDays int
+
+	// MinAge is the age under which backups are kept.
+	MinAge time.Duration
+}
+
+// PruneBackups removes the backups in dir that the policy doesn't keep,
+// the most recent first, along with their verifications. With dryRun,
+// it only logs the backups it would remove. It returns their names.
+func PruneBackups(ctx context.Context, bs backupstorage.BackupStorage, dir string, policy RetentionPolicy, dryRun bool, logger logutil.Logger) ([]string, error) {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	prune, err := findBackupsToPrune(ctx, bhs, policy, time.Now(), logger)
+	if err != nil {
+		return nil, err
+	}
+	verified := make(map[string]bool)
+	if vhs, err := bs.ListBackups(ctx, backupVerificationDir(dir)); err == nil {
+		for _, vh := range vhs {
+			verified[vh.Name()] = true
+		}
+	}
+	names := make([]string, 0, len(prune))
+	for _, bh := range prune {
+		if dryRun {
+			logger.Infof("Would remove backup %v from %v", bh.Name(), dir)
+		} else {
+			logger.Infof("Removing backup %v from %v", bh.Name(), dir)
+			if err := bs.RemoveBackup(ctx, dir, bh.Name()); err != nil {
+				return names, vterrors.Wrapf(err, "can't remove backup %v from %v", bh.Name(), dir)
+			}
+			if verified[bh.Name()] {
+				if err := bs.RemoveBackup(ctx, backupVerificationDir(dir), bh.Name()); err != nil {
+					logger.Warningf("Can't remove the verification of backup %v: %v", bh.Name(), err)
+				}
+			}
+		}
+		names = append(names, bh.Name())
+	}
+	return names, nil
+}
+
+// findBackupsToPrune returns the backups the policy doesn't keep, the
+// most recent first. bhs are sorted by name, so the oldest come first.
+// A backup without a MANIFEST is incomplete. If a MANIFEST can't be
+// read for another reason, nothing can be pruned safely, and an error
+// is returned.
+func findBackupsToPrune(ctx context.Context, bhs []backupstorage.BackupHandle, policy RetentionPolicy, now time.Time, logger logutil.Logger) ([]backupstorage.BackupHandle, error) {
+	manifests := make([]*BackupManifest, len(bhs))
+	lastComplete := -1
+	for i, bh := range bhs {
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			if errors.Is(vterrors.RootCause(err), backupstorage.ErrFileNotFound) {
+				continue
+			}
+			return nil, vterrors.Wrapf(err, "can't read the MANIFEST of backup %v", bh.Name())
+		}
+		manifests[i] = bm
+		lastComplete = i
+	}
+
+	keep := make(map[string]bool)
+	days := make(map[string]bool)
+	completeCount := 0
+	for i := len(bhs) - 1; i >= 0; i-- {
+		name := bhs[i].Name()
+		backupTime, err := ParseBackupName(name)
+		if err != nil {
+			logger.Warningf("Keeping backup %v: %v", name, err)
+			keep[name] = true
+			continue
+		}
+		age := now.Sub(backupTime)
+		if manifests[i] == nil {
+			// Backups more recent than the last complete one may still
+			// be in progress.
+			if i > lastComplete || age < policy.MinAge {
+				keep[name] = true
+			}
+			continue
+		}
+
+		completeCount++
+		if i == lastComplete || completeCount <= policy.KeepCount || age < policy.MinAge {
+			keep[name] = true
+		}
+		day := backupTime.UTC().Format("2006-01-02")
+		if policy.KeepDailyDays > 0 && age < time.Duration(policy.KeepDailyDays)*24*time.Hour && !days[day] {
+			days[day] = true
+			keep[name] = true
+		}
+	}
+
+	// An incremental backup can't be restored without the backups it is
+	// based on. Chains are walked from their most recent backup, so
+	// bases are marked before they are visited.
+	for i := len(bhs) - 1; i >= 0; i-- {
+		if keep[bhs[i].Name()] && manifests[i] != nil && manifests[i].Incremental {
+			keep[manifests[i].BaseBackup] = true
+		}
+	}
+
+	var prune []backupstorage.BackupHandle
+	for i := len(bhs) - 1; i >= 0; i-- {
+		if !keep[bhs[i].Name()] {
+			prune = append(prune, bhs[i])
+		}
+	}
+	return prune, nil
+}
+
+// ParseBackupName returns the time of a backup from its name, which is
+// formatted as "date.time.tablet-alias".
+func ParseBackupName(name string) (time.Time, error) {
+	parts := strings.SplitN(name, ".", 3)
+	if len(parts) != 3 {
+		return time.Time{}, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "backup name not in expected format (date.time.tablet-alias): %v", name)
+	}
+	backupTime, err := time.Parse(BackupTimestampFormat, parts[0]+"."+parts[1])
+	if err != nil {
+		return time.Time{}, vterrors.Wrapf(err, "can't parse timestamp from backup %q", name)
+	}
+	return backupTime, nil
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestPruneBackups(t *testing.T) {
+	now := time.Date(2020, 9, 10, 12, 0, 0, 0, time.UTC)
+	hoursAgo := func(hours int) string {
+		return now.Add(-time.Duration(hours)*time.Hour).Format(BackupTimestampFormat) + ".zone1-0000000100"
+	}
+
+	type backup struct {
+		name       string
+		incomplete bool
+		base       string
+	}
+	backups := []backup{
+		{name: hoursAgo(100)},
+		{name: hoursAgo(80)},
+		{name: hoursAgo(72), incomplete: true},
+		{name: hoursAgo(60)},
+		{name: hoursAgo(50), base: hoursAgo(60)},
+		{name: hoursAgo(30)},
+		{name: hoursAgo(28)},
+		{name: hoursAgo(10), base: hoursAgo(28)},
+		{name: hoursAgo(2), incomplete: true},
+	}
+
+	testcases := []struct {
+		name   string
+		policy RetentionPolicy
+		pruned []string
+	}{{
+		name:   "keep count",
+		policy: RetentionPolicy{KeepCount: 3},
+		pruned: []string{hoursAgo(50), hoursAgo(60), hoursAgo(72), hoursAgo(80), hoursAgo(100)},
+	}, {
+		name: "keep the base of an incremental backup",
+		// The 28 hours old backup is also needed.
+		policy: RetentionPolicy{KeepCount: 1},
+		pruned: []string{hoursAgo(30), hoursAgo(50), hoursAgo(60), hoursAgo(72), hoursAgo(80), hoursAgo(100)},
+	}, {
+		name: "never remove the last complete backup",
+		// Nothing keeps the last complete backup but this rule.
+		policy: RetentionPolicy{},
+		pruned: []string{hoursAgo(30), hoursAgo(50), hoursAgo(60), hoursAgo(72), hoursAgo(80), hoursAgo(100)},
+	}, {
+		name: "keep daily",
+		// The most recent backups of Sep 9, 8, 7 and 6 (UTC) are kept,
+		// with the base of the one of Sep 8.
+		policy: RetentionPolicy{KeepDailyDays: 5},
+		pruned: []string{hoursAgo(30), hoursAgo(72)},
+	}, {
+		name:   "keep daily for a short time",
+		policy: RetentionPolicy{KeepDailyDays: 2},
+		pruned: []string{hoursAgo(30), hoursAgo(50), hoursAgo(60), hoursAgo(72), hoursAgo(80), hoursAgo(100)},
+	}, {
+		name:   "min age",
+		policy: RetentionPolicy{MinAge: 75 * time.Hour},
+		pruned: []string{hoursAgo(80), hoursAgo(100)},
+	}, {
+		name: "combined rules",
+		// The 50 hours old backup is kept by its age, and its base with it.
+		policy: RetentionPolicy{KeepCount: 3, MinAge: 55 * time.Hour},
+		pruned: []string{hoursAgo(72), hoursAgo(80), hoursAgo(100)},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			root, err := ioutil.TempDir("", "backupretentiontest")
+			require.NoError(t, err)
+			defer os.RemoveAll(root)
+			savedRoot := *filebackupstorage.FileBackupStorageRoot
+			*filebackupstorage.FileBackupStorageRoot = root
+			defer func() { *filebackupstorage.FileBackupStorageRoot = savedRoot }()
+
+			ctx := context.Background()
+			bs := &filebackupstorage.FileBackupStorage{}
+			dir := GetBackupDir("ks", "0")
+			for _, b := range backups {
+				bh, err := bs.StartBackup(ctx, dir, b.name)
+				require.NoError(t, err)
+				if !b.incomplete {
+					wc, err := bh.AddFile(ctx, backupManifestFileName, 0)
+					require.NoError(t, err)
+					data, err := json.Marshal(BackupManifest{
+						BackupMethod: builtinBackupEngineName,
+						Incremental:  b.base != "",
+						BaseBackup:   b.base,
+					})
+					require.NoError(t, err)
+					_, err = wc.Write(data)
+					require.NoError(t, err)
+					require.NoError(t, wc.Close())
+				}
+				require.NoError(t, bh.EndBackup(ctx))
+			}
+
+			bhs, err := bs.ListBackups(ctx, dir)
+			require.NoError(t, err)
+			prune, err := findBackupsToPrune(ctx, bhs, tc.policy, now, logutil.NewMemoryLogger())
+			require.NoError(t, err)
+			var pruned []string
+			for _, bh := range prune {
+				pruned = append(pruned, bh.Name())
+			}
+			assert.Equal(t, tc.pruned, pruned)
+
+			// PruneBackups uses the current time, which only the count
+			// based rules don't depend on.
+			if tc.policy.MinAge != 0 || tc.policy.KeepDailyDays != 0 {
+				return
+			}
+			// A dry run doesn't remove anything.
+			names, err := PruneBackups(ctx, bs, dir, tc.policy, true, logutil.NewMemoryLogger())
+			require.NoError(t, err)
+			assert.Len(t, names, len(tc.pruned))
+			bhs, err = bs.ListBackups(ctx, dir)
+			require.NoError(t, err)
+			assert.Len(t, bhs, len(backups))
+
+			names, err = PruneBackups(ctx, bs, dir, tc.policy, false, logutil.NewMemoryLogger())
+			require.NoError(t, err)
+			assert.Equal(t, tc.pruned, names)
+			bhs, err = bs.ListBackups(ctx, dir)
+			require.NoError(t, err)
+			assert.Len(t, bhs, len(backups)-len(tc.pruned))
+		})
+	}
+}
+
+func TestPruneBackupsUnreadableManifest(t *testing.T) {
+	root, err := ioutil.TempDir("", "backupretentiontest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	savedRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = root
+	defer func() { *filebackupstorage.FileBackupStorageRoot = savedRoot }()
+
+	// The MANIFEST of the oldest backup can't be read, so it may be
+	// a complete backup that must be kept, and nothing is pruned.
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	dir := GetBackupDir("ks", "0")
+	manifests := map[string]string{
+		"2020-09-08.120000.zone1-0000000100": `{"BackupMethod": `,
+		"2020-09-09.120000.zone1-0000000100": `{"BackupMethod": "builtin"}`,
+		"2020-09-10.120000.zone1-0000000100": `{"BackupMethod": "builtin"}`,
+	}
+	for name, manifest := range manifests {
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, backupManifestFileName, 0)
+		require.NoError(t, err)
+		_, err = wc.Write([]byte(manifest))
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+
+	names, err := PruneBackups(ctx, bs, dir, RetentionPolicy{KeepCount: 1}, false, logutil.NewMemoryLogger())
+	assert.Error(t, err)
+	assert.Empty(t, names)
+	bhs, err := bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	assert.Len(t, bhs, 3)
+}
+
+func TestParseBackupName(t *testing.T) {
+	backupTime, err := ParseBackupName("2020-09-10.120000.zone1-0000000100")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 9, 10, 12, 0, 0, 0, time.UTC), backupTime)
+
+	_, err = ParseBackupName("zone1-0000000100")
+	assert.EqualError(t, err, "backup name not in expected format (date.time.tablet-alias): zone1-0000000100")
+
+	_, err = ParseBackupName("2020-09-10.noon.zone1-0000000100")
+	assert.Error(t, err)
+}
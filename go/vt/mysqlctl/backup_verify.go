@@ -0,0 +1,498 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file verifies backups, by restoring them into a scratch mysqld.
+
+const (
+	// backupVerificationFile is the file of a verification entry that
+	// contains the BackupVerification.
+	backupVerificationFile = "VERIFICATION"
+
+	// defaultScratchDbaUser is the dba user created by the default
+	// init_db.sql, used when the process doesn't have the db flags.
+	defaultScratchDbaUser = "vt_dba"
+)
+
+// BackupVerification is the result of the verification of a backup.
+type BackupVerification struct {
+	// BackupName is the name of the verified backup.
+	BackupName string
+
+	// VerifyTime is when the verification finished, in RFC3339.
+	VerifyTime string
+
+	// Success is true if the backup was restored and passed all checks.
+	Success bool
+
+	// Error is the reason why the backup couldn't be restored.
+	Error string `json:",omitempty"`
+
+	// Position is the GTID position of the restored mysqld.
+	Position mysql.Position
+
+	// Checks are the checks run on the restored mysqld, in order.
+	Checks []BackupCheck
+
+	// RowCounts are the number of rows of each table, as "db.table".
+	RowCounts map[string]int64 `json:",omitempty"`
+}
+
+// BackupCheck is the result of one check of a BackupVerification.
+type BackupCheck struct {
+	Name    string
+	Success bool
+	Detail  string `json:",omitempty"`
+}
+
+// VerifyBackupParams are the parameters of VerifyBackup.
+type VerifyBackupParams struct {
+	Logger logutil.Logger
+	// Concurrency is the number of files restored in parallel.
+	Concurrency int
+	// HookExtraEnv are the extra env variables of the restore hooks.
+	HookExtraEnv map[string]string
+	// Keyspace and Shard are the shard of the backup.
+	Keyspace string
+	Shard    string
+	// BackupName is the backup to verify. If empty, the most recent
+	// complete backup is verified.
+	BackupName string
+}
+
+// GetBackupVerificationDir returns the directory where the verifications
+// of the backups of keyspace/shard are stored. It is a sibling of the
+// backup directory, so verifications never show up as backups.
+func GetBackupVerificationDir(keyspace, shard string) string {
+	return backupVerificationDir(GetBackupDir(keyspace, shard))
+}
+
+func backupVerificationDir(backupDir string) string {
+	return backupDir + ".verifications"
+}
+
+// VerifyBackup restores a backup into a scratch mysqld, started in a
+// temporary directory, and checks the restored data: CHECK TABLE on every
+// table, and their row counts and the GTID position against the MANIFEST.
+// It needs a local mysqld, so it runs where backups are taken, in vtbackup.
+// The result is stored next to the backup, and returned. A backup that
+// fails verification is not an error: errors are only returned when the
+// verification itself couldn't run, or couldn't be stored.
+func VerifyBackup(ctx context.Context, params VerifyBackupParams) (*BackupVerification, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	restoreParams := RestoreParams{
+		Logger:     params.Logger,
+		Keyspace:   params.Keyspace,
+		Shard:      params.Shard,
+		BackupName: params.BackupName,
+	}
+	bh, err := FindBackupToRestore(ctx, restoreParams, bhs)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &BackupVerification{BackupName: bh.Name()}
+	if err := verifyBackup(ctx, params, bh.Name(), v); err != nil {
+		params.Logger.Errorf("Backup %v failed verification: %v", bh.Name(), err)
+		v.Error = err.Error()
+	}
+	v.Success = v.Error == ""
+	for _, check := range v.Checks {
+		v.Success = v.Success && check.Success
+	}
+	v.VerifyTime = time.Now().UTC().Format(time.RFC3339)
+
+	if err := writeBackupVerification(ctx, bs, backupVerificationDir(backupDir), v); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+// verifyBackup restores the backup into a scratch mysqld, and runs the
+// checks on it.
+func verifyBackup(ctx context.Context, params VerifyBackupParams, name string, v *BackupVerification) error {
+	scratchDir, err := ioutil.TempDir("", "verify_backup")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(scratchDir)
+
+	mysqld, cnf, err := newScratchMysqld(scratchDir)
+	if err != nil {
+		return err
+	}
+	defer mysqld.Close()
+	defer func() {
+		if err := mysqld.Teardown(context.Background(), cnf, true); err != nil {
+			params.Logger.Warningf("Can't tear down the scratch mysqld in %v: %v", scratchDir, err)
+		}
+	}()
+
+	params.Logger.Infof("Starting a scratch mysqld in %v", scratchDir)
+	if err := mysqld.Init(ctx, cnf, ""); err != nil {
+		return vterrors.Wrap(err, "can't start the scratch mysqld")
+	}
+
+	params.Logger.Infof("Restoring backup %v into the scratch mysqld", name)
+	manifest, err := Restore(ctx, RestoreParams{
+		Cnf:                 cnf,
+		Mysqld:              mysqld,
+		Logger:              params.Logger,
+		Concurrency:         params.Concurrency,
+		HookExtraEnv:        params.HookExtraEnv,
+		LocalMetadata:       map[string]string{},
+		DeleteBeforeRestore: true,
+		DbName:              "vt_" + params.Keyspace,
+		Keyspace:            params.Keyspace,
+		Shard:               params.Shard,
+		BackupName:          name,
+	})
+	if err != nil {
+		return vterrors.Wrap(err, "restore failed")
+	}
+	return runBackupChecks(ctx, mysqld, manifest, v)
+}
+
+// newScratchMysqld returns a mysqld and its config, with all its files
+// in dir, listening on a free port.
+func newScratchMysqld(dir string) (*Mysqld, *Mycnf, error) {
+	// NewMysqld panics if it can't find the MySQL version, which could
+	// take down the whole process.
+	version, err := GetVersionString()
+	if err == nil {
+		_, _, err = ParseVersionString(version)
+	}
+	if err != nil {
+		if _, _, envErr := GetVersionFromEnv(); envErr != nil {
+			return nil, nil, vterrors.Wrap(err, "a local mysqld is required to verify backups")
+		}
+	}
+
+	port, err := freeTCPPort()
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "can't find a port for the scratch mysqld")
+	}
+	cnf := newScratchMycnf(dir, uint32(rand.Int31()), port)
+	if err := cnf.RandomizeMysqlServerID(); err != nil {
+		return nil, nil, vterrors.Wrap(err, "couldn't generate random MySQL server_id")
+	}
+
+	// The scratch mysqld only needs the dba user. Its connection params
+	// are not the ones of the global configs, which may point to the
+	// mysqld of a tablet.
+	dba := dbconfigs.GlobalDBConfigs.Dba
+	if dba.User == "" {
+		dba = dbconfigs.UserConfig{User: defaultScratchDbaUser}
+	}
+	dbcfgs := &dbconfigs.DBConfigs{
+		Charset: dbconfigs.GlobalDBConfigs.Charset,
+		Flavor:  dbconfigs.GlobalDBConfigs.Flavor,
+		App:     dba,
+		Dba:     dba,
+	}
+	dbcfgs.InitWithSocket(cnf.SocketFile)
+	return NewMysqld(dbcfgs), cnf, nil
+}
+
+// newScratchMycnf returns the config of a mysqld with all its files in
+// dir. NewMycnf puts them under the tablet directory, so they are moved.
+func newScratchMycnf(dir string, uid uint32, port int32) *Mycnf {
+	cnf := NewMycnf(uid, port)
+	tabletDir := path.Dir(cnf.DataDir)
+	for _, p := range []*string{
+		&cnf.DataDir,
+		&cnf.InnodbDataHomeDir,
+		&cnf.InnodbLogGroupHomeDir,
+		&cnf.SocketFile,
+		&cnf.GeneralLogPath,
+		&cnf.ErrorLogPath,
+		&cnf.SlowLogPath,
+		&cnf.RelayLogPath,
+		&cnf.RelayLogIndexPath,
+		&cnf.RelayLogInfoPath,
+		&cnf.BinLogPath,
+		&cnf.MasterInfoFile,
+		&cnf.PidFile,
+		&cnf.TmpDir,
+	} {
+		*p = path.Join(dir, strings.TrimPrefix(*p, tabletDir))
+	}
+	cnf.path = path.Join(dir, "my.cnf")
+	return cnf
+}
+
+// freeTCPPort returns a port that is free at the time of the call.
+func freeTCPPort() (int32, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return int32(l.Addr().(*net.TCPAddr).Port), nil
+}
+
+// runBackupChecks runs the checks of a verification on a mysqld restored
+// from the backup of manifest.
+func runBackupChecks(ctx context.Context, mysqld MysqlDaemon, manifest *BackupManifest, v *BackupVerification) error {
+	pos, err := mysqld.MasterPosition()
+	if err != nil {
+		return vterrors.Wrap(err, "can't get the position of the restored mysqld")
+	}
+	v.Position = pos
+	gtidCheck := BackupCheck{Name: "gtid", Success: pos.Equal(manifest.Position)}
+	if !gtidCheck.Success {
+		gtidCheck.Detail = fmt.Sprintf("restored position %v doesn't match the MANIFEST position %v", pos, manifest.Position)
+	}
+	v.Checks = append(v.Checks, gtidCheck)
+
+	tables, err := listTables(ctx, mysqld)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		v.Checks = append(v.Checks, checkTable(ctx, mysqld, table))
+	}
+	if v.RowCounts, err = countRows(ctx, mysqld, tables); err != nil {
+		return err
+	}
+	v.Checks = append(v.Checks, checkRowCounts(manifest.RowCounts, v.RowCounts))
+	return nil
+}
+
+// backupTable is a table of a restored mysqld.
+type backupTable struct {
+	// name is the table as "db.table".
+	name string
+	// escaped is the table as it's used in queries.
+	escaped string
+}
+
+// listTables returns the tables of the user databases of mysqld.
+func listTables(ctx context.Context, mysqld MysqlDaemon) ([]backupTable, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SELECT table_schema, table_name FROM information_schema.tables WHERE table_type = 'BASE TABLE' AND table_schema NOT IN ('information_schema', 'mysql', 'performance_schema', 'sys') ORDER BY table_schema, table_name")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't list the tables")
+	}
+	tables := make([]backupTable, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		tables = append(tables, backupTable{
+			name:    row[0].ToString() + "." + row[1].ToString(),
+			escaped: sqlescape.EscapeID(row[0].ToString()) + "." + sqlescape.EscapeID(row[1].ToString()),
+		})
+	}
+	return tables, nil
+}
+
+// tableRowCounts returns the number of rows of every table of mysqld.
+func tableRowCounts(ctx context.Context, mysqld MysqlDaemon) (map[string]int64, error) {
+	tables, err := listTables(ctx, mysqld)
+	if err != nil {
+		return nil, err
+	}
+	return countRows(ctx, mysqld, tables)
+}
+
+// countRows returns the number of rows of tables, by name.
+func countRows(ctx context.Context, mysqld MysqlDaemon, tables []backupTable) (map[string]int64, error) {
+	counts := make(map[string]int64, len(tables))
+	for _, table := range tables {
+		qr, err := mysqld.FetchSuperQuery(ctx, "SELECT COUNT(*) FROM "+table.escaped)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't count the rows of %v", table.name)
+		}
+		if len(qr.Rows) != 1 {
+			return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "unexpected result for the row count of %v: %v", table.name, qr.Rows)
+		}
+		n, err := qr.Rows[0][0].ToInt64()
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't parse the row count of %v", table.name)
+		}
+		counts[table.name] = n
+	}
+	return counts, nil
+}
+
+// checkRowCounts compares the row counts of the restored tables with the
+// ones of the MANIFEST. The check fails on any missing or extra table, or
+// any different count. It passes, without comparing anything, if the
+// MANIFEST has no row counts.
+func checkRowCounts(want, got map[string]int64) BackupCheck {
+	check := BackupCheck{Name: "row counts"}
+	if want == nil {
+		check.Success = true
+		check.Detail = "the MANIFEST has no row counts"
+		return check
+	}
+	names := make([]string, 0, len(want)+len(got))
+	for name := range want {
+		names = append(names, name)
+	}
+	for name := range got {
+		if _, ok := want[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var diffs []string
+	for _, name := range names {
+		wantCount, inManifest := want[name]
+		gotCount, restored := got[name]
+		switch {
+		case !restored:
+			diffs = append(diffs, fmt.Sprintf("%v: missing, the MANIFEST has %v rows", name, wantCount))
+		case !inManifest:
+			diffs = append(diffs, fmt.Sprintf("%v: %v rows, not in the MANIFEST", name, gotCount))
+		case gotCount != wantCount:
+			diffs = append(diffs, fmt.Sprintf("%v: %v rows, the MANIFEST has %v", name, gotCount, wantCount))
+		}
+	}
+	check.Success = len(diffs) == 0
+	check.Detail = strings.Join(diffs, "; ")
+	return check
+}
+
+// checkTable runs CHECK TABLE on a table. Its result has a row per
+// message, and the last one has the status.
+func checkTable(ctx context.Context, mysqld MysqlDaemon, table backupTable) BackupCheck {
+	check := BackupCheck{Name: "check table " + table.name}
+	qr, err := mysqld.FetchSuperQuery(ctx, "CHECK TABLE "+table.escaped)
+	if err != nil {
+		check.Detail = err.Error()
+		return check
+	}
+	var messages []string
+	for _, row := range qr.Rows {
+		if len(row) < 4 {
+			continue
+		}
+		msgType, msgText := row[2].ToString(), row[3].ToString()
+		if msgType == "status" && msgText == "OK" {
+			check.Success = true
+			continue
+		}
+		messages = append(messages, msgType+": "+msgText)
+	}
+	check.Detail = strings.Join(messages, "; ")
+	return check
+}
+
+// writeBackupVerification stores the verification in dir, replacing the
+// previous one of the same backup.
+func writeBackupVerification(ctx context.Context, bs backupstorage.BackupStorage, dir string, v *BackupVerification) (finalErr error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return vterrors.Wrap(err, "ListBackups failed")
+	}
+	for _, bh := range bhs {
+		if bh.Name() == v.BackupName {
+			if err := bs.RemoveBackup(ctx, dir, v.BackupName); err != nil {
+				return vterrors.Wrapf(err, "can't remove the previous verification of %v", v.BackupName)
+			}
+		}
+	}
+
+	bh, err := bs.StartBackup(ctx, dir, v.BackupName)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	defer func() {
+		if finalErr != nil {
+			if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+				finalErr = vterrors.Wrapf(finalErr, "can't abort verification of %v: %v", v.BackupName, abortErr)
+			}
+		}
+	}()
+	wc, err := bh.AddFile(ctx, backupVerificationFile, int64(len(data)))
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to verification", backupVerificationFile)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return vterrors.Wrapf(err, "cannot write %v", backupVerificationFile)
+	}
+	if err := wc.Close(); err != nil {
+		return vterrors.Wrapf(err, "cannot close %v", backupVerificationFile)
+	}
+	return bh.EndBackup(ctx)
+}
+
+// GetBackupVerifications returns the stored verifications of the backups
+// of keyspace/shard, by backup name.
+func GetBackupVerifications(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard string) (map[string]*BackupVerification, error) {
+	bhs, err := bs.ListBackups(ctx, GetBackupVerificationDir(keyspace, shard))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	result := make(map[string]*BackupVerification, len(bhs))
+	for _, bh := range bhs {
+		v, err := readBackupVerification(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		result[bh.Name()] = v
+	}
+	return result, nil
+}
+
+func readBackupVerification(ctx context.Context, bh backupstorage.BackupHandle) (*BackupVerification, error) {
+	rc, err := bh.ReadFile(ctx, backupVerificationFile)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't read verification of %v", bh.Name())
+	}
+	defer rc.Close()
+	v := &BackupVerification{}
+	if err := json.NewDecoder(rc).Decode(v); err != nil {
+		return nil, vterrors.Wrapf(err, "can't decode verification of %v", bh.Name())
+	}
+	return v, nil
+}
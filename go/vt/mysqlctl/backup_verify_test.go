@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// checksMysqlDaemon answers the queries of runBackupChecks.
+type checksMysqlDaemon struct {
+	MysqlDaemon
+	pos     mysql.Position
+	results map[string]*sqltypes.Result
+}
+
+func (d *checksMysqlDaemon) MasterPosition() (mysql.Position, error) {
+	return d.pos, nil
+}
+
+func (d *checksMysqlDaemon) FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error) {
+	if strings.HasPrefix(query, "SELECT table_schema") {
+		query = "tables"
+	}
+	qr, ok := d.results[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	return qr, nil
+}
+
+func TestRunBackupChecks(t *testing.T) {
+	checkFields := sqltypes.MakeTestFields("Table|Op|Msg_type|Msg_text", "varchar|varchar|varchar|varchar")
+	countFields := sqltypes.MakeTestFields("COUNT(*)", "int64")
+	mysqld := &checksMysqlDaemon{
+		pos: testPosition(t, testSID+":1-10"),
+		results: map[string]*sqltypes.Result{
+			"tables": sqltypes.MakeTestResult(sqltypes.MakeTestFields("table_schema|table_name", "varchar|varchar"),
+				"vt_ks|t1",
+				"vt_ks|t2",
+			),
+			"CHECK TABLE `vt_ks`.`t1`":          sqltypes.MakeTestResult(checkFields, "vt_ks.t1|check|status|OK"),
+			"CHECK TABLE `vt_ks`.`t2`":          sqltypes.MakeTestResult(checkFields, "vt_ks.t2|check|error|Corrupt", "vt_ks.t2|check|status|Operation failed"),
+			"SELECT COUNT(*) FROM `vt_ks`.`t1`": sqltypes.MakeTestResult(countFields, "42"),
+			"SELECT COUNT(*) FROM `vt_ks`.`t2`": sqltypes.MakeTestResult(countFields, "0"),
+		},
+	}
+
+	v := &BackupVerification{}
+	manifest := &BackupManifest{
+		Position:  testPosition(t, testSID+":1-12"),
+		RowCounts: map[string]int64{"vt_ks.t1": 40, "vt_ks.t3": 5},
+	}
+	require.NoError(t, runBackupChecks(context.Background(), mysqld, manifest, v))
+	assert.Equal(t, testSID+":1-10", v.Position.String())
+	assert.Equal(t, []BackupCheck{{
+		Name:   "gtid",
+		Detail: "restored position " + testSID + ":1-10 doesn't match the MANIFEST position " + testSID + ":1-12",
+	}, {
+		Name:    "check table vt_ks.t1",
+		Success: true,
+	}, {
+		Name:   "check table vt_ks.t2",
+		Detail: "error: Corrupt; status: Operation failed",
+	}, {
+		Name:   "row counts",
+		Detail: "vt_ks.t1: 42 rows, the MANIFEST has 40; vt_ks.t2: 0 rows, not in the MANIFEST; vt_ks.t3: missing, the MANIFEST has 5 rows",
+	}}, v.Checks)
+	assert.Equal(t, map[string]int64{"vt_ks.t1": 42, "vt_ks.t2": 0}, v.RowCounts)
+}
+
+func TestCheckRowCounts(t *testing.T) {
+	counts := map[string]int64{"vt_ks.t1": 42, "vt_ks.t2": 0}
+	assert.Equal(t, BackupCheck{Name: "row counts", Success: true}, checkRowCounts(map[string]int64{"vt_ks.t1": 42, "vt_ks.t2": 0}, counts))
+	// The row counts of backups that didn't record them are not compared.
+	assert.Equal(t, BackupCheck{Name: "row counts", Success: true, Detail: "the MANIFEST has no row counts"}, checkRowCounts(nil, counts))
+	// A table that was empty at backup time must be empty once restored.
+	assert.Equal(t, BackupCheck{Name: "row counts", Detail: "vt_ks.t2: 3 rows, the MANIFEST has 0"}, checkRowCounts(counts, map[string]int64{"vt_ks.t1": 42, "vt_ks.t2": 3}))
+}
+
+func TestNewScratchMycnf(t *testing.T) {
+	cnf := newScratchMycnf("/tmp/scratch", 123, 4567)
+	assert.Equal(t, "/tmp/scratch/my.cnf", cnf.path)
+	assert.Equal(t, "/tmp/scratch/data", cnf.DataDir)
+	assert.Equal(t, "/tmp/scratch/mysql.sock", cnf.SocketFile)
+	assert.Equal(t, "/tmp/scratch/bin-logs/vt-0000000123-bin", cnf.BinLogPath)
+	assert.Equal(t, "/tmp/scratch", cnf.TabletDir())
+	assert.Equal(t, int32(4567), cnf.MysqlPort)
+}
+
+func TestBackupVerificationRoundTrip(t *testing.T) {
+	root, err := ioutil.TempDir("", "backupverifytest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	savedRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "storage")
+	defer func() { *filebackupstorage.FileBackupStorageRoot = savedRoot }()
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	dir := GetBackupVerificationDir("ks", "0")
+	failed := &BackupVerification{
+		BackupName: "2020-09-01.100000.zone1-0000000100",
+		VerifyTime: "2020-09-02T10:00:00Z",
+		Error:      "restore failed",
+	}
+	require.NoError(t, writeBackupVerification(ctx, bs, dir, failed))
+
+	// Verifying again replaces the previous verification.
+	verified := &BackupVerification{
+		BackupName: "2020-09-01.100000.zone1-0000000100",
+		VerifyTime: "2020-09-03T10:00:00Z",
+		Success:    true,
+		Position:   testPosition(t, testSID+":1-10"),
+		Checks:     []BackupCheck{{Name: "gtid", Success: true}},
+		RowCounts:  map[string]int64{"vt_ks.t1": 42},
+	}
+	require.NoError(t, writeBackupVerification(ctx, bs, dir, verified))
+
+	got, err := GetBackupVerifications(ctx, bs, "ks", "0")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*BackupVerification{verified.BackupName: verified}, got)
+
+	// Verifications don't show up as backups of the shard.
+	backups, err := bs.ListBackups(ctx, GetBackupDir("ks", "0"))
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+}
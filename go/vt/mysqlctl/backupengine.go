@@ -66,6 +66,9 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// Incremental: if set, only store the binlogs since the previous
+	// backup of the shard
+	Incremental bool
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -92,6 +95,15 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToTime: if non-zero, restore the most recent backup that finished
+	// at or before this time, then replay the archived binlogs up to this time
+	RestoreToTime time.Time
+	// RestoreToPosition: if non-zero, restore the most recent backup taken at
+	// or before this GTID position, then replay the archived binlogs up to it
+	RestoreToPosition mysql.Position
+	// BackupName: if set, restore this backup instead of looking for the
+	// most recent one
+	BackupName string
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -100,6 +112,14 @@ type RestoreEngine interface {
 	ExecuteRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error)
 }
 
+// IncrementalRestoreEngine is implemented by the engines that can take
+// incremental backups. ExecuteIncrementalRestore applies one incremental
+// backup to a running mysqld, which has been restored up to the base of
+// that backup.
+type IncrementalRestoreEngine interface {
+	ExecuteIncrementalRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error)
+}
+
 // BackupRestoreEngine is a combination of BackupEngine and RestoreEngine.
 type BackupRestoreEngine interface {
 	BackupEngine
@@ -188,6 +208,32 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Incremental is set if the backup only holds the changes since the
+	// backup it is based on, which must be restored first.
+	Incremental bool
+
+	// BaseBackup is the name of the backup an incremental backup is based on.
+	BaseBackup string
+
+	// FromPosition is the position of the backup an incremental backup is
+	// based on. The backup holds the changes from FromPosition to Position.
+	FromPosition mysql.Position
+
+	// CompressionEngine is the engine the files of the backup were
+	// compressed with. If this is empty, and the files were compressed,
+	// they were compressed with gzip.
+	CompressionEngine string `json:",omitempty"`
+
+	// ExternalDecompressor is the command that decompresses the files
+	// of the backup, if CompressionEngine is external. It is only
+	// informational: restores use the external_decompressor flag.
+	ExternalDecompressor string `json:",omitempty"`
+
+	// RowCounts are the number of rows of each table at Position, as
+	// "db.table". They are only recorded by the builtin engine, when
+	// -builtinbackup_row_counts is set.
+	RowCounts map[string]int64 `json:",omitempty"`
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
@@ -198,8 +244,30 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	var index int
 	// if a StartTime is provided in params, then find a backup that was taken at or before that time
 	checkBackupTime := !params.StartTime.IsZero()
+	// for a point-in-time restore, find a backup whose data is not past the target
+	pointInTime := !params.RestoreToTime.IsZero() || !params.RestoreToPosition.IsZero()
 	backupDir := GetBackupDir(params.Keyspace, params.Shard)
 
+	if params.BackupName != "" {
+		for _, bh := range bhs {
+			if bh.Name() != params.BackupName {
+				continue
+			}
+			bm, err := GetBackupManifest(ctx, bh)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "can't read MANIFEST of backup %v in directory %v", bh.Name(), backupDir)
+			}
+			if pointInTime {
+				if err := checkPointInTimeBackup(params, bm); err != nil {
+					return nil, vterrors.Wrapf(err, "can't restore backup %v", bh.Name())
+				}
+			}
+			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
+			return bh, nil
+		}
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no backup %v in directory %v", params.BackupName, backupDir)
+	}
+
 	for index = len(bhs) - 1; index >= 0; index-- {
 		bh = bhs[index]
 		// Check that the backup MANIFEST exists and can be successfully decoded.
@@ -209,6 +277,12 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 			continue
 		}
 
+		if pointInTime {
+			if err := checkPointInTimeBackup(params, bm); err != nil {
+				params.Logger.Infof("Restore: skipping backup %v/%v: %v", backupDir, bh.Name(), err)
+				continue
+			}
+		}
 		var backupTime time.Time
 		if checkBackupTime {
 			backupTime, err = time.Parse(time.RFC3339, bm.BackupTime)
@@ -226,6 +300,12 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 		if checkBackupTime {
 			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
 		}
+		if !params.RestoreToTime.IsZero() {
+			params.Logger.Errorf("No valid backup found that finished before time %v", params.RestoreToTime.Format(BackupTimestampFormat))
+		}
+		if !params.RestoreToPosition.IsZero() {
+			params.Logger.Errorf("No valid backup found before position %v", params.RestoreToPosition)
+		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
@@ -235,6 +315,61 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	return bh, nil
 }
 
+// checkPointInTimeBackup returns an error if the data of a backup may be
+// past the target of a point-in-time restore. The name and BackupTime of
+// a backup are when it started, but its position can be as late as when
+// it finished: xtrabackup copies the files while mysqld runs, and ends at
+// the position of the last copied transaction. So for a target time, it
+// is FinishedTime that must not be after the target.
+func checkPointInTimeBackup(params RestoreParams, bm *BackupManifest) error {
+	if !params.RestoreToPosition.IsZero() && !params.RestoreToPosition.AtLeast(bm.Position) {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup position %v is past the target position %v", bm.Position, params.RestoreToPosition)
+	}
+	if params.RestoreToTime.IsZero() {
+		return nil
+	}
+	if bm.FinishedTime == "" {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup has no finished time, its position may be past the target time %v", params.RestoreToTime.UTC().Format(time.RFC3339))
+	}
+	finishedTime, err := time.Parse(time.RFC3339, bm.FinishedTime)
+	if err != nil {
+		return vterrors.Wrapf(err, "invalid backup finished time %v", bm.FinishedTime)
+	}
+	if finishedTime.After(params.RestoreToTime) {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "backup finished at %v, its position may be past the target time %v", bm.FinishedTime, params.RestoreToTime.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// FindBackupChain returns the backups to restore, in order, to restore
+// bh: the full backup it is based on, followed by the incremental backups
+// between that one and bh. For a full backup, that's just bh.
+func FindBackupChain(ctx context.Context, bhs []backupstorage.BackupHandle, bh backupstorage.BackupHandle) ([]backupstorage.BackupHandle, error) {
+	byName := make(map[string]backupstorage.BackupHandle, len(bhs))
+	for _, b := range bhs {
+		byName[b.Name()] = b
+	}
+
+	chain := []backupstorage.BackupHandle{bh}
+	for {
+		bm, err := GetBackupManifest(ctx, chain[0])
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "can't read MANIFEST of backup %v", chain[0].Name())
+		}
+		if !bm.Incremental {
+			return chain, nil
+		}
+		base, ok := byName[bm.BaseBackup]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "can't find backup %v that incremental backup %v is based on", bm.BaseBackup, chain[0].Name())
+		}
+		if len(chain) > len(bhs) {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "incremental backup %v is based on itself", bh.Name())
+		}
+		chain = append([]backupstorage.BackupHandle{base}, chain...)
+	}
+}
+
 func prepareToRestore(ctx context.Context, cnf *Mycnf, mysqld MysqlDaemon, logger logutil.Logger) error {
 	// shutdown mysqld if it is running
 	logger.Infof("Restore: shutdown mysqld")
@@ -0,0 +1,413 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// This file handles the client-side encryption of the backup files.
+//
+// Every file is encrypted with its own random data key, with AES-256-GCM.
+// The data key is itself encrypted with a master key read from the key
+// file, and stored in the header of the file along with the id of the
+// master key. The data is split in chunks, so files can be streamed. The
+// nonce of each chunk holds its index, and whether it is the last one,
+// so chunks can't be reordered, and truncated files are detected.
+//
+// A file starts with:
+//   - the magic string
+//   - the length of the id of the master key, on one byte
+//   - the id of the master key
+//   - the data key, encrypted with the master key, prefixed by the nonce
+// followed by the chunks, each of them prefixed by its length on 4 bytes.
+
+var (
+	// encryptionKeyFile is the file that holds the master keys.
+	encryptionKeyFile = flag.String("backup_storage_encryption_key_file", "", "if set, the files of new backups are encrypted with AES-256-GCM, using the current key of this JSON file, e.g. {\"current\": \"key2\", \"keys\": {\"key1\": \"<base64 key>\", \"key2\": \"<base64 key>\"}}. Keys are 32 bytes long. Restores look up the key a file was encrypted with by its id, so old keys must be kept as long as backups use them. While it is set, files that are not encrypted are not restored, unless backup_storage_allow_unencrypted_reads is set.")
+	// allowUnencryptedReads lets the files of backups taken before
+	// encryption was enabled be read.
+	allowUnencryptedReads = flag.Bool("backup_storage_allow_unencrypted_reads", false, "if set along with backup_storage_encryption_key_file, the files that are not encrypted are read as they are instead of being rejected, so that the backups taken before encryption was enabled can still be restored. Only meant to be set while migrating to encrypted backups: a file replaced in the storage by a plain one is then restored too.")
+)
+
+const (
+	encryptionMagic     = "VTBKENC1"
+	encryptionChunkSize = 64 * 1024
+	encryptionKeySize   = 32
+	encryptionNonceSize = 12
+	encryptionTagSize   = 16
+)
+
+// encryptionKeys is the content of the key file.
+type encryptionKeys struct {
+	// Current is the id of the key used to encrypt new files.
+	Current string `json:"current"`
+
+	// Keys are the base64 encoded keys, by id.
+	Keys map[string]string `json:"keys"`
+}
+
+// loadEncryptionKeys reads the keys of backup_storage_encryption_key_file.
+// It returns nil if encryption is disabled.
+func loadEncryptionKeys() (*encryptionKeys, error) {
+	if *encryptionKeyFile == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(*encryptionKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't read backup encryption keys: %v", err)
+	}
+	keys := &encryptionKeys{}
+	if err := json.Unmarshal(data, keys); err != nil {
+		return nil, fmt.Errorf("can't parse backup encryption keys in %v: %v", *encryptionKeyFile, err)
+	}
+	for id := range keys.Keys {
+		if len(id) > 255 {
+			return nil, fmt.Errorf("backup encryption key id %q is longer than 255 bytes", id)
+		}
+		if _, err := keys.key(id); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := keys.Keys[keys.Current]; !ok {
+		return nil, fmt.Errorf("current backup encryption key %q is not in %v", keys.Current, *encryptionKeyFile)
+	}
+	return keys, nil
+}
+
+// key returns the AEAD of a master key.
+func (keys *encryptionKeys) key(id string) (cipher.AEAD, error) {
+	encoded, ok := keys.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown backup encryption key %q", id)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup encryption key %q: %v", id, err)
+	}
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("backup encryption key %q is %v bytes long instead of %v", id, len(key), encryptionKeySize)
+	}
+	return newAEAD(key)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptedSize returns the size of a file once encrypted.
+func encryptedSize(keyID string, size int64) int64 {
+	if size < 0 {
+		return size
+	}
+	header := int64(len(encryptionMagic) + 1 + len(keyID) + encryptionNonceSize + encryptionKeySize + encryptionTagSize)
+	// An empty file still has an empty last chunk.
+	chunks := (size + encryptionChunkSize - 1) / encryptionChunkSize
+	if chunks == 0 {
+		chunks = 1
+	}
+	return header + size + chunks*(4+encryptionTagSize)
+}
+
+// chunkNonce returns the nonce of a chunk of a file.
+func chunkNonce(index uint64, last bool) []byte {
+	nonce := make([]byte, encryptionNonceSize)
+	binary.BigEndian.PutUint64(nonce, index)
+	if last {
+		nonce[encryptionNonceSize-1] = 1
+	}
+	return nonce
+}
+
+// encryptingWriter encrypts the data written to it, by chunks.
+type encryptingWriter struct {
+	w     io.WriteCloser
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+}
+
+// newEncryptingWriter writes the header of a file to w, and returns the
+// writer of its content.
+func newEncryptingWriter(w io.WriteCloser, keys *encryptionKeys) (*encryptingWriter, error) {
+	masterKey, err := keys.key(keys.Current)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, encryptionKeySize)
+	nonce := make([]byte, encryptionNonceSize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	header := bytes.NewBufferString(encryptionMagic)
+	header.WriteByte(byte(len(keys.Current)))
+	header.WriteString(keys.Current)
+	header.Write(nonce)
+	header.Write(masterKey.Seal(nil, nonce, dataKey, []byte(keys.Current)))
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return nil, err
+	}
+	return &encryptingWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ew *encryptingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(ew.buf[len(ew.buf):cap(ew.buf)], p)
+		ew.buf = ew.buf[:len(ew.buf)+n]
+		p = p[n:]
+		written += n
+		// A full chunk is only written once there is more data, as
+		// it may be the last one.
+		if len(ew.buf) == cap(ew.buf) && len(p) > 0 {
+			if err := ew.writeChunk(false); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (ew *encryptingWriter) writeChunk(last bool) error {
+	sealed := ew.aead.Seal(nil, chunkNonce(ew.index, last), ew.buf, nil)
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(sealed)))
+	if _, err := ew.w.Write(length[:]); err != nil {
+		return err
+	}
+	if _, err := ew.w.Write(sealed); err != nil {
+		return err
+	}
+	ew.index++
+	ew.buf = ew.buf[:0]
+	return nil
+}
+
+// Close writes the last chunk, and closes the underlying writer.
+func (ew *encryptingWriter) Close() error {
+	if err := ew.writeChunk(true); err != nil {
+		ew.w.Close()
+		return err
+	}
+	return ew.w.Close()
+}
+
+// decryptingReader decrypts a file written by an encryptingWriter.
+type decryptingReader struct {
+	r     *bufio.Reader
+	c     io.Closer
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+	done  bool
+}
+
+// newDecryptingReader reads the header of a file. If encryption is disabled,
+// files that are not encrypted are returned as is. If it is enabled, they are
+// rejected, so that a file replaced in the storage by a plain one can't be
+// restored, unless backup_storage_allow_unencrypted_reads is set.
+func newDecryptingReader(rc io.ReadCloser, keys *encryptionKeys) (io.ReadCloser, error) {
+	r := bufio.NewReader(rc)
+	magic, err := r.Peek(len(encryptionMagic))
+	if err != nil || string(magic) != encryptionMagic {
+		if keys != nil && !*allowUnencryptedReads {
+			return nil, fmt.Errorf("backup file is not encrypted, and backup_storage_encryption_key_file is set")
+		}
+		return struct {
+			io.Reader
+			io.Closer
+		}{r, rc}, nil
+	}
+	if keys == nil {
+		return nil, fmt.Errorf("backup file is encrypted, and backup_storage_encryption_key_file is not set")
+	}
+	if _, err := r.Discard(len(encryptionMagic)); err != nil {
+		return nil, err
+	}
+	idLen, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("can't read backup encryption header: %v", err)
+	}
+	header := make([]byte, int(idLen)+encryptionNonceSize+encryptionKeySize+encryptionTagSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("can't read backup encryption header: %v", err)
+	}
+	id := string(header[:idLen])
+	nonce := header[idLen : int(idLen)+encryptionNonceSize]
+	masterKey, err := keys.key(id)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := masterKey.Open(nil, nonce, header[int(idLen)+encryptionNonceSize:], []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("can't decrypt the data key of backup file with key %q: %v", id, err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptingReader{
+		r:    r,
+		c:    rc,
+		aead: aead,
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (dr *decryptingReader) Read(p []byte) (int, error) {
+	for len(dr.buf) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.buf)
+	dr.buf = dr.buf[n:]
+	return n, nil
+}
+
+func (dr *decryptingReader) readChunk() error {
+	var length [4]byte
+	if _, err := io.ReadFull(dr.r, length[:]); err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("encrypted backup file is truncated")
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size < encryptionTagSize || size > encryptionChunkSize+encryptionTagSize {
+		return fmt.Errorf("invalid chunk of %v bytes in encrypted backup file", size)
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(dr.r, sealed); err != nil {
+		return err
+	}
+	// The chunk is the last one if it opens with the nonce of the last one.
+	// Open clears its output when it fails, so it can't decrypt in place.
+	plain, err := dr.aead.Open(nil, chunkNonce(dr.index, false), sealed, nil)
+	if err != nil {
+		plain, err = dr.aead.Open(nil, chunkNonce(dr.index, true), sealed, nil)
+		if err != nil {
+			return fmt.Errorf("can't decrypt chunk %v of backup file: %v", dr.index, err)
+		}
+		dr.done = true
+	}
+	dr.index++
+	dr.buf = plain
+	return nil
+}
+
+// Close is part of the io.Closer interface.
+func (dr *decryptingReader) Close() error {
+	return dr.c.Close()
+}
+
+// encryptedBackupStorage encrypts the files written to a BackupStorage,
+// and decrypts the encrypted ones it reads.
+type encryptedBackupStorage struct {
+	BackupStorage
+	keys *encryptionKeys
+}
+
+// ListBackups is part of the BackupStorage interface.
+func (ebs *encryptedBackupStorage) ListBackups(ctx context.Context, dir string) ([]BackupHandle, error) {
+	bhs, err := ebs.BackupStorage.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	for i, bh := range bhs {
+		bhs[i] = &encryptedBackupHandle{BackupHandle: bh, keys: ebs.keys}
+	}
+	return bhs, nil
+}
+
+// StartBackup is part of the BackupStorage interface.
+func (ebs *encryptedBackupStorage) StartBackup(ctx context.Context, dir, name string) (BackupHandle, error) {
+	bh, err := ebs.BackupStorage.StartBackup(ctx, dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedBackupHandle{BackupHandle: bh, keys: ebs.keys}, nil
+}
+
+// encryptedBackupHandle encrypts the files added to a backup.
+type encryptedBackupHandle struct {
+	BackupHandle
+	keys *encryptionKeys
+}
+
+// AddFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) AddFile(ctx context.Context, filename string, filesize int64) (io.WriteCloser, error) {
+	if ebh.keys == nil {
+		return ebh.BackupHandle.AddFile(ctx, filename, filesize)
+	}
+	wc, err := ebh.BackupHandle.AddFile(ctx, filename, encryptedSize(ebh.keys.Current, filesize))
+	if err != nil {
+		return nil, err
+	}
+	ew, err := newEncryptingWriter(wc, ebh.keys)
+	if err != nil {
+		wc.Close()
+		return nil, err
+	}
+	return ew, nil
+}
+
+// ReadFile is part of the BackupHandle interface.
+func (ebh *encryptedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := ebh.BackupHandle.ReadFile(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := newDecryptingReader(rc, ebh.keys)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	return dr, nil
+}
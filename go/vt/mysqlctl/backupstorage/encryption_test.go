@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closeBuffer struct {
+	bytes.Buffer
+}
+
+func (*closeBuffer) Close() error {
+	return nil
+}
+
+func testEncryptionKeys() *encryptionKeys {
+	return &encryptionKeys{
+		Current: "key2",
+		Keys: map[string]string{
+			"key1": base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, encryptionKeySize)),
+			"key2": base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, encryptionKeySize)),
+		},
+	}
+}
+
+func encrypt(t *testing.T, keys *encryptionKeys, data []byte) []byte {
+	t.Helper()
+	buf := &closeBuffer{}
+	ew, err := newEncryptingWriter(buf, keys)
+	require.NoError(t, err)
+	// Write in uneven pieces, to cross the chunk boundaries.
+	for len(data) > 0 {
+		n := 1000
+		if n > len(data) {
+			n = len(data)
+		}
+		_, err := ew.Write(data[:n])
+		require.NoError(t, err)
+		data = data[n:]
+	}
+	require.NoError(t, ew.Close())
+	return buf.Bytes()
+}
+
+func decrypt(keys *encryptionKeys, data []byte) ([]byte, error) {
+	dr, err := newDecryptingReader(ioutil.NopCloser(bytes.NewReader(data)), keys)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+	return ioutil.ReadAll(dr)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	keys := testEncryptionKeys()
+	for _, size := range []int{0, 10, encryptionChunkSize, 3*encryptionChunkSize + 17} {
+		data := bytes.Repeat([]byte("0123456789"), size/10+1)[:size]
+		encrypted := encrypt(t, keys, data)
+		assert.EqualValues(t, encryptedSize(keys.Current, int64(size)), len(encrypted), "size %v", size)
+		assert.False(t, bytes.Contains(encrypted, []byte("0123456789")))
+
+		decrypted, err := decrypt(keys, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, data, decrypted, "size %v", size)
+	}
+}
+
+func TestEncryptionErrors(t *testing.T) {
+	keys := testEncryptionKeys()
+	data := bytes.Repeat([]byte("x"), 2*encryptionChunkSize+1)
+	encrypted := encrypt(t, keys, data)
+
+	// Files encrypted with an older key can be decrypted, as long as the
+	// key is in the file.
+	keys.Current = "key1"
+	decrypted, err := decrypt(keys, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, data, decrypted)
+	delete(keys.Keys, "key2")
+	_, err = decrypt(keys, encrypted)
+	assert.EqualError(t, err, `unknown backup encryption key "key2"`)
+	keys = testEncryptionKeys()
+
+	_, err = decrypt(nil, encrypted)
+	assert.EqualError(t, err, "backup file is encrypted, and backup_storage_encryption_key_file is not set")
+
+	// Truncating the file at a chunk boundary is detected.
+	_, err = decrypt(keys, encrypted[:encryptedSize(keys.Current, encryptionChunkSize)])
+	assert.EqualError(t, err, "encrypted backup file is truncated")
+
+	corrupted := append([]byte(nil), encrypted...)
+	corrupted[len(corrupted)-1] ^= 1
+	_, err = decrypt(keys, corrupted)
+	assert.Error(t, err)
+
+	// Files that are not encrypted are only read as is if encryption is disabled.
+	_, err = decrypt(keys, []byte("plain"))
+	assert.EqualError(t, err, "backup file is not encrypted, and backup_storage_encryption_key_file is set")
+	_, err = decrypt(keys, nil)
+	assert.EqualError(t, err, "backup file is not encrypted, and backup_storage_encryption_key_file is set")
+	decrypted, err = decrypt(nil, []byte("plain"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("plain"), decrypted)
+
+	// Or if they're explicitly allowed, while migrating to encryption.
+	*allowUnencryptedReads = true
+	defer func() { *allowUnencryptedReads = false }()
+	decrypted, err = decrypt(keys, []byte("plain"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("plain"), decrypted)
+	decrypted, err = decrypt(keys, encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, data, decrypted)
+}
+
+func TestLoadEncryptionKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryptionkeys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	saved := *encryptionKeyFile
+	defer func() { *encryptionKeyFile = saved }()
+
+	keys, err := loadEncryptionKeys()
+	require.NoError(t, err)
+	assert.Nil(t, keys)
+
+	*encryptionKeyFile = path.Join(dir, "keys.json")
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, encryptionKeySize))
+	require.NoError(t, ioutil.WriteFile(*encryptionKeyFile, []byte(`{"current": "key1", "keys": {"key1": "`+key+`"}}`), 0600))
+	keys, err = loadEncryptionKeys()
+	require.NoError(t, err)
+	assert.Equal(t, "key1", keys.Current)
+
+	require.NoError(t, ioutil.WriteFile(*encryptionKeyFile, []byte(`{"current": "key2", "keys": {"key1": "`+key+`"}}`), 0600))
+	_, err = loadEncryptionKeys()
+	assert.Contains(t, err.Error(), `current backup encryption key "key2" is not in`)
+
+	require.NoError(t, ioutil.WriteFile(*encryptionKeyFile, []byte(`{"current": "key1", "keys": {"key1": "c2hvcnQ="}}`), 0600))
+	_, err = loadEncryptionKeys()
+	assert.EqualError(t, err, `backup encryption key "key1" is 5 bytes long instead of 32`)
+}
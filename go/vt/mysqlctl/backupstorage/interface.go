@@ -19,6 +19,7 @@ limitations under the License.
 package backupstorage
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -28,6 +29,10 @@ import (
 )
 
 var (
+	// ErrFileNotFound is wrapped by the errors of ReadFile
+	// for files that don't exist in a backup.
+	ErrFileNotFound = errors.New("file not found in backup")
+
 	// BackupStorageImplementation is the implementation to use
 	// for BackupStorage. Exported for test purposes.
 	BackupStorageImplementation = flag.String("backup_storage_implementation", "", "which implementation to use for the backup storage feature")
@@ -72,6 +77,7 @@ type BackupHandle interface {
 
 	// ReadFile starts reading a file from a backup.
 	// Only works for read-only backups (created by ListBackups).
+	// If the file doesn't exist, the error wraps ErrFileNotFound.
 	// The context is valid for the duration of the reads, until the
 	// ReadCloser is closed.
 	ReadFile(ctx context.Context, filename string) (io.ReadCloser, error)
@@ -114,10 +120,18 @@ var BackupStorageMap = make(map[string]BackupStorage)
 // GetBackupStorage returns the current BackupStorage implementation.
 // Should be called after flags have been initialized.
 // When all operations are done, call BackupStorage.Close() to free resources.
+// The files of new backups are encrypted if
+// backup_storage_encryption_key_file is set. The files that are not
+// encrypted are then only read if backup_storage_allow_unencrypted_reads
+// is set too.
 func GetBackupStorage() (BackupStorage, error) {
 	bs, ok := BackupStorageMap[*BackupStorageImplementation]
 	if !ok {
 		return nil, fmt.Errorf("no registered implementation of BackupStorage")
 	}
-	return bs, nil
+	keys, err := loadEncryptionKeys()
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedBackupStorage{BackupStorage: bs, keys: keys}, nil
 }
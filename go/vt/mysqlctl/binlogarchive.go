@@ -0,0 +1,531 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the binlog archive used for point-in-time recovery.
+//
+// Closed binlog files are copied to the BackupStorage, one handle per
+// file, in a directory next to the backups of the shard. A restore to a
+// given time or GTID position first restores the most recent backup
+// taken before that point, and then replays the archived binlogs on top
+// of it with mysqlbinlog.
+//
+// The archive relies on GTIDs to find out which binlogs are needed, so
+// it only supports the MySQL 5.6+ GTID flavor.
+
+const (
+	// binlogFileName is the name of the binlog file within an
+	// archive handle.
+	binlogFileName = "binlog"
+
+	// binlogMagic is the magic number at the start of a binlog file.
+	binlogMagic = "\xfebin"
+)
+
+// BinlogManifest describes one archived binlog file. It is stored as
+// the MANIFEST file of its archive handle.
+type BinlogManifest struct {
+	// FileName is the name of the binlog file on the tablet that
+	// archived it.
+	FileName string
+
+	// TabletAlias is the alias of the tablet that archived the file.
+	TabletAlias string
+
+	// StartPosition is the GTID set that was executed before the first
+	// transaction of the file.
+	StartPosition mysql.Position
+
+	// EndPosition is the GTID set that was executed after the last
+	// transaction of the file.
+	EndPosition mysql.Position
+
+	// StartTime is when the file was created, in UTC time (RFC 3339 format).
+	StartTime string
+
+	// EndTime is when the file was rotated, in UTC time (RFC 3339 format).
+	EndTime string
+
+	// Compressed is set if the binlog file was stored compressed.
+	Compressed bool
+
+	// CompressionEngine is the engine the binlog file was compressed
+	// with. If this is empty, and the file was compressed, it was
+	// compressed with gzip.
+	CompressionEngine string `json:",omitempty"`
+
+	// ExternalDecompressor is the command that decompresses the binlog
+	// file, if CompressionEngine is external. It is only informational:
+	// restores use the external_decompressor flag.
+	ExternalDecompressor string `json:",omitempty"`
+}
+
+// ArchiveBinlogsParams is the struct that holds all params passed to
+// ArchiveBinlogs.
+type ArchiveBinlogsParams struct {
+	Cnf    *Mycnf
+	Mysqld MysqlDaemon
+	Logger logutil.Logger
+	// Keyspace and Shard are used to infer the directory where the
+	// archive is stored
+	Keyspace string
+	Shard    string
+	// TabletAlias is used to name the archived files
+	TabletAlias string
+}
+
+// GetBinlogArchiveDir returns the directory where archived binlogs for
+// the given keyspace/shard are (or will be) stored. It is a sibling of
+// the backup directory, so the archived files never show up as backups.
+func GetBinlogArchiveDir(keyspace, shard string) string {
+	return fmt.Sprintf("%v/%v.binlogs", keyspace, shard)
+}
+
+// binlogFileInfo is what ArchiveBinlogs needs to know about a binlog file
+// of the local mysqld.
+type binlogFileInfo struct {
+	name          string
+	startPosition mysql.Position
+	startTime     time.Time
+}
+
+// ArchiveBinlogs copies all binlog files of the local mysqld that were
+// rotated and are not archived yet to the BackupStorage. The file that
+// is currently being written is never archived. It returns the number
+// of files that were archived.
+func ArchiveBinlogs(ctx context.Context, params ArchiveBinlogsParams) (int, error) {
+	qr, err := params.Mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return 0, vterrors.Wrap(err, "can't list binary logs")
+	}
+	if len(qr.Rows) < 2 {
+		// Only the active file, nothing to archive.
+		return 0, nil
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return 0, err
+	}
+	defer bs.Close()
+
+	archiveDir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, archiveDir)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "ListBackups failed")
+	}
+
+	binlogDir := path.Dir(params.Cnf.BinLogPath)
+	count := 0
+	var next *binlogFileInfo
+	// Walk the files backwards, so the start of the next file, which
+	// is the end of the current one, is always known.
+	for i := len(qr.Rows) - 1; i >= 0; i-- {
+		cur, err := readBinlogFileInfo(ctx, params.Mysqld, binlogDir, qr.Rows[i][0].ToString())
+		if err != nil {
+			return count, err
+		}
+		end := next
+		next = cur
+		if end == nil {
+			continue
+		}
+
+		archived, err := isBinlogArchived(ctx, params, bs, bhs, cur.name)
+		if err != nil {
+			return count, err
+		}
+		if archived {
+			// The older files are archived too.
+			break
+		}
+
+		bm := &BinlogManifest{
+			FileName:      cur.name,
+			TabletAlias:   params.TabletAlias,
+			StartPosition: cur.startPosition,
+			EndPosition:   end.startPosition,
+			StartTime:     cur.startTime.UTC().Format(time.RFC3339),
+			EndTime:       end.startTime.UTC().Format(time.RFC3339),
+			Compressed:    *backupStorageCompress,
+		}
+		if bm.Compressed {
+			if bm.CompressionEngine, _, bm.ExternalDecompressor, err = getCompressionEngine(); err != nil {
+				return count, err
+			}
+		}
+		name := binlogArchiveName(cur.startTime, params.TabletAlias, cur.name)
+		if err := archiveBinlog(ctx, bs, archiveDir, name, path.Join(binlogDir, cur.name), bm); err != nil {
+			return count, err
+		}
+		params.Logger.Infof("ArchiveBinlogs: archived %v as %v/%v", cur.name, archiveDir, name)
+		count++
+	}
+	return count, nil
+}
+
+// binlogArchiveName returns the name of the archive handle of a binlog
+// file. Handles are listed by name, so the name starts with the time the
+// file was created.
+func binlogArchiveName(startTime time.Time, tabletAlias, fileName string) string {
+	return fmt.Sprintf("%v.%v.%v", startTime.UTC().Format(BackupTimestampFormat), tabletAlias, fileName)
+}
+
+// isBinlogArchived returns true if the given binlog file of this tablet
+// was already archived. An incomplete archive of the file, without a
+// valid MANIFEST, is removed so it can be archived again.
+func isBinlogArchived(ctx context.Context, params ArchiveBinlogsParams, bs backupstorage.BackupStorage, bhs []backupstorage.BackupHandle, fileName string) (bool, error) {
+	suffix := fmt.Sprintf(".%v.%v", params.TabletAlias, fileName)
+	for _, bh := range bhs {
+		if !strings.HasSuffix(bh.Name(), suffix) {
+			continue
+		}
+		if _, err := GetBinlogManifest(ctx, bh); err == nil {
+			return true, nil
+		}
+		params.Logger.Warningf("ArchiveBinlogs: removing incomplete archive %v/%v", bh.Directory(), bh.Name())
+		if err := bs.RemoveBackup(ctx, bh.Directory(), bh.Name()); err != nil {
+			return false, vterrors.Wrapf(err, "can't remove incomplete archive %v", bh.Name())
+		}
+	}
+	return false, nil
+}
+
+// readBinlogFileInfo returns the GTID set executed before the given
+// binlog file and the time it was created.
+func readBinlogFileInfo(ctx context.Context, mysqld MysqlDaemon, binlogDir, name string) (*binlogFileInfo, error) {
+	// The first event is the format description, the second one lists
+	// the GTIDs executed before the file.
+	qr, err := mysqld.FetchSuperQuery(ctx, fmt.Sprintf("SHOW BINLOG EVENTS IN '%v' LIMIT 2", name))
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't read events of %v", name)
+	}
+	if len(qr.Rows) < 2 || len(qr.Rows[1]) < 6 {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "can't find the previous GTIDs event of %v", name)
+	}
+	pos, err := parsePreviousGTIDs(qr.Rows[1][2].ToString(), qr.Rows[1][5].ToString())
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid previous GTIDs event in %v", name)
+	}
+
+	f, err := os.Open(path.Join(binlogDir, name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	startTime, err := readBinlogStartTime(f)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't read header of %v", name)
+	}
+	return &binlogFileInfo{
+		name:          name,
+		startPosition: pos,
+		startTime:     startTime,
+	}, nil
+}
+
+// parsePreviousGTIDs parses the Info column of a Previous_gtids event,
+// as returned by SHOW BINLOG EVENTS.
+func parsePreviousGTIDs(eventType, info string) (mysql.Position, error) {
+	if eventType != "Previous_gtids" {
+		return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "got %v event instead of Previous_gtids: the binlog archive requires MySQL 5.6+ GTIDs", eventType)
+	}
+	// Long sets are split over several lines.
+	info = strings.Join(strings.Fields(info), "")
+	return mysql.ParsePosition(mysql.Mysql56FlavorID, info)
+}
+
+// readBinlogStartTime returns the timestamp of the first event of a
+// binlog file, which is when the file was created.
+func readBinlogStartTime(r io.Reader) (time.Time, error) {
+	// magic number, then the event header, starting with the timestamp
+	header := make([]byte, len(binlogMagic)+4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return time.Time{}, err
+	}
+	if !bytes.Equal(header[:len(binlogMagic)], []byte(binlogMagic)) {
+		return time.Time{}, fmt.Errorf("not a binlog file")
+	}
+	return time.Unix(int64(binary.LittleEndian.Uint32(header[len(binlogMagic):])), 0), nil
+}
+
+// archiveBinlog stores a binlog file and its MANIFEST in a new handle.
+func archiveBinlog(ctx context.Context, bs backupstorage.BackupStorage, dir, name, fileName string, bm *BinlogManifest) error {
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
+	if err := writeBinlogArchive(ctx, bh, fileName, bm); err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			return vterrors.Wrapf(err, "can't abort archive of %v: %v", fileName, abortErr)
+		}
+		return err
+	}
+	return bh.EndBackup(ctx)
+}
+
+// writeBinlogArchive copies the binlog file, then writes the MANIFEST.
+func writeBinlogArchive(ctx context.Context, bh backupstorage.BackupHandle, fileName string, bm *BinlogManifest) (finalErr error) {
+	source, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	fi, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err := func() (finalErr error) {
+		wc, err := bh.AddFile(ctx, binlogFileName, fi.Size())
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot add %v to archive", fileName)
+		}
+		defer func() {
+			if closeErr := wc.Close(); finalErr == nil {
+				finalErr = closeErr
+			}
+		}()
+		dst := bufio.NewWriterSize(wc, writerBufferSize)
+		writer := io.Writer(dst)
+
+		var compressor io.WriteCloser
+		if bm.Compressed {
+			_, ce, _, err := getCompressionEngine()
+			if err != nil {
+				return err
+			}
+			if compressor, err = ce.NewWriter(ctx, dst); err != nil {
+				return vterrors.Wrap(err, "can't create compressor")
+			}
+			writer = compressor
+		}
+		if _, err := io.Copy(writer, source); err != nil {
+			return vterrors.Wrap(err, "cannot copy data")
+		}
+		if compressor != nil {
+			if err := compressor.Close(); err != nil {
+				return vterrors.Wrap(err, "cannot close compressor")
+			}
+		}
+		return dst.Flush()
+	}(); err != nil {
+		return err
+	}
+
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to archive", backupManifestFileName)
+	}
+	defer func() {
+		if closeErr := wc.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+	data, err := json.MarshalIndent(bm, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	return nil
+}
+
+// GetBinlogManifest returns the MANIFEST of an archived binlog file.
+func GetBinlogManifest(ctx context.Context, bh backupstorage.BackupHandle) (*BinlogManifest, error) {
+	bm := &BinlogManifest{}
+	if err := getBackupManifestInto(ctx, bh, bm); err != nil {
+		return nil, err
+	}
+	return bm, nil
+}
+
+// archivedBinlog is an archived binlog file with its MANIFEST.
+type archivedBinlog struct {
+	bh        backupstorage.BackupHandle
+	manifest  *BinlogManifest
+	startTime time.Time
+	endTime   time.Time
+}
+
+// findBinlogsToRestore returns the archived binlogs to replay on top of
+// a backup taken at pos, in order to reach restoreToTime or restoreToPos.
+// It fails if the archive has a gap, or doesn't go far enough.
+func findBinlogsToRestore(binlogs []*archivedBinlog, pos mysql.Position, restoreToTime time.Time, restoreToPos mysql.Position) ([]*archivedBinlog, error) {
+	sort.SliceStable(binlogs, func(i, j int) bool {
+		return binlogs[i].startTime.Before(binlogs[j].startTime)
+	})
+
+	var result []*archivedBinlog
+	covered := pos
+	reached := false
+	for _, b := range binlogs {
+		if !restoreToTime.IsZero() && b.startTime.After(restoreToTime) {
+			reached = true
+			break
+		}
+		if !restoreToPos.IsZero() && covered.AtLeast(restoreToPos) {
+			break
+		}
+		if !restoreToTime.IsZero() && !b.endTime.Before(restoreToTime) {
+			reached = true
+		}
+		if covered.AtLeast(b.manifest.EndPosition) {
+			// Everything in this file is already applied.
+			continue
+		}
+		if !covered.AtLeast(b.manifest.StartPosition) {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog archive has a gap: %v starts at %v, but only %v can be restored", b.bh.Name(), b.manifest.StartPosition, covered)
+		}
+		covered = mysql.Position{GTIDSet: covered.GTIDSet.Union(b.manifest.EndPosition.GTIDSet)}
+		result = append(result, b)
+	}
+
+	if !restoreToPos.IsZero() && !covered.AtLeast(restoreToPos) {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog archive ends at %v, and doesn't reach position %v", covered, restoreToPos)
+	}
+	if !restoreToTime.IsZero() && !reached {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlog archive doesn't reach time %v", restoreToTime.UTC().Format(time.RFC3339))
+	}
+	return result, nil
+}
+
+// restoreFromBinlogArchive replays the archived binlogs on top of a
+// restored backup taken at pos, up to params.RestoreToTime or
+// params.RestoreToPosition. It returns the position mysqld reached.
+func restoreFromBinlogArchive(ctx context.Context, params RestoreParams, bs backupstorage.BackupStorage, pos mysql.Position) (mysql.Position, error) {
+	if !params.RestoreToPosition.IsZero() && !params.RestoreToPosition.MatchesFlavor(mysql.Mysql56FlavorID) {
+		return mysql.Position{}, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "the binlog archive requires MySQL 5.6+ GTIDs, got position %v", params.RestoreToPosition)
+	}
+	if !pos.MatchesFlavor(mysql.Mysql56FlavorID) {
+		return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the binlog archive requires MySQL 5.6+ GTIDs, backup is at position %v", pos)
+	}
+
+	archiveDir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	bhs, err := bs.ListBackups(ctx, archiveDir)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var binlogs []*archivedBinlog
+	for _, bh := range bhs {
+		bm, err := GetBinlogManifest(ctx, bh)
+		if err != nil {
+			params.Logger.Warningf("Restore: skipping incomplete archived binlog %v/%v: %v", archiveDir, bh.Name(), err)
+			continue
+		}
+		startTime, err := time.Parse(time.RFC3339, bm.StartTime)
+		if err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "invalid start time in archived binlog %v", bh.Name())
+		}
+		endTime, err := time.Parse(time.RFC3339, bm.EndTime)
+		if err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "invalid end time in archived binlog %v", bh.Name())
+		}
+		binlogs = append(binlogs, &archivedBinlog{
+			bh:        bh,
+			manifest:  bm,
+			startTime: startTime,
+			endTime:   endTime,
+		})
+	}
+	binlogs, err = findBinlogsToRestore(binlogs, pos, params.RestoreToTime, params.RestoreToPosition)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	if len(binlogs) == 0 {
+		params.Logger.Infof("Restore: backup is already at the requested point, no binlog to replay")
+		return pos, nil
+	}
+
+	tmpDir, err := ioutil.TempDir(params.Cnf.TmpDir, "binlog_restore")
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+	var files []string
+	for i, b := range binlogs {
+		fileName := path.Join(tmpDir, fmt.Sprintf("%06d.%v", i, b.manifest.FileName))
+		params.Logger.Infof("Restore: fetching archived binlog %v", b.bh.Name())
+		if err := fetchArchivedBinlog(ctx, b, fileName); err != nil {
+			return mysql.Position{}, vterrors.Wrapf(err, "can't fetch archived binlog %v", b.bh.Name())
+		}
+		files = append(files, fileName)
+	}
+
+	params.Logger.Infof("Restore: replaying %v archived binlogs", len(files))
+	if err := params.Mysqld.ApplyBinlogFiles(ctx, files, params.RestoreToTime, params.RestoreToPosition); err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "can't replay archived binlogs")
+	}
+	return params.Mysqld.MasterPosition()
+}
+
+// fetchArchivedBinlog copies an archived binlog file to a local file.
+func fetchArchivedBinlog(ctx context.Context, b *archivedBinlog, fileName string) (finalErr error) {
+	source, err := b.bh.ReadFile(ctx, binlogFileName)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	reader := io.Reader(source)
+	if b.manifest.Compressed {
+		ce, err := getDecompressionEngine(b.manifest.CompressionEngine, b.manifest.ExternalDecompressor)
+		if err != nil {
+			return err
+		}
+		decompressor, err := ce.NewReader(ctx, reader)
+		if err != nil {
+			return vterrors.Wrap(err, "can't open decompressor")
+		}
+		defer decompressor.Close()
+		reader = decompressor
+	}
+
+	dst, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := dst.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+	_, err = io.Copy(dst, reader)
+	return err
+}
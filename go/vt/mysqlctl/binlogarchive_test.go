@@ -0,0 +1,245 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const testSID = "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+
+func testPosition(t *testing.T, gtids string) mysql.Position {
+	t.Helper()
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, gtids)
+	require.NoError(t, err)
+	return pos
+}
+
+func TestParsePreviousGTIDs(t *testing.T) {
+	pos, err := parsePreviousGTIDs("Previous_gtids", testSID+":1-5,\n"+"4e11fa47-71ca-11e1-9e33-c80aa9429562:1-3")
+	require.NoError(t, err)
+	assert.Equal(t, testSID+":1-5,4e11fa47-71ca-11e1-9e33-c80aa9429562:1-3", pos.String())
+
+	pos, err = parsePreviousGTIDs("Previous_gtids", "")
+	require.NoError(t, err)
+	assert.Equal(t, "", pos.String())
+
+	_, err = parsePreviousGTIDs("Gtid_list", "[]")
+	assert.EqualError(t, err, "got Gtid_list event instead of Previous_gtids: the binlog archive requires MySQL 5.6+ GTIDs")
+}
+
+func TestReadBinlogStartTime(t *testing.T) {
+	header := []byte(binlogMagic + "\x10\x32\x54\x5f" + "rest of the event")
+	startTime, err := readBinlogStartTime(bytes.NewReader(header))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0x5f543210), startTime.Unix())
+
+	_, err = readBinlogStartTime(bytes.NewReader([]byte("not a binlog")))
+	assert.EqualError(t, err, "not a binlog file")
+
+	_, err = readBinlogStartTime(bytes.NewReader([]byte(binlogMagic)))
+	assert.Error(t, err)
+}
+
+func TestFindBinlogsToRestore(t *testing.T) {
+	base := time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC)
+	binlog := func(name string, start, end int, startPos, endPos string) *archivedBinlog {
+		return &archivedBinlog{
+			bh: &filebackupstorage.FileBackupHandle{},
+			manifest: &BinlogManifest{
+				FileName:      name,
+				StartPosition: testPosition(t, startPos),
+				EndPosition:   testPosition(t, endPos),
+			},
+			startTime: base.Add(time.Duration(start) * time.Hour),
+			endTime:   base.Add(time.Duration(end) * time.Hour),
+		}
+	}
+	names := func(binlogs []*archivedBinlog) []string {
+		var result []string
+		for _, b := range binlogs {
+			result = append(result, b.manifest.FileName)
+		}
+		return result
+	}
+	archive := func() []*archivedBinlog {
+		// Out of order, with a file of a replica overlapping the ones of
+		// the master, which is never needed.
+		return []*archivedBinlog{
+			binlog("bin.000003", 2, 3, testSID+":1-20", testSID+":1-30"),
+			binlog("bin.000001", 0, 1, testSID+":1-5", testSID+":1-10"),
+			binlog("bin.000002", 1, 2, testSID+":1-10", testSID+":1-20"),
+			binlog("replica-bin.000001", 1, 2, testSID+":1-8", testSID+":1-18"),
+			binlog("bin.000004", 3, 4, testSID+":1-30", testSID+":1-40"),
+		}
+	}
+
+	testcases := []struct {
+		name   string
+		pos    string
+		toTime time.Time
+		toPos  string
+		want   []string
+		err    string
+	}{{
+		name:   "to time",
+		pos:    testSID + ":1-12",
+		toTime: base.Add(150 * time.Minute),
+		want:   []string{"bin.000002", "bin.000003"},
+	}, {
+		name:   "to time already restored",
+		pos:    testSID + ":1-20",
+		toTime: base.Add(90 * time.Minute),
+		want:   nil,
+	}, {
+		name:   "to time after the archive",
+		pos:    testSID + ":1-12",
+		toTime: base.Add(5 * time.Hour),
+		err:    "binlog archive doesn't reach time 2020-09-01T15:00:00Z",
+	}, {
+		name:  "to position",
+		pos:   testSID + ":1-12",
+		toPos: testSID + ":1-25",
+		want:  []string{"bin.000002", "bin.000003"},
+	}, {
+		name:  "to position at the end of a file",
+		pos:   testSID + ":1-5",
+		toPos: testSID + ":1-10",
+		want:  []string{"bin.000001"},
+	}, {
+		name:  "to position after the archive",
+		pos:   testSID + ":1-12",
+		toPos: testSID + ":1-50",
+		err:   "binlog archive ends at " + testSID + ":1-40, and doesn't reach position " + testSID + ":1-50",
+	}, {
+		name:  "gap",
+		pos:   testSID + ":1-3",
+		toPos: testSID + ":1-25",
+		err:   "binlog archive has a gap",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var toPos mysql.Position
+			if tc.toPos != "" {
+				toPos = testPosition(t, tc.toPos)
+			}
+			got, err := findBinlogsToRestore(archive(), testPosition(t, tc.pos), tc.toTime, toPos)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, names(got))
+		})
+	}
+}
+
+func TestCheckPointInTimeBackup(t *testing.T) {
+	target := time.Date(2020, 9, 6, 1, 0, 0, 0, time.UTC)
+	// An xtrabackup backup started before the target, but its position
+	// is where it finished, after the target.
+	bm := &BackupManifest{
+		Position:     testPosition(t, testSID+":1-10"),
+		BackupTime:   "2020-09-06T00:30:00Z",
+		FinishedTime: "2020-09-06T01:30:00Z",
+	}
+	err := checkPointInTimeBackup(RestoreParams{RestoreToTime: target}, bm)
+	assert.EqualError(t, err, "backup finished at 2020-09-06T01:30:00Z, its position may be past the target time 2020-09-06T01:00:00Z")
+
+	bm.FinishedTime = "2020-09-06T00:50:00Z"
+	assert.NoError(t, checkPointInTimeBackup(RestoreParams{RestoreToTime: target}, bm))
+
+	// Without a finished time, the position of the backup is unknown.
+	bm.FinishedTime = ""
+	err = checkPointInTimeBackup(RestoreParams{RestoreToTime: target}, bm)
+	assert.EqualError(t, err, "backup has no finished time, its position may be past the target time 2020-09-06T01:00:00Z")
+
+	err = checkPointInTimeBackup(RestoreParams{RestoreToPosition: testPosition(t, testSID+":1-8")}, bm)
+	assert.EqualError(t, err, "backup position "+testSID+":1-10 is past the target position "+testSID+":1-8")
+	assert.NoError(t, checkPointInTimeBackup(RestoreParams{RestoreToPosition: testPosition(t, testSID+":1-12")}, bm))
+}
+
+func TestBinlogArchiveRoundTrip(t *testing.T) {
+	root, err := ioutil.TempDir("", "binlogarchivetest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	savedRoot := *filebackupstorage.FileBackupStorageRoot
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "storage")
+	defer func() { *filebackupstorage.FileBackupStorageRoot = savedRoot }()
+
+	contents := []byte(binlogMagic + "\x10\x32\x54\x5f" + "some binlog events")
+	source := path.Join(root, "bin.000001")
+	require.NoError(t, ioutil.WriteFile(source, contents, 0600))
+
+	savedEngine := *compressionEngineName
+	defer func() { *compressionEngineName = savedEngine }()
+	// An empty engine is for a binlog stored uncompressed.
+	for _, engine := range []string{"", pargzipCompressor, zstdCompressor} {
+		compressed := engine != ""
+		if compressed {
+			*compressionEngineName = engine
+		}
+		ctx := context.Background()
+		bs := &filebackupstorage.FileBackupStorage{}
+		name := binlogArchiveName(time.Unix(0x5f543210, 0), "zone1-0000000100", "bin.000001")
+		want := &BinlogManifest{
+			FileName:      "bin.000001",
+			TabletAlias:   "zone1-0000000100",
+			StartPosition: testPosition(t, testSID+":1-5"),
+			EndPosition:   testPosition(t, testSID+":1-10"),
+			StartTime:     "2020-09-06T00:49:20Z",
+			EndTime:       "2020-09-06T02:00:00Z",
+			Compressed:    compressed,
+		}
+		want.CompressionEngine = engine
+		require.NoError(t, archiveBinlog(ctx, bs, GetBinlogArchiveDir("ks", "0"), name, source, want))
+
+		bhs, err := bs.ListBackups(ctx, GetBinlogArchiveDir("ks", "0"))
+		require.NoError(t, err)
+		require.Len(t, bhs, 1)
+		assert.Equal(t, "2020-09-06.004920.zone1-0000000100.bin.000001", bhs[0].Name())
+		got, err := GetBinlogManifest(ctx, bhs[0])
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		// The archive doesn't show up as a backup of the shard.
+		backups, err := bs.ListBackups(ctx, GetBackupDir("ks", "0"))
+		require.NoError(t, err)
+		assert.Empty(t, backups)
+
+		fetched := path.Join(root, "fetched")
+		require.NoError(t, fetchArchivedBinlog(ctx, &archivedBinlog{bh: bhs[0], manifest: got}, fetched))
+		data, err := ioutil.ReadFile(fetched)
+		require.NoError(t, err)
+		assert.Equal(t, contents, data)
+
+		require.NoError(t, bs.RemoveBackup(ctx, bhs[0].Directory(), bhs[0].Name()))
+	}
+}
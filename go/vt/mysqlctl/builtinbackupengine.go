@@ -23,13 +23,12 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path"
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -54,6 +53,8 @@ var (
 	// It can later be extended for other calls to mysqld during backup functions.
 	// Exported for testing.
 	BuiltinBackupMysqldTimeout = flag.Duration("builtinbackup_mysqld_timeout", 10*time.Minute, "how long to wait for mysqld to shutdown at the start of the backup")
+
+	builtinBackupRowCounts = flag.Bool("builtinbackup_row_counts", false, "count the rows of every table before mysqld is shut down, and store them in the MANIFEST, so verifications of the backup can compare them. This keeps replication stopped, or the master read-only, while the rows are counted")
 )
 
 // BuiltinBackupEngine encapsulates the logic of the builtin engine
@@ -89,6 +90,7 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlog for binlogs that go next to Mycnf.BinLogPath
 	Base string
 
 	// Name is the file name, relative to Base
@@ -109,6 +111,8 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlog:
+		root = path.Dir(cnf.BinLogPath)
 	default:
 		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
 	}
@@ -137,7 +141,18 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Compression engine: %v", *backupStorageHook, *backupStorageCompress, *compressionEngineName)
+
+	// Check the compression settings before stopping mysqld.
+	if *backupStorageCompress {
+		if _, _, _, err := getCompressionEngine(); err != nil {
+			return false, err
+		}
+	}
+
+	if params.Incremental {
+		return be.executeIncrementalBackup(ctx, params, bh)
+	}
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -190,6 +205,15 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 	params.Logger.Infof("using replication position: %v", replicationPosition)
 
+	// Writes are stopped, so the row counts match the position.
+	var rowCounts map[string]int64
+	if *builtinBackupRowCounts {
+		params.Logger.Infof("counting the rows of every table")
+		if rowCounts, err = tableRowCounts(ctx, params.Mysqld); err != nil {
+			return false, err
+		}
+	}
+
 	// shutdown mysqld
 	shutdownCtx, cancel := context.WithTimeout(ctx, *BuiltinBackupMysqldTimeout)
 	err = params.Mysqld.Shutdown(shutdownCtx, params.Cnf, true)
@@ -199,7 +223,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 	}
 
 	// Backup everything, capture the error.
-	backupErr := be.backupFiles(ctx, params, bh, replicationPosition)
+	backupErr := be.backupFiles(ctx, params, bh, replicationPosition, rowCounts)
 	usable := backupErr == nil
 
 	// Try to restart mysqld, use background context in case we timed out the original context
@@ -275,7 +299,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 }
 
 // backupFiles finds the list of files to backup, and creates the backup.
-func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position, rowCounts map[string]int64) error {
 
 	// Get the files to backup.
 	// We don't care about totalSize because we add each file separately.
@@ -285,6 +309,16 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	return be.backupFileEntries(ctx, params, bh, fes, BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     replicationPosition,
+		BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+		RowCounts:    rowCounts,
+	})
+}
+
+// backupFileEntries copies the files to the backup, then writes its MANIFEST.
+func (be *BuiltinBackupEngine) backupFileEntries(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fes []FileEntry, manifest BackupManifest) (finalErr error) {
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	wg := sync.WaitGroup{}
@@ -333,14 +367,16 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}()
 
 	// JSON-encode and write the MANIFEST
+	manifest.FinishedTime = time.Now().UTC().Format(time.RFC3339)
+	if *backupStorageCompress {
+		manifest.CompressionEngine, _, manifest.ExternalDecompressor, err = getCompressionEngine()
+		if err != nil {
+			return err
+		}
+	}
 	bm := &builtinBackupManifest{
 		// Common base fields
-		BackupManifest: BackupManifest{
-			BackupMethod: builtinBackupEngineName,
-			Position:     replicationPosition,
-			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
-			FinishedTime: time.Now().UTC().Format(time.RFC3339),
-		},
+		BackupManifest: manifest,
 
 		// Builtin-specific fields
 		FileEntries:   fes,
@@ -358,6 +394,108 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	return nil
 }
 
+// executeIncrementalBackup stores the binlogs since the previous backup
+// of the shard. mysqld keeps running: the binlogs are only rotated, so
+// that all the changes up to now are in closed files.
+func (be *BuiltinBackupEngine) executeIncrementalBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return false, err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, bh.Directory())
+	if err != nil {
+		return false, vterrors.Wrap(err, "ListBackups failed")
+	}
+
+	// The base is the most recent complete backup, full or incremental.
+	var base backupstorage.BackupHandle
+	var baseManifest *BackupManifest
+	for i := len(bhs) - 1; i >= 0; i-- {
+		if bhs[i].Name() == bh.Name() {
+			continue
+		}
+		if baseManifest, err = GetBackupManifest(ctx, bhs[i]); err == nil {
+			base = bhs[i]
+			break
+		}
+	}
+	if base == nil {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no complete backup in %v to base an incremental backup on", bh.Directory())
+	}
+	if !baseManifest.Position.MatchesFlavor(mysql.Mysql56FlavorID) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "incremental backups require MySQL 5.6+ GTIDs, backup %v is at position %v", base.Name(), baseManifest.Position)
+	}
+	params.Logger.Infof("taking an incremental backup based on %v at position %v", base.Name(), baseManifest.Position)
+
+	if err := params.Mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return false, vterrors.Wrap(err, "can't rotate binlogs")
+	}
+	binlogs, pos, err := findBinlogsSince(ctx, params.Mysqld, params.Cnf, baseManifest.Position)
+	if err != nil {
+		return false, err
+	}
+	if !pos.AtLeast(baseManifest.Position) {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "mysqld at position %v is behind backup %v at position %v", pos, base.Name(), baseManifest.Position)
+	}
+	params.Logger.Infof("found %v binlogs to backup, up to position %v", len(binlogs), pos)
+
+	fes := make([]FileEntry, 0, len(binlogs))
+	for _, binlog := range binlogs {
+		fes = append(fes, FileEntry{
+			Base: backupBinlog,
+			Name: binlog,
+		})
+	}
+	err = be.backupFileEntries(ctx, params, bh, fes, BackupManifest{
+		BackupMethod: builtinBackupEngineName,
+		Position:     pos,
+		BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+		Incremental:  true,
+		BaseBackup:   base.Name(),
+		FromPosition: baseManifest.Position,
+	})
+	return err == nil, err
+}
+
+// findBinlogsSince returns the closed binlog files, in order, that hold
+// the transactions executed after pos, and the position at their end.
+func findBinlogsSince(ctx context.Context, mysqld MysqlDaemon, cnf *Mycnf, pos mysql.Position) ([]string, mysql.Position, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, mysql.Position{}, vterrors.Wrap(err, "can't list binary logs")
+	}
+	if len(qr.Rows) == 0 {
+		return nil, mysql.Position{}, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "binary logging is disabled")
+	}
+	binlogDir := path.Dir(cnf.BinLogPath)
+
+	// The active file starts where the closed ones end.
+	active, err := readBinlogFileInfo(ctx, mysqld, binlogDir, qr.Rows[len(qr.Rows)-1][0].ToString())
+	if err != nil {
+		return nil, mysql.Position{}, err
+	}
+	end := active.startPosition
+	if pos.AtLeast(end) {
+		return nil, end, nil
+	}
+
+	// Walk the closed files backwards, until the one that starts at or
+	// before pos.
+	var binlogs []string
+	for i := len(qr.Rows) - 2; i >= 0; i-- {
+		info, err := readBinlogFileInfo(ctx, mysqld, binlogDir, qr.Rows[i][0].ToString())
+		if err != nil {
+			return nil, mysql.Position{}, err
+		}
+		binlogs = append([]string{info.name}, binlogs...)
+		if pos.AtLeast(info.startPosition) {
+			return binlogs, end, nil
+		}
+	}
+	return nil, mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlogs since position %v were purged, take a full backup instead", pos)
+}
+
 // backupFile backs up an individual file.
 func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
 	// Open the source file for reading.
@@ -407,27 +545,30 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pargzip.Writer
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip = pargzip.NewWriter(writer)
-		gzip.ChunkSize = *backupCompressBlockSize
-		gzip.Parallel = *backupCompressBlocks
-		gzip.CompressionLevel = pargzip.BestSpeed
-		writer = gzip
+		_, ce, _, err := getCompressionEngine()
+		if err != nil {
+			return err
+		}
+		if compressor, err = ce.NewWriter(ctx, writer); err != nil {
+			return vterrors.Wrap(err, "can't create compressor")
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (optional compression,
 	// optional pipe, tee, output file and hasher).
 	_, err = io.Copy(writer, source)
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -486,6 +627,53 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 	return &bm.BackupManifest, nil
 }
 
+// ExecuteIncrementalRestore replays the binlogs of an incremental backup.
+// It is part of the IncrementalRestoreEngine interface.
+func (be *BuiltinBackupEngine) ExecuteIncrementalRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error) {
+	var bm builtinBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return nil, err
+	}
+	if !bm.Incremental {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v is not an incremental backup", bh.Name())
+	}
+	pos, err := params.Mysqld.MasterPosition()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't get position of mysqld")
+	}
+	if !pos.AtLeast(bm.FromPosition) {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "mysqld at position %v is behind position %v, where incremental backup %v starts", pos, bm.FromPosition, bh.Name())
+	}
+
+	// The binlogs are restored in a temporary directory, so they don't
+	// mix with the ones of the restored mysqld.
+	tmpDir, err := ioutil.TempDir(params.Cnf.TmpDir, "incremental_restore")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	cnf := *params.Cnf
+	cnf.BinLogPath = path.Join(tmpDir, path.Base(params.Cnf.BinLogPath))
+	params.Cnf = &cnf
+
+	params.Logger.Infof("Restore: copying %v binlogs of incremental backup %v", len(bm.FileEntries), bh.Name())
+	if err := be.restoreFiles(ctx, params, bh, bm); err != nil {
+		return nil, vterrors.Wrap(err, "failed to restore files")
+	}
+	if len(bm.FileEntries) > 0 {
+		binlogs := make([]string, 0, len(bm.FileEntries))
+		for _, fe := range bm.FileEntries {
+			binlogs = append(binlogs, path.Join(tmpDir, fe.Name))
+		}
+		if err := params.Mysqld.ApplyBinlogFiles(ctx, binlogs, time.Time{}, bm.Position); err != nil {
+			return nil, vterrors.Wrapf(err, "can't replay binlogs of incremental backup %v", bh.Name())
+		}
+	}
+
+	params.Logger.Infof("Restore: returning replication position %v", bm.Position)
+	return &bm.BackupManifest, nil
+}
+
 // restoreFiles will copy all the files from the BackupStorage to the
 // right place.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
@@ -509,7 +697,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], bm, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -520,7 +708,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, bm builtinBackupManifest, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -556,32 +744,36 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
-	if transformHook != "" {
-		h := hook.NewHook(transformHook, []string{"-operation", "read"})
+	if bm.TransformHook != "" {
+		h := hook.NewHook(bm.TransformHook, []string{"-operation", "read"})
 		h.ExtraEnv = params.HookExtraEnv
 		reader, wait, _, err = h.ExecuteAsReadPipe(reader)
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' hook returned error", transformHook)
+			return vterrors.Wrapf(err, "'%v' hook returned error", bm.TransformHook)
 		}
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if !bm.SkipCompress {
+		ce, err := getDecompressionEngine(bm.CompressionEngine, bm.ExternalDecompressor)
+		if err != nil {
+			return err
+		}
+		decompressor, err := ce.NewReader(ctx, reader)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return vterrors.Wrap(err, "can't open decompressor")
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -593,10 +785,10 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	if wait != nil {
 		stderr, err := wait()
 		if stderr != "" {
-			log.Infof("'%v' hook returned stderr: %v", transformHook, stderr)
+			log.Infof("'%v' hook returned stderr: %v", bm.TransformHook, stderr)
 		}
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' returned error", transformHook)
+			return vterrors.Wrapf(err, "'%v' returned error", bm.TransformHook)
 		}
 	}
 
@@ -6,6 +6,8 @@ package mysqlctl_test
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"os"
 	"path"
 	"testing"
@@ -13,9 +15,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
 	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
 	"vitess.io/vitess/go/vt/proto/topodata"
@@ -133,3 +138,124 @@ func TestExecuteBackup(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, ok)
 }
+
+func TestExecuteIncrementalBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	savedRoot := *filebackupstorage.FileBackupStorageRoot
+	savedImplementation := *backupstorage.BackupStorageImplementation
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "storage")
+	*backupstorage.BackupStorageImplementation = "file"
+	defer func() {
+		*filebackupstorage.FileBackupStorageRoot = savedRoot
+		*backupstorage.BackupStorageImplementation = savedImplementation
+	}()
+
+	ctx := context.Background()
+	sid := "3e11fa47-71ca-11e1-9e33-c80aa9429562"
+	position := func(gtids string) mysql.Position {
+		pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, sid+":"+gtids)
+		require.NoError(t, err)
+		return pos
+	}
+
+	// A full backup to base the incremental one on.
+	bs := &filebackupstorage.FileBackupStorage{}
+	backupDir := mysqlctl.GetBackupDir("ks", "0")
+	full, err := bs.StartBackup(ctx, backupDir, "2020-09-01.100000.zone1-0000000100")
+	require.NoError(t, err)
+	wc, err := full.AddFile(ctx, "MANIFEST", 0)
+	require.NoError(t, err)
+	data, err := json.Marshal(mysqlctl.BackupManifest{BackupMethod: "builtin", Position: position("1-10")})
+	require.NoError(t, err)
+	_, err = wc.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, wc.Close())
+	require.NoError(t, full.EndBackup(ctx))
+
+	// The binlogs of mysqld. The first one was purged by the backup, and
+	// the last one is the active one.
+	binlogDir := path.Join(root, "binlogs")
+	require.NoError(t, os.MkdirAll(binlogDir, 0755))
+	binlogs := []struct {
+		name, previousGTIDs string
+	}{
+		{"vt-bin.000002", "1-8"},
+		{"vt-bin.000003", "1-15"},
+		{"vt-bin.000004", "1-20"},
+	}
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	mysqld.ExpectedExecuteSuperQueryList = []string{"FLUSH BINARY LOGS"}
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{}
+	var binlogRows []string
+	for _, binlog := range binlogs {
+		require.NoError(t, ioutil.WriteFile(path.Join(binlogDir, binlog.name), []byte("\xfebin\x10\x32\x54\x5f"+binlog.name), 0644))
+		binlogRows = append(binlogRows, binlog.name+"|100")
+		mysqld.FetchSuperQueryMap["SHOW BINLOG EVENTS IN '"+binlog.name+"' LIMIT 2"] = sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Log_name|Pos|Event_type|Server_id|End_log_pos|Info", "varchar|int64|varchar|int64|int64|varchar"),
+			binlog.name+"|4|Format_desc|1|123|Server ver: 5.7.31-log, Binlog ver: 4",
+			binlog.name+"|123|Previous_gtids|1|194|"+sid+":"+binlog.previousGTIDs,
+		)
+	}
+	mysqld.FetchSuperQueryMap["SHOW BINARY LOGS"] = sqltypes.MakeTestResult(sqltypes.MakeTestFields("Log_name|File_size", "varchar|int64"), binlogRows...)
+	cnf := &mysqlctl.Mycnf{
+		BinLogPath: path.Join(binlogDir, "vt-bin"),
+		TmpDir:     root,
+	}
+
+	be := &mysqlctl.BuiltinBackupEngine{}
+	incremental, err := bs.StartBackup(ctx, backupDir, "2020-09-01.110000.zone1-0000000100")
+	require.NoError(t, err)
+	ok, err := be.ExecuteBackup(ctx, mysqlctl.BackupParams{
+		Cnf:         cnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewConsoleLogger(),
+		Concurrency: 2,
+		BackupTime:  time.Now(),
+		Incremental: true,
+	}, incremental)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	require.NoError(t, incremental.EndBackup(ctx))
+
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 2)
+	manifest, err := mysqlctl.GetBackupManifest(ctx, bhs[1])
+	require.NoError(t, err)
+	assert.True(t, manifest.Incremental)
+	assert.Equal(t, full.Name(), manifest.BaseBackup)
+	assert.Equal(t, position("1-10"), manifest.FromPosition)
+	assert.Equal(t, position("1-20"), manifest.Position)
+	chain, err := mysqlctl.FindBackupChain(ctx, bhs, bhs[1])
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	assert.Equal(t, full.Name(), chain[0].Name())
+	assert.Equal(t, incremental.Name(), chain[1].Name())
+
+	// Restoring replays the binlogs on top of the full backup.
+	mysqld.CurrentMasterPosition = position("1-10")
+	restored, err := be.ExecuteIncrementalRestore(ctx, mysqlctl.RestoreParams{
+		Cnf:         cnf,
+		Mysqld:      mysqld,
+		Logger:      logutil.NewConsoleLogger(),
+		Concurrency: 2,
+	}, bhs[1])
+	require.NoError(t, err)
+	assert.Equal(t, position("1-20"), restored.Position)
+	var applied []string
+	for _, binlog := range mysqld.AppliedBinlogFiles {
+		applied = append(applied, path.Base(binlog))
+	}
+	assert.Equal(t, []string{"vt-bin.000002", "vt-bin.000003"}, applied)
+
+	// mysqld must have the transactions the incremental backup builds on.
+	mysqld.CurrentMasterPosition = position("1-5")
+	_, err = be.ExecuteIncrementalRestore(ctx, mysqlctl.RestoreParams{
+		Cnf:    cnf,
+		Mysqld: mysqld,
+		Logger: logutil.NewConsoleLogger(),
+	}, bhs[1])
+	assert.Error(t, err)
+}
@@ -142,7 +142,19 @@ func (bh *CephBackupHandle) ReadFile(ctx context.Context, filename string) (io.R
 	// ceph bucket name
 	bucket := alterBucketName(bh.dir)
 	object := objName(bh.dir, bh.name, filename)
-	return bh.client.GetObjectWithContext(ctx, bucket, object, minio.GetObjectOptions{})
+	obj, err := bh.client.GetObjectWithContext(ctx, bucket, object, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// The object is only requested by its first read or Stat.
+	if _, err := obj.Stat(); err != nil {
+		obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+		}
+		return nil, err
+	}
+	return obj, nil
 }
 
 // CephBackupStorage implements BackupStorage for Ceph Cloud Storage.
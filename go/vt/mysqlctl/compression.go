@@ -0,0 +1,287 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file handles the compression of the backup files.
+
+const (
+	pargzipCompressor  = "pargzip"
+	pgzipCompressor    = "pgzip"
+	lz4Compressor      = "lz4"
+	zstdCompressor     = "zstd"
+	externalCompressor = "external"
+)
+
+var (
+	// compressionEngineName is the engine used to compress new backups.
+	compressionEngineName = flag.String("compression_engine_name", pargzipCompressor, "compression engine used to compress the files of new backups, if backup_storage_compress is true: pargzip, pgzip, lz4, zstd or external. Restores use the engine recorded in the backup MANIFEST.")
+
+	// externalCompressorCmd and externalDecompressorCmd are the commands
+	// of the external compression engine.
+	externalCompressorCmd   = flag.String("external_compressor", "", "command, with its arguments, that compresses its standard input to its standard output, used if compression_engine_name is external")
+	externalDecompressorCmd = flag.String("external_decompressor", "", "command, with its arguments, that decompresses its standard input to its standard output. It is required to restore backups compressed with the external engine. The command recorded in the MANIFEST of new backups is only informational, and is never run.")
+)
+
+// CompressionEngine creates the compressors and decompressors of a
+// compression format.
+type CompressionEngine interface {
+	// NewWriter returns a writer that compresses the data it gets to w.
+	// Closing it flushes the data, but doesn't close w.
+	NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns a reader that decompresses the data of r.
+	NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error)
+}
+
+// CompressionEngineMap contains the registered implementations of
+// CompressionEngine, except the external one, which is created from
+// the external_compressor and external_decompressor flags.
+var CompressionEngineMap = make(map[string]CompressionEngine)
+
+func init() {
+	CompressionEngineMap[pargzipCompressor] = pargzipEngine{}
+	CompressionEngineMap[pgzipCompressor] = pgzipEngine{}
+	CompressionEngineMap[lz4Compressor] = lz4Engine{}
+	CompressionEngineMap[zstdCompressor] = zstdEngine{}
+}
+
+// getCompressionEngine returns the engine to compress new backups, and
+// the decompressor command to record in the MANIFEST, if any.
+func getCompressionEngine() (string, CompressionEngine, string, error) {
+	if *compressionEngineName == externalCompressor {
+		if *externalCompressorCmd == "" || *externalDecompressorCmd == "" {
+			return "", nil, "", vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "external_compressor and external_decompressor are required by the external compression engine")
+		}
+		return externalCompressor, &externalEngine{compressCmd: *externalCompressorCmd}, *externalDecompressorCmd, nil
+	}
+	ce, ok := CompressionEngineMap[*compressionEngineName]
+	if !ok {
+		return "", nil, "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown compression engine %q", *compressionEngineName)
+	}
+	return *compressionEngineName, ce, "", nil
+}
+
+// getDecompressionEngine returns the engine to decompress the files of
+// a backup. Backups taken before the engine was recorded in the MANIFEST
+// were compressed with gzip.
+//
+// The decompressor command recorded in the MANIFEST is never run: anyone
+// who can write to the backup storage could otherwise run any command on
+// the restoring tablets. Only the external_decompressor flag is used.
+func getDecompressionEngine(name, manifestDecompressCmd string) (CompressionEngine, error) {
+	if name == "" {
+		name = pgzipCompressor
+	}
+	if name == externalCompressor {
+		if *externalDecompressorCmd == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the backup was compressed with an external command, and external_decompressor is not set (the backup MANIFEST suggests %q)", manifestDecompressCmd)
+		}
+		if manifestDecompressCmd != "" && manifestDecompressCmd != *externalDecompressorCmd {
+			log.Warningf("using external_decompressor %q, while the backup MANIFEST records %q", *externalDecompressorCmd, manifestDecompressCmd)
+		}
+		return &externalEngine{decompressCmd: *externalDecompressorCmd}, nil
+	}
+	ce, ok := CompressionEngineMap[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "unknown compression engine %q used by the backup", name)
+	}
+	return ce, nil
+}
+
+// pargzipEngine compresses with gzip in parallel, using blocks of
+// backup_storage_block_size.
+type pargzipEngine struct{}
+
+func (pargzipEngine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	gzip := pargzip.NewWriter(w)
+	gzip.ChunkSize = *backupCompressBlockSize
+	gzip.Parallel = *backupCompressBlocks
+	gzip.CompressionLevel = pargzip.BestSpeed
+	return gzip, nil
+}
+
+func (pargzipEngine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+// pgzipEngine compresses with gzip in parallel as well, with the
+// klauspost implementation.
+type pgzipEngine struct{}
+
+func (pgzipEngine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	gzip, err := pgzip.NewWriterLevel(w, pgzip.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	if err := gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks); err != nil {
+		return nil, err
+	}
+	return gzip, nil
+}
+
+func (pgzipEngine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	return pgzip.NewReader(r)
+}
+
+type lz4Engine struct{}
+
+func (lz4Engine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	return lz4.NewWriter(w), nil
+}
+
+func (lz4Engine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	return ioutil.NopCloser(lz4.NewReader(r)), nil
+}
+
+type zstdEngine struct{}
+
+func (zstdEngine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest))
+}
+
+func (zstdEngine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+// externalEngine pipes the data through commands, e.g. "zstd -T0" and
+// "zstd -d".
+type externalEngine struct {
+	compressCmd   string
+	decompressCmd string
+}
+
+func (e *externalEngine) NewWriter(ctx context.Context, w io.Writer) (io.WriteCloser, error) {
+	cmd, err := externalCommand(ctx, e.compressCmd)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdout = w
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %q", e.compressCmd)
+	}
+	return &externalWriter{WriteCloser: stdin, cmd: cmd}, nil
+}
+
+func (e *externalEngine) NewReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
+	cmd, err := externalCommand(ctx, e.decompressCmd)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Stdin = r
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start %q", e.decompressCmd)
+	}
+	return &externalReader{ReadCloser: stdout, cmd: cmd}, nil
+}
+
+func externalCommand(ctx context.Context, command string) (*exec.Cmd, error) {
+	args, err := shlex.Split(command)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't parse command %q", command)
+	}
+	if len(args) == 0 {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "empty compression command")
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = &strings.Builder{}
+	return cmd, nil
+}
+
+// externalWriter closes the standard input of the command, and waits
+// for it to write the rest of its output.
+type externalWriter struct {
+	io.WriteCloser
+	cmd *exec.Cmd
+}
+
+func (w *externalWriter) Close() error {
+	if err := w.WriteCloser.Close(); err != nil {
+		return err
+	}
+	return waitExternalCommand(w.cmd)
+}
+
+// externalReader waits for the command once its output was read. If it
+// is closed before, the command is killed, since it could otherwise block
+// forever writing output that nobody reads.
+type externalReader struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+	eof bool
+}
+
+func (r *externalReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err == io.EOF {
+		r.eof = true
+	}
+	return n, err
+}
+
+func (r *externalReader) Close() error {
+	if !r.eof {
+		// Kill fails if the command already exited, which is fine. The
+		// error of the killed command is irrelevant: the caller stopped
+		// reading its output.
+		r.cmd.Process.Kill()
+		waitExternalCommand(r.cmd)
+		return nil
+	}
+	return waitExternalCommand(r.cmd)
+}
+
+func waitExternalCommand(cmd *exec.Cmd) error {
+	err := cmd.Wait()
+	if stderr := cmd.Stderr.(*strings.Builder).String(); stderr != "" {
+		log.Infof("%v returned stderr: %v", cmd.Args, stderr)
+	}
+	if err != nil {
+		return vterrors.Wrapf(err, "%v failed", cmd.Args)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionEngines(t *testing.T) {
+	savedName := *compressionEngineName
+	savedCompressor, savedDecompressor := *externalCompressorCmd, *externalDecompressorCmd
+	defer func() {
+		*compressionEngineName = savedName
+		*externalCompressorCmd, *externalDecompressorCmd = savedCompressor, savedDecompressor
+	}()
+
+	names := []string{pargzipCompressor, pgzipCompressor, lz4Compressor, zstdCompressor}
+	if _, err := exec.LookPath("gzip"); err == nil {
+		names = append(names, externalCompressor)
+	}
+	data := bytes.Repeat([]byte("some data to compress "), 100000)
+	ctx := context.Background()
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			*compressionEngineName = name
+			*externalCompressorCmd, *externalDecompressorCmd = "gzip -c", "gzip -d -c"
+			engineName, ce, decompressCmd, err := getCompressionEngine()
+			require.NoError(t, err)
+			assert.Equal(t, name, engineName)
+
+			compressed := &bytes.Buffer{}
+			w, err := ce.NewWriter(ctx, compressed)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			assert.Less(t, compressed.Len(), len(data)/10)
+
+			// Restores never run the command recorded in the MANIFEST.
+			*externalCompressorCmd = ""
+			if decompressCmd != "" {
+				decompressCmd = "false"
+			}
+			de, err := getDecompressionEngine(engineName, decompressCmd)
+			require.NoError(t, err)
+			r, err := de.NewReader(ctx, compressed)
+			require.NoError(t, err)
+			got, err := ioutil.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			assert.Equal(t, data, got)
+		})
+	}
+}
+
+func TestCompressionEngineErrors(t *testing.T) {
+	savedName := *compressionEngineName
+	defer func() { *compressionEngineName = savedName }()
+
+	*compressionEngineName = "brotli"
+	_, _, _, err := getCompressionEngine()
+	assert.EqualError(t, err, `unknown compression engine "brotli"`)
+
+	*compressionEngineName = externalCompressor
+	_, _, _, err = getCompressionEngine()
+	assert.EqualError(t, err, "external_compressor and external_decompressor are required by the external compression engine")
+
+	savedDecompressor := *externalDecompressorCmd
+	defer func() { *externalDecompressorCmd = savedDecompressor }()
+	*externalDecompressorCmd = ""
+	_, err = getDecompressionEngine(externalCompressor, "/tmp/decompress")
+	assert.EqualError(t, err, `the backup was compressed with an external command, and external_decompressor is not set (the backup MANIFEST suggests "/tmp/decompress")`)
+
+	// Backups that don't record the engine were compressed with gzip.
+	ce, err := getDecompressionEngine("", "")
+	require.NoError(t, err)
+	assert.Equal(t, pgzipEngine{}, ce)
+}
+
+func TestExternalReaderCloseEarly(t *testing.T) {
+	if _, err := exec.LookPath("yes"); err != nil {
+		t.Skip("yes is not available")
+	}
+	// The command never exits by itself: closing the reader must not block.
+	ce := &externalEngine{decompressCmd: "yes"}
+	r, err := ce.NewReader(context.Background(), bytes.NewReader(nil))
+	require.NoError(t, err)
+	buf := make([]byte, 10)
+	_, err = io.ReadFull(r, buf)
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() { done <- r.Close() }()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("Close is blocked")
+	}
+}
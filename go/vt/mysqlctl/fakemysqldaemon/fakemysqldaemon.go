@@ -155,6 +155,9 @@ type FakeMysqlDaemon struct {
 	// FetchSuperQueryResults is used by FetchSuperQuery
 	FetchSuperQueryMap map[string]*sqltypes.Result
 
+	// AppliedBinlogFiles is set by ApplyBinlogFiles
+	AppliedBinlogFiles []string
+
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled sync2.AtomicBool
 
@@ -458,6 +461,12 @@ func (fmd *FakeMysqlDaemon) FetchSuperQuery(ctx context.Context, query string) (
 	return qr, nil
 }
 
+// ApplyBinlogFiles is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFiles(ctx context.Context, binlogFiles []string, restoreToTime time.Time, restoreToPos mysql.Position) error {
+	fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, binlogFiles...)
+	return nil
+}
+
 // EnableBinlogPlayback is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) EnableBinlogPlayback() error {
 	fmd.BinlogPlayerEnabled.Set(true)
@@ -103,7 +103,11 @@ func (fbh *FileBackupHandle) ReadFile(ctx context.Context, filename string) (io.
 		return nil, fmt.Errorf("ReadFile cannot be called on read-write backup")
 	}
 	p := path.Join(*FileBackupStorageRoot, fbh.dir, fbh.name, filename)
-	return os.Open(p)
+	f, err := os.Open(p)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+	}
+	return f, err
 }
 
 // FileBackupStorage implements BackupStorage for local file system.
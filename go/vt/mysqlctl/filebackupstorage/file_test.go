@@ -17,12 +17,15 @@ limitations under the License.
 package filebackupstorage
 
 import (
+	"errors"
 	"io"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"context"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 )
 
 // This file tests the file BackupStorage engine.
@@ -203,4 +206,9 @@ func TestFileContents(t *testing.T) {
 	if err := rc.Close(); err != nil {
 		t.Fatalf("rc.Close failed: %v", err)
 	}
+
+	// a missing file is reported as such
+	if _, err := bhs[0].ReadFile(ctx, "missing"); !errors.Is(err, backupstorage.ErrFileNotFound) {
+		t.Fatalf("bhs[0].ReadFile of a missing file returned wrong error: %v", err)
+	}
 }
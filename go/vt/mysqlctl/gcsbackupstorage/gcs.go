@@ -111,7 +111,11 @@ func (bh *GCSBackupHandle) ReadFile(ctx context.Context, filename string) (io.Re
 		return nil, fmt.Errorf("ReadFile cannot be called on read-write backup")
 	}
 	object := objName(bh.dir, bh.name, filename)
-	return bh.client.Bucket(*bucket).Object(object).NewReader(ctx)
+	r, err := bh.client.Bucket(*bucket).Object(object).NewReader(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil, fmt.Errorf("%w: %v", backupstorage.ErrFileNotFound, err)
+	}
+	return r, err
 }
 
 // GCSBackupStorage implements BackupStorage for Google Cloud Storage.
@@ -18,6 +18,7 @@ package mysqlctl
 
 import (
 	"context"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -88,6 +89,9 @@ type MysqlDaemon interface {
 	// FetchSuperQuery executes one query, returns the result
 	FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error)
 
+	// ApplyBinlogFiles replays binlog files up to a time or a GTID position
+	ApplyBinlogFiles(ctx context.Context, binlogFiles []string, restoreToTime time.Time, restoreToPos mysql.Position) error
+
 	// EnableBinlogPlayback enables playback of binlog events
 	EnableBinlogPlayback() error
 
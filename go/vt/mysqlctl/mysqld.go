@@ -1046,6 +1046,85 @@ func (mysqld *Mysqld) executeMysqlScript(connParams *mysql.ConnParams, sql io.Re
 	return nil
 }
 
+// ApplyBinlogFiles replays binlog files, in order, with mysqlbinlog
+// piped into the mysql command line tool, using the dba credentials.
+// If restoreToTime is set, it stops at the first event at or after that
+// time. If restoreToPos is set, only the transactions in that GTID set
+// are applied. Transactions mysqld already executed are skipped.
+func (mysqld *Mysqld) ApplyBinlogFiles(ctx context.Context, binlogFiles []string, restoreToTime time.Time, restoreToPos mysql.Position) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogName, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlName, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+	env, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	if !restoreToTime.IsZero() {
+		// mysqlbinlog reads the date in its own time zone, which is set
+		// to UTC below.
+		args = append(args, "--stop-datetime="+restoreToTime.UTC().Format("2006-01-02 15:04:05"))
+	}
+	if !restoreToPos.IsZero() {
+		args = append(args, "--include-gtids="+restoreToPos.GTIDSet.String())
+	}
+	args = append(args, binlogFiles...)
+	log.Infof("ApplyBinlogFiles: %v %v", mysqlbinlogName, args)
+
+	binlogCmd := exec.CommandContext(ctx, mysqlbinlogName, args...)
+	binlogCmd.Env = append(append([]string{}, env...), "TZ=UTC")
+	binlogCmd.Dir = dir
+	var binlogStderr bytes.Buffer
+	binlogCmd.Stderr = &binlogStderr
+	pipe, err := binlogCmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	mysqlCmd := exec.CommandContext(ctx, mysqlName, "--defaults-extra-file="+cnf, "--batch")
+	mysqlCmd.Env = env
+	mysqlCmd.Dir = dir
+	mysqlCmd.Stdin = pipe
+	var mysqlOutput bytes.Buffer
+	mysqlCmd.Stdout = &mysqlOutput
+	mysqlCmd.Stderr = &mysqlOutput
+
+	if err := binlogCmd.Start(); err != nil {
+		return fmt.Errorf("%v: %v", mysqlbinlogName, err)
+	}
+	// Run reads the pipe to the end, so it's safe to Wait for mysqlbinlog
+	// afterwards. If mysql fails, mysqlbinlog gets a broken pipe: report
+	// the mysql error.
+	mysqlErr := mysqlCmd.Run()
+	binlogErr := binlogCmd.Wait()
+	if mysqlErr != nil {
+		return fmt.Errorf("%v: %v, output: %v", mysqlName, mysqlErr, mysqlOutput.String())
+	}
+	if binlogErr != nil {
+		return fmt.Errorf("%v: %v, output: %v", mysqlbinlogName, binlogErr, binlogStderr.String())
+	}
+	return nil
+}
+
 // defaultsExtraFile returns the filename for a temporary config file
 // that contains the user, password and socket file to connect to
 // mysqld.  We write a temporary config file so the password is never
@@ -126,6 +126,9 @@ func closeFile(wc io.WriteCloser, fileName string, logger logutil.Logger, finalE
 // and an overall error.
 func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (complete bool, finalErr error) {
 
+	if params.Incremental {
+		return false, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "incremental backups are only supported by the builtin backup engine")
+	}
 	if *xtrabackupUser == "" {
 		return false, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "xtrabackupUser must be specified.")
 	}
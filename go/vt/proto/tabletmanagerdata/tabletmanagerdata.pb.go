@@ -3555,8 +3555,11 @@ func (m *PromoteReplicaResponse) GetPosition() string {
 }
 
 type BackupRequest struct {
-	Concurrency          int64    `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	AllowMaster          bool     `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	Concurrency int64 `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	AllowMaster bool  `protobuf:"varint,2,opt,name=allowMaster,proto3" json:"allowMaster,omitempty"`
+	// incremental makes the tablet store only the binlogs since the
+	// previous backup of the shard.
+	Incremental          bool     `protobuf:"varint,3,opt,name=incremental,proto3" json:"incremental,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3601,6 +3604,13 @@ func (m *BackupRequest) GetAllowMaster() bool {
 	return false
 }
 
+func (m *BackupRequest) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
 type BackupResponse struct {
 	Event                *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -3907,142 +3917,142 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0xdb, 0x72, 0xdb, 0xc6,
-	0x75, 0x40, 0x4a, 0x32, 0x75, 0x78, 0x11, 0x05, 0x52, 0x22, 0x44, 0xd7, 0xb2, 0x0c, 0x3b, 0x89,
-	0x27, 0x99, 0x52, 0x89, 0x9c, 0x64, 0x32, 0x49, 0xdb, 0xa9, 0x6c, 0x4b, 0x76, 0x62, 0x39, 0x56,
-	0x20, 0x5f, 0x3a, 0x99, 0x4e, 0x31, 0x20, 0xb0, 0x22, 0x31, 0x02, 0xb1, 0xf0, 0xee, 0x82, 0x14,
-	0x5f, 0xfa, 0x09, 0xed, 0x1f, 0xf4, 0xa5, 0x33, 0xed, 0x7b, 0x3f, 0xa2, 0x9f, 0x90, 0x7e, 0x4a,
-	0x1f, 0xfa, 0xd0, 0xce, 0x5e, 0x40, 0x02, 0x04, 0x64, 0xcb, 0x1a, 0x77, 0x26, 0x2f, 0x1a, 0x9c,
-	0xfb, 0x65, 0xcf, 0x9e, 0x73, 0x96, 0x82, 0x0e, 0x73, 0xfa, 0x01, 0x62, 0x23, 0x27, 0x74, 0x06,
-	0x88, 0x78, 0x0e, 0x73, 0x7a, 0x11, 0xc1, 0x0c, 0xeb, 0xeb, 0x39, 0x42, 0xb7, 0xfa, 0x3a, 0x46,
-	0x64, 0x2a, 0xe9, 0xdd, 0x06, 0xc3, 0x11, 0x9e, 0xf3, 0x77, 0x37, 0x08, 0x8a, 0x02, 0xdf, 0x75,
-	0x98, 0x8f, 0xc3, 0x14, 0xba, 0x1e, 0xe0, 0x41, 0xcc, 0xfc, 0x40, 0x82, 0xe6, 0x7f, 0x35, 0x58,
-	0x7b, 0xce, 0x15, 0x3f, 0x44, 0xa7, 0x7e, 0xe8, 0x73, 0x66, 0x5d, 0x87, 0xa5, 0xd0, 0x19, 0x21,
-	0x43, 0xdb, 0xd1, 0xee, 0xae, 0x5a, 0xe2, 0x5b, 0xdf, 0x84, 0x15, 0xea, 0x0e, 0xd1, 0xc8, 0x31,
-	0x4a, 0x02, 0xab, 0x20, 0xdd, 0x80, 0x6b, 0x2e, 0x0e, 0xe2, 0x51, 0x48, 0x8d, 0xf2, 0x4e, 0xf9,
-	0xee, 0xaa, 0x95, 0x80, 0x7a, 0x0f, 0x5a, 0x11, 0xf1, 0x47, 0x0e, 0x99, 0xda, 0x67, 0x68, 0x6a,
-	0x27, 0x5c, 0x4b, 0x82, 0x6b, 0x5d, 0x91, 0x9e, 0xa0, 0xe9, 0x03, 0xc5, 0xaf, 0xc3, 0x12, 0x9b,
-	0x46, 0xc8, 0x58, 0x96, 0x56, 0xf9, 0xb7, 0x7e, 0x13, 0xaa, 0xdc, 0x75, 0x3b, 0x40, 0xe1, 0x80,
-	0x0d, 0x8d, 0x95, 0x1d, 0xed, 0xee, 0x92, 0x05, 0x1c, 0x75, 0x24, 0x30, 0xfa, 0x75, 0x58, 0x25,
-	0x78, 0x62, 0xbb, 0x38, 0x0e, 0x99, 0x71, 0x4d, 0x90, 0x2b, 0x04, 0x4f, 0x1e, 0x70, 0x58, 0xbf,
-	0x03, 0x2b, 0xa7, 0x3e, 0x0a, 0x3c, 0x6a, 0x54, 0x76, 0xca, 0x77, 0xab, 0x7b, 0xb5, 0x9e, 0xcc,
-	0xd7, 0x21, 0x47, 0x5a, 0x8a, 0x66, 0xfe, 0x4d, 0x83, 0xe6, 0x89, 0x08, 0x26, 0x95, 0x82, 0x8f,
-	0x60, 0x8d, 0x5b, 0xe9, 0x3b, 0x14, 0xd9, 0x2a, 0x6e, 0x99, 0x8d, 0x46, 0x82, 0x96, 0x22, 0xfa,
-	0x33, 0x90, 0xe7, 0x62, 0x7b, 0x33, 0x61, 0x6a, 0x94, 0x84, 0x39, 0xb3, 0x97, 0x3f, 0xca, 0x85,
-	0x54, 0x5b, 0x4d, 0x96, 0x45, 0x50, 0x9e, 0xd0, 0x31, 0x22, 0xd4, 0xc7, 0xa1, 0x51, 0x16, 0x16,
-	0x13, 0x90, 0x3b, 0xaa, 0x4b, 0xab, 0x0f, 0x86, 0x4e, 0x38, 0x40, 0x16, 0xa2, 0x71, 0xc0, 0xf4,
-	0xc7, 0x50, 0xef, 0xa3, 0x53, 0x4c, 0x32, 0x8e, 0x56, 0xf7, 0x6e, 0x17, 0x58, 0x5f, 0x0c, 0xd3,
-	0xaa, 0x49, 0x49, 0x15, 0xcb, 0x21, 0xd4, 0x9c, 0x53, 0x86, 0x88, 0x9d, 0x3a, 0xe9, 0x4b, 0x2a,
-	0xaa, 0x0a, 0x41, 0x89, 0x36, 0xff, 0xad, 0x41, 0xe3, 0x05, 0x45, 0xe4, 0x18, 0x91, 0x91, 0x4f,
-	0xa9, 0x2a, 0xa9, 0x21, 0xa6, 0x2c, 0x29, 0x29, 0xfe, 0xcd, 0x71, 0x31, 0x45, 0x44, 0x15, 0x94,
-	0xf8, 0xd6, 0x3f, 0x81, 0xf5, 0xc8, 0xa1, 0x74, 0x82, 0x89, 0x67, 0xbb, 0x43, 0xe4, 0x9e, 0xd1,
-	0x78, 0x24, 0xf2, 0xb0, 0x64, 0x35, 0x13, 0xc2, 0x03, 0x85, 0xd7, 0x7f, 0x00, 0x88, 0x88, 0x3f,
-	0xf6, 0x03, 0x34, 0x40, 0xb2, 0xb0, 0xaa, 0x7b, 0x9f, 0x15, 0x78, 0x9b, 0xf5, 0xa5, 0x77, 0x3c,
-	0x93, 0x39, 0x08, 0x19, 0x99, 0x5a, 0x29, 0x25, 0xdd, 0x5f, 0xc3, 0xda, 0x02, 0x59, 0x6f, 0x42,
-	0xf9, 0x0c, 0x4d, 0x95, 0xe7, 0xfc, 0x53, 0x6f, 0xc3, 0xf2, 0xd8, 0x09, 0x62, 0xa4, 0x3c, 0x97,
-	0xc0, 0xd7, 0xa5, 0xaf, 0x34, 0xf3, 0x27, 0x0d, 0x6a, 0x0f, 0xfb, 0x6f, 0x89, 0xbb, 0x01, 0x25,
-	0xaf, 0xaf, 0x64, 0x4b, 0x5e, 0x7f, 0x96, 0x87, 0x72, 0x2a, 0x0f, 0xcf, 0x0a, 0x42, 0xdb, 0x2d,
-	0x08, 0x2d, 0x6d, 0xec, 0xff, 0x19, 0xd8, 0x5f, 0x35, 0xa8, 0xce, 0x2d, 0x51, 0xfd, 0x08, 0x9a,
-	0xdc, 0x4f, 0x3b, 0x9a, 0xe3, 0x0c, 0x4d, 0x78, 0x79, 0xeb, 0xad, 0x07, 0x60, 0xad, 0xc5, 0x19,
-	0x98, 0xea, 0x87, 0xd0, 0xf0, 0xfa, 0x19, 0x5d, 0xf2, 0x06, 0xdd, 0x7c, 0x4b, 0xc4, 0x56, 0xdd,
-	0x4b, 0x41, 0xd4, 0xfc, 0x08, 0xaa, 0xc7, 0x7e, 0x38, 0xb0, 0xd0, 0xeb, 0x18, 0x51, 0xc6, 0xaf,
-	0x52, 0xe4, 0x4c, 0x03, 0xec, 0x78, 0x2a, 0xc8, 0x04, 0x34, 0xef, 0x42, 0x4d, 0x32, 0xd2, 0x08,
-	0x87, 0x14, 0xbd, 0x81, 0xf3, 0x63, 0xa8, 0x9d, 0x04, 0x08, 0x45, 0x89, 0xce, 0x2e, 0x54, 0xbc,
-	0x98, 0x88, 0xa6, 0x2a, 0x58, 0xcb, 0xd6, 0x0c, 0x36, 0xd7, 0xa0, 0xae, 0x78, 0xa5, 0x5a, 0xf3,
-	0x5f, 0x1a, 0xe8, 0x07, 0xe7, 0xc8, 0x8d, 0x19, 0x7a, 0x8c, 0xf1, 0x59, 0xa2, 0xa3, 0xa8, 0xbf,
-	0x6e, 0x03, 0x44, 0x0e, 0x71, 0x46, 0x88, 0x21, 0x22, 0xc3, 0x5f, 0xb5, 0x52, 0x18, 0xfd, 0x18,
-	0x56, 0xd1, 0x39, 0x23, 0x8e, 0x8d, 0xc2, 0xb1, 0xe8, 0xb4, 0xd5, 0xbd, 0x7b, 0x05, 0xd9, 0xc9,
-	0x5b, 0xeb, 0x1d, 0x70, 0xb1, 0x83, 0x70, 0x2c, 0x6b, 0xa2, 0x82, 0x14, 0xd8, 0xfd, 0x06, 0xea,
-	0x19, 0xd2, 0x3b, 0xd5, 0xc3, 0x29, 0xb4, 0x32, 0xa6, 0x54, 0x1e, 0x6f, 0x42, 0x15, 0x9d, 0xfb,
-	0xcc, 0xa6, 0xcc, 0x61, 0x31, 0x55, 0x09, 0x02, 0x8e, 0x3a, 0x11, 0x18, 0x31, 0x46, 0x98, 0x87,
-	0x63, 0x36, 0x1b, 0x23, 0x02, 0x52, 0x78, 0x44, 0x92, 0x5b, 0xa0, 0x20, 0x73, 0x0c, 0xcd, 0x47,
-	0x88, 0xc9, 0xbe, 0x92, 0xa4, 0x6f, 0x13, 0x56, 0x44, 0xe0, 0xb2, 0xe2, 0x56, 0x2d, 0x05, 0xe9,
-	0xb7, 0xa1, 0xee, 0x87, 0x6e, 0x10, 0x7b, 0xc8, 0x1e, 0xfb, 0x68, 0x42, 0x85, 0x89, 0x8a, 0x55,
-	0x53, 0xc8, 0x97, 0x1c, 0xa7, 0x7f, 0x00, 0x0d, 0x74, 0x2e, 0x99, 0x94, 0x12, 0x39, 0xb6, 0xea,
-	0x0a, 0x2b, 0x1a, 0x34, 0x35, 0x11, 0xac, 0xa7, 0xec, 0xaa, 0xe8, 0x8e, 0x61, 0x5d, 0x76, 0xc6,
-	0x54, 0xb3, 0x7f, 0x97, 0x6e, 0xdb, 0xa4, 0x0b, 0x18, 0xb3, 0x03, 0x1b, 0x8f, 0x10, 0x4b, 0x95,
-	0xb0, 0x8a, 0xd1, 0xfc, 0x11, 0x36, 0x17, 0x09, 0xca, 0x89, 0xdf, 0x42, 0x35, 0x7b, 0xe9, 0xb8,
-	0xf9, 0xed, 0x02, 0xf3, 0x69, 0xe1, 0xb4, 0x88, 0xd9, 0x06, 0xfd, 0x04, 0x31, 0x0b, 0x39, 0xde,
-	0xb3, 0x30, 0x98, 0x26, 0x16, 0x37, 0xa0, 0x95, 0xc1, 0xaa, 0x12, 0x9e, 0xa3, 0x5f, 0x11, 0x9f,
-	0xa1, 0x84, 0x7b, 0x13, 0xda, 0x59, 0xb4, 0x62, 0xff, 0x0e, 0xd6, 0xe5, 0x70, 0x7a, 0x3e, 0x8d,
-	0x12, 0x66, 0xfd, 0x0b, 0xa8, 0x4a, 0xf7, 0x6c, 0x31, 0xe0, 0xb9, 0xcb, 0x8d, 0xbd, 0x76, 0x6f,
-	0xb6, 0xaf, 0x88, 0x9c, 0x33, 0x21, 0x01, 0x6c, 0xf6, 0xcd, 0xfd, 0x4c, 0xeb, 0x9a, 0x3b, 0x64,
-	0xa1, 0x53, 0x82, 0xe8, 0x90, 0x97, 0x54, 0xda, 0xa1, 0x2c, 0x5a, 0xb1, 0x77, 0x60, 0xc3, 0x8a,
-	0xc3, 0xc7, 0xc8, 0x09, 0xd8, 0x50, 0x0c, 0x8e, 0x44, 0xc0, 0x80, 0xcd, 0x45, 0x82, 0x12, 0xf9,
-	0x1c, 0x8c, 0x6f, 0x07, 0x21, 0x26, 0x48, 0x12, 0x0f, 0x08, 0xc1, 0x24, 0xd3, 0x52, 0x18, 0x43,
-	0x24, 0x9c, 0x37, 0x0a, 0x01, 0x9a, 0xd7, 0x61, 0xab, 0x40, 0x4a, 0xa9, 0xfc, 0x9a, 0x3b, 0xcd,
-	0xfb, 0x49, 0xb6, 0x92, 0x6f, 0x43, 0x7d, 0xe2, 0xf8, 0xcc, 0x8e, 0x30, 0x9d, 0x17, 0xd3, 0xaa,
-	0x55, 0xe3, 0xc8, 0x63, 0x85, 0x93, 0x91, 0xa5, 0x65, 0x95, 0xce, 0x3d, 0xd8, 0x3c, 0x26, 0xe8,
-	0x34, 0xf0, 0x07, 0xc3, 0x85, 0x0b, 0xc2, 0x77, 0x32, 0x91, 0xb8, 0xe4, 0x86, 0x24, 0xa0, 0x39,
-	0x80, 0x4e, 0x4e, 0x46, 0xd5, 0xd5, 0x11, 0x34, 0x24, 0x97, 0x4d, 0xc4, 0x5e, 0x91, 0xf4, 0xf3,
-	0x0f, 0x2e, 0xac, 0xec, 0xf4, 0x16, 0x62, 0xd5, 0xdd, 0x14, 0x44, 0xcd, 0xff, 0x68, 0xa0, 0xef,
-	0x47, 0x51, 0x30, 0xcd, 0x7a, 0xd6, 0x84, 0x32, 0x7d, 0x1d, 0x24, 0x2d, 0x86, 0xbe, 0x0e, 0x78,
-	0x8b, 0x39, 0xc5, 0xc4, 0x45, 0xea, 0xb2, 0x4a, 0x80, 0xaf, 0x01, 0x4e, 0x10, 0xe0, 0x89, 0x9d,
-	0xda, 0x61, 0x45, 0x67, 0xa8, 0x58, 0x4d, 0x41, 0xb0, 0xe6, 0xf8, 0xfc, 0x02, 0xb4, 0xf4, 0xbe,
-	0x16, 0xa0, 0xe5, 0x2b, 0x2e, 0x40, 0x7f, 0xd7, 0xa0, 0x95, 0x89, 0x5e, 0xe5, 0xf8, 0xe7, 0xb7,
-	0xaa, 0xb5, 0x60, 0xfd, 0x08, 0xbb, 0x67, 0xb2, 0xeb, 0x25, 0x57, 0xa3, 0x0d, 0x7a, 0x1a, 0x39,
-	0xbf, 0x78, 0x2f, 0xc2, 0x20, 0xc7, 0xbc, 0x09, 0xed, 0x2c, 0x5a, 0xb1, 0xff, 0x43, 0x03, 0x43,
-	0x8d, 0x88, 0x43, 0xc4, 0xdc, 0xe1, 0x3e, 0x7d, 0xd8, 0x9f, 0xd5, 0x41, 0x1b, 0x96, 0xc5, 0x2a,
-	0x2e, 0x12, 0x50, 0xb3, 0x24, 0xa0, 0x77, 0xe0, 0x9a, 0xd7, 0xb7, 0xc5, 0x68, 0x54, 0xd3, 0xc1,
-	0xeb, 0x7f, 0xcf, 0x87, 0xe3, 0x16, 0x54, 0x46, 0xce, 0xb9, 0x4d, 0xf0, 0x84, 0xaa, 0x65, 0xf0,
-	0xda, 0xc8, 0x39, 0xb7, 0xf0, 0x84, 0x8a, 0x45, 0xdd, 0xa7, 0x62, 0x03, 0xef, 0xfb, 0x61, 0x80,
-	0x07, 0x54, 0x1c, 0x7f, 0xc5, 0x6a, 0x28, 0xf4, 0x7d, 0x89, 0xe5, 0x77, 0x8d, 0x88, 0x6b, 0x94,
-	0x3e, 0xdc, 0x8a, 0x55, 0x23, 0xa9, 0xbb, 0x65, 0x3e, 0x82, 0xad, 0x02, 0x9f, 0xd5, 0xe9, 0x7d,
-	0x0c, 0x2b, 0xf2, 0x6a, 0xa8, 0x63, 0xd3, 0xd5, 0x73, 0xe2, 0x07, 0xfe, 0x57, 0x5d, 0x03, 0xc5,
-	0x61, 0xfe, 0x49, 0x83, 0x1b, 0x59, 0x4d, 0xfb, 0x41, 0xc0, 0x17, 0x30, 0xfa, 0xfe, 0x53, 0x90,
-	0x8b, 0x6c, 0xa9, 0x20, 0xb2, 0x23, 0xd8, 0xbe, 0xc8, 0x9f, 0x2b, 0x84, 0xf7, 0x64, 0xf1, 0x6c,
-	0xf7, 0xa3, 0xe8, 0xcd, 0x81, 0xa5, 0xfd, 0x2f, 0x65, 0xfc, 0xcf, 0x27, 0x5d, 0x28, 0xbb, 0x82,
-	0x57, 0x5d, 0x30, 0x52, 0x7d, 0x41, 0x6e, 0x1c, 0x49, 0x99, 0x1e, 0xc1, 0x56, 0x01, 0x4d, 0x19,
-	0xd9, 0xe5, 0xdb, 0xc7, 0x6c, 0x63, 0xa9, 0xee, 0x75, 0x7a, 0x8b, 0x6f, 0x67, 0x25, 0xa0, 0xd8,
-	0xf8, 0x5d, 0x78, 0xea, 0x50, 0x7e, 0x8d, 0x32, 0x46, 0x9e, 0x42, 0x3b, 0x8b, 0x56, 0xfa, 0xbf,
-	0x58, 0xd0, 0x7f, 0x23, 0xa7, 0x3f, 0x23, 0x96, 0x58, 0xe9, 0xc0, 0x86, 0xc4, 0x27, 0xb3, 0x20,
-	0xb1, 0xf3, 0x39, 0x6c, 0x2e, 0x12, 0x94, 0xa5, 0x2e, 0x54, 0x16, 0x86, 0xc9, 0x0c, 0xe6, 0x52,
-	0xaf, 0x1c, 0x9f, 0x1d, 0xe2, 0x45, 0x7d, 0x6f, 0x94, 0xda, 0x82, 0x4e, 0x4e, 0x4a, 0x5d, 0x71,
-	0x03, 0x36, 0x4f, 0x18, 0x8e, 0x52, 0x79, 0x4d, 0x1c, 0xdc, 0x82, 0x4e, 0x8e, 0xa2, 0x84, 0xfe,
-	0x00, 0x37, 0x16, 0x48, 0x4f, 0xfd, 0xd0, 0x1f, 0xc5, 0xa3, 0x4b, 0x38, 0xa3, 0xdf, 0x02, 0x31,
-	0x1b, 0x6d, 0xe6, 0x8f, 0x50, 0xb2, 0x44, 0x96, 0xad, 0x2a, 0xc7, 0x3d, 0x97, 0x28, 0xf3, 0x57,
-	0xb0, 0x7d, 0x91, 0xfe, 0x4b, 0xe4, 0x48, 0x38, 0xee, 0x10, 0x56, 0x10, 0x53, 0x17, 0x8c, 0x3c,
-	0x49, 0x05, 0xd5, 0x87, 0x5b, 0x8b, 0xb4, 0x17, 0x21, 0xf3, 0x83, 0x7d, 0xde, 0x6a, 0xdf, 0x53,
-	0x60, 0x77, 0xc0, 0x7c, 0x93, 0x0d, 0xe5, 0x49, 0x1b, 0xf4, 0x47, 0x28, 0xe1, 0x99, 0x15, 0xe6,
-	0x27, 0xd0, 0xca, 0x60, 0x55, 0x26, 0xda, 0xb0, 0xec, 0x78, 0x1e, 0x49, 0xd6, 0x04, 0x09, 0xf0,
-	0x1c, 0x58, 0x88, 0xa2, 0x0b, 0x72, 0x90, 0x27, 0x29, 0xcb, 0xbb, 0xd0, 0x79, 0x99, 0xc2, 0xf3,
-	0x2b, 0x5d, 0xd8, 0x12, 0x56, 0x55, 0x4b, 0x30, 0x0f, 0xc1, 0xc8, 0x0b, 0x5c, 0xa9, 0x19, 0xdd,
-	0x48, 0xeb, 0x99, 0x57, 0x6b, 0x62, 0xbe, 0x01, 0x25, 0xdf, 0x53, 0x8f, 0x91, 0x92, 0xef, 0x65,
-	0x0e, 0xa2, 0xb4, 0x50, 0x00, 0x3b, 0xb0, 0x7d, 0x91, 0x32, 0x15, 0x67, 0x0b, 0xd6, 0xbf, 0x0d,
-	0x7d, 0x26, 0x2f, 0x60, 0x92, 0x98, 0x4f, 0x41, 0x4f, 0x23, 0x2f, 0x51, 0x69, 0x3f, 0x69, 0xb0,
-	0x7d, 0x8c, 0xa3, 0x38, 0x10, 0xdb, 0x6a, 0xe4, 0x10, 0x14, 0xb2, 0xef, 0x70, 0x4c, 0x42, 0x27,
-	0x48, 0xfc, 0xfe, 0x10, 0xd6, 0x78, 0x3d, 0xd8, 0x2e, 0x41, 0x0e, 0x43, 0x9e, 0x1d, 0x26, 0x2f,
-	0xaa, 0x3a, 0x47, 0x3f, 0x90, 0xd8, 0xef, 0x29, 0x7f, 0x75, 0x39, 0x2e, 0x57, 0x9a, 0x1e, 0x1c,
-	0x20, 0x51, 0x62, 0x78, 0x7c, 0x05, 0xb5, 0x91, 0xf0, 0xcc, 0x76, 0x02, 0xdf, 0x91, 0x03, 0xa4,
-	0xba, 0xb7, 0xb1, 0xb8, 0x81, 0xef, 0x73, 0xa2, 0x55, 0x95, 0xac, 0x02, 0xd0, 0x3f, 0x83, 0x76,
-	0xaa, 0x55, 0xcd, 0x17, 0xd5, 0x25, 0x61, 0xa3, 0x95, 0xa2, 0xcd, 0xf6, 0xd5, 0x5b, 0x70, 0xf3,
-	0xc2, 0xb8, 0x54, 0x0a, 0xff, 0xa2, 0xc9, 0x74, 0xa9, 0x44, 0x27, 0xf1, 0xfe, 0x12, 0x56, 0x24,
-	0xbf, 0x3a, 0xf4, 0x0b, 0x1c, 0x54, 0x4c, 0x17, 0xfa, 0x56, 0xba, 0xd0, 0xb7, 0xa2, 0x8c, 0x96,
-	0x0b, 0x32, 0xca, 0xfb, 0x7b, 0xc6, 0xbf, 0xf9, 0x0a, 0xf4, 0x10, 0x8d, 0x30, 0x43, 0xd9, 0xc3,
-	0xff, 0xb3, 0x06, 0xed, 0x2c, 0x5e, 0x9d, 0xff, 0x3d, 0x68, 0x79, 0x28, 0x22, 0xc8, 0x15, 0xc6,
-	0xb2, 0xa5, 0x70, 0xbf, 0x64, 0x68, 0x96, 0x3e, 0x27, 0xcf, 0x7c, 0xbc, 0x0f, 0x75, 0x75, 0x58,
-	0x6a, 0x66, 0x94, 0x2e, 0x33, 0x33, 0xd4, 0x01, 0x4b, 0x88, 0x5f, 0xe1, 0x17, 0xa1, 0x87, 0x8b,
-	0x9c, 0xed, 0x82, 0x91, 0x27, 0xa9, 0xf8, 0xae, 0xcf, 0x86, 0xe4, 0x2b, 0x87, 0x1e, 0x13, 0xcc,
-	0x59, 0xbc, 0x44, 0xf0, 0x17, 0xd0, 0x2d, 0x22, 0x2a, 0xd1, 0x7f, 0x6a, 0xd0, 0x3c, 0x41, 0xd9,
-	0x5b, 0xf1, 0xae, 0x07, 0x5a, 0x70, 0x3a, 0xa5, 0xa2, 0x7a, 0xff, 0x12, 0x3a, 0xe2, 0x99, 0xc0,
-	0x13, 0x44, 0x58, 0xc1, 0x1b, 0x61, 0x43, 0x90, 0x17, 0xbb, 0x65, 0xfe, 0xb9, 0xb5, 0x54, 0xf0,
-	0xdc, 0x6a, 0xc1, 0x7a, 0x2a, 0x0e, 0x15, 0xdd, 0x93, 0x74, 0xec, 0x16, 0x12, 0x76, 0x67, 0x99,
-	0x79, 0xc7, 0x30, 0xcd, 0x1b, 0x70, 0xbd, 0x50, 0x99, 0xb2, 0xf5, 0x47, 0xde, 0xe7, 0x33, 0x03,
-	0x6c, 0x3f, 0xf4, 0x1e, 0x21, 0x96, 0x59, 0x35, 0xf4, 0xdf, 0xc1, 0x06, 0x65, 0x38, 0x4a, 0x07,
-	0x6f, 0x8f, 0xb0, 0x97, 0xbc, 0xae, 0xef, 0x14, 0x6c, 0x30, 0xd9, 0xa1, 0x88, 0x3d, 0x64, 0xb5,
-	0x68, 0x1e, 0xc9, 0x1f, 0x2f, 0xb7, 0xdf, 0xe8, 0xc0, 0xec, 0x87, 0x88, 0xfa, 0x70, 0xda, 0x27,
-	0xbe, 0x67, 0x5f, 0x6a, 0x77, 0x12, 0xf5, 0x5e, 0x93, 0x12, 0xea, 0xc7, 0xa0, 0xdf, 0xcc, 0xd6,
-	0x22, 0x59, 0xe2, 0x1f, 0xbe, 0xcd, 0xe9, 0xfc, 0x7e, 0xa4, 0xea, 0x30, 0xdb, 0x48, 0xf8, 0xa6,
-	0xb3, 0x48, 0xb8, 0x44, 0x47, 0x3e, 0x81, 0xfa, 0x7d, 0xc7, 0x3d, 0x8b, 0x67, 0x9b, 0xec, 0x0e,
-	0x54, 0x5d, 0x1c, 0xba, 0x31, 0x21, 0x28, 0x74, 0xa7, 0xaa, 0xf7, 0xa6, 0x51, 0x9c, 0x43, 0x3c,
-	0x47, 0x65, 0xb9, 0xa8, 0x37, 0x6c, 0x1a, 0x65, 0x7e, 0x09, 0x8d, 0x44, 0xa9, 0x72, 0xe1, 0x0e,
-	0x2c, 0xa3, 0xf1, 0xbc, 0x58, 0x1a, 0xbd, 0xe4, 0x1f, 0x32, 0x07, 0x1c, 0x6b, 0x49, 0xa2, 0x9a,
-	0xb4, 0x0c, 0x13, 0x74, 0x48, 0xf0, 0x28, 0xe3, 0x97, 0xb9, 0xcf, 0xaf, 0x69, 0x8e, 0xf6, 0x4e,
-	0xea, 0x7f, 0x0f, 0xb5, 0x97, 0x6f, 0x9d, 0xd0, 0x3c, 0x5b, 0x13, 0x4c, 0xce, 0x4e, 0x03, 0x3c,
-	0x49, 0x06, 0x65, 0x02, 0x73, 0xda, 0x19, 0x9a, 0xd2, 0xc8, 0x71, 0x91, 0xfa, 0xcd, 0x6e, 0x06,
-	0x9b, 0xdf, 0x40, 0xfd, 0xe5, 0x55, 0xc7, 0xf9, 0xfd, 0x4f, 0x7f, 0xec, 0x8d, 0x7d, 0x86, 0x28,
-	0xed, 0xf9, 0x78, 0x57, 0x7e, 0xed, 0x0e, 0xf0, 0xee, 0x98, 0xed, 0x8a, 0xff, 0x58, 0xed, 0xe6,
-	0x9e, 0xb8, 0xfd, 0x15, 0x41, 0xb8, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x46, 0xf8,
-	0x40, 0x3b, 0x1b, 0x00, 0x00,
+	// 2187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x19, 0xdb, 0x6e, 0xdc, 0xc6,
+	0x15, 0x5c, 0x5d, 0x2c, 0x9d, 0xbd, 0x48, 0xe2, 0xae, 0xb4, 0xd4, 0xba, 0x96, 0x65, 0xda, 0x49,
+	0x8c, 0x04, 0x5d, 0x25, 0x72, 0x12, 0x04, 0x49, 0x5b, 0x54, 0xb6, 0x25, 0x3b, 0xb1, 0x1c, 0x2b,
+	0xf4, 0xad, 0x08, 0x8a, 0x12, 0x5c, 0xf2, 0x68, 0x45, 0x88, 0xcb, 0xa1, 0x67, 0x86, 0x92, 0xf6,
+	0xa5, 0x9f, 0xd0, 0xfe, 0x41, 0x5f, 0x0a, 0xb4, 0xef, 0xfd, 0x88, 0x7e, 0x42, 0xfa, 0x29, 0x7d,
+	0xe8, 0x43, 0x8b, 0xb9, 0x70, 0x97, 0xdc, 0xa5, 0x64, 0x59, 0x30, 0x8a, 0xbc, 0x08, 0x3c, 0xf7,
+	0xcb, 0x9c, 0x39, 0xe7, 0xcc, 0x0a, 0xda, 0xdc, 0xeb, 0x45, 0xc8, 0x07, 0x5e, 0xec, 0xf5, 0x91,
+	0x06, 0x1e, 0xf7, 0xba, 0x09, 0x25, 0x9c, 0x98, 0x2b, 0x53, 0x84, 0x4e, 0xf5, 0x4d, 0x8a, 0x74,
+	0xa8, 0xe8, 0x9d, 0x06, 0x27, 0x09, 0x19, 0xf3, 0x77, 0x56, 0x29, 0x26, 0x51, 0xe8, 0x7b, 0x3c,
+	0x24, 0x71, 0x0e, 0x5d, 0x8f, 0x48, 0x3f, 0xe5, 0x61, 0xa4, 0x40, 0xfb, 0xbf, 0x06, 0x2c, 0xbd,
+	0x10, 0x8a, 0x1f, 0xe2, 0x61, 0x18, 0x87, 0x82, 0xd9, 0x34, 0x61, 0x36, 0xf6, 0x06, 0x68, 0x19,
+	0x9b, 0xc6, 0xdd, 0x45, 0x47, 0x7e, 0x9b, 0x6b, 0x30, 0xcf, 0xfc, 0x23, 0x1c, 0x78, 0x56, 0x45,
+	0x62, 0x35, 0x64, 0x5a, 0x70, 0xcd, 0x27, 0x51, 0x3a, 0x88, 0x99, 0x35, 0xb3, 0x39, 0x73, 0x77,
+	0xd1, 0xc9, 0x40, 0xb3, 0x0b, 0xcd, 0x84, 0x86, 0x03, 0x8f, 0x0e, 0xdd, 0x63, 0x1c, 0xba, 0x19,
+	0xd7, 0xac, 0xe4, 0x5a, 0xd1, 0xa4, 0x27, 0x38, 0x7c, 0xa0, 0xf9, 0x4d, 0x98, 0xe5, 0xc3, 0x04,
+	0xad, 0x39, 0x65, 0x55, 0x7c, 0x9b, 0x37, 0xa1, 0x2a, 0x5c, 0x77, 0x23, 0x8c, 0xfb, 0xfc, 0xc8,
+	0x9a, 0xdf, 0x34, 0xee, 0xce, 0x3a, 0x20, 0x50, 0xfb, 0x12, 0x63, 0x5e, 0x87, 0x45, 0x4a, 0x4e,
+	0x5d, 0x9f, 0xa4, 0x31, 0xb7, 0xae, 0x49, 0xf2, 0x02, 0x25, 0xa7, 0x0f, 0x04, 0x6c, 0xde, 0x81,
+	0xf9, 0xc3, 0x10, 0xa3, 0x80, 0x59, 0x0b, 0x9b, 0x33, 0x77, 0xab, 0xdb, 0xb5, 0xae, 0xca, 0xd7,
+	0x9e, 0x40, 0x3a, 0x9a, 0x66, 0xff, 0xcd, 0x80, 0xe5, 0xe7, 0x32, 0x98, 0x5c, 0x0a, 0x3e, 0x82,
+	0x25, 0x61, 0xa5, 0xe7, 0x31, 0x74, 0x75, 0xdc, 0x2a, 0x1b, 0x8d, 0x0c, 0xad, 0x44, 0xcc, 0x67,
+	0xa0, 0xce, 0xc5, 0x0d, 0x46, 0xc2, 0xcc, 0xaa, 0x48, 0x73, 0x76, 0x77, 0xfa, 0x28, 0x27, 0x52,
+	0xed, 0x2c, 0xf3, 0x22, 0x82, 0x89, 0x84, 0x9e, 0x20, 0x65, 0x21, 0x89, 0xad, 0x19, 0x69, 0x31,
+	0x03, 0x85, 0xa3, 0xa6, 0xb2, 0xfa, 0xe0, 0xc8, 0x8b, 0xfb, 0xe8, 0x20, 0x4b, 0x23, 0x6e, 0x3e,
+	0x86, 0x7a, 0x0f, 0x0f, 0x09, 0x2d, 0x38, 0x5a, 0xdd, 0xbe, 0x5d, 0x62, 0x7d, 0x32, 0x4c, 0xa7,
+	0xa6, 0x24, 0x75, 0x2c, 0x7b, 0x50, 0xf3, 0x0e, 0x39, 0x52, 0x37, 0x77, 0xd2, 0x97, 0x54, 0x54,
+	0x95, 0x82, 0x0a, 0x6d, 0xff, 0xdb, 0x80, 0xc6, 0x4b, 0x86, 0xf4, 0x00, 0xe9, 0x20, 0x64, 0x4c,
+	0x97, 0xd4, 0x11, 0x61, 0x3c, 0x2b, 0x29, 0xf1, 0x2d, 0x70, 0x29, 0x43, 0xaa, 0x0b, 0x4a, 0x7e,
+	0x9b, 0x9f, 0xc0, 0x4a, 0xe2, 0x31, 0x76, 0x4a, 0x68, 0xe0, 0xfa, 0x47, 0xe8, 0x1f, 0xb3, 0x74,
+	0x20, 0xf3, 0x30, 0xeb, 0x2c, 0x67, 0x84, 0x07, 0x1a, 0x6f, 0xfe, 0x00, 0x90, 0xd0, 0xf0, 0x24,
+	0x8c, 0xb0, 0x8f, 0xaa, 0xb0, 0xaa, 0xdb, 0x9f, 0x95, 0x78, 0x5b, 0xf4, 0xa5, 0x7b, 0x30, 0x92,
+	0xd9, 0x8d, 0x39, 0x1d, 0x3a, 0x39, 0x25, 0x9d, 0x5f, 0xc3, 0xd2, 0x04, 0xd9, 0x5c, 0x86, 0x99,
+	0x63, 0x1c, 0x6a, 0xcf, 0xc5, 0xa7, 0xd9, 0x82, 0xb9, 0x13, 0x2f, 0x4a, 0x51, 0x7b, 0xae, 0x80,
+	0xaf, 0x2b, 0x5f, 0x19, 0xf6, 0x4f, 0x06, 0xd4, 0x1e, 0xf6, 0xde, 0x12, 0x77, 0x03, 0x2a, 0x41,
+	0x4f, 0xcb, 0x56, 0x82, 0xde, 0x28, 0x0f, 0x33, 0xb9, 0x3c, 0x3c, 0x2b, 0x09, 0x6d, 0xab, 0x24,
+	0xb4, 0x87, 0xbd, 0xff, 0x4f, 0x60, 0x7f, 0x35, 0xa0, 0x3a, 0xb6, 0xc4, 0xcc, 0x7d, 0x58, 0x16,
+	0x7e, 0xba, 0xc9, 0x18, 0x67, 0x19, 0xd2, 0xcb, 0x5b, 0x6f, 0x3d, 0x00, 0x67, 0x29, 0x2d, 0xc0,
+	0xcc, 0xdc, 0x83, 0x46, 0xd0, 0x2b, 0xe8, 0x52, 0x37, 0xe8, 0xe6, 0x5b, 0x22, 0x76, 0xea, 0x41,
+	0x0e, 0x62, 0xf6, 0x47, 0x50, 0x3d, 0x08, 0xe3, 0xbe, 0x83, 0x6f, 0x52, 0x64, 0x5c, 0x5c, 0xa5,
+	0xc4, 0x1b, 0x46, 0xc4, 0x0b, 0x74, 0x90, 0x19, 0x68, 0xdf, 0x85, 0x9a, 0x62, 0x64, 0x09, 0x89,
+	0x19, 0x5e, 0xc0, 0xf9, 0x31, 0xd4, 0x9e, 0x47, 0x88, 0x49, 0xa6, 0xb3, 0x03, 0x0b, 0x41, 0x4a,
+	0x65, 0x53, 0x95, 0xac, 0x33, 0xce, 0x08, 0xb6, 0x97, 0xa0, 0xae, 0x79, 0x95, 0x5a, 0xfb, 0x5f,
+	0x06, 0x98, 0xbb, 0x67, 0xe8, 0xa7, 0x1c, 0x1f, 0x13, 0x72, 0x9c, 0xe9, 0x28, 0xeb, 0xaf, 0x1b,
+	0x00, 0x89, 0x47, 0xbd, 0x01, 0x72, 0xa4, 0x2a, 0xfc, 0x45, 0x27, 0x87, 0x31, 0x0f, 0x60, 0x11,
+	0xcf, 0x38, 0xf5, 0x5c, 0x8c, 0x4f, 0x64, 0xa7, 0xad, 0x6e, 0xdf, 0x2b, 0xc9, 0xce, 0xb4, 0xb5,
+	0xee, 0xae, 0x10, 0xdb, 0x8d, 0x4f, 0x54, 0x4d, 0x2c, 0xa0, 0x06, 0x3b, 0xdf, 0x40, 0xbd, 0x40,
+	0x7a, 0xa7, 0x7a, 0x38, 0x84, 0x66, 0xc1, 0x94, 0xce, 0xe3, 0x4d, 0xa8, 0xe2, 0x59, 0xc8, 0x5d,
+	0xc6, 0x3d, 0x9e, 0x32, 0x9d, 0x20, 0x10, 0xa8, 0xe7, 0x12, 0x23, 0xc7, 0x08, 0x0f, 0x48, 0xca,
+	0x47, 0x63, 0x44, 0x42, 0x1a, 0x8f, 0x34, 0xbb, 0x05, 0x1a, 0xb2, 0x4f, 0x60, 0xf9, 0x11, 0x72,
+	0xd5, 0x57, 0xb2, 0xf4, 0xad, 0xc1, 0xbc, 0x0c, 0x5c, 0x55, 0xdc, 0xa2, 0xa3, 0x21, 0xf3, 0x36,
+	0xd4, 0xc3, 0xd8, 0x8f, 0xd2, 0x00, 0xdd, 0x93, 0x10, 0x4f, 0x99, 0x34, 0xb1, 0xe0, 0xd4, 0x34,
+	0xf2, 0x95, 0xc0, 0x99, 0x1f, 0x40, 0x03, 0xcf, 0x14, 0x93, 0x56, 0xa2, 0xc6, 0x56, 0x5d, 0x63,
+	0x65, 0x83, 0x66, 0x36, 0xc2, 0x4a, 0xce, 0xae, 0x8e, 0xee, 0x00, 0x56, 0x54, 0x67, 0xcc, 0x35,
+	0xfb, 0x77, 0xe9, 0xb6, 0xcb, 0x6c, 0x02, 0x63, 0xb7, 0x61, 0xf5, 0x11, 0xf2, 0x5c, 0x09, 0xeb,
+	0x18, 0xed, 0x1f, 0x61, 0x6d, 0x92, 0xa0, 0x9d, 0xf8, 0x2d, 0x54, 0x8b, 0x97, 0x4e, 0x98, 0xdf,
+	0x28, 0x31, 0x9f, 0x17, 0xce, 0x8b, 0xd8, 0x2d, 0x30, 0x9f, 0x23, 0x77, 0xd0, 0x0b, 0x9e, 0xc5,
+	0xd1, 0x30, 0xb3, 0xb8, 0x0a, 0xcd, 0x02, 0x56, 0x97, 0xf0, 0x18, 0xfd, 0x9a, 0x86, 0x1c, 0x33,
+	0xee, 0x35, 0x68, 0x15, 0xd1, 0x9a, 0xfd, 0x3b, 0x58, 0x51, 0xc3, 0xe9, 0xc5, 0x30, 0xc9, 0x98,
+	0xcd, 0x2f, 0xa0, 0xaa, 0xdc, 0x73, 0xe5, 0x80, 0x17, 0x2e, 0x37, 0xb6, 0x5b, 0xdd, 0xd1, 0xbe,
+	0x22, 0x73, 0xce, 0xa5, 0x04, 0xf0, 0xd1, 0xb7, 0xf0, 0x33, 0xaf, 0x6b, 0xec, 0x90, 0x83, 0x87,
+	0x14, 0xd9, 0x91, 0x28, 0xa9, 0xbc, 0x43, 0x45, 0xb4, 0x66, 0x6f, 0xc3, 0xaa, 0x93, 0xc6, 0x8f,
+	0xd1, 0x8b, 0xf8, 0x91, 0x1c, 0x1c, 0x99, 0x80, 0x05, 0x6b, 0x93, 0x04, 0x2d, 0xf2, 0x39, 0x58,
+	0xdf, 0xf6, 0x63, 0x42, 0x51, 0x11, 0x77, 0x29, 0x25, 0xb4, 0xd0, 0x52, 0x38, 0x47, 0x1a, 0x8f,
+	0x1b, 0x85, 0x04, 0xed, 0xeb, 0xb0, 0x5e, 0x22, 0xa5, 0x55, 0x7e, 0x2d, 0x9c, 0x16, 0xfd, 0xa4,
+	0x58, 0xc9, 0xb7, 0xa1, 0x7e, 0xea, 0x85, 0xdc, 0x4d, 0x08, 0x1b, 0x17, 0xd3, 0xa2, 0x53, 0x13,
+	0xc8, 0x03, 0x8d, 0x53, 0x91, 0xe5, 0x65, 0xb5, 0xce, 0x6d, 0x58, 0x3b, 0xa0, 0x78, 0x18, 0x85,
+	0xfd, 0xa3, 0x89, 0x0b, 0x22, 0x76, 0x32, 0x99, 0xb8, 0xec, 0x86, 0x64, 0xa0, 0xdd, 0x87, 0xf6,
+	0x94, 0x8c, 0xae, 0xab, 0x7d, 0x68, 0x28, 0x2e, 0x97, 0xca, 0xbd, 0x22, 0xeb, 0xe7, 0x1f, 0x9c,
+	0x5b, 0xd9, 0xf9, 0x2d, 0xc4, 0xa9, 0xfb, 0x39, 0x88, 0xd9, 0xff, 0x31, 0xc0, 0xdc, 0x49, 0x92,
+	0x68, 0x58, 0xf4, 0x6c, 0x19, 0x66, 0xd8, 0x9b, 0x28, 0x6b, 0x31, 0xec, 0x4d, 0x24, 0x5a, 0xcc,
+	0x21, 0xa1, 0x3e, 0xea, 0xcb, 0xaa, 0x00, 0xb1, 0x06, 0x78, 0x51, 0x44, 0x4e, 0xdd, 0xdc, 0x0e,
+	0x2b, 0x3b, 0xc3, 0x82, 0xb3, 0x2c, 0x09, 0xce, 0x18, 0x3f, 0xbd, 0x00, 0xcd, 0xbe, 0xaf, 0x05,
+	0x68, 0xee, 0x8a, 0x0b, 0xd0, 0xdf, 0x0d, 0x68, 0x16, 0xa2, 0xd7, 0x39, 0xfe, 0xf9, 0xad, 0x6a,
+	0x4d, 0x58, 0xd9, 0x27, 0xfe, 0xb1, 0xea, 0x7a, 0xd9, 0xd5, 0x68, 0x81, 0x99, 0x47, 0x8e, 0x2f,
+	0xde, 0xcb, 0x38, 0x9a, 0x62, 0x5e, 0x83, 0x56, 0x11, 0xad, 0xd9, 0xff, 0x61, 0x80, 0xa5, 0x47,
+	0xc4, 0x1e, 0x72, 0xff, 0x68, 0x87, 0x3d, 0xec, 0x8d, 0xea, 0xa0, 0x05, 0x73, 0x72, 0x15, 0x97,
+	0x09, 0xa8, 0x39, 0x0a, 0x30, 0xdb, 0x70, 0x2d, 0xe8, 0xb9, 0x72, 0x34, 0xea, 0xe9, 0x10, 0xf4,
+	0xbe, 0x17, 0xc3, 0x71, 0x1d, 0x16, 0x06, 0xde, 0x99, 0x4b, 0xc9, 0x29, 0xd3, 0xcb, 0xe0, 0xb5,
+	0x81, 0x77, 0xe6, 0x90, 0x53, 0x26, 0x17, 0xf5, 0x90, 0xc9, 0x0d, 0xbc, 0x17, 0xc6, 0x11, 0xe9,
+	0x33, 0x79, 0xfc, 0x0b, 0x4e, 0x43, 0xa3, 0xef, 0x2b, 0xac, 0xb8, 0x6b, 0x54, 0x5e, 0xa3, 0xfc,
+	0xe1, 0x2e, 0x38, 0x35, 0x9a, 0xbb, 0x5b, 0xf6, 0x23, 0x58, 0x2f, 0xf1, 0x59, 0x9f, 0xde, 0xc7,
+	0x30, 0xaf, 0xae, 0x86, 0x3e, 0x36, 0x53, 0x3f, 0x27, 0x7e, 0x10, 0x7f, 0xf5, 0x35, 0xd0, 0x1c,
+	0xf6, 0x9f, 0x0c, 0xb8, 0x51, 0xd4, 0xb4, 0x13, 0x45, 0x62, 0x01, 0x63, 0xef, 0x3f, 0x05, 0x53,
+	0x91, 0xcd, 0x96, 0x44, 0xb6, 0x0f, 0x1b, 0xe7, 0xf9, 0x73, 0x85, 0xf0, 0x9e, 0x4c, 0x9e, 0xed,
+	0x4e, 0x92, 0x5c, 0x1c, 0x58, 0xde, 0xff, 0x4a, 0xc1, 0xff, 0xe9, 0xa4, 0x4b, 0x65, 0x57, 0xf0,
+	0xaa, 0x03, 0x56, 0xae, 0x2f, 0xa8, 0x8d, 0x23, 0x2b, 0xd3, 0x7d, 0x58, 0x2f, 0xa1, 0x69, 0x23,
+	0x5b, 0x62, 0xfb, 0x18, 0x6d, 0x2c, 0xd5, 0xed, 0x76, 0x77, 0xf2, 0xed, 0xac, 0x05, 0x34, 0x9b,
+	0xb8, 0x0b, 0x4f, 0x3d, 0x26, 0xae, 0x51, 0xc1, 0xc8, 0x53, 0x68, 0x15, 0xd1, 0x5a, 0xff, 0x17,
+	0x13, 0xfa, 0x6f, 0x4c, 0xe9, 0x2f, 0x88, 0x65, 0x56, 0xda, 0xb0, 0xaa, 0xf0, 0xd9, 0x2c, 0xc8,
+	0xec, 0x7c, 0x0e, 0x6b, 0x93, 0x04, 0x6d, 0xa9, 0x03, 0x0b, 0x13, 0xc3, 0x64, 0x04, 0x0b, 0xa9,
+	0xd7, 0x5e, 0xc8, 0xf7, 0xc8, 0xa4, 0xbe, 0x0b, 0xa5, 0xd6, 0xa1, 0x3d, 0x25, 0xa5, 0xaf, 0xb8,
+	0x05, 0x6b, 0xcf, 0x39, 0x49, 0x72, 0x79, 0xcd, 0x1c, 0x5c, 0x87, 0xf6, 0x14, 0x45, 0x0b, 0xfd,
+	0x01, 0x6e, 0x4c, 0x90, 0x9e, 0x86, 0x71, 0x38, 0x48, 0x07, 0x97, 0x70, 0xc6, 0xbc, 0x05, 0x72,
+	0x36, 0xba, 0x3c, 0x1c, 0x60, 0xb6, 0x44, 0xce, 0x38, 0x55, 0x81, 0x7b, 0xa1, 0x50, 0xf6, 0xaf,
+	0x60, 0xe3, 0x3c, 0xfd, 0x97, 0xc8, 0x91, 0x74, 0xdc, 0xa3, 0xbc, 0x24, 0xa6, 0x0e, 0x58, 0xd3,
+	0x24, 0x1d, 0x54, 0x0f, 0x6e, 0x4d, 0xd2, 0x5e, 0xc6, 0x3c, 0x8c, 0x76, 0x44, 0xab, 0x7d, 0x4f,
+	0x81, 0xdd, 0x01, 0xfb, 0x22, 0x1b, 0xda, 0x93, 0x16, 0x98, 0x8f, 0x30, 0xe3, 0x19, 0x15, 0xe6,
+	0x27, 0xd0, 0x2c, 0x60, 0x75, 0x26, 0x5a, 0x30, 0xe7, 0x05, 0x01, 0xcd, 0xd6, 0x04, 0x05, 0x88,
+	0x1c, 0x38, 0xc8, 0xf0, 0x9c, 0x1c, 0x4c, 0x93, 0xb4, 0xe5, 0x2d, 0x68, 0xbf, 0xca, 0xe1, 0xc5,
+	0x95, 0x2e, 0x6d, 0x09, 0x8b, 0xba, 0x25, 0xd8, 0x7b, 0x60, 0x4d, 0x0b, 0x5c, 0xa9, 0x19, 0xdd,
+	0xc8, 0xeb, 0x19, 0x57, 0x6b, 0x66, 0xbe, 0x01, 0x95, 0x30, 0xd0, 0x8f, 0x91, 0x4a, 0x18, 0x14,
+	0x0e, 0xa2, 0x32, 0x51, 0x00, 0x9b, 0xb0, 0x71, 0x9e, 0x32, 0x1d, 0x67, 0x13, 0x56, 0xbe, 0x8d,
+	0x43, 0xae, 0x2e, 0x60, 0x96, 0x98, 0x4f, 0xc1, 0xcc, 0x23, 0x2f, 0x51, 0x69, 0x3f, 0x19, 0xb0,
+	0x71, 0x40, 0x92, 0x34, 0x92, 0xdb, 0x6a, 0xe2, 0x51, 0x8c, 0xf9, 0x77, 0x24, 0xa5, 0xb1, 0x17,
+	0x65, 0x7e, 0x7f, 0x08, 0x4b, 0xa2, 0x1e, 0x5c, 0x9f, 0xa2, 0xc7, 0x31, 0x70, 0xe3, 0xec, 0x45,
+	0x55, 0x17, 0xe8, 0x07, 0x0a, 0xfb, 0x3d, 0x13, 0xaf, 0x2e, 0xcf, 0x17, 0x4a, 0xf3, 0x83, 0x03,
+	0x14, 0x4a, 0x0e, 0x8f, 0xaf, 0xa0, 0x36, 0x90, 0x9e, 0xb9, 0x5e, 0x14, 0x7a, 0x6a, 0x80, 0x54,
+	0xb7, 0x57, 0x27, 0x37, 0xf0, 0x1d, 0x41, 0x74, 0xaa, 0x8a, 0x55, 0x02, 0xe6, 0x67, 0xd0, 0xca,
+	0xb5, 0xaa, 0xf1, 0xa2, 0x3a, 0x2b, 0x6d, 0x34, 0x73, 0xb4, 0xd1, 0xbe, 0x7a, 0x0b, 0x6e, 0x9e,
+	0x1b, 0x97, 0x4e, 0xe1, 0x5f, 0x0c, 0x95, 0x2e, 0x9d, 0xe8, 0x2c, 0xde, 0x5f, 0xc2, 0xbc, 0xe2,
+	0xb7, 0x8c, 0x8b, 0x1c, 0xd4, 0x4c, 0xe7, 0xfa, 0x56, 0x39, 0xd7, 0xb7, 0xb2, 0x8c, 0xce, 0x94,
+	0x64, 0x54, 0xf4, 0xf7, 0x82, 0x7f, 0xe3, 0x15, 0xe8, 0x21, 0x0e, 0x08, 0xc7, 0xe2, 0xe1, 0xff,
+	0xd9, 0x80, 0x56, 0x11, 0xaf, 0xcf, 0xff, 0x1e, 0x34, 0x03, 0x4c, 0x28, 0xfa, 0xd2, 0x58, 0xb1,
+	0x14, 0xee, 0x57, 0x2c, 0xc3, 0x31, 0xc7, 0xe4, 0x91, 0x8f, 0xf7, 0xa1, 0xae, 0x0f, 0x4b, 0xcf,
+	0x8c, 0xca, 0x65, 0x66, 0x46, 0x6d, 0x90, 0x83, 0xc4, 0x15, 0x7e, 0x19, 0x07, 0xa4, 0xcc, 0xd9,
+	0x0e, 0x58, 0xd3, 0x24, 0x1d, 0xdf, 0xf5, 0xd1, 0x90, 0x7c, 0xed, 0xb1, 0x03, 0x4a, 0x04, 0x4b,
+	0x90, 0x09, 0xfe, 0x02, 0x3a, 0x65, 0x44, 0x2d, 0xfa, 0x4f, 0xf1, 0x2b, 0x2a, 0x16, 0x6f, 0xc5,
+	0xbb, 0x1e, 0x68, 0xc9, 0xe9, 0x54, 0xca, 0xea, 0xfd, 0x4b, 0x68, 0xcb, 0x67, 0x82, 0x48, 0x10,
+	0xe5, 0x25, 0x6f, 0x84, 0x55, 0x49, 0x9e, 0xec, 0x96, 0xd3, 0xcf, 0xad, 0xd9, 0x92, 0xe7, 0x56,
+	0x13, 0x56, 0x72, 0x71, 0xe8, 0xe8, 0x9e, 0xe4, 0x63, 0x77, 0x50, 0xda, 0xc5, 0xe0, 0x6a, 0x61,
+	0xda, 0x37, 0xe0, 0x7a, 0xa9, 0x32, 0x6d, 0xeb, 0x8f, 0xa2, 0xcf, 0x17, 0x06, 0xd8, 0x4e, 0x1c,
+	0x88, 0x1f, 0x23, 0xf2, 0xab, 0x86, 0xf9, 0x3b, 0x58, 0x65, 0x9c, 0x24, 0xf9, 0xe0, 0xdd, 0x01,
+	0x09, 0xb2, 0xd7, 0xf5, 0x9d, 0x92, 0x0d, 0xa6, 0x38, 0x14, 0x49, 0x80, 0x4e, 0x93, 0x4d, 0x23,
+	0xc5, 0xe3, 0xe5, 0xf6, 0x85, 0x0e, 0x8c, 0x7e, 0x88, 0xa8, 0x1f, 0x0d, 0x7b, 0x34, 0x0c, 0xdc,
+	0x4b, 0xed, 0x4e, 0xb2, 0xde, 0x6b, 0x4a, 0x42, 0x61, 0xcc, 0xdf, 0x8c, 0xd6, 0x22, 0x55, 0xe2,
+	0x1f, 0xbe, 0xcd, 0xe9, 0xe9, 0xfd, 0x48, 0xd7, 0x61, 0xb1, 0x91, 0x88, 0x4d, 0x67, 0x92, 0x70,
+	0x89, 0x8e, 0x9c, 0x42, 0xfd, 0xbe, 0xe7, 0x1f, 0xa7, 0xa3, 0x4d, 0x76, 0x13, 0xaa, 0x3e, 0x89,
+	0xfd, 0x94, 0x52, 0x8c, 0xfd, 0xa1, 0xee, 0xbd, 0x79, 0x94, 0xe0, 0x90, 0xcf, 0x51, 0x55, 0x2e,
+	0xfa, 0x0d, 0x9b, 0x47, 0x09, 0x8e, 0x30, 0xf6, 0x29, 0x0e, 0x30, 0xe6, 0x5e, 0xa4, 0xeb, 0x33,
+	0x8f, 0xb2, 0xbf, 0x84, 0x46, 0x66, 0x56, 0x3b, 0x79, 0x07, 0xe6, 0xf0, 0x64, 0x5c, 0x4e, 0x8d,
+	0x6e, 0xf6, 0x2f, 0x9b, 0x5d, 0x81, 0x75, 0x14, 0x51, 0xcf, 0x62, 0x4e, 0x28, 0xee, 0x51, 0x32,
+	0x28, 0x78, 0x6e, 0xef, 0xc0, 0x7a, 0x09, 0xed, 0x9d, 0xd4, 0xff, 0x1e, 0x6a, 0xaf, 0xde, 0x3a,
+	0xc3, 0x45, 0x3e, 0x4f, 0x09, 0x3d, 0x3e, 0x8c, 0xc8, 0x69, 0x36, 0x4a, 0x33, 0x58, 0xd0, 0x8e,
+	0x71, 0xc8, 0x12, 0xcf, 0x47, 0xfd, 0xab, 0xde, 0x08, 0xb6, 0xbf, 0x81, 0xfa, 0xab, 0xab, 0x0e,
+	0xfc, 0xfb, 0x9f, 0xfe, 0xd8, 0x3d, 0x09, 0x39, 0x32, 0xd6, 0x0d, 0xc9, 0x96, 0xfa, 0xda, 0xea,
+	0x93, 0xad, 0x13, 0xbe, 0x25, 0xff, 0xa7, 0xb5, 0x35, 0xf5, 0x08, 0xee, 0xcd, 0x4b, 0xc2, 0xbd,
+	0xff, 0x0d, 0x00, 0xaa, 0x4e, 0x8d, 0xa8, 0x5d, 0x1b, 0x00, 0x00,
 }
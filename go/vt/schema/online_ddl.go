@@ -32,7 +32,7 @@ var (
 	migrationBasePath                 = "schema-migration"
 	onlineDdlUUIDRegexp               = regexp.MustCompile(`^[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}$`)
 	strategyParserRegexp              = regexp.MustCompile(`^([\S]+)\s+(.*)$`)
-	onlineDDLGeneratedTableNameRegexp = regexp.MustCompile(`^_[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}_([0-9]{14})_(gho|ghc|del|new)$`)
+	onlineDDLGeneratedTableNameRegexp = regexp.MustCompile(`^_[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}_([0-9]{14})_(gho|ghc|del|new|vrepl)$`)
 	ptOSCGeneratedTableNameRegexp     = regexp.MustCompile(`^_.*_old$`)
 )
 
@@ -76,12 +76,14 @@ const (
 	OnlineDDLStatusFailed    OnlineDDLStatus = "failed"
 )
 
-// DDLStrategy suggests how an ALTER TABLE should run (e.g. "" for normal, "gh-ost" or "pt-osc")
+// DDLStrategy suggests how an ALTER TABLE should run (e.g. "" for normal, "online", "gh-ost" or "pt-osc")
 type DDLStrategy string
 
 const (
 	// DDLStrategyDirect means not an online-ddl migration. Just a normal MySQL ALTER TABLE
 	DDLStrategyDirect DDLStrategy = "direct"
+	// DDLStrategyOnline requests vreplication to run the migration
+	DDLStrategyOnline DDLStrategy = "online"
 	// DDLStrategyGhost requests gh-ost to run the migration
 	DDLStrategyGhost DDLStrategy = "gh-ost"
 	// DDLStrategyPTOSC requests pt-online-schema-change to run the migration
@@ -92,12 +94,15 @@ const (
 // A strategy is direct if it's not explciitly one of the online DDL strategies
 func (s DDLStrategy) IsDirect() bool {
 	switch s {
-	case DDLStrategyGhost, DDLStrategyPTOSC:
+	case DDLStrategyOnline, DDLStrategyGhost, DDLStrategyPTOSC:
 		return false
 	}
 	return true
 }
 
+// RevertActionStr is the ddl_action of a migration which reverts another migration
+const RevertActionStr = "revert"
+
 // OnlineDDL encapsulates the relevant information in an online schema change request
 type OnlineDDL struct {
 	Keyspace       string          `json:"keyspace,omitempty"`
@@ -125,7 +130,7 @@ func ParseDDLStrategy(strategyVariable string) (strategy DDLStrategy, options st
 	switch strategy = DDLStrategy(strategyName); strategy {
 	case "": // backwards compatiblity and to handle unspecified values
 		return DDLStrategyDirect, options, nil
-	case DDLStrategyGhost, DDLStrategyPTOSC, DDLStrategyDirect:
+	case DDLStrategyOnline, DDLStrategyGhost, DDLStrategyPTOSC, DDLStrategyDirect:
 		return strategy, options, nil
 	default:
 		return DDLStrategyDirect, options, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
@@ -166,6 +171,23 @@ func ParseOnlineDDLStatement(sql string) (ddlStmt sqlparser.DDLStatement, action
 	return ddlStmt, action, fmt.Errorf("Unsupported query type: %s", sql)
 }
 
+// ParseRevertStatement parses the given SQL as a REVERT statement, and returns the UUID of the
+// migration to revert, or error if the statement is not a valid REVERT
+func ParseRevertStatement(sql string) (uuid string, err error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing statement: SQL=%s, error=%+v", sql, err)
+	}
+	revert, ok := stmt.(*sqlparser.RevertMigration)
+	if !ok {
+		return "", fmt.Errorf("Not a REVERT statement: %s", sql)
+	}
+	if !IsOnlineDDLUUID(revert.UUID) {
+		return "", fmt.Errorf("Not an online DDL UUID: '%s'", revert.UUID)
+	}
+	return revert.UUID, nil
+}
+
 // NewOnlineDDL creates a schema change request with self generated UUID and RequestTime
 func NewOnlineDDL(keyspace string, table string, sql string, strategy DDLStrategy, options string, requestContext string) (*OnlineDDL, error) {
 	u, err := createUUID("_")
@@ -206,8 +228,23 @@ func (onlineDDL *OnlineDDL) GetAction() (action sqlparser.DDLAction, err error)
 	return action, err
 }
 
+// GetRevertUUID returns the UUID of the migration this migration reverts, or error if this
+// migration is not a REVERT
+func (onlineDDL *OnlineDDL) GetRevertUUID() (uuid string, err error) {
+	return ParseRevertStatement(onlineDDL.SQL)
+}
+
+// IsRevert returns true when this migration reverts another migration
+func (onlineDDL *OnlineDDL) IsRevert() bool {
+	_, err := onlineDDL.GetRevertUUID()
+	return err == nil
+}
+
 // GetActionStr returns a string representation of the DDL action
 func (onlineDDL *OnlineDDL) GetActionStr() (actionStr string, err error) {
+	if onlineDDL.IsRevert() {
+		return RevertActionStr, nil
+	}
 	action, err := onlineDDL.GetAction()
 	if err != nil {
 		return actionStr, err
@@ -261,8 +298,8 @@ func OnlineDDLToGCUUID(uuid string) string {
 }
 
 // IsOnlineDDLTableName answers 'true' when the given table name _appears to be_ a name
-// generated by an online DDL operation; either the name determined by the online DDL Executor, or
-// by pt-online-schema-change.
+// generated by an online DDL operation; either the name determined by the online DDL Executor
+// (for gh-ost or vreplication), or by pt-online-schema-change.
 // There is no guarantee that the tables _was indeed_ generated by an online DDL flow.
 func IsOnlineDDLTableName(tableName string) bool {
 	if onlineDDLGeneratedTableNameRegexp.MatchString(tableName) {
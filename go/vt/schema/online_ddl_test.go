@@ -30,11 +30,13 @@ func TestCreateUUID(t *testing.T) {
 
 func TestIsDirect(t *testing.T) {
 	assert.True(t, DDLStrategyDirect.IsDirect())
+	assert.False(t, DDLStrategyOnline.IsDirect())
 	assert.False(t, DDLStrategyGhost.IsDirect())
 	assert.False(t, DDLStrategyPTOSC.IsDirect())
 	assert.True(t, DDLStrategy("").IsDirect())
 	assert.False(t, DDLStrategy("gh-ost").IsDirect())
 	assert.False(t, DDLStrategy("pt-osc").IsDirect())
+	assert.False(t, DDLStrategy("online").IsDirect())
 	assert.True(t, DDLStrategy("something").IsDirect())
 }
 
@@ -49,6 +51,10 @@ func TestParseDDLStrategy(t *testing.T) {
 			strategyVariable: "direct",
 			strategy:         DDLStrategyDirect,
 		},
+		{
+			strategyVariable: "online",
+			strategy:         DDLStrategyOnline,
+		},
 		{
 			strategyVariable: "gh-ost",
 			strategy:         DDLStrategyGhost,
@@ -131,6 +137,14 @@ func TestGetActionStr(t *testing.T) {
 			statement: "rename table t to t2",
 			isError:   true,
 		},
+		{
+			statement: "revert 'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a'",
+			actionStr: RevertActionStr,
+		},
+		{
+			statement: "revert 'not-a-uuid'",
+			isError:   true,
+		},
 	}
 	for _, ts := range tt {
 		onlineDDL := &OnlineDDL{SQL: ts.statement}
@@ -144,12 +158,25 @@ func TestGetActionStr(t *testing.T) {
 	}
 }
 
+func TestParseRevertStatement(t *testing.T) {
+	uuid, err := ParseRevertStatement("revert 'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a'")
+	assert.NoError(t, err)
+	assert.Equal(t, "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a", uuid)
+
+	_, err = ParseRevertStatement("alter table t drop column c")
+	assert.Error(t, err)
+
+	_, err = ParseRevertStatement("revert 'a0638f6b-ec7b-11ea-9bf8-000d3a9b8a9a'")
+	assert.Error(t, err)
+}
+
 func TestIsOnlineDDLTableName(t *testing.T) {
 	names := []string{
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_gho",
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_ghc",
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_del",
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114013_new",
+		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114013_vrepl",
 		"_table_old",
 		"__table_old",
 	}
@@ -163,6 +190,7 @@ func TestIsOnlineDDLTableName(t *testing.T) {
 		"_table_gho",
 		"_table_ghc",
 		"_table_del",
+		"_table_vrepl",
 		"table_old",
 	}
 	for _, tableName := range irrelevantNames {
@@ -0,0 +1,225 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package schemadiff computes the DDL statements needed to bring a schema, described
+as a set of CREATE TABLE statements, to a desired state described the same way.
+
+Tables are matched by name, and a table that exists on both sides is diffed by its
+columns, indexes, foreign keys, partitioning and table options. The resulting
+statements are ordered so that they can be applied one by one: new tables are
+created first, with referenced tables created before the tables that reference them,
+then existing tables are altered, and finally removed tables are dropped, with
+referencing tables dropped before the tables they reference.
+*/
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// Schema is a set of tables, as described by CREATE TABLE statements
+type Schema struct {
+	tables map[string]*sqlparser.CreateTable
+}
+
+// NewSchemaFromSQL parses a semicolon delimited list of CREATE TABLE statements
+func NewSchemaFromSQL(sql string) (*Schema, error) {
+	queries, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+	return NewSchemaFromQueries(queries)
+}
+
+// NewSchemaFromQueries parses the given CREATE TABLE statements. Any other kind of statement,
+// or a CREATE TABLE statement that sqlparser cannot fully parse, is an error, since the
+// schema could not then be reliably diffed.
+func NewSchemaFromQueries(queries []string) (*Schema, error) {
+	s := &Schema{tables: make(map[string]*sqlparser.CreateTable)}
+	for _, query := range queries {
+		if strings.TrimSpace(query) == "" {
+			continue
+		}
+		stmt, err := sqlparser.ParseStrictDDL(query)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse %q: %v", query, err)
+		}
+		createTable, ok := stmt.(*sqlparser.CreateTable)
+		if !ok {
+			return nil, fmt.Errorf("expected a CREATE TABLE statement, got: %s", sqlparser.String(stmt))
+		}
+		if !createTable.FullyParsed || createTable.TableSpec == nil {
+			return nil, fmt.Errorf("cannot fully parse CREATE TABLE statement: %s", query)
+		}
+		name := createTable.Table.Name.String()
+		if _, ok := s.tables[name]; ok {
+			return nil, fmt.Errorf("duplicate definition of table %s", name)
+		}
+		if err := normalizeTable(createTable); err != nil {
+			return nil, fmt.Errorf("table %s: %v", name, err)
+		}
+		s.tables[name] = createTable
+	}
+	return s, nil
+}
+
+// TableNames returns the sorted names of the tables in the schema
+func (s *Schema) TableNames() []string {
+	names := make([]string, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Table returns the normalized CREATE TABLE statement of the given table, or nil if there is
+// no such table
+func (s *Schema) Table(name string) *sqlparser.CreateTable {
+	return s.tables[name]
+}
+
+// Diff returns the statements that transform this schema into the desired schema. The
+// statements are *sqlparser.CreateTable, *sqlparser.AlterTable and *sqlparser.DropTable, in the
+// order in which they should be applied. An empty result means the schemas are equivalent.
+func (s *Schema) Diff(desired *Schema) ([]sqlparser.Statement, error) {
+	var created, dropped []string
+	var stmts []sqlparser.Statement
+	for _, name := range desired.TableNames() {
+		if _, ok := s.tables[name]; !ok {
+			created = append(created, name)
+		}
+	}
+	for _, name := range s.TableNames() {
+		if _, ok := desired.tables[name]; !ok {
+			dropped = append(dropped, name)
+		}
+	}
+
+	created, err := sortByForeignKeys(desired.tables, created)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range created {
+		stmts = append(stmts, desired.tables[name])
+	}
+
+	for _, name := range s.TableNames() {
+		to, ok := desired.tables[name]
+		if !ok {
+			continue
+		}
+		alterTable, err := diffTables(s.tables[name], to)
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %v", name, err)
+		}
+		if alterTable != nil {
+			stmts = append(stmts, alterTable)
+		}
+	}
+
+	dropped, err = sortByForeignKeys(s.tables, dropped)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(dropped) - 1; i >= 0; i-- {
+		stmts = append(stmts, &sqlparser.DropTable{
+			FromTables: sqlparser.TableNames{s.tables[dropped[i]].Table},
+		})
+	}
+	return stmts, nil
+}
+
+// DiffSQL parses the current and desired schemas, each a semicolon delimited list of CREATE
+// TABLE statements, and returns the DDL statements that transform the former into the latter.
+func DiffSQL(current, desired string) ([]string, error) {
+	from, err := NewSchemaFromSQL(current)
+	if err != nil {
+		return nil, err
+	}
+	to, err := NewSchemaFromSQL(desired)
+	if err != nil {
+		return nil, err
+	}
+	stmts, err := from.Diff(to)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		queries = append(queries, sqlparser.String(stmt))
+	}
+	return queries, nil
+}
+
+// sortByForeignKeys orders the given tables so that a table comes after any of the given
+// tables it references through a foreign key. Tables that do not depend on each other keep
+// their relative order.
+func sortByForeignKeys(tables map[string]*sqlparser.CreateTable, names []string) ([]string, error) {
+	pending := make(map[string]bool, len(names))
+	for _, name := range names {
+		pending[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for len(sorted) < len(names) {
+		progress := false
+		for _, name := range names {
+			if !pending[name] {
+				continue
+			}
+			ready := true
+			for _, referenced := range referencedTables(tables[name]) {
+				if pending[referenced] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				sorted = append(sorted, name)
+				delete(pending, name)
+				progress = true
+			}
+		}
+		if !progress {
+			var cycle []string
+			for _, name := range names {
+				if pending[name] {
+					cycle = append(cycle, name)
+				}
+			}
+			return nil, fmt.Errorf("circular foreign key dependency between tables: %s", strings.Join(cycle, ", "))
+		}
+	}
+	return sorted, nil
+}
+
+// referencedTables returns the names of the other tables referenced by the foreign keys of the
+// given table
+func referencedTables(createTable *sqlparser.CreateTable) []string {
+	var referenced []string
+	for _, fk := range foreignKeys(createTable.TableSpec) {
+		name := fk.ReferencedTable.Name.String()
+		if name != createTable.Table.Name.String() {
+			referenced = append(referenced, name)
+		}
+	}
+	return referenced
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSchemaFromSQL(t *testing.T) {
+	s, err := NewSchemaFromSQL("create table t2 (id int);\ncreate table t1 (id int);\n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"t1", "t2"}, s.TableNames())
+	assert.NotNil(t, s.Table("t1"))
+	assert.Nil(t, s.Table("t3"))
+
+	_, err = NewSchemaFromSQL("create table t1 (id int); create table t1 (id bigint)")
+	assert.EqualError(t, err, "duplicate definition of table t1")
+
+	_, err = NewSchemaFromSQL("create table t1 (id int); create view v1 as select id from t1")
+	assert.EqualError(t, err, "expected a CREATE TABLE statement, got: create view v1 as select id from t1")
+
+	_, err = NewSchemaFromSQL("create table t1 like t2")
+	assert.Error(t, err)
+}
+
+func TestDiffSchemas(t *testing.T) {
+	current := `
+create table parent (id int, primary key (id));
+create table child (id int, parent_id int, primary key (id), constraint child_parent_fk foreign key (parent_id) references parent (id));
+create table grandchild (id int, child_id int, primary key (id), constraint grandchild_child_fk foreign key (child_id) references child (id));
+create table unchanged (id int, primary key (id));
+create table changed (id int, primary key (id));
+`
+	desired := `
+create table unchanged (id int, primary key (id));
+create table changed (id int, val int, primary key (id));
+create table new_child (id int, new_parent_id int, primary key (id), constraint new_child_new_parent_fk foreign key (new_parent_id) references new_parent (id));
+create table new_parent (id int, primary key (id));
+`
+	diff, err := DiffSQL(current, desired)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"create table new_parent (\n\tid int,\n\tprimary key (id)\n)",
+		"create table new_child (\n\tid int,\n\tnew_parent_id int,\n\tprimary key (id),\n\tkey new_child_new_parent_fk (new_parent_id),\n\tconstraint new_child_new_parent_fk foreign key (new_parent_id) references new_parent (id)\n)",
+		"alter table changed add column val int after id",
+		"drop table grandchild",
+		"drop table child",
+		"drop table parent",
+	}, diff)
+
+	diff, err = DiffSQL(desired, desired)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestDiffSchemasCircularForeignKeys(t *testing.T) {
+	desired := `
+create table a (id int, b_id int, primary key (id), constraint a_b_fk foreign key (b_id) references b (id));
+create table b (id int, a_id int, primary key (id), constraint b_a_fk foreign key (a_id) references a (id));
+`
+	_, err := DiffSQL("", desired)
+	assert.EqualError(t, err, "circular foreign key dependency between tables: a, b")
+}
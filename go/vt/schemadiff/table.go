@@ -0,0 +1,396 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// noKeyOption is the KeyOpt of a column that is not declared as a key inline
+var noKeyOption sqlparser.ColumnKeyOption
+
+// defaultIntegerWidths are the display widths MySQL gives integer types (signed, unsigned)
+// when none is specified. SHOW CREATE TABLE prints them, so they are dropped before comparing.
+var defaultIntegerWidths = map[string][2]string{
+	"tinyint":   {"4", "3"},
+	"smallint":  {"6", "5"},
+	"mediumint": {"9", "8"},
+	"int":       {"11", "10"},
+	"bigint":    {"20", "20"},
+}
+
+// normalizeTable rewrites a CREATE TABLE statement into the canonical form MySQL would show
+// for it, so that equivalent definitions compare equal: indexes and foreign keys are named,
+// foreign keys get the index MySQL would implicitly create for them, and type names, default
+// display widths and redundant defaults are normalized.
+func normalizeTable(createTable *sqlparser.CreateTable) error {
+	spec := createTable.TableSpec
+	for _, col := range spec.Columns {
+		if col.Type.KeyOpt != noKeyOption {
+			return fmt.Errorf("column %s: inline key definitions are not supported, declare the index separately", col.Name.String())
+		}
+		normalizeColumnType(&col.Type)
+	}
+
+	indexNames := make(map[string]bool)
+	for _, idx := range spec.Indexes {
+		if !idx.Info.Name.IsEmpty() {
+			indexNames[idx.Info.Name.Lowered()] = true
+		}
+	}
+	for _, idx := range spec.Indexes {
+		normalizeIndexInfo(idx.Info)
+		if idx.Info.Name.IsEmpty() {
+			idx.Info.Name = uniqueIndexName(idx.Columns[0].Column.String(), indexNames)
+		}
+	}
+
+	numForeignKeys := 0
+	for _, c := range spec.Constraints {
+		fk, ok := c.Details.(*sqlparser.ForeignKeyDefinition)
+		if !ok {
+			continue
+		}
+		numForeignKeys++
+		indexName := c.Name
+		if c.Name == "" {
+			c.Name = fmt.Sprintf("%s_ibfk_%d", createTable.Table.Name.String(), numForeignKeys)
+			indexName = fk.Source[0].String()
+		}
+		fk.OnDelete = normalizeReferenceAction(fk.OnDelete)
+		fk.OnUpdate = normalizeReferenceAction(fk.OnUpdate)
+		if !hasIndexPrefix(spec.Indexes, fk.Source) {
+			idx := &sqlparser.IndexDefinition{
+				Info: &sqlparser.IndexInfo{Type: "key", Name: uniqueIndexName(indexName, indexNames)},
+			}
+			for _, col := range fk.Source {
+				idx.Columns = append(idx.Columns, &sqlparser.IndexColumn{Column: col})
+			}
+			spec.Indexes = append(spec.Indexes, idx)
+		}
+	}
+	return nil
+}
+
+func normalizeColumnType(ct *sqlparser.ColumnType) {
+	ct.Type = strings.ToLower(ct.Type)
+	if ct.Type == "integer" {
+		ct.Type = "int"
+	}
+	ct.Charset = strings.ToLower(ct.Charset)
+	ct.Collate = strings.ToLower(ct.Collate)
+	if _, ok := ct.Default.(*sqlparser.NullVal); ok && !ct.NotNull {
+		ct.Default = nil
+	}
+	if widths, ok := defaultIntegerWidths[ct.Type]; ok && ct.Length != nil && !ct.Zerofill {
+		width := widths[0]
+		if ct.Unsigned {
+			width = widths[1]
+		}
+		if string(ct.Length.Val) == width {
+			ct.Length = nil
+		}
+	}
+}
+
+func normalizeIndexInfo(info *sqlparser.IndexInfo) {
+	switch {
+	case info.Primary:
+		info.Type = "primary key"
+		info.Name = sqlparser.NewColIdent("PRIMARY")
+	case info.Spatial:
+		info.Type = "spatial key"
+	case info.Fulltext:
+		info.Type = "fulltext key"
+	case info.Unique:
+		info.Type = "unique key"
+		if info.Name.IsEmpty() {
+			info.Name = info.ConstraintName
+		}
+	default:
+		info.Type = "key"
+	}
+	info.ConstraintName = sqlparser.NewColIdent("")
+}
+
+// uniqueIndexName returns the given name, suffixed like MySQL does if an index of that name
+// already exists, and records it as taken
+func uniqueIndexName(name string, taken map[string]bool) sqlparser.ColIdent {
+	candidate := name
+	for i := 2; taken[strings.ToLower(candidate)]; i++ {
+		candidate = fmt.Sprintf("%s_%d", name, i)
+	}
+	taken[strings.ToLower(candidate)] = true
+	return sqlparser.NewColIdent(candidate)
+}
+
+// normalizeReferenceAction maps the actions InnoDB treats as the default one to it
+func normalizeReferenceAction(action sqlparser.ReferenceAction) sqlparser.ReferenceAction {
+	if action == sqlparser.Restrict || action == sqlparser.NoAction {
+		return sqlparser.DefaultAction
+	}
+	return action
+}
+
+// hasIndexPrefix returns true if one of the indexes starts with the given columns
+func hasIndexPrefix(indexes []*sqlparser.IndexDefinition, cols sqlparser.Columns) bool {
+	for _, idx := range indexes {
+		if len(idx.Columns) < len(cols) {
+			continue
+		}
+		match := true
+		for i, col := range cols {
+			if !idx.Columns[i].Column.Equal(col) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// foreignKeys returns the foreign key definitions of a table
+func foreignKeys(spec *sqlparser.TableSpec) []*sqlparser.ForeignKeyDefinition {
+	var fks []*sqlparser.ForeignKeyDefinition
+	for _, c := range spec.Constraints {
+		if fk, ok := c.Details.(*sqlparser.ForeignKeyDefinition); ok {
+			fks = append(fks, fk)
+		}
+	}
+	return fks
+}
+
+// diffTables returns the ALTER TABLE statement that transforms one normalized table definition
+// into another, or nil if they are equivalent. Changed indexes and foreign keys are dropped and
+// re-added, and foreign keys are dropped before and added after any index change.
+func diffTables(from, to *sqlparser.CreateTable) (*sqlparser.AlterTable, error) {
+	fromSpec, toSpec := from.TableSpec, to.TableSpec
+	var options []sqlparser.AlterOption
+
+	if checkConstraints(fromSpec) != checkConstraints(toSpec) {
+		return nil, fmt.Errorf("changing check constraints is not supported")
+	}
+
+	fromFKs, toFKs := foreignKeysByName(fromSpec), foreignKeysByName(toSpec)
+	for _, c := range fromSpec.Constraints {
+		if _, ok := c.Details.(*sqlparser.ForeignKeyDefinition); !ok {
+			continue
+		}
+		if other, ok := toFKs[strings.ToLower(c.Name)]; !ok || sqlparser.String(other) != sqlparser.String(c) {
+			options = append(options, &sqlparser.DropKey{Type: sqlparser.ForeignKeyType, Name: c.Name})
+		}
+	}
+
+	fromIndexes, toIndexes := indexesByName(fromSpec), indexesByName(toSpec)
+	for _, idx := range fromSpec.Indexes {
+		if other, ok := toIndexes[idx.Info.Name.Lowered()]; ok && sqlparser.String(other) == sqlparser.String(idx) {
+			continue
+		}
+		if idx.Info.Primary {
+			options = append(options, &sqlparser.DropKey{Type: sqlparser.PrimaryKeyType})
+		} else {
+			options = append(options, &sqlparser.DropKey{Type: sqlparser.NormalKeyType, Name: sqlparser.String(idx.Info.Name)})
+		}
+	}
+
+	options = append(options, diffColumns(fromSpec.Columns, toSpec.Columns)...)
+
+	for _, idx := range toSpec.Indexes {
+		if other, ok := fromIndexes[idx.Info.Name.Lowered()]; !ok || sqlparser.String(other) != sqlparser.String(idx) {
+			options = append(options, &sqlparser.AddIndexDefinition{IndexDefinition: idx})
+		}
+	}
+
+	for _, c := range toSpec.Constraints {
+		if _, ok := c.Details.(*sqlparser.ForeignKeyDefinition); !ok {
+			continue
+		}
+		if other, ok := fromFKs[strings.ToLower(c.Name)]; !ok || sqlparser.String(other) != sqlparser.String(c) {
+			options = append(options, &sqlparser.AddConstraintDefinition{ConstraintDefinition: c})
+		}
+	}
+
+	if tableOptions := diffTableOptions(fromSpec.Options, toSpec.Options); len(tableOptions) > 0 {
+		options = append(options, tableOptions)
+	}
+
+	alterTable := &sqlparser.AlterTable{Table: from.Table, AlterOptions: options}
+	switch {
+	case toSpec.PartitionOption == nil && fromSpec.PartitionOption != nil:
+		alterTable.PartitionSpec = &sqlparser.PartitionSpec{Action: sqlparser.RemoveAction}
+	case toSpec.PartitionOption != nil && (fromSpec.PartitionOption == nil ||
+		sqlparser.String(toSpec.PartitionOption) != sqlparser.String(fromSpec.PartitionOption)):
+		alterTable.PartitionOption = toSpec.PartitionOption
+	case len(options) == 0:
+		return nil, nil
+	}
+	return alterTable, nil
+}
+
+// diffColumns returns the alter options that drop, add, modify and reorder columns. Columns are
+// positioned using AFTER only: once every desired column directly follows its predecessor in
+// the desired order, the first desired column is necessarily first.
+func diffColumns(from, to []*sqlparser.ColumnDefinition) []sqlparser.AlterOption {
+	var options []sqlparser.AlterOption
+	fromCols := make(map[string]*sqlparser.ColumnDefinition, len(from))
+	for _, col := range from {
+		fromCols[col.Name.Lowered()] = col
+	}
+	toCols := make(map[string]*sqlparser.ColumnDefinition, len(to))
+	for _, col := range to {
+		toCols[col.Name.Lowered()] = col
+	}
+
+	// order tracks the column order as it evolves through the generated alter options
+	var order []string
+	for _, col := range from {
+		if _, ok := toCols[col.Name.Lowered()]; !ok {
+			options = append(options, &sqlparser.DropColumn{Name: &sqlparser.ColName{Name: col.Name}})
+			continue
+		}
+		order = append(order, col.Name.Lowered())
+	}
+
+	for i, col := range to {
+		name := col.Name.Lowered()
+		var after *sqlparser.ColName
+		if i > 0 {
+			after = &sqlparser.ColName{Name: to[i-1].Name}
+		}
+		fromCol, ok := fromCols[name]
+		if !ok {
+			options = append(options, &sqlparser.AddColumns{Columns: []*sqlparser.ColumnDefinition{col}, After: after})
+			order = insertAfter(order, name, after)
+			continue
+		}
+		pos := indexOf(order, name)
+		if after == nil || (pos > 0 && order[pos-1] == after.Name.Lowered()) {
+			if sqlparser.String(fromCol) != sqlparser.String(col) {
+				options = append(options, &sqlparser.ModifyColumn{NewColDefinition: col})
+			}
+			continue
+		}
+		options = append(options, &sqlparser.ModifyColumn{NewColDefinition: col, After: after})
+		order = insertAfter(append(order[:pos], order[pos+1:]...), name, after)
+	}
+	return options
+}
+
+// insertAfter inserts name in order after the given column, or at the end if there is none
+func insertAfter(order []string, name string, after *sqlparser.ColName) []string {
+	if after == nil {
+		return append(order, name)
+	}
+	pos := indexOf(order, after.Name.Lowered()) + 1
+	order = append(order, "")
+	copy(order[pos+1:], order[pos:])
+	order[pos] = name
+	return order
+}
+
+func indexOf(order []string, name string) int {
+	for i, n := range order {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// diffTableOptions returns the table options that are new or changed in the desired table.
+// AUTO_INCREMENT is ignored, and a removed comment is cleared. Other removed options are
+// left alone, since MySQL has no way to reset them.
+func diffTableOptions(from, to sqlparser.TableOptions) sqlparser.TableOptions {
+	fromOptions := make(map[string]*sqlparser.TableOption, len(from))
+	for _, opt := range from {
+		fromOptions[tableOptionName(opt)] = opt
+	}
+	toOptions := make(map[string]*sqlparser.TableOption, len(to))
+	var options sqlparser.TableOptions
+	for _, opt := range to {
+		name := tableOptionName(opt)
+		toOptions[name] = opt
+		if name == "auto_increment" {
+			continue
+		}
+		if other, ok := fromOptions[name]; !ok || tableOptionValue(other) != tableOptionValue(opt) {
+			options = append(options, opt)
+		}
+	}
+	if opt, ok := fromOptions["comment"]; ok && tableOptionValue(opt) != "''" {
+		if _, ok := toOptions["comment"]; !ok {
+			options = append(options, &sqlparser.TableOption{Name: "comment", Value: sqlparser.NewStrLiteral([]byte(""))})
+		}
+	}
+	return options
+}
+
+func tableOptionName(opt *sqlparser.TableOption) string {
+	name := strings.ToLower(strings.Join(strings.Fields(opt.Name), " "))
+	if name == "charset" {
+		return "character set"
+	}
+	return name
+}
+
+func tableOptionValue(opt *sqlparser.TableOption) string {
+	switch {
+	case opt.String != "":
+		return strings.ToLower(opt.String)
+	case opt.Value != nil:
+		return sqlparser.String(opt.Value)
+	default:
+		return sqlparser.String(opt.Tables)
+	}
+}
+
+func foreignKeysByName(spec *sqlparser.TableSpec) map[string]*sqlparser.ConstraintDefinition {
+	fks := make(map[string]*sqlparser.ConstraintDefinition)
+	for _, c := range spec.Constraints {
+		if _, ok := c.Details.(*sqlparser.ForeignKeyDefinition); ok {
+			fks[strings.ToLower(c.Name)] = c
+		}
+	}
+	return fks
+}
+
+func indexesByName(spec *sqlparser.TableSpec) map[string]*sqlparser.IndexDefinition {
+	indexes := make(map[string]*sqlparser.IndexDefinition, len(spec.Indexes))
+	for _, idx := range spec.Indexes {
+		indexes[idx.Info.Name.Lowered()] = idx
+	}
+	return indexes
+}
+
+// checkConstraints returns a canonical string of the table's non foreign key constraints
+func checkConstraints(spec *sqlparser.TableSpec) string {
+	var checks []string
+	for _, c := range spec.Constraints {
+		if _, ok := c.Details.(*sqlparser.ForeignKeyDefinition); !ok {
+			checks = append(checks, sqlparser.String(c))
+		}
+	}
+	sort.Strings(checks)
+	return strings.Join(checks, ", ")
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTables(t *testing.T) {
+	tt := []struct {
+		name    string
+		from    string
+		to      string
+		diff    string
+		wantErr string
+	}{
+		{
+			name: "identical",
+			from: "create table t (id int, primary key (id))",
+			to:   "create table t (id int, primary key (id))",
+		},
+		{
+			name: "equivalent",
+			from: "CREATE TABLE `t` (`id` int(11) NOT NULL, `name` varchar(64) DEFAULT NULL, PRIMARY KEY (`id`), KEY `name_idx` (`name`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+			to:   "create table t (id integer not null, name varchar(64), primary key (id), index name_idx (name)) engine innodb charset utf8mb4",
+		},
+		{
+			name: "add column",
+			from: "create table t (id int, primary key (id))",
+			to:   "create table t (id int, name varchar(64), primary key (id))",
+			diff: "alter table t add column `name` varchar(64) after id",
+		},
+		{
+			name: "add first column",
+			from: "create table t (id int, val int)",
+			to:   "create table t (x int, id int, val int)",
+			diff: "alter table t add column x int, modify column id int after x, modify column val int after id",
+		},
+		{
+			name: "drop column",
+			from: "create table t (id int, val int, primary key (id))",
+			to:   "create table t (id int, primary key (id))",
+			diff: "alter table t drop column val",
+		},
+		{
+			name: "modify column",
+			from: "create table t (id int, val int)",
+			to:   "create table t (id int, val bigint not null default 0)",
+			diff: "alter table t modify column val bigint not null default 0",
+		},
+		{
+			name: "reorder columns",
+			from: "create table t (a int, b int, c int)",
+			to:   "create table t (a int, c int, b int)",
+			diff: "alter table t modify column c int after a",
+		},
+		{
+			name: "change index",
+			from: "create table t (id int, a int, b int, primary key (id), key ab (a))",
+			to:   "create table t (id int, a int, b int, primary key (id, a), key ab (a, b), unique key (b))",
+			diff: "alter table t drop primary key, drop key ab, add primary key (id, a), add key ab (a, b), add unique key b (b)",
+		},
+		{
+			name: "add foreign key",
+			from: "create table t (id int, parent_id int, primary key (id))",
+			to:   "create table t (id int, parent_id int, primary key (id), constraint fk foreign key (parent_id) references p (id) on delete cascade)",
+			diff: "alter table t add key fk (parent_id), add constraint fk foreign key (parent_id) references p (id) on delete cascade",
+		},
+		{
+			name: "change foreign key",
+			from: "create table t (id int, parent_id int, primary key (id), key fk (parent_id), constraint fk foreign key (parent_id) references p (id) on delete restrict)",
+			to:   "create table t (id int, parent_id int, primary key (id), constraint fk foreign key (parent_id) references p (id) on delete cascade)",
+			diff: "alter table t drop foreign key fk, add constraint fk foreign key (parent_id) references p (id) on delete cascade",
+		},
+		{
+			name: "drop foreign key",
+			from: "create table t (id int, parent_id int, primary key (id), key parent_idx (parent_id), constraint fk foreign key (parent_id) references p (id))",
+			to:   "create table t (id int, parent_id int, primary key (id))",
+			diff: "alter table t drop foreign key fk, drop key parent_idx",
+		},
+		{
+			name: "table options",
+			from: "create table t (id int) engine=MyISAM auto_increment=100 comment 'old'",
+			to:   "create table t (id int) engine=InnoDB auto_increment=1",
+			diff: "alter table t engine InnoDB comment ''",
+		},
+		{
+			name: "add partitioning",
+			from: "create table t (id int)",
+			to:   "create table t (id int) partition by hash (id) partitions 4",
+			diff: "alter table t partition by hash(id) partitions 4",
+		},
+		{
+			name: "change partitioning",
+			from: "create table t (id int, val int) partition by hash (id) partitions 4",
+			to:   "create table t (id int) partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+			diff: "alter table t drop column val partition by range(id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+		},
+		{
+			name: "remove partitioning",
+			from: "create table t (id int) partition by key (id) partitions 2",
+			to:   "create table t (id int)",
+			diff: "alter table t remove partitioning",
+		},
+		{
+			name:    "inline key",
+			from:    "create table t (id int)",
+			to:      "create table t (id int primary key)",
+			wantErr: "inline key definitions are not supported",
+		},
+		{
+			name:    "check constraint",
+			from:    "create table t (id int)",
+			to:      "create table t (id int, constraint positive check (id > 0))",
+			wantErr: "changing check constraints is not supported",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := DiffSQL(tc.from, tc.to)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.diff == "" {
+				assert.Empty(t, diff)
+				return
+			}
+			assert.Equal(t, []string{tc.diff}, diff)
+		})
+	}
+}
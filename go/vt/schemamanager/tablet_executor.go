@@ -143,6 +143,8 @@ func (exec *TabletExecutor) parseDDLs(sqls []string) ([]sqlparser.DDLStatement,
 			parsedDDLs = append(parsedDDLs, ddl)
 		case sqlparser.DBDDLStatement:
 			parsedDBDDLs = append(parsedDBDDLs, ddl)
+		case *sqlparser.RevertMigration:
+			// A REVERT is always an online migration, and is not a big schema change.
 		default:
 			if len(exec.tablets) != 1 {
 				return nil, nil, fmt.Errorf("non-ddl statements can only be executed for single shard keyspaces: %s", sql)
@@ -212,7 +214,18 @@ func (exec *TabletExecutor) detectBigSchemaChanges(ctx context.Context, parsedDD
 }
 
 func (exec *TabletExecutor) preflightSchemaChanges(ctx context.Context, sqls []string) error {
-	_, err := exec.wr.TabletManagerClient().PreflightSchema(ctx, exec.tablets[0], sqls)
+	// REVERT statements are not MySQL statements, and revert to a schema which was already applied.
+	var preflightSqls []string
+	for _, sql := range sqls {
+		if _, err := schema.ParseRevertStatement(sql); err == nil {
+			continue
+		}
+		preflightSqls = append(preflightSqls, sql)
+	}
+	if len(preflightSqls) == 0 {
+		return nil
+	}
+	_, err := exec.wr.TabletManagerClient().PreflightSchema(ctx, exec.tablets[0], preflightSqls)
 	return err
 }
 
@@ -236,6 +249,15 @@ func (exec *TabletExecutor) executeSQL(ctx context.Context, sql string, execResu
 			}
 			return nil
 		}
+	case *sqlparser.RevertMigration:
+		// The reverted migration determines the table, which is only known to the tablets.
+		_, options, err := schema.ParseDDLStrategy(exec.ddlStrategy)
+		if err != nil {
+			return err
+		}
+		exec.wr.Logger().Infof("Received REVERT request. strategy=%+v", schema.DDLStrategyOnline)
+		exec.executeOnlineDDL(ctx, execResult, sqlparser.String(stat), "", schema.DDLStrategyOnline, options)
+		return nil
 	}
 	exec.wr.Logger().Infof("Received DDL request. strategy=%+v", schema.DDLStrategyDirect)
 	exec.executeOnAllTablets(ctx, execResult, sql)
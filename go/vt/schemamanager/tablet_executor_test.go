@@ -114,6 +114,7 @@ func TestTabletExecutorValidate(t *testing.T) {
 		"CREATE TABLE test_table_02 (pk int)",
 		"ALTER DATABASE db_name DEFAULT CHARACTER SET = utf8mb4",
 		"ALTER SCHEMA db_name CHARACTER SET = utf8mb4",
+		"REVERT 'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a'",
 	}
 
 	if err := executor.Validate(ctx, sqls); err == nil {
@@ -76,7 +76,7 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtSet
 	case *Show:
 		return StmtShow
-	case DDLStatement, DBDDLStatement, *AlterVschema:
+	case DDLStatement, DBDDLStatement, *AlterVschema, *RevertMigration:
 		return StmtDDL
 	case *Use:
 		return StmtUse
@@ -151,7 +151,7 @@ func Preview(sql string) StatementType {
 	// Comparison is done in order of priority.
 	loweredFirstWord := strings.ToLower(firstWord)
 	switch loweredFirstWord {
-	case "select":
+	case "select", "with":
 		return StmtSelect
 	case "stream":
 		return StmtStream
@@ -187,7 +187,7 @@ func Preview(sql string) StatementType {
 		return StmtRollback
 	}
 	switch loweredFirstWord {
-	case "create", "alter", "rename", "drop", "truncate", "flush":
+	case "create", "alter", "rename", "drop", "truncate", "flush", "revert":
 		return StmtDDL
 	case "set":
 		return StmtSet
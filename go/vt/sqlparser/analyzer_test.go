@@ -35,6 +35,7 @@ func TestPreview(t *testing.T) {
 		{"    select ...", StmtSelect},
 		{"(select ...", StmtSelect},
 		{"( select ...", StmtSelect},
+		{"with cte as (select ...) select ...", StmtSelect},
 		{"insert ...", StmtInsert},
 		{"replace ....", StmtReplace},
 		{"   update ...", StmtUpdate},
@@ -75,6 +76,7 @@ func TestPreview(t *testing.T) {
 		{"grant", StmtPriv},
 		{"revoke", StmtPriv},
 		{"truncate", StmtDDL},
+		{"revert 'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a'", StmtDDL},
 		{"unknown", StmtUnknown},
 
 		{"/* leading comment */ select ...", StmtSelect},
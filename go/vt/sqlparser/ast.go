@@ -192,6 +192,7 @@ type (
 
 	// Select represents a SELECT statement.
 	Select struct {
+		With             *With
 		Cache            *bool // a reference here so it can be nil
 		Distinct         bool
 		StraightJoinHint bool
@@ -202,12 +203,27 @@ type (
 		Where            *Where
 		GroupBy          GroupBy
 		Having           *Where
+		Windows          WindowDefinitions
 		OrderBy          OrderBy
 		Limit            *Limit
 		Lock             Lock
 		Into             *SelectInto
 	}
 
+	// With represents a WITH clause.
+	With struct {
+		Recursive bool
+		CTEs      []*CommonTableExpr
+	}
+
+	// CommonTableExpr represents a common table expression
+	// of a WITH clause.
+	CommonTableExpr struct {
+		Name     TableIdent
+		Columns  Columns
+		Subquery *Subquery
+	}
+
 	// SelectInto is a struct that represent the INTO part of a select query
 	SelectInto struct {
 		Type         SelectIntoType
@@ -232,6 +248,7 @@ type (
 	}
 	// Union represents a UNION statement.
 	Union struct {
+		With           *With
 		FirstStatement SelectStatement
 		UnionSelects   []*UnionSelect
 		OrderBy        OrderBy
@@ -403,10 +420,11 @@ type (
 
 	// AlterTable represents a ALTER TABLE statement.
 	AlterTable struct {
-		Table         TableName
-		AlterOptions  []AlterOption
-		PartitionSpec *PartitionSpec
-		FullyParsed   bool
+		Table           TableName
+		AlterOptions    []AlterOption
+		PartitionSpec   *PartitionSpec
+		PartitionOption *PartitionOption
+		FullyParsed     bool
 	}
 
 	// DropTable represents a DROP TABLE statement.
@@ -549,6 +567,11 @@ type (
 
 	// UnlockTables represents the unlock statement
 	UnlockTables struct{}
+
+	// RevertMigration represents a REVERT statement, which reverts a completed online DDL migration
+	RevertMigration struct {
+		UUID string
+	}
 )
 
 func (*Union) iStatement()             {}
@@ -589,6 +612,7 @@ func (*AlterTable) iStatement()        {}
 func (*AlterVschema) iStatement()      {}
 func (*DropTable) iStatement()         {}
 func (*DropView) iStatement()          {}
+func (*RevertMigration) iStatement()   {}
 
 func (*DDL) iDDLStatement()         {}
 func (*CreateIndex) iDDLStatement() {}
@@ -1202,17 +1226,34 @@ type PartitionDefinition struct {
 	Name     ColIdent
 	Limit    Expr
 	Maxvalue bool
+	InValues Exprs
+}
+
+// PartitionOption describes the partitioning of a table, as in the PARTITION BY clause of
+// CREATE TABLE and ALTER TABLE
+type PartitionOption struct {
+	Linear bool
+	Type   PartitionByType
+	// Expr is set for HASH, RANGE and LIST, and ColList for KEY, RANGE COLUMNS and LIST COLUMNS
+	Expr        Expr
+	ColList     Columns
+	Partitions  *Literal
+	Definitions []*PartitionDefinition
 }
 
+// PartitionByType is an enum for PartitionOption.Type
+type PartitionByType int8
+
 // TableOptions specifies a list of table options
 type TableOptions []*TableOption
 
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
-	Columns     []*ColumnDefinition
-	Indexes     []*IndexDefinition
-	Constraints []*ConstraintDefinition
-	Options     TableOptions
+	Columns         []*ColumnDefinition
+	Indexes         []*IndexDefinition
+	Constraints     []*ConstraintDefinition
+	Options         TableOptions
+	PartitionOption *PartitionOption
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -1608,6 +1649,14 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+
+		// FromLast and IgnoreNulls are the FROM LAST and IGNORE NULLS
+		// modifiers of window functions like NTH_VALUE.
+		FromLast    bool
+		IgnoreNulls bool
+
+		// Over is set if the function is called as a window function.
+		Over *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -1758,6 +1807,52 @@ type Limit struct {
 	Offset, Rowcount Expr
 }
 
+// OverClause represents the OVER clause of a window function call.
+// It either references a named window or specifies the window inline.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpec
+}
+
+// WindowSpec represents a window specification:
+// [window_name] [PARTITION BY ...] [ORDER BY ...] [frame_clause]
+type WindowSpec struct {
+	Name        ColIdent
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil if the frame is specified by its start only.
+type FrameClause struct {
+	Unit  FrameUnit
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnit is an enum for the unit of a frame - rows or range.
+type FrameUnit int8
+
+// FramePoint represents the start or the end of a frame.
+// Expr is only set for the ExprPreceding and ExprFollowing types.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for the types of frame points.
+type FramePointType int8
+
+// WindowDefinitions represents a WINDOW clause.
+type WindowDefinitions []*WindowDefinition
+
+// WindowDefinition represents a named window of a WINDOW clause.
+type WindowDefinition struct {
+	Name ColIdent
+	Spec *WindowSpec
+}
+
 // Values represents a VALUES clause.
 type Values []ValTuple
 
@@ -1821,10 +1916,10 @@ func (node *Select) Format(buf *TrackedBuffer) {
 	addIf(node.StraightJoinHint, StraightJoinHint)
 	addIf(node.SQLCalcFoundRows, SQLCalcFoundRowsStr)
 
-	buf.astPrintf(node, "select %v%s%v from %v%v%v%v%v%v%s%v",
-		node.Comments, options, node.SelectExprs,
+	buf.astPrintf(node, "%vselect %v%s%v from %v%v%v%v%v%v%v%s%v",
+		node.With, node.Comments, options, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock.ToString(), node.Into)
 }
 
@@ -1835,13 +1930,35 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%v", node.FirstStatement)
+	buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 	for _, us := range node.UnionSelects {
 		buf.astPrintf(node, "%v", us)
 	}
 	buf.astPrintf(node, "%v%v%s", node.OrderBy, node.Limit, node.Lock.ToString())
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.astPrintf(node, "%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node *UnionSelect) Format(buf *TrackedBuffer) {
 	if node.Distinct {
@@ -2143,10 +2260,55 @@ func (node *PartitionSpec) Format(buf *TrackedBuffer) {
 
 // Format formats the node
 func (node *PartitionDefinition) Format(buf *TrackedBuffer) {
-	if !node.Maxvalue {
-		buf.astPrintf(node, "partition %v values less than (%v)", node.Name, node.Limit)
-	} else {
+	switch {
+	case node.Maxvalue:
 		buf.astPrintf(node, "partition %v values less than (maxvalue)", node.Name)
+	case node.Limit != nil:
+		buf.astPrintf(node, "partition %v values less than (%v)", node.Name, node.Limit)
+	case node.InValues != nil:
+		buf.astPrintf(node, "partition %v values in (%v)", node.Name, node.InValues)
+	default:
+		buf.astPrintf(node, "partition %v", node.Name)
+	}
+}
+
+// Format formats the node.
+func (node *PartitionOption) Format(buf *TrackedBuffer) {
+	buf.WriteString("partition by ")
+	if node.Linear {
+		buf.WriteString("linear ")
+	}
+	switch node.Type {
+	case HashPartitionType:
+		buf.astPrintf(node, "%s(%v)", HashTypeStr, node.Expr)
+	case KeyPartitionType:
+		if len(node.ColList) == 0 {
+			buf.astPrintf(node, "%s()", KeyTypeStr)
+		} else {
+			buf.astPrintf(node, "%s%v", KeyTypeStr, node.ColList)
+		}
+	case RangePartitionType, ListPartitionType:
+		typeStr := RangeTypeStr
+		if node.Type == ListPartitionType {
+			typeStr = ListTypeStr
+		}
+		if node.Expr != nil {
+			buf.astPrintf(node, "%s(%v)", typeStr, node.Expr)
+		} else {
+			buf.astPrintf(node, "%s columns%v", typeStr, node.ColList)
+		}
+	}
+	if node.Partitions != nil {
+		buf.astPrintf(node, " partitions %v", node.Partitions)
+	}
+	if len(node.Definitions) > 0 {
+		buf.WriteString(" (")
+		prefix := ""
+		for _, pd := range node.Definitions {
+			buf.astPrintf(node, "%s%v", prefix, pd)
+			prefix = ", "
+		}
+		buf.WriteString(")")
 	}
 }
 
@@ -2181,6 +2343,9 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 			buf.astPrintf(ts, " (%v)", opt.Tables)
 		}
 	}
+	if ts.PartitionOption != nil {
+		buf.astPrintf(ts, "\n%v", ts.PartitionOption)
+	}
 }
 
 // Format formats the node.
@@ -2820,6 +2985,15 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	if node.FromLast {
+		buf.WriteString(" from last")
+	}
+	if node.IgnoreNulls {
+		buf.WriteString(" ignore nulls")
+	}
+	if node.Over != nil {
+		buf.astPrintf(node, " %v", node.Over)
+	}
 }
 
 // Format formats the node
@@ -2958,6 +3132,75 @@ func (node *Limit) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "%v", node.Rowcount)
 }
 
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node.WindowSpec != nil {
+		buf.astPrintf(node, "over (%v)", node.WindowSpec)
+		return
+	}
+	buf.astPrintf(node, "over %v", node.WindowName)
+}
+
+// Format formats the node.
+func (node *WindowSpec) Format(buf *TrackedBuffer) {
+	var prefix string
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionBy) > 0 {
+		buf.astPrintf(node, "%spartition by %v", prefix, node.PartitionBy)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		buf.astPrintf(node, "%sorder by ", prefix)
+		orderPrefix := ""
+		for _, n := range node.OrderBy {
+			buf.astPrintf(node, "%s%v", orderPrefix, n)
+			orderPrefix = ", "
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.Frame)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case ExprPreceding:
+		buf.astPrintf(node, "%v %s", node.Expr, PrecedingStr)
+	case ExprFollowing:
+		buf.astPrintf(node, "%v %s", node.Expr, FollowingStr)
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// Format formats the node.
+func (node WindowDefinitions) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *WindowDefinition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.Spec)
+}
+
 // Format formats the node.
 func (node Values) Format(buf *TrackedBuffer) {
 	prefix := "values "
@@ -3068,6 +3311,12 @@ func (node *Load) Format(buf *TrackedBuffer) {
 	buf.WriteString("AST node missing for Load type")
 }
 
+// Format formats the node.
+func (node *RevertMigration) Format(buf *TrackedBuffer) {
+	buf.WriteString("revert ")
+	sqltypes.MakeTrusted(sqltypes.VarBinary, []byte(node.UUID)).EncodeSQL(buf)
+}
+
 // Format formats the node.
 func (node *ShowTableStatus) Format(buf *TrackedBuffer) {
 	buf.WriteString("show table status")
@@ -3268,6 +3517,9 @@ func (node *AlterTable) Format(buf *TrackedBuffer) {
 	if node.PartitionSpec != nil {
 		buf.astPrintf(node, "%s %v", prefix, node.PartitionSpec)
 	}
+	if node.PartitionOption != nil {
+		buf.astPrintf(node, " %v", node.PartitionOption)
+	}
 }
 
 // Format formats the node.
@@ -516,8 +516,15 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// Aggregates called with an OVER clause are window functions:
+// they don't group the rows.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunc returns true if the function is called as a window function.
+func (node *FuncExpr) IsWindowFunc() bool {
+	return node.Over != nil
 }
 
 // NewColIdent makes a new ColIdent.
@@ -850,6 +857,17 @@ func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit,
 	return &Union{FirstStatement: lhs, UnionSelects: []*UnionSelect{{Distinct: distinct, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
 }
 
+// SetWith sets the WITH clause of a SELECT or UNION statement.
+func SetWith(stmt SelectStatement, with *With) SelectStatement {
+	switch stmt := stmt.(type) {
+	case *Select:
+		stmt.With = with
+	case *Union:
+		stmt.With = with
+	}
+	return stmt
+}
+
 // ToString returns the string associated with the DDLAction Enum
 func (action DDLAction) ToString() string {
 	switch action {
@@ -1118,6 +1136,36 @@ func (dir OrderDirection) ToString() string {
 	}
 }
 
+// ToString returns the unit as a string
+func (unit FrameUnit) ToString() string {
+	switch unit {
+	case RowsUnit:
+		return RowsStr
+	case RangeUnit:
+		return RangeStr
+	default:
+		return "Unknown FrameUnit"
+	}
+}
+
+// ToString returns the type of the frame point as a string
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRow:
+		return CurrentRowStr
+	case UnboundedPreceding:
+		return UnboundedPrecedingStr
+	case UnboundedFollowing:
+		return UnboundedFollowingStr
+	case ExprPreceding:
+		return PrecedingStr
+	case ExprFollowing:
+		return FollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 // ToString returns the operator as a string
 func (op ConvertTypeOperator) ToString() string {
 	switch op {
@@ -0,0 +1,2093 @@
+// Code generated by visitorgen/main/main.go. DO NOT EDIT.
+
+package sqlparser
+
+import (
+	"reflect"
+)
+
+// CloneSQLNode creates a deep clone of the input.
+func CloneSQLNode(node SQLNode) SQLNode {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *AddColumns:
+		return CloneRefOfAddColumns(n)
+	case *AddConstraintDefinition:
+		return CloneRefOfAddConstraintDefinition(n)
+	case *AddIndexDefinition:
+		return CloneRefOfAddIndexDefinition(n)
+	case *AliasedExpr:
+		return CloneRefOfAliasedExpr(n)
+	case *AliasedTableExpr:
+		return CloneRefOfAliasedTableExpr(n)
+	case *AlterCharset:
+		return CloneRefOfAlterCharset(n)
+	case *AlterColumn:
+		return CloneRefOfAlterColumn(n)
+	case *AlterDatabase:
+		return CloneRefOfAlterDatabase(n)
+	case *AlterTable:
+		return CloneRefOfAlterTable(n)
+	case *AlterView:
+		return CloneRefOfAlterView(n)
+	case *AlterVschema:
+		return CloneRefOfAlterVschema(n)
+	case *AndExpr:
+		return CloneRefOfAndExpr(n)
+	case *AutoIncSpec:
+		return CloneRefOfAutoIncSpec(n)
+	case *Begin:
+		return CloneRefOfBegin(n)
+	case *BinaryExpr:
+		return CloneRefOfBinaryExpr(n)
+	case *CaseExpr:
+		return CloneRefOfCaseExpr(n)
+	case *ChangeColumn:
+		return CloneRefOfChangeColumn(n)
+	case *CheckConstraintDefinition:
+		return CloneRefOfCheckConstraintDefinition(n)
+	case *ColName:
+		return CloneRefOfColName(n)
+	case *CollateExpr:
+		return CloneRefOfCollateExpr(n)
+	case *ColumnDefinition:
+		return CloneRefOfColumnDefinition(n)
+	case *ColumnType:
+		return CloneRefOfColumnType(n)
+	case *Commit:
+		return CloneRefOfCommit(n)
+	case *CommonTableExpr:
+		return CloneRefOfCommonTableExpr(n)
+	case *ComparisonExpr:
+		return CloneRefOfComparisonExpr(n)
+	case *ConstraintDefinition:
+		return CloneRefOfConstraintDefinition(n)
+	case *ConvertExpr:
+		return CloneRefOfConvertExpr(n)
+	case *ConvertType:
+		return CloneRefOfConvertType(n)
+	case *ConvertUsingExpr:
+		return CloneRefOfConvertUsingExpr(n)
+	case *CreateDatabase:
+		return CloneRefOfCreateDatabase(n)
+	case *CreateIndex:
+		return CloneRefOfCreateIndex(n)
+	case *CreateTable:
+		return CloneRefOfCreateTable(n)
+	case *CreateView:
+		return CloneRefOfCreateView(n)
+	case *CurTimeFuncExpr:
+		return CloneRefOfCurTimeFuncExpr(n)
+	case *DDL:
+		return CloneRefOfDDL(n)
+	case *Default:
+		return CloneRefOfDefault(n)
+	case *Delete:
+		return CloneRefOfDelete(n)
+	case *DerivedTable:
+		return CloneRefOfDerivedTable(n)
+	case *DropColumn:
+		return CloneRefOfDropColumn(n)
+	case *DropDatabase:
+		return CloneRefOfDropDatabase(n)
+	case *DropKey:
+		return CloneRefOfDropKey(n)
+	case *DropTable:
+		return CloneRefOfDropTable(n)
+	case *DropView:
+		return CloneRefOfDropView(n)
+	case *ExistsExpr:
+		return CloneRefOfExistsExpr(n)
+	case *Explain:
+		return CloneRefOfExplain(n)
+	case *Force:
+		return CloneRefOfForce(n)
+	case *ForeignKeyDefinition:
+		return CloneRefOfForeignKeyDefinition(n)
+	case *FrameClause:
+		return CloneRefOfFrameClause(n)
+	case *FramePoint:
+		return CloneRefOfFramePoint(n)
+	case *FuncExpr:
+		return CloneRefOfFuncExpr(n)
+	case *GroupConcatExpr:
+		return CloneRefOfGroupConcatExpr(n)
+	case *IndexDefinition:
+		return CloneRefOfIndexDefinition(n)
+	case *IndexHints:
+		return CloneRefOfIndexHints(n)
+	case *IndexInfo:
+		return CloneRefOfIndexInfo(n)
+	case *Insert:
+		return CloneRefOfInsert(n)
+	case *IntervalExpr:
+		return CloneRefOfIntervalExpr(n)
+	case *IsExpr:
+		return CloneRefOfIsExpr(n)
+	case *JoinTableExpr:
+		return CloneRefOfJoinTableExpr(n)
+	case *KeyState:
+		return CloneRefOfKeyState(n)
+	case *Limit:
+		return CloneRefOfLimit(n)
+	case *Literal:
+		return CloneRefOfLiteral(n)
+	case *Load:
+		return CloneRefOfLoad(n)
+	case *LockOption:
+		return CloneRefOfLockOption(n)
+	case *LockTables:
+		return CloneRefOfLockTables(n)
+	case *MatchExpr:
+		return CloneRefOfMatchExpr(n)
+	case *ModifyColumn:
+		return CloneRefOfModifyColumn(n)
+	case *NotExpr:
+		return CloneRefOfNotExpr(n)
+	case *NullVal:
+		return CloneRefOfNullVal(n)
+	case *OptLike:
+		return CloneRefOfOptLike(n)
+	case *OrExpr:
+		return CloneRefOfOrExpr(n)
+	case *Order:
+		return CloneRefOfOrder(n)
+	case *OrderByOption:
+		return CloneRefOfOrderByOption(n)
+	case *OtherAdmin:
+		return CloneRefOfOtherAdmin(n)
+	case *OtherRead:
+		return CloneRefOfOtherRead(n)
+	case *OverClause:
+		return CloneRefOfOverClause(n)
+	case *ParenSelect:
+		return CloneRefOfParenSelect(n)
+	case *ParenTableExpr:
+		return CloneRefOfParenTableExpr(n)
+	case *PartitionDefinition:
+		return CloneRefOfPartitionDefinition(n)
+	case *PartitionOption:
+		return CloneRefOfPartitionOption(n)
+	case *PartitionSpec:
+		return CloneRefOfPartitionSpec(n)
+	case *RangeCond:
+		return CloneRefOfRangeCond(n)
+	case *Release:
+		return CloneRefOfRelease(n)
+	case *RenameIndex:
+		return CloneRefOfRenameIndex(n)
+	case *RenameTable:
+		return CloneRefOfRenameTable(n)
+	case *RevertMigration:
+		return CloneRefOfRevertMigration(n)
+	case *Rollback:
+		return CloneRefOfRollback(n)
+	case *SRollback:
+		return CloneRefOfSRollback(n)
+	case *Savepoint:
+		return CloneRefOfSavepoint(n)
+	case *Select:
+		return CloneRefOfSelect(n)
+	case *SelectInto:
+		return CloneRefOfSelectInto(n)
+	case *Set:
+		return CloneRefOfSet(n)
+	case *SetExpr:
+		return CloneRefOfSetExpr(n)
+	case *SetTransaction:
+		return CloneRefOfSetTransaction(n)
+	case *Show:
+		return CloneRefOfShow(n)
+	case *ShowBasic:
+		return CloneRefOfShowBasic(n)
+	case *ShowColumns:
+		return CloneRefOfShowColumns(n)
+	case *ShowFilter:
+		return CloneRefOfShowFilter(n)
+	case *ShowLegacy:
+		return CloneRefOfShowLegacy(n)
+	case *ShowTableStatus:
+		return CloneRefOfShowTableStatus(n)
+	case *StarExpr:
+		return CloneRefOfStarExpr(n)
+	case *Stream:
+		return CloneRefOfStream(n)
+	case *Subquery:
+		return CloneRefOfSubquery(n)
+	case *SubstrExpr:
+		return CloneRefOfSubstrExpr(n)
+	case *TableSpec:
+		return CloneRefOfTableSpec(n)
+	case *TablespaceOperation:
+		return CloneRefOfTablespaceOperation(n)
+	case *TimestampFuncExpr:
+		return CloneRefOfTimestampFuncExpr(n)
+	case *UnaryExpr:
+		return CloneRefOfUnaryExpr(n)
+	case *Union:
+		return CloneRefOfUnion(n)
+	case *UnionSelect:
+		return CloneRefOfUnionSelect(n)
+	case *UnlockTables:
+		return CloneRefOfUnlockTables(n)
+	case *Update:
+		return CloneRefOfUpdate(n)
+	case *UpdateExpr:
+		return CloneRefOfUpdateExpr(n)
+	case *Use:
+		return CloneRefOfUse(n)
+	case *VStream:
+		return CloneRefOfVStream(n)
+	case *Validation:
+		return CloneRefOfValidation(n)
+	case *ValuesFuncExpr:
+		return CloneRefOfValuesFuncExpr(n)
+	case *VindexSpec:
+		return CloneRefOfVindexSpec(n)
+	case *When:
+		return CloneRefOfWhen(n)
+	case *Where:
+		return CloneRefOfWhere(n)
+	case *WindowDefinition:
+		return CloneRefOfWindowDefinition(n)
+	case *WindowSpec:
+		return CloneRefOfWindowSpec(n)
+	case *With:
+		return CloneRefOfWith(n)
+	case *XorExpr:
+		return CloneRefOfXorExpr(n)
+	case AccessMode:
+		return CloneAccessMode(n)
+	case AlgorithmValue:
+		return CloneAlgorithmValue(n)
+	case Argument:
+		return CloneArgument(n)
+	case BoolVal:
+		return CloneBoolVal(n)
+	case ColIdent:
+		return CloneColIdent(n)
+	case Columns:
+		return CloneColumns(n)
+	case Comments:
+		return CloneComments(n)
+	case Exprs:
+		return CloneExprs(n)
+	case GroupBy:
+		return CloneGroupBy(n)
+	case IsolationLevel:
+		return CloneIsolationLevel(n)
+	case JoinCondition:
+		return CloneJoinCondition(n)
+	case ListArg:
+		return CloneListArg(n)
+	case Nextval:
+		return CloneNextval(n)
+	case OnDup:
+		return CloneOnDup(n)
+	case OrderBy:
+		return CloneOrderBy(n)
+	case Partitions:
+		return ClonePartitions(n)
+	case ReferenceAction:
+		return CloneReferenceAction(n)
+	case SelectExprs:
+		return CloneSelectExprs(n)
+	case SetExprs:
+		return CloneSetExprs(n)
+	case TableExprs:
+		return CloneTableExprs(n)
+	case TableIdent:
+		return CloneTableIdent(n)
+	case TableName:
+		return CloneTableName(n)
+	case TableNames:
+		return CloneTableNames(n)
+	case TableOptions:
+		return CloneTableOptions(n)
+	case UpdateExprs:
+		return CloneUpdateExprs(n)
+	case ValTuple:
+		return CloneValTuple(n)
+	case Values:
+		return CloneValues(n)
+	case VindexParam:
+		return CloneVindexParam(n)
+	case WindowDefinitions:
+		return CloneWindowDefinitions(n)
+	default:
+		panic("unknown ast type " + reflect.TypeOf(node).String())
+	}
+}
+
+// CloneAlterOption creates a deep clone of the input.
+func CloneAlterOption(in AlterOption) AlterOption {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(AlterOption)
+}
+
+// CloneCharacteristic creates a deep clone of the input.
+func CloneCharacteristic(in Characteristic) Characteristic {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(Characteristic)
+}
+
+// CloneConstraintInfo creates a deep clone of the input.
+func CloneConstraintInfo(in ConstraintInfo) ConstraintInfo {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(ConstraintInfo)
+}
+
+// CloneExpr creates a deep clone of the input.
+func CloneExpr(in Expr) Expr {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(Expr)
+}
+
+// CloneInsertRows creates a deep clone of the input.
+func CloneInsertRows(in InsertRows) InsertRows {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(InsertRows)
+}
+
+// CloneSelectExpr creates a deep clone of the input.
+func CloneSelectExpr(in SelectExpr) SelectExpr {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(SelectExpr)
+}
+
+// CloneSelectStatement creates a deep clone of the input.
+func CloneSelectStatement(in SelectStatement) SelectStatement {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(SelectStatement)
+}
+
+// CloneShowInternal creates a deep clone of the input.
+func CloneShowInternal(in ShowInternal) ShowInternal {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(ShowInternal)
+}
+
+// CloneSimpleTableExpr creates a deep clone of the input.
+func CloneSimpleTableExpr(in SimpleTableExpr) SimpleTableExpr {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(SimpleTableExpr)
+}
+
+// CloneStatement creates a deep clone of the input.
+func CloneStatement(in Statement) Statement {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(Statement)
+}
+
+// CloneTableExpr creates a deep clone of the input.
+func CloneTableExpr(in TableExpr) TableExpr {
+	if in == nil {
+		return nil
+	}
+	return CloneSQLNode(in).(TableExpr)
+}
+
+// CloneRefOfAddColumns creates a deep clone of the input.
+func CloneRefOfAddColumns(n *AddColumns) *AddColumns {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.After = CloneRefOfColName(n.After)
+	if n.Columns != nil {
+		out.Columns = make([]*ColumnDefinition, len(n.Columns))
+		for i, item := range n.Columns {
+			out.Columns[i] = CloneRefOfColumnDefinition(item)
+		}
+	}
+	out.First = CloneRefOfColName(n.First)
+	return &out
+}
+
+// CloneRefOfAddConstraintDefinition creates a deep clone of the input.
+func CloneRefOfAddConstraintDefinition(n *AddConstraintDefinition) *AddConstraintDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.ConstraintDefinition = CloneRefOfConstraintDefinition(n.ConstraintDefinition)
+	return &out
+}
+
+// CloneRefOfAddIndexDefinition creates a deep clone of the input.
+func CloneRefOfAddIndexDefinition(n *AddIndexDefinition) *AddIndexDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.IndexDefinition = CloneRefOfIndexDefinition(n.IndexDefinition)
+	return &out
+}
+
+// CloneRefOfAliasedExpr creates a deep clone of the input.
+func CloneRefOfAliasedExpr(n *AliasedExpr) *AliasedExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.As = CloneColIdent(n.As)
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfAliasedTableExpr creates a deep clone of the input.
+func CloneRefOfAliasedTableExpr(n *AliasedTableExpr) *AliasedTableExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.As = CloneTableIdent(n.As)
+	out.Expr = CloneSimpleTableExpr(n.Expr)
+	out.Hints = CloneRefOfIndexHints(n.Hints)
+	out.Partitions = ClonePartitions(n.Partitions)
+	return &out
+}
+
+// CloneRefOfAlterCharset creates a deep clone of the input.
+func CloneRefOfAlterCharset(n *AlterCharset) *AlterCharset {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfAlterColumn creates a deep clone of the input.
+func CloneRefOfAlterColumn(n *AlterColumn) *AlterColumn {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Column = CloneRefOfColName(n.Column)
+	out.DefaultVal = CloneExpr(n.DefaultVal)
+	return &out
+}
+
+// CloneRefOfAlterDatabase creates a deep clone of the input.
+func CloneRefOfAlterDatabase(n *AlterDatabase) *AlterDatabase {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfAlterTable creates a deep clone of the input.
+func CloneRefOfAlterTable(n *AlterTable) *AlterTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	if n.AlterOptions != nil {
+		out.AlterOptions = make([]AlterOption, len(n.AlterOptions))
+		for i, item := range n.AlterOptions {
+			out.AlterOptions[i] = CloneAlterOption(item)
+		}
+	}
+	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
+	out.PartitionSpec = CloneRefOfPartitionSpec(n.PartitionSpec)
+	out.Table = CloneTableName(n.Table)
+	return &out
+}
+
+// CloneRefOfAlterView creates a deep clone of the input.
+func CloneRefOfAlterView(n *AlterView) *AlterView {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	out.Select = CloneSelectStatement(n.Select)
+	out.ViewName = CloneTableName(n.ViewName)
+	return &out
+}
+
+// CloneRefOfAlterVschema creates a deep clone of the input.
+func CloneRefOfAlterVschema(n *AlterVschema) *AlterVschema {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.AutoIncSpec = CloneRefOfAutoIncSpec(n.AutoIncSpec)
+	out.Table = CloneTableName(n.Table)
+	if n.VindexCols != nil {
+		out.VindexCols = make([]ColIdent, len(n.VindexCols))
+		for i, item := range n.VindexCols {
+			out.VindexCols[i] = CloneColIdent(item)
+		}
+	}
+	out.VindexSpec = CloneRefOfVindexSpec(n.VindexSpec)
+	return &out
+}
+
+// CloneRefOfAndExpr creates a deep clone of the input.
+func CloneRefOfAndExpr(n *AndExpr) *AndExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Left = CloneExpr(n.Left)
+	out.Right = CloneExpr(n.Right)
+	return &out
+}
+
+// CloneRefOfAutoIncSpec creates a deep clone of the input.
+func CloneRefOfAutoIncSpec(n *AutoIncSpec) *AutoIncSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Column = CloneColIdent(n.Column)
+	out.Sequence = CloneTableName(n.Sequence)
+	return &out
+}
+
+// CloneRefOfBegin creates a deep clone of the input.
+func CloneRefOfBegin(n *Begin) *Begin {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfBinaryExpr creates a deep clone of the input.
+func CloneRefOfBinaryExpr(n *BinaryExpr) *BinaryExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Left = CloneExpr(n.Left)
+	out.Right = CloneExpr(n.Right)
+	return &out
+}
+
+// CloneRefOfCaseExpr creates a deep clone of the input.
+func CloneRefOfCaseExpr(n *CaseExpr) *CaseExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Else = CloneExpr(n.Else)
+	out.Expr = CloneExpr(n.Expr)
+	if n.Whens != nil {
+		out.Whens = make([]*When, len(n.Whens))
+		for i, item := range n.Whens {
+			out.Whens[i] = CloneRefOfWhen(item)
+		}
+	}
+	return &out
+}
+
+// CloneRefOfChangeColumn creates a deep clone of the input.
+func CloneRefOfChangeColumn(n *ChangeColumn) *ChangeColumn {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.After = CloneRefOfColName(n.After)
+	out.First = CloneRefOfColName(n.First)
+	out.NewColDefinition = CloneRefOfColumnDefinition(n.NewColDefinition)
+	out.OldColumn = CloneRefOfColName(n.OldColumn)
+	return &out
+}
+
+// CloneRefOfCheckConstraintDefinition creates a deep clone of the input.
+func CloneRefOfCheckConstraintDefinition(n *CheckConstraintDefinition) *CheckConstraintDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfColName creates a deep clone of the input.
+func CloneRefOfColName(n *ColName) *ColName {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Qualifier = CloneTableName(n.Qualifier)
+	return &out
+}
+
+// CloneRefOfCollateExpr creates a deep clone of the input.
+func CloneRefOfCollateExpr(n *CollateExpr) *CollateExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfColumnDefinition creates a deep clone of the input.
+func CloneRefOfColumnDefinition(n *ColumnDefinition) *ColumnDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfColumnType creates a deep clone of the input.
+func CloneRefOfColumnType(n *ColumnType) *ColumnType {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comment = CloneRefOfLiteral(n.Comment)
+	out.Default = CloneExpr(n.Default)
+	out.Length = CloneRefOfLiteral(n.Length)
+	out.OnUpdate = CloneExpr(n.OnUpdate)
+	out.Scale = CloneRefOfLiteral(n.Scale)
+	return &out
+}
+
+// CloneRefOfCommit creates a deep clone of the input.
+func CloneRefOfCommit(n *Commit) *Commit {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfCommonTableExpr creates a deep clone of the input.
+func CloneRefOfCommonTableExpr(n *CommonTableExpr) *CommonTableExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	out.Name = CloneTableIdent(n.Name)
+	out.Subquery = CloneRefOfSubquery(n.Subquery)
+	return &out
+}
+
+// CloneRefOfComparisonExpr creates a deep clone of the input.
+func CloneRefOfComparisonExpr(n *ComparisonExpr) *ComparisonExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Escape = CloneExpr(n.Escape)
+	out.Left = CloneExpr(n.Left)
+	out.Right = CloneExpr(n.Right)
+	return &out
+}
+
+// CloneRefOfConstraintDefinition creates a deep clone of the input.
+func CloneRefOfConstraintDefinition(n *ConstraintDefinition) *ConstraintDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Details = CloneConstraintInfo(n.Details)
+	return &out
+}
+
+// CloneRefOfConvertExpr creates a deep clone of the input.
+func CloneRefOfConvertExpr(n *ConvertExpr) *ConvertExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Type = CloneRefOfConvertType(n.Type)
+	return &out
+}
+
+// CloneRefOfConvertType creates a deep clone of the input.
+func CloneRefOfConvertType(n *ConvertType) *ConvertType {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Length = CloneRefOfLiteral(n.Length)
+	out.Scale = CloneRefOfLiteral(n.Scale)
+	return &out
+}
+
+// CloneRefOfConvertUsingExpr creates a deep clone of the input.
+func CloneRefOfConvertUsingExpr(n *ConvertUsingExpr) *ConvertUsingExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfCreateDatabase creates a deep clone of the input.
+func CloneRefOfCreateDatabase(n *CreateDatabase) *CreateDatabase {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfCreateIndex creates a deep clone of the input.
+func CloneRefOfCreateIndex(n *CreateIndex) *CreateIndex {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Table = CloneTableName(n.Table)
+	return &out
+}
+
+// CloneRefOfCreateTable creates a deep clone of the input.
+func CloneRefOfCreateTable(n *CreateTable) *CreateTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.OptLike = CloneRefOfOptLike(n.OptLike)
+	out.Table = CloneTableName(n.Table)
+	out.TableSpec = CloneRefOfTableSpec(n.TableSpec)
+	return &out
+}
+
+// CloneRefOfCreateView creates a deep clone of the input.
+func CloneRefOfCreateView(n *CreateView) *CreateView {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	out.Select = CloneSelectStatement(n.Select)
+	out.ViewName = CloneTableName(n.ViewName)
+	return &out
+}
+
+// CloneRefOfCurTimeFuncExpr creates a deep clone of the input.
+func CloneRefOfCurTimeFuncExpr(n *CurTimeFuncExpr) *CurTimeFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Fsp = CloneExpr(n.Fsp)
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfDDL creates a deep clone of the input.
+func CloneRefOfDDL(n *DDL) *DDL {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.FromTables = CloneTableNames(n.FromTables)
+	out.OptLike = CloneRefOfOptLike(n.OptLike)
+	out.PartitionSpec = CloneRefOfPartitionSpec(n.PartitionSpec)
+	out.Table = CloneTableName(n.Table)
+	out.TableSpec = CloneRefOfTableSpec(n.TableSpec)
+	out.ToTables = CloneTableNames(n.ToTables)
+	return &out
+}
+
+// CloneRefOfDefault creates a deep clone of the input.
+func CloneRefOfDefault(n *Default) *Default {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfDelete creates a deep clone of the input.
+func CloneRefOfDelete(n *Delete) *Delete {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneComments(n.Comments)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.Partitions = ClonePartitions(n.Partitions)
+	out.TableExprs = CloneTableExprs(n.TableExprs)
+	out.Targets = CloneTableNames(n.Targets)
+	out.Where = CloneRefOfWhere(n.Where)
+	return &out
+}
+
+// CloneRefOfDerivedTable creates a deep clone of the input.
+func CloneRefOfDerivedTable(n *DerivedTable) *DerivedTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Select = CloneSelectStatement(n.Select)
+	return &out
+}
+
+// CloneRefOfDropColumn creates a deep clone of the input.
+func CloneRefOfDropColumn(n *DropColumn) *DropColumn {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneRefOfColName(n.Name)
+	return &out
+}
+
+// CloneRefOfDropDatabase creates a deep clone of the input.
+func CloneRefOfDropDatabase(n *DropDatabase) *DropDatabase {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfDropKey creates a deep clone of the input.
+func CloneRefOfDropKey(n *DropKey) *DropKey {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfDropTable creates a deep clone of the input.
+func CloneRefOfDropTable(n *DropTable) *DropTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.FromTables = CloneTableNames(n.FromTables)
+	return &out
+}
+
+// CloneRefOfDropView creates a deep clone of the input.
+func CloneRefOfDropView(n *DropView) *DropView {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.FromTables = CloneTableNames(n.FromTables)
+	return &out
+}
+
+// CloneRefOfExistsExpr creates a deep clone of the input.
+func CloneRefOfExistsExpr(n *ExistsExpr) *ExistsExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Subquery = CloneRefOfSubquery(n.Subquery)
+	return &out
+}
+
+// CloneRefOfExplain creates a deep clone of the input.
+func CloneRefOfExplain(n *Explain) *Explain {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Statement = CloneStatement(n.Statement)
+	return &out
+}
+
+// CloneRefOfForce creates a deep clone of the input.
+func CloneRefOfForce(n *Force) *Force {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfForeignKeyDefinition creates a deep clone of the input.
+func CloneRefOfForeignKeyDefinition(n *ForeignKeyDefinition) *ForeignKeyDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.OnDelete = CloneReferenceAction(n.OnDelete)
+	out.OnUpdate = CloneReferenceAction(n.OnUpdate)
+	out.ReferencedColumns = CloneColumns(n.ReferencedColumns)
+	out.ReferencedTable = CloneTableName(n.ReferencedTable)
+	out.Source = CloneColumns(n.Source)
+	return &out
+}
+
+// CloneRefOfFrameClause creates a deep clone of the input.
+func CloneRefOfFrameClause(n *FrameClause) *FrameClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.End = CloneRefOfFramePoint(n.End)
+	out.Start = CloneRefOfFramePoint(n.Start)
+	return &out
+}
+
+// CloneRefOfFramePoint creates a deep clone of the input.
+func CloneRefOfFramePoint(n *FramePoint) *FramePoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfFuncExpr creates a deep clone of the input.
+func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Exprs = CloneSelectExprs(n.Exprs)
+	out.Name = CloneColIdent(n.Name)
+	out.Over = CloneRefOfOverClause(n.Over)
+	out.Qualifier = CloneTableIdent(n.Qualifier)
+	return &out
+}
+
+// CloneRefOfGroupConcatExpr creates a deep clone of the input.
+func CloneRefOfGroupConcatExpr(n *GroupConcatExpr) *GroupConcatExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Exprs = CloneSelectExprs(n.Exprs)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	return &out
+}
+
+// CloneRefOfIndexDefinition creates a deep clone of the input.
+func CloneRefOfIndexDefinition(n *IndexDefinition) *IndexDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Info = CloneRefOfIndexInfo(n.Info)
+	return &out
+}
+
+// CloneRefOfIndexHints creates a deep clone of the input.
+func CloneRefOfIndexHints(n *IndexHints) *IndexHints {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	if n.Indexes != nil {
+		out.Indexes = make([]ColIdent, len(n.Indexes))
+		for i, item := range n.Indexes {
+			out.Indexes[i] = CloneColIdent(item)
+		}
+	}
+	return &out
+}
+
+// CloneRefOfIndexInfo creates a deep clone of the input.
+func CloneRefOfIndexInfo(n *IndexInfo) *IndexInfo {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.ConstraintName = CloneColIdent(n.ConstraintName)
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfInsert creates a deep clone of the input.
+func CloneRefOfInsert(n *Insert) *Insert {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	out.Comments = CloneComments(n.Comments)
+	out.OnDup = CloneOnDup(n.OnDup)
+	out.Partitions = ClonePartitions(n.Partitions)
+	out.Rows = CloneInsertRows(n.Rows)
+	out.Table = CloneTableName(n.Table)
+	return &out
+}
+
+// CloneRefOfIntervalExpr creates a deep clone of the input.
+func CloneRefOfIntervalExpr(n *IntervalExpr) *IntervalExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfIsExpr creates a deep clone of the input.
+func CloneRefOfIsExpr(n *IsExpr) *IsExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfJoinTableExpr creates a deep clone of the input.
+func CloneRefOfJoinTableExpr(n *JoinTableExpr) *JoinTableExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Condition = CloneJoinCondition(n.Condition)
+	out.LeftExpr = CloneTableExpr(n.LeftExpr)
+	out.RightExpr = CloneTableExpr(n.RightExpr)
+	return &out
+}
+
+// CloneRefOfKeyState creates a deep clone of the input.
+func CloneRefOfKeyState(n *KeyState) *KeyState {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfLimit creates a deep clone of the input.
+func CloneRefOfLimit(n *Limit) *Limit {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Offset = CloneExpr(n.Offset)
+	out.Rowcount = CloneExpr(n.Rowcount)
+	return &out
+}
+
+// CloneRefOfLiteral creates a deep clone of the input.
+func CloneRefOfLiteral(n *Literal) *Literal {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfLoad creates a deep clone of the input.
+func CloneRefOfLoad(n *Load) *Load {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfLockOption creates a deep clone of the input.
+func CloneRefOfLockOption(n *LockOption) *LockOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfLockTables creates a deep clone of the input.
+func CloneRefOfLockTables(n *LockTables) *LockTables {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfMatchExpr creates a deep clone of the input.
+func CloneRefOfMatchExpr(n *MatchExpr) *MatchExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneSelectExprs(n.Columns)
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfModifyColumn creates a deep clone of the input.
+func CloneRefOfModifyColumn(n *ModifyColumn) *ModifyColumn {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.After = CloneRefOfColName(n.After)
+	out.First = CloneRefOfColName(n.First)
+	out.NewColDefinition = CloneRefOfColumnDefinition(n.NewColDefinition)
+	return &out
+}
+
+// CloneRefOfNotExpr creates a deep clone of the input.
+func CloneRefOfNotExpr(n *NotExpr) *NotExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfNullVal creates a deep clone of the input.
+func CloneRefOfNullVal(n *NullVal) *NullVal {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfOptLike creates a deep clone of the input.
+func CloneRefOfOptLike(n *OptLike) *OptLike {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.LikeTable = CloneTableName(n.LikeTable)
+	return &out
+}
+
+// CloneRefOfOrExpr creates a deep clone of the input.
+func CloneRefOfOrExpr(n *OrExpr) *OrExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Left = CloneExpr(n.Left)
+	out.Right = CloneExpr(n.Right)
+	return &out
+}
+
+// CloneRefOfOrder creates a deep clone of the input.
+func CloneRefOfOrder(n *Order) *Order {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfOrderByOption creates a deep clone of the input.
+func CloneRefOfOrderByOption(n *OrderByOption) *OrderByOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cols = CloneColumns(n.Cols)
+	return &out
+}
+
+// CloneRefOfOtherAdmin creates a deep clone of the input.
+func CloneRefOfOtherAdmin(n *OtherAdmin) *OtherAdmin {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfOtherRead creates a deep clone of the input.
+func CloneRefOfOtherRead(n *OtherRead) *OtherRead {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfOverClause creates a deep clone of the input.
+func CloneRefOfOverClause(n *OverClause) *OverClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.WindowName = CloneColIdent(n.WindowName)
+	out.WindowSpec = CloneRefOfWindowSpec(n.WindowSpec)
+	return &out
+}
+
+// CloneRefOfParenSelect creates a deep clone of the input.
+func CloneRefOfParenSelect(n *ParenSelect) *ParenSelect {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Select = CloneSelectStatement(n.Select)
+	return &out
+}
+
+// CloneRefOfParenTableExpr creates a deep clone of the input.
+func CloneRefOfParenTableExpr(n *ParenTableExpr) *ParenTableExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Exprs = CloneTableExprs(n.Exprs)
+	return &out
+}
+
+// CloneRefOfPartitionDefinition creates a deep clone of the input.
+func CloneRefOfPartitionDefinition(n *PartitionDefinition) *PartitionDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.InValues = CloneExprs(n.InValues)
+	out.Limit = CloneExpr(n.Limit)
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfPartitionOption creates a deep clone of the input.
+func CloneRefOfPartitionOption(n *PartitionOption) *PartitionOption {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.ColList = CloneColumns(n.ColList)
+	if n.Definitions != nil {
+		out.Definitions = make([]*PartitionDefinition, len(n.Definitions))
+		for i, item := range n.Definitions {
+			out.Definitions[i] = CloneRefOfPartitionDefinition(item)
+		}
+	}
+	out.Expr = CloneExpr(n.Expr)
+	out.Partitions = CloneRefOfLiteral(n.Partitions)
+	return &out
+}
+
+// CloneRefOfPartitionSpec creates a deep clone of the input.
+func CloneRefOfPartitionSpec(n *PartitionSpec) *PartitionSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	if n.Definitions != nil {
+		out.Definitions = make([]*PartitionDefinition, len(n.Definitions))
+		for i, item := range n.Definitions {
+			out.Definitions[i] = CloneRefOfPartitionDefinition(item)
+		}
+	}
+	out.Names = ClonePartitions(n.Names)
+	out.Number = CloneRefOfLiteral(n.Number)
+	out.TableName = CloneTableName(n.TableName)
+	return &out
+}
+
+// CloneRefOfRangeCond creates a deep clone of the input.
+func CloneRefOfRangeCond(n *RangeCond) *RangeCond {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.From = CloneExpr(n.From)
+	out.Left = CloneExpr(n.Left)
+	out.To = CloneExpr(n.To)
+	return &out
+}
+
+// CloneRefOfRelease creates a deep clone of the input.
+func CloneRefOfRelease(n *Release) *Release {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfRenameIndex creates a deep clone of the input.
+func CloneRefOfRenameIndex(n *RenameIndex) *RenameIndex {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfRenameTable creates a deep clone of the input.
+func CloneRefOfRenameTable(n *RenameTable) *RenameTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Table = CloneTableName(n.Table)
+	return &out
+}
+
+// CloneRefOfRevertMigration creates a deep clone of the input.
+func CloneRefOfRevertMigration(n *RevertMigration) *RevertMigration {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfRollback creates a deep clone of the input.
+func CloneRefOfRollback(n *Rollback) *Rollback {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfSRollback creates a deep clone of the input.
+func CloneRefOfSRollback(n *SRollback) *SRollback {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfSavepoint creates a deep clone of the input.
+func CloneRefOfSavepoint(n *Savepoint) *Savepoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfSelect creates a deep clone of the input.
+func CloneRefOfSelect(n *Select) *Select {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneComments(n.Comments)
+	out.From = CloneTableExprs(n.From)
+	out.GroupBy = CloneGroupBy(n.GroupBy)
+	out.Having = CloneRefOfWhere(n.Having)
+	out.Into = CloneRefOfSelectInto(n.Into)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.SelectExprs = CloneSelectExprs(n.SelectExprs)
+	out.Where = CloneRefOfWhere(n.Where)
+	out.Windows = CloneWindowDefinitions(n.Windows)
+	out.With = CloneRefOfWith(n.With)
+	return &out
+}
+
+// CloneRefOfSelectInto creates a deep clone of the input.
+func CloneRefOfSelectInto(n *SelectInto) *SelectInto {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfSet creates a deep clone of the input.
+func CloneRefOfSet(n *Set) *Set {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneComments(n.Comments)
+	out.Exprs = CloneSetExprs(n.Exprs)
+	return &out
+}
+
+// CloneRefOfSetExpr creates a deep clone of the input.
+func CloneRefOfSetExpr(n *SetExpr) *SetExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Name = CloneColIdent(n.Name)
+	return &out
+}
+
+// CloneRefOfSetTransaction creates a deep clone of the input.
+func CloneRefOfSetTransaction(n *SetTransaction) *SetTransaction {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	if n.Characteristics != nil {
+		out.Characteristics = make([]Characteristic, len(n.Characteristics))
+		for i, item := range n.Characteristics {
+			out.Characteristics[i] = CloneCharacteristic(item)
+		}
+	}
+	out.Comments = CloneComments(n.Comments)
+	return &out
+}
+
+// CloneRefOfShow creates a deep clone of the input.
+func CloneRefOfShow(n *Show) *Show {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Internal = CloneShowInternal(n.Internal)
+	return &out
+}
+
+// CloneRefOfShowBasic creates a deep clone of the input.
+func CloneRefOfShowBasic(n *ShowBasic) *ShowBasic {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Filter = CloneRefOfShowFilter(n.Filter)
+	return &out
+}
+
+// CloneRefOfShowColumns creates a deep clone of the input.
+func CloneRefOfShowColumns(n *ShowColumns) *ShowColumns {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Filter = CloneRefOfShowFilter(n.Filter)
+	out.Table = CloneTableName(n.Table)
+	return &out
+}
+
+// CloneRefOfShowFilter creates a deep clone of the input.
+func CloneRefOfShowFilter(n *ShowFilter) *ShowFilter {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Filter = CloneExpr(n.Filter)
+	return &out
+}
+
+// CloneRefOfShowLegacy creates a deep clone of the input.
+func CloneRefOfShowLegacy(n *ShowLegacy) *ShowLegacy {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.OnTable = CloneTableName(n.OnTable)
+	out.ShowCollationFilterOpt = CloneExpr(n.ShowCollationFilterOpt)
+	out.Table = CloneTableName(n.Table)
+	return &out
+}
+
+// CloneRefOfShowTableStatus creates a deep clone of the input.
+func CloneRefOfShowTableStatus(n *ShowTableStatus) *ShowTableStatus {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Filter = CloneRefOfShowFilter(n.Filter)
+	return &out
+}
+
+// CloneRefOfStarExpr creates a deep clone of the input.
+func CloneRefOfStarExpr(n *StarExpr) *StarExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.TableName = CloneTableName(n.TableName)
+	return &out
+}
+
+// CloneRefOfStream creates a deep clone of the input.
+func CloneRefOfStream(n *Stream) *Stream {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneComments(n.Comments)
+	out.SelectExpr = CloneSelectExpr(n.SelectExpr)
+	out.Table = CloneTableName(n.Table)
+	return &out
+}
+
+// CloneRefOfSubquery creates a deep clone of the input.
+func CloneRefOfSubquery(n *Subquery) *Subquery {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Select = CloneSelectStatement(n.Select)
+	return &out
+}
+
+// CloneRefOfSubstrExpr creates a deep clone of the input.
+func CloneRefOfSubstrExpr(n *SubstrExpr) *SubstrExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.From = CloneExpr(n.From)
+	out.Name = CloneRefOfColName(n.Name)
+	out.StrVal = CloneRefOfLiteral(n.StrVal)
+	out.To = CloneExpr(n.To)
+	return &out
+}
+
+// CloneRefOfTableSpec creates a deep clone of the input.
+func CloneRefOfTableSpec(n *TableSpec) *TableSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	if n.Columns != nil {
+		out.Columns = make([]*ColumnDefinition, len(n.Columns))
+		for i, item := range n.Columns {
+			out.Columns[i] = CloneRefOfColumnDefinition(item)
+		}
+	}
+	if n.Constraints != nil {
+		out.Constraints = make([]*ConstraintDefinition, len(n.Constraints))
+		for i, item := range n.Constraints {
+			out.Constraints[i] = CloneRefOfConstraintDefinition(item)
+		}
+	}
+	if n.Indexes != nil {
+		out.Indexes = make([]*IndexDefinition, len(n.Indexes))
+		for i, item := range n.Indexes {
+			out.Indexes[i] = CloneRefOfIndexDefinition(item)
+		}
+	}
+	out.Options = CloneTableOptions(n.Options)
+	out.PartitionOption = CloneRefOfPartitionOption(n.PartitionOption)
+	return &out
+}
+
+// CloneRefOfTablespaceOperation creates a deep clone of the input.
+func CloneRefOfTablespaceOperation(n *TablespaceOperation) *TablespaceOperation {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfTimestampFuncExpr creates a deep clone of the input.
+func CloneRefOfTimestampFuncExpr(n *TimestampFuncExpr) *TimestampFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr1 = CloneExpr(n.Expr1)
+	out.Expr2 = CloneExpr(n.Expr2)
+	return &out
+}
+
+// CloneRefOfUnaryExpr creates a deep clone of the input.
+func CloneRefOfUnaryExpr(n *UnaryExpr) *UnaryExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfUnion creates a deep clone of the input.
+func CloneRefOfUnion(n *Union) *Union {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.FirstStatement = CloneSelectStatement(n.FirstStatement)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	if n.UnionSelects != nil {
+		out.UnionSelects = make([]*UnionSelect, len(n.UnionSelects))
+		for i, item := range n.UnionSelects {
+			out.UnionSelects[i] = CloneRefOfUnionSelect(item)
+		}
+	}
+	out.With = CloneRefOfWith(n.With)
+	return &out
+}
+
+// CloneRefOfUnionSelect creates a deep clone of the input.
+func CloneRefOfUnionSelect(n *UnionSelect) *UnionSelect {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Statement = CloneSelectStatement(n.Statement)
+	return &out
+}
+
+// CloneRefOfUnlockTables creates a deep clone of the input.
+func CloneRefOfUnlockTables(n *UnlockTables) *UnlockTables {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfUpdate creates a deep clone of the input.
+func CloneRefOfUpdate(n *Update) *Update {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneComments(n.Comments)
+	out.Exprs = CloneUpdateExprs(n.Exprs)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.TableExprs = CloneTableExprs(n.TableExprs)
+	out.Where = CloneRefOfWhere(n.Where)
+	return &out
+}
+
+// CloneRefOfUpdateExpr creates a deep clone of the input.
+func CloneRefOfUpdateExpr(n *UpdateExpr) *UpdateExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	out.Name = CloneRefOfColName(n.Name)
+	return &out
+}
+
+// CloneRefOfUse creates a deep clone of the input.
+func CloneRefOfUse(n *Use) *Use {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.DBName = CloneTableIdent(n.DBName)
+	return &out
+}
+
+// CloneRefOfVStream creates a deep clone of the input.
+func CloneRefOfVStream(n *VStream) *VStream {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Comments = CloneComments(n.Comments)
+	out.Limit = CloneRefOfLimit(n.Limit)
+	out.SelectExpr = CloneSelectExpr(n.SelectExpr)
+	out.Table = CloneTableName(n.Table)
+	out.Where = CloneRefOfWhere(n.Where)
+	return &out
+}
+
+// CloneRefOfValidation creates a deep clone of the input.
+func CloneRefOfValidation(n *Validation) *Validation {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
+// CloneRefOfValuesFuncExpr creates a deep clone of the input.
+func CloneRefOfValuesFuncExpr(n *ValuesFuncExpr) *ValuesFuncExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneRefOfColName(n.Name)
+	return &out
+}
+
+// CloneRefOfVindexSpec creates a deep clone of the input.
+func CloneRefOfVindexSpec(n *VindexSpec) *VindexSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	if n.Params != nil {
+		out.Params = make([]VindexParam, len(n.Params))
+		for i, item := range n.Params {
+			out.Params[i] = CloneVindexParam(item)
+		}
+	}
+	out.Type = CloneColIdent(n.Type)
+	return &out
+}
+
+// CloneRefOfWhen creates a deep clone of the input.
+func CloneRefOfWhen(n *When) *When {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Cond = CloneExpr(n.Cond)
+	out.Val = CloneExpr(n.Val)
+	return &out
+}
+
+// CloneRefOfWhere creates a deep clone of the input.
+func CloneRefOfWhere(n *Where) *Where {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
+// CloneRefOfWindowDefinition creates a deep clone of the input.
+func CloneRefOfWindowDefinition(n *WindowDefinition) *WindowDefinition {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Name = CloneColIdent(n.Name)
+	out.Spec = CloneRefOfWindowSpec(n.Spec)
+	return &out
+}
+
+// CloneRefOfWindowSpec creates a deep clone of the input.
+func CloneRefOfWindowSpec(n *WindowSpec) *WindowSpec {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Frame = CloneRefOfFrameClause(n.Frame)
+	out.Name = CloneColIdent(n.Name)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.PartitionBy = CloneExprs(n.PartitionBy)
+	return &out
+}
+
+// CloneRefOfWith creates a deep clone of the input.
+func CloneRefOfWith(n *With) *With {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	if n.CTEs != nil {
+		out.CTEs = make([]*CommonTableExpr, len(n.CTEs))
+		for i, item := range n.CTEs {
+			out.CTEs[i] = CloneRefOfCommonTableExpr(item)
+		}
+	}
+	return &out
+}
+
+// CloneRefOfXorExpr creates a deep clone of the input.
+func CloneRefOfXorExpr(n *XorExpr) *XorExpr {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Left = CloneExpr(n.Left)
+	out.Right = CloneExpr(n.Right)
+	return &out
+}
+
+// CloneAccessMode creates a deep clone of the input.
+func CloneAccessMode(n AccessMode) AccessMode {
+	return n
+}
+
+// CloneAlgorithmValue creates a deep clone of the input.
+func CloneAlgorithmValue(n AlgorithmValue) AlgorithmValue {
+	return n
+}
+
+// CloneArgument creates a deep clone of the input.
+func CloneArgument(n Argument) Argument {
+	if n == nil {
+		return nil
+	}
+	out := make(Argument, len(n))
+	copy(out, n)
+	return out
+}
+
+// CloneBoolVal creates a deep clone of the input.
+func CloneBoolVal(n BoolVal) BoolVal {
+	return n
+}
+
+// CloneColIdent creates a deep clone of the input.
+func CloneColIdent(n ColIdent) ColIdent {
+	out := n
+	return out
+}
+
+// CloneColumns creates a deep clone of the input.
+func CloneColumns(n Columns) Columns {
+	if n == nil {
+		return nil
+	}
+	out := make(Columns, len(n))
+	for i, item := range n {
+		out[i] = CloneColIdent(item)
+	}
+	return out
+}
+
+// CloneComments creates a deep clone of the input.
+func CloneComments(n Comments) Comments {
+	if n == nil {
+		return nil
+	}
+	out := make(Comments, len(n))
+	copy(out, n)
+	return out
+}
+
+// CloneExprs creates a deep clone of the input.
+func CloneExprs(n Exprs) Exprs {
+	if n == nil {
+		return nil
+	}
+	out := make(Exprs, len(n))
+	for i, item := range n {
+		out[i] = CloneExpr(item)
+	}
+	return out
+}
+
+// CloneGroupBy creates a deep clone of the input.
+func CloneGroupBy(n GroupBy) GroupBy {
+	if n == nil {
+		return nil
+	}
+	out := make(GroupBy, len(n))
+	for i, item := range n {
+		out[i] = CloneExpr(item)
+	}
+	return out
+}
+
+// CloneIsolationLevel creates a deep clone of the input.
+func CloneIsolationLevel(n IsolationLevel) IsolationLevel {
+	return n
+}
+
+// CloneJoinCondition creates a deep clone of the input.
+func CloneJoinCondition(n JoinCondition) JoinCondition {
+	out := n
+	out.On = CloneExpr(n.On)
+	out.Using = CloneColumns(n.Using)
+	return out
+}
+
+// CloneListArg creates a deep clone of the input.
+func CloneListArg(n ListArg) ListArg {
+	if n == nil {
+		return nil
+	}
+	out := make(ListArg, len(n))
+	copy(out, n)
+	return out
+}
+
+// CloneNextval creates a deep clone of the input.
+func CloneNextval(n Nextval) Nextval {
+	out := n
+	out.Expr = CloneExpr(n.Expr)
+	return out
+}
+
+// CloneOnDup creates a deep clone of the input.
+func CloneOnDup(n OnDup) OnDup {
+	if n == nil {
+		return nil
+	}
+	out := make(OnDup, len(n))
+	for i, item := range n {
+		out[i] = CloneRefOfUpdateExpr(item)
+	}
+	return out
+}
+
+// CloneOrderBy creates a deep clone of the input.
+func CloneOrderBy(n OrderBy) OrderBy {
+	if n == nil {
+		return nil
+	}
+	out := make(OrderBy, len(n))
+	for i, item := range n {
+		out[i] = CloneRefOfOrder(item)
+	}
+	return out
+}
+
+// ClonePartitions creates a deep clone of the input.
+func ClonePartitions(n Partitions) Partitions {
+	if n == nil {
+		return nil
+	}
+	out := make(Partitions, len(n))
+	for i, item := range n {
+		out[i] = CloneColIdent(item)
+	}
+	return out
+}
+
+// CloneReferenceAction creates a deep clone of the input.
+func CloneReferenceAction(n ReferenceAction) ReferenceAction {
+	return n
+}
+
+// CloneSelectExprs creates a deep clone of the input.
+func CloneSelectExprs(n SelectExprs) SelectExprs {
+	if n == nil {
+		return nil
+	}
+	out := make(SelectExprs, len(n))
+	for i, item := range n {
+		out[i] = CloneSelectExpr(item)
+	}
+	return out
+}
+
+// CloneSetExprs creates a deep clone of the input.
+func CloneSetExprs(n SetExprs) SetExprs {
+	if n == nil {
+		return nil
+	}
+	out := make(SetExprs, len(n))
+	for i, item := range n {
+		out[i] = CloneRefOfSetExpr(item)
+	}
+	return out
+}
+
+// CloneTableExprs creates a deep clone of the input.
+func CloneTableExprs(n TableExprs) TableExprs {
+	if n == nil {
+		return nil
+	}
+	out := make(TableExprs, len(n))
+	for i, item := range n {
+		out[i] = CloneTableExpr(item)
+	}
+	return out
+}
+
+// CloneTableIdent creates a deep clone of the input.
+func CloneTableIdent(n TableIdent) TableIdent {
+	out := n
+	return out
+}
+
+// CloneTableName creates a deep clone of the input.
+func CloneTableName(n TableName) TableName {
+	out := n
+	out.Name = CloneTableIdent(n.Name)
+	out.Qualifier = CloneTableIdent(n.Qualifier)
+	return out
+}
+
+// CloneTableNames creates a deep clone of the input.
+func CloneTableNames(n TableNames) TableNames {
+	if n == nil {
+		return nil
+	}
+	out := make(TableNames, len(n))
+	for i, item := range n {
+		out[i] = CloneTableName(item)
+	}
+	return out
+}
+
+// CloneTableOptions creates a deep clone of the input.
+func CloneTableOptions(n TableOptions) TableOptions {
+	if n == nil {
+		return nil
+	}
+	out := make(TableOptions, len(n))
+	copy(out, n)
+	return out
+}
+
+// CloneUpdateExprs creates a deep clone of the input.
+func CloneUpdateExprs(n UpdateExprs) UpdateExprs {
+	if n == nil {
+		return nil
+	}
+	out := make(UpdateExprs, len(n))
+	for i, item := range n {
+		out[i] = CloneRefOfUpdateExpr(item)
+	}
+	return out
+}
+
+// CloneValTuple creates a deep clone of the input.
+func CloneValTuple(n ValTuple) ValTuple {
+	if n == nil {
+		return nil
+	}
+	out := make(ValTuple, len(n))
+	for i, item := range n {
+		out[i] = CloneExpr(item)
+	}
+	return out
+}
+
+// CloneValues creates a deep clone of the input.
+func CloneValues(n Values) Values {
+	if n == nil {
+		return nil
+	}
+	out := make(Values, len(n))
+	for i, item := range n {
+		out[i] = CloneValTuple(item)
+	}
+	return out
+}
+
+// CloneVindexParam creates a deep clone of the input.
+func CloneVindexParam(n VindexParam) VindexParam {
+	out := n
+	out.Key = CloneColIdent(n.Key)
+	return out
+}
+
+// CloneWindowDefinitions creates a deep clone of the input.
+func CloneWindowDefinitions(n WindowDefinitions) WindowDefinitions {
+	if n == nil {
+		return nil
+	}
+	out := make(WindowDefinitions, len(n))
+	for i, item := range n {
+		out[i] = CloneRefOfWindowDefinition(item)
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClone(t *testing.T) {
+	for _, tcase := range validSQL {
+		t.Run(tcase.input, func(t *testing.T) {
+			tree, err := Parse(tcase.input)
+			require.NoError(t, err)
+			want := String(tree)
+
+			clone := CloneSQLNode(tree)
+			assert.Equal(t, want, String(clone))
+
+			// Changing the clone doesn't change the original.
+			Rewrite(clone, func(cursor *Cursor) bool {
+				switch cursor.Node().(type) {
+				case *ColName:
+					cursor.Replace(NewColName("cloned"))
+				case *Literal:
+					cursor.Replace(NewIntLiteral([]byte("42")))
+				}
+				return true
+			}, nil)
+			assert.Equal(t, want, String(tree))
+		})
+	}
+}
+
+func TestCloneSelectStatement(t *testing.T) {
+	stmt, err := Parse("select a from t where b = 1 union select c from u")
+	require.NoError(t, err)
+	sel := stmt.(SelectStatement)
+	clone := CloneSelectStatement(sel)
+	clone.(*Union).FirstStatement.(*Select).Where.Expr = NewColName("d")
+	assert.Equal(t, "select a from t where b = 1 union select c from u", String(sel))
+	assert.Equal(t, "select a from t where d union select c from u", String(clone))
+	assert.Nil(t, CloneSelectStatement(nil))
+}
@@ -87,6 +87,12 @@ const (
 	RemoveStr            = "remove partitioning"
 	UpgradeStr           = "upgrade partitioning"
 
+	// PartitionOption.Type
+	HashTypeStr  = "hash"
+	KeyTypeStr   = "key"
+	RangeTypeStr = "range"
+	ListTypeStr  = "list"
+
 	// JoinTableExpr.Join
 	JoinStr             = "join"
 	StraightJoinStr     = "straight_join"
@@ -181,6 +187,17 @@ const (
 	AscScr  = "asc"
 	DescScr = "desc"
 
+	// FrameClause.Unit
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+
 	// SetExpr.Expr, for SET TRANSACTION ... or START TRANSACTION
 	// TransactionStr is the Name for a SET TRANSACTION statement
 	TransactionStr = "transaction"
@@ -382,6 +399,21 @@ const (
 	DescOrder
 )
 
+// Constant for Enum Type - FrameUnit
+const (
+	RowsUnit FrameUnit = iota
+	RangeUnit
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRow FramePointType = iota
+	UnboundedPreceding
+	UnboundedFollowing
+	ExprPreceding
+	ExprFollowing
+)
+
 // Constant for Enum Type - ConvertTypeOperator
 const (
 	NoOperator ConvertTypeOperator = iota
@@ -414,6 +446,14 @@ const (
 	UpgradeAction
 )
 
+// Constant for Enum Type - PartitionByType
+const (
+	HashPartitionType PartitionByType = iota
+	KeyPartitionType
+	RangePartitionType
+	ListPartitionType
+)
+
 // Constant for Enum Type - ExplainType
 const (
 	EmptyType ExplainType = iota
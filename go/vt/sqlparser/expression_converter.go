@@ -25,8 +25,11 @@ import (
 // ErrExprNotSupported signals that the expression cannot be handled by expression evaluation engine.
 var ErrExprNotSupported = fmt.Errorf("Expr Not Supported")
 
-//Convert converts between AST expressions and executable expressions
-func Convert(e Expr) (evalengine.Expr, error) {
+// Convert converts between AST expressions and executable expressions.
+// columnLookup resolves column references to offsets in the rows that
+// the expression will be evaluated on. If it's nil, column references
+// are not supported.
+func Convert(e Expr, columnLookup func(col *ColName) (int, error)) (evalengine.Expr, error) {
 	switch node := e.(type) {
 	case Argument:
 		return evalengine.NewBindVar(string(node[1:])), nil
@@ -44,6 +47,17 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			return evalengine.NewLiteralIntFromBytes([]byte("1"))
 		}
 		return evalengine.NewLiteralIntFromBytes([]byte("0"))
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
+	case *ColName:
+		if columnLookup == nil {
+			return nil, ErrExprNotSupported
+		}
+		offset, err := columnLookup(node)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewColumn(offset), nil
 	case *BinaryExpr:
 		var op evalengine.BinaryExpr
 		switch node.Operator {
@@ -55,23 +69,208 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			op = &evalengine.Multiplication{}
 		case DivOp:
 			op = &evalengine.Division{}
+		case ModOp:
+			return convertCall("mod", columnLookup, node.Left, node.Right)
 		default:
 			return nil, ErrExprNotSupported
 		}
-		left, err := Convert(node.Left)
+		return convertBinaryOp(op, node.Left, node.Right, columnLookup)
+	case *UnaryExpr:
+		inner, err := Convert(node.Expr, columnLookup)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Convert(node.Right)
+		switch node.Operator {
+		case UPlusOp:
+			return inner, nil
+		case UMinusOp:
+			return &evalengine.BinaryOp{
+				Expr:  &evalengine.Subtraction{},
+				Left:  evalengine.NewLiteralInt(0),
+				Right: inner,
+			}, nil
+		}
+	case *AndExpr:
+		return convertBinaryOp(&evalengine.And{}, node.Left, node.Right, columnLookup)
+	case *OrExpr:
+		return convertBinaryOp(&evalengine.Or{}, node.Left, node.Right, columnLookup)
+	case *XorExpr:
+		return convertBinaryOp(&evalengine.Xor{}, node.Left, node.Right, columnLookup)
+	case *NotExpr:
+		inner, err := Convert(node.Expr, columnLookup)
 		if err != nil {
 			return nil, err
 		}
-		return &evalengine.BinaryOp{
-			Expr:  op,
-			Left:  left,
-			Right: right,
-		}, nil
-
+		return &evalengine.NotExpr{Inner: inner}, nil
+	case *IsExpr:
+		inner, err := Convert(node.Expr, columnLookup)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.IsExpr{Op: isOps[node.Operator], Inner: inner}, nil
+	case *ComparisonExpr:
+		return convertComparison(node, columnLookup)
+	case *RangeCond:
+		// BETWEEN is evaluated as a pair of comparisons.
+		lower, err := convertBinaryOp(&evalengine.GreaterEqual{}, node.Left, node.From, columnLookup)
+		if err != nil {
+			return nil, err
+		}
+		upper, err := convertBinaryOp(&evalengine.LessEqual{}, node.Left, node.To, columnLookup)
+		if err != nil {
+			return nil, err
+		}
+		if node.Operator == NotBetweenOp {
+			return &evalengine.NotExpr{Inner: &evalengine.BinaryOp{Expr: &evalengine.And{}, Left: lower, Right: upper}}, nil
+		}
+		return &evalengine.BinaryOp{Expr: &evalengine.And{}, Left: lower, Right: upper}, nil
+	case *CaseExpr:
+		return convertCase(node, columnLookup)
+	case *FuncExpr:
+		if !node.Qualifier.IsEmpty() || node.Distinct || !evalengine.IsBuiltin(node.Name.String()) {
+			return nil, ErrExprNotSupported
+		}
+		var args []Expr
+		for _, expr := range node.Exprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				return nil, ErrExprNotSupported
+			}
+			args = append(args, aliased.Expr)
+		}
+		return convertCall(node.Name.String(), columnLookup, args...)
+	case *SubstrExpr:
+		var str Expr = node.StrVal
+		if node.Name != nil {
+			str = node.Name
+		}
+		args := []Expr{str, node.From}
+		if node.To != nil {
+			args = append(args, node.To)
+		}
+		return convertCall("substring", columnLookup, args...)
 	}
 	return nil, ErrExprNotSupported
 }
+
+var isOps = map[IsExprOperator]evalengine.IsOp{
+	IsNullOp:     evalengine.IsNull,
+	IsNotNullOp:  evalengine.IsNotNull,
+	IsTrueOp:     evalengine.IsTrue,
+	IsNotTrueOp:  evalengine.IsNotTrue,
+	IsFalseOp:    evalengine.IsFalse,
+	IsNotFalseOp: evalengine.IsNotFalse,
+}
+
+func convertBinaryOp(op evalengine.BinaryExpr, l, r Expr, columnLookup func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	left, err := Convert(l, columnLookup)
+	if err != nil {
+		return nil, err
+	}
+	right, err := Convert(r, columnLookup)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.BinaryOp{
+		Expr:  op,
+		Left:  left,
+		Right: right,
+	}, nil
+}
+
+func convertCall(name string, columnLookup func(col *ColName) (int, error), exprs ...Expr) (evalengine.Expr, error) {
+	args := make([]evalengine.Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		arg, err := Convert(expr, columnLookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return evalengine.NewCall(name, args)
+}
+
+func convertComparison(node *ComparisonExpr, columnLookup func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	var op evalengine.BinaryExpr
+	switch node.Operator {
+	case EqualOp:
+		op = &evalengine.Equal{}
+	case NotEqualOp:
+		op = &evalengine.NotEqual{}
+	case NullSafeEqualOp:
+		op = &evalengine.NullSafeEqual{}
+	case LessThanOp:
+		op = &evalengine.LessThan{}
+	case LessEqualOp:
+		op = &evalengine.LessEqual{}
+	case GreaterThanOp:
+		op = &evalengine.GreaterThan{}
+	case GreaterEqualOp:
+		op = &evalengine.GreaterEqual{}
+	case InOp, NotInOp:
+		tuple, ok := node.Right.(ValTuple)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		left, err := Convert(node.Left, columnLookup)
+		if err != nil {
+			return nil, err
+		}
+		in := &evalengine.InExpr{Left: left, Negate: node.Operator == NotInOp}
+		for _, expr := range tuple {
+			right, err := Convert(expr, columnLookup)
+			if err != nil {
+				return nil, err
+			}
+			in.Right = append(in.Right, right)
+		}
+		return in, nil
+	case LikeOp, NotLikeOp:
+		escape := byte('\\')
+		if node.Escape != nil {
+			lit, ok := node.Escape.(*Literal)
+			if !ok || lit.Type != StrVal || len(lit.Val) != 1 {
+				return nil, ErrExprNotSupported
+			}
+			escape = lit.Val[0]
+		}
+		left, err := Convert(node.Left, columnLookup)
+		if err != nil {
+			return nil, err
+		}
+		right, err := Convert(node.Right, columnLookup)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewLikeExpr(left, right, escape, node.Operator == NotLikeOp)
+	default:
+		return nil, ErrExprNotSupported
+	}
+	return convertBinaryOp(op, node.Left, node.Right, columnLookup)
+}
+
+func convertCase(node *CaseExpr, columnLookup func(col *ColName) (int, error)) (evalengine.Expr, error) {
+	c := &evalengine.CaseExpr{}
+	var err error
+	if node.Expr != nil {
+		if c.Base, err = Convert(node.Expr, columnLookup); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		var wt evalengine.WhenThen
+		if wt.When, err = Convert(when.Cond, columnLookup); err != nil {
+			return nil, err
+		}
+		if wt.Then, err = Convert(when.Val, columnLookup); err != nil {
+			return nil, err
+		}
+		c.Whens = append(c.Whens, wt)
+	}
+	if node.Else != nil {
+		if c.Else, err = Convert(node.Else, columnLookup); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"fmt"
 	"testing"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -69,6 +70,219 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "1/0",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 + null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "-3",
+		expected:   sqltypes.NewInt64(-3),
+	}, {
+		expression: "7 % 3",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 = 1.0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' = 'ABC'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'10' > 9",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'10' > '9'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "'2020-01-02' > '2020-01-02 00:00:00'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "null = null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "null <=> null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 < 2 and 2 < 3",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null and 0",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "null or 0",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 xor 1",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "not 0",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "0 is not false",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "2 in (1, 2, 3)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "4 not in (1, null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "5 between 1 and 10",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "5 not between 1 and 4",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'Vitess' like 'vit%'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'a_c' like 'a\\_c'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "'abc' not like 'a_c'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "case 2 when 1 then 'one' when 2 then 'two' else 'many' end",
+		expected:   sqltypes.NewVarBinary("two"),
+	}, {
+		expression: "case when 1 > 2 then 'yes' end",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "if(1 > 2, 'yes', 'no')",
+		expected:   sqltypes.NewVarBinary("no"),
+	}, {
+		expression: "ifnull(null, 42)",
+		expected:   sqltypes.NewInt64(42),
+	}, {
+		expression: "coalesce(null, null, 'x')",
+		expected:   sqltypes.NewVarBinary("x"),
+	}, {
+		expression: "nullif(1, 1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "greatest(1, 5, 3)",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "least('b', 'a', 'c')",
+		expected:   sqltypes.NewVarBinary("a"),
+	}, {
+		expression: "concat('a', 1, 2.5)",
+		expected:   sqltypes.NewVarChar("a12.5"),
+	}, {
+		expression: "concat('a', null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat_ws(',', 'a', null, 'b')",
+		expected:   sqltypes.NewVarChar("a,b"),
+	}, {
+		expression: "upper('vitess')",
+		expected:   sqltypes.NewVarChar("VITESS"),
+	}, {
+		expression: "length('héllo')",
+		expected:   sqltypes.NewInt64(6),
+	}, {
+		expression: "char_length('héllo')",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "substring('vitess', 2, 3)",
+		expected:   sqltypes.NewVarChar("ite"),
+	}, {
+		expression: "substr('vitess', -3)",
+		expected:   sqltypes.NewVarChar("ess"),
+	}, {
+		expression: "left('vitess', 3)",
+		expected:   sqltypes.NewVarChar("vit"),
+	}, {
+		expression: "right('vitess', 3)",
+		expected:   sqltypes.NewVarChar("ess"),
+	}, {
+		expression: "trim('  a  ')",
+		expected:   sqltypes.NewVarChar("a"),
+	}, {
+		expression: "replace('aXbX', 'X', '-')",
+		expected:   sqltypes.NewVarChar("a-b-"),
+	}, {
+		expression: "reverse('abc')",
+		expected:   sqltypes.NewVarChar("cba"),
+	}, {
+		expression: "repeat('ab', 3)",
+		expected:   sqltypes.NewVarChar("ababab"),
+	}, {
+		expression: "lpad('5', 3, '0')",
+		expected:   sqltypes.NewVarChar("005"),
+	}, {
+		expression: "rpad('abc', 2, '0')",
+		expected:   sqltypes.NewVarChar("ab"),
+	}, {
+		expression: "instr('foobar', 'BAR')",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "locate('o', 'foo', 3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "abs(-4)",
+		expected:   sqltypes.NewInt64(4),
+	}, {
+		expression: "ceil(1.2)",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "floor(-1.2)",
+		expected:   sqltypes.NewInt64(-2),
+	}, {
+		expression: "round(2.345, 2)",
+		expected:   sqltypes.NewFloat64(2.35),
+	}, {
+		expression: "round(1250, -2)",
+		expected:   sqltypes.NewInt64(1300),
+	}, {
+		expression: "truncate(2.345, 1)",
+		expected:   sqltypes.NewFloat64(2.3),
+	}, {
+		expression: "mod(10, 0)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "sign(-2.5)",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "pow(2, 10)",
+		expected:   sqltypes.NewFloat64(1024),
+	}, {
+		expression: "sqrt(-1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "date('2020-03-04 05:06:07')",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2020-03-04")),
+	}, {
+		expression: "year('2020-03-04')",
+		expected:   sqltypes.NewInt64(2020),
+	}, {
+		expression: "month(20200304)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "hour('2020-03-04 05:06:07')",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "dayofweek('2020-03-08')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "weekday('2020-03-08')",
+		expected:   sqltypes.NewInt64(6),
+	}, {
+		expression: "dayofyear('2020-02-01')",
+		expected:   sqltypes.NewInt64(32),
+	}, {
+		expression: "datediff('2020-03-01 23:00:00', '2020-02-28')",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "date_format('2020-03-04 15:06:07', '%Y/%m/%d %H:%i:%s %W %D %%')",
+		expected:   sqltypes.NewVarChar("2020/03/04 15:06:07 Wednesday 4th %"),
+	}, {
+		expression: "year('not a date')",
+		expected:   sqltypes.NULL,
 	}}
 
 	for _, test := range tests {
@@ -77,7 +291,7 @@ func TestEvaluate(t *testing.T) {
 			stmt, err := Parse("select " + test.expression)
 			require.NoError(t, err)
 			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
-			sqltypesExpr, err := Convert(astExpr)
+			sqltypesExpr, err := Convert(astExpr, nil)
 			require.Nil(t, err)
 			require.NotNil(t, sqltypesExpr)
 			env := evalengine.ExpressionEnv{
@@ -99,3 +313,90 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateColumns(t *testing.T) {
+	fields := []*querypb.Field{
+		{Name: "txt", Type: sqltypes.VarChar},
+		{Name: "bin", Type: sqltypes.VarBinary},
+		{Name: "num", Type: sqltypes.Int64},
+	}
+	row := []sqltypes.Value{
+		sqltypes.NewVarChar("Vitess"),
+		sqltypes.NewVarBinary("Vitess"),
+		sqltypes.NewInt64(3),
+	}
+	lookup := func(col *ColName) (int, error) {
+		for i, f := range fields {
+			if col.Name.EqualString(f.Name) {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("unknown column %s", String(col))
+	}
+
+	tests := []struct {
+		expression string
+		expected   sqltypes.Value
+		typ        querypb.Type
+	}{{
+		// text columns compare case-insensitively
+		expression: "txt = 'VITESS'",
+		expected:   sqltypes.NewInt64(1),
+		typ:        sqltypes.Int64,
+	}, {
+		// binary columns compare byte by byte
+		expression: "bin = 'VITESS'",
+		expected:   sqltypes.NewInt64(0),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "bin like 'vit%'",
+		expected:   sqltypes.NewInt64(0),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "upper(bin)",
+		expected:   sqltypes.NewVarBinary("Vitess"),
+		typ:        sqltypes.VarChar,
+	}, {
+		expression: "num * 2",
+		expected:   sqltypes.NewInt64(6),
+		typ:        sqltypes.Int64,
+	}, {
+		expression: "case when num > 2 then txt else 'small' end",
+		expected:   sqltypes.NewVarBinary("Vitess"),
+		typ:        sqltypes.VarChar,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			expr, err := Convert(astExpr, lookup)
+			require.NoError(t, err)
+			env := evalengine.ExpressionEnv{Row: row, Fields: fields}
+
+			r, err := expr.Evaluate(env)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value())
+			typ, err := expr.Type(env)
+			require.NoError(t, err)
+			assert.Equal(t, test.typ, typ)
+		})
+	}
+}
+
+func TestConvertUnsupported(t *testing.T) {
+	for _, expression := range []string{"md5('a')", "count(*)", "id", "1 regexp 'a'", "x'1f'"} {
+		t.Run(expression, func(t *testing.T) {
+			stmt, err := Parse("select " + expression)
+			require.NoError(t, err)
+			_, err = Convert(stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr, nil)
+			assert.Equal(t, ErrExprNotSupported, err)
+		})
+	}
+
+	stmt, err := Parse("select concat()")
+	require.NoError(t, err)
+	_, err = Convert(stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr, nil)
+	assert.EqualError(t, err, "Incorrect parameter count in the call to native function 'concat'")
+}
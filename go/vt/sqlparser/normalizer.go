@@ -94,6 +94,15 @@ func (nz *normalizer) WalkSelect(cursor *Cursor) bool {
 	case OrderBy, GroupBy:
 		// do not make a bind var for order by column_position
 		return false
+	case WindowDefinitions:
+		// frame offsets must remain constants
+		return false
+	case *FuncExpr:
+		// the arguments and frame offsets of window functions
+		// are needed by vtgate when building the plan
+		if node.IsWindowFunc() {
+			return false
+		}
 	case *ConvertType:
 		// we should not rewrite the type description
 		return false
@@ -154,6 +154,21 @@ func TestNormalize(t *testing.T) {
 		in:      "select a, b from t order by c asc",
 		outstmt: "select a, b from t order by c asc",
 		outbv:   map[string]*querypb.BindVariable{},
+	}, {
+		// Common table expressions
+		in:      "with cte as (select a from t where b = 1) select a from cte where c = 1 union select a from t where d = 2",
+		outstmt: "with cte as (select a from t where b = :bv1) select a from cte where c = :bv1 union select a from t where d = :bv2",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+			"bv2": sqltypes.Int64BindVariable(2),
+		},
+	}, {
+		// Window function arguments and frames
+		in:      "select ntile(4) over w, lag(a, 2, 0) over (rows 1 preceding) from t where b = 1 window w as (order by a)",
+		outstmt: "select ntile(4) over w, lag(a, 2, 0) over (rows 1 preceding) from t where b = :bv1 window w as (order by a asc)",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.Int64BindVariable(1),
+		},
 	}, {
 		// Values up to len 256 will reuse.
 		in:      fmt.Sprintf("select * from t where v1 = '%256s' and v2 = '%256s'", "a", "a"),
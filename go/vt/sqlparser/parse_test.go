@@ -134,6 +134,27 @@ var (
 	}, {
 		input:  "(select id, a from t order by id limit 1) union (select id, b as a from s order by id limit 1) order by a limit 1",
 		output: "(select id, a from t order by id asc limit 1) union (select id, b as a from s order by id asc limit 1) order by a asc limit 1",
+	}, {
+		input: "with cte as (select a from t) select a from cte",
+	}, {
+		input: "with cte1 as (select a from t), cte2(b, c) as (select a, 1 from cte1) select b from cte2 join t on cte2.b = t.a",
+	}, {
+		input: "with recursive cte(n) as (select 1 from dual union all select n + 1 from cte where n < 10) select n from cte",
+	}, {
+		input: "with cte as (select a from t) select a from cte union select b from cte",
+	}, {
+		input:  "with cte as (select a from t) select a from cte order by a",
+		output: "with cte as (select a from t) select a from cte order by a asc",
+	}, {
+		input: "select a from (with cte as (select a from t) select a from cte) as t",
+	}, {
+		input: "select a from t where a in (with cte as (select a from s) select a from cte)",
+	}, {
+		input: "insert into t(a) with cte as (select a from s) select a from cte",
+	}, {
+		input: "insert into t with cte as (select a from s) select a from cte",
+	}, {
+		input: "explain with cte as (select a from t) select a from cte",
 	}, {
 		input: "select a from (select 1 as a from tbl1 union select 2 from tbl2) as t",
 	}, {
@@ -995,7 +1016,19 @@ var (
 		input: "alter table a upgrade partitioning",
 	}, {
 		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
-		output: "alter table a",
+		output: "alter table a partition by range(id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+	}, {
+		input:  "alter table a partition by key () partitions 2",
+		output: "alter table a partition by key() partitions 2",
+	}, {
+		input:  "alter table a partition by hash (id) partitions 4",
+		output: "alter table a partition by hash(id) partitions 4",
+	}, {
+		input:  "alter table a partition by linear key (id, name) partitions 8",
+		output: "alter table a partition by linear key(id, `name`) partitions 8",
+	}, {
+		input:  "alter table a partition by list columns (region) (partition p0 values in ('eu', 'us'), partition p1 values in ('asia'))",
+		output: "alter table a partition by list columns(region) (partition p0 values in ('eu', 'us'), partition p1 values in ('asia'))",
 	}, {
 		input: "alter table a add column (id int, id2 char(23))",
 	}, {
@@ -1553,6 +1586,11 @@ var (
 	}, {
 		input:  "unlock tables",
 		output: "unlock tables",
+	}, {
+		input: "revert 'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a'",
+	}, {
+		input:  "REVERT \"a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a\"",
+		output: "revert 'a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a'",
 	}, {
 		input: "select /* EQ true */ 1 from t where a = true",
 	}, {
@@ -1656,6 +1694,31 @@ var (
 	}, {
 		input:  "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
 		output: "select `name`, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by `name`",
+	}, {
+		input: "select id, row_number() over (partition by col order by id asc) from t",
+	}, {
+		input: "select rank() over (), dense_rank() over (order by a asc), percent_rank() over w, cume_dist() over w from t window w as (order by a desc)",
+	}, {
+		input: "select ntile(4) over (order by a asc) from t",
+	}, {
+		input:  "select lag(a) respect nulls over w, lead(a, 2, 0) ignore nulls over w from t window w as (partition by b order by a)",
+		output: "select lag(a) over w, lead(a, 2, 0) ignore nulls over w from t window w as (partition by b order by a asc)",
+	}, {
+		input:  "select first_value(a) over w, last_value(a) over (w rows unbounded preceding), nth_value(a, 2) from first over w, nth_value(a, 2) from last over w from t window w as (order by a)",
+		output: "select first_value(a) over w, last_value(a) over (w rows unbounded preceding), nth_value(a, 2) over w, nth_value(a, 2) from last over w from t window w as (order by a asc)",
+	}, {
+		input: "select sum(a) over (partition by b order by c asc rows between 1 preceding and current row) from t",
+	}, {
+		input: "select count(*) over (partition by b), avg(a) over (order by c asc range between interval 1 day preceding and unbounded following) from t",
+	}, {
+		input: "select sum(a) over (order by c asc rows between :a preceding and 2 following) from t",
+	}, {
+		input: "select a, sum(b) over w1, max(b) over w2 from t window w1 as (partition by a), w2 as (w1 order by b asc) order by a asc",
+	}, {
+		input: "select `rank`, `window`, `over`, `rows` from t",
+	}, {
+		input:  "select current, row, following from t",
+		output: "select `current`, `row`, `following` from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -2669,6 +2732,35 @@ func TestCreateTable(t *testing.T) {
 			"	time4 timestamp default current_timestamp() on update current_timestamp(),\n" +
 			"	time5 timestamp(3) default current_timestamp(3) on update current_timestamp(3)\n" +
 			")",
+	}, {
+		// test partitioning
+		input: "create table t (\n" +
+			"	id int,\n" +
+			"	created date\n" +
+			") engine InnoDB partition by range (year(created)) (partition p0 values less than (2000), partition p1 values less than (maxvalue))",
+		output: "create table t (\n" +
+			"	id int,\n" +
+			"	created date\n" +
+			") engine InnoDB\n" +
+			"partition by range(year(created)) (partition p0 values less than (2000), partition p1 values less than (maxvalue))",
+	}, {
+		input: "create table t (\n" +
+			"	id int\n" +
+			") partition by linear hash (id) partitions 4 (partition p0, partition p1, partition p2, partition p3)",
+		output: "create table t (\n" +
+			"	id int\n" +
+			")\n" +
+			"partition by linear hash(id) partitions 4 (partition p0, partition p1, partition p2, partition p3)",
+	}, {
+		input: "create table t (\n" +
+			"	id int,\n" +
+			"	region varchar(10)\n" +
+			") partition by list columns (region) (partition p0 values in ('eu'), partition p1 values in ('us', 'asia'))",
+		output: "create table t (\n" +
+			"	id int,\n" +
+			"	region varchar(10)\n" +
+			")\n" +
+			"partition by list columns(region) (partition p0 values in ('eu'), partition p1 values in ('us', 'asia'))",
 	}, {
 		// test utc_timestamp with and without ()
 		input: "create table t (\n" +
@@ -2861,6 +2953,15 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "select row_number() from t",
+		output: "syntax error at position 25 near 'from'",
+	}, {
+		input:  "select sum(a) over (rows between a preceding and current row) from t",
+		output: "syntax error at position 35 near 'a'",
+	}, {
+		input:  "select rank from t",
+		output: "syntax error at position 17 near 'from'",
 	}, {
 		input:  "select 0xH from t",
 		output: "syntax error at position 10 near '0x'",
@@ -2,7 +2,7 @@
 
 package sqlparser
 
-//go:generate go run ./visitorgen/main -input=ast.go -output=rewriter.go
+//go:generate go run ./visitorgen/main -input=ast.go -output=rewriter.go -clone=clone.go
 
 import (
 	"reflect"
@@ -84,6 +84,10 @@ func (r *replaceAlterTableAlterOptions) inc() {
 	*r++
 }
 
+func replaceAlterTablePartitionOption(newNode, parent SQLNode) {
+	parent.(*AlterTable).PartitionOption = newNode.(*PartitionOption)
+}
+
 func replaceAlterTablePartitionSpec(newNode, parent SQLNode) {
 	parent.(*AlterTable).PartitionSpec = newNode.(*PartitionSpec)
 }
@@ -234,6 +238,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Name = newNode.(TableIdent)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -416,6 +432,18 @@ func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
 	parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 }
 
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	parent.(*FrameClause).End = newNode.(*FramePoint)
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	parent.(*FrameClause).Start = newNode.(*FramePoint)
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	parent.(*FramePoint).Expr = newNode.(Expr)
+}
+
 func replaceFuncExprExprs(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 }
@@ -424,6 +452,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -603,6 +635,14 @@ func replaceOrderByOptionCols(newNode, parent SQLNode) {
 	parent.(*OrderByOption).Cols = newNode.(Columns)
 }
 
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowName = newNode.(ColIdent)
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowSpec = newNode.(*WindowSpec)
+}
+
 func replaceParenSelectSelect(newNode, parent SQLNode) {
 	parent.(*ParenSelect).Select = newNode.(SelectStatement)
 }
@@ -611,6 +651,10 @@ func replaceParenTableExprExprs(newNode, parent SQLNode) {
 	parent.(*ParenTableExpr).Exprs = newNode.(TableExprs)
 }
 
+func replacePartitionDefinitionInValues(newNode, parent SQLNode) {
+	parent.(*PartitionDefinition).InValues = newNode.(Exprs)
+}
+
 func replacePartitionDefinitionLimit(newNode, parent SQLNode) {
 	parent.(*PartitionDefinition).Limit = newNode.(Expr)
 }
@@ -619,6 +663,28 @@ func replacePartitionDefinitionName(newNode, parent SQLNode) {
 	parent.(*PartitionDefinition).Name = newNode.(ColIdent)
 }
 
+func replacePartitionOptionColList(newNode, parent SQLNode) {
+	parent.(*PartitionOption).ColList = newNode.(Columns)
+}
+
+type replacePartitionOptionDefinitions int
+
+func (r *replacePartitionOptionDefinitions) replace(newNode, container SQLNode) {
+	container.(*PartitionOption).Definitions[int(*r)] = newNode.(*PartitionDefinition)
+}
+
+func (r *replacePartitionOptionDefinitions) inc() {
+	*r++
+}
+
+func replacePartitionOptionExpr(newNode, parent SQLNode) {
+	parent.(*PartitionOption).Expr = newNode.(Expr)
+}
+
+func replacePartitionOptionPartitions(newNode, parent SQLNode) {
+	parent.(*PartitionOption).Partitions = newNode.(*Literal)
+}
+
 type replacePartitionSpecDefinitions int
 
 func (r *replacePartitionSpecDefinitions) replace(newNode, container SQLNode) {
@@ -715,6 +781,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(WindowDefinitions)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(*With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -901,6 +975,10 @@ func replaceTableSpecOptions(newNode, parent SQLNode) {
 	parent.(*TableSpec).Options = newNode.(TableOptions)
 }
 
+func replaceTableSpecPartitionOption(newNode, parent SQLNode) {
+	parent.(*TableSpec).PartitionOption = newNode.(*PartitionOption)
+}
+
 func replaceTimestampFuncExprExpr1(newNode, parent SQLNode) {
 	parent.(*TimestampFuncExpr).Expr1 = newNode.(Expr)
 }
@@ -935,6 +1013,10 @@ func (r *replaceUnionUnionSelects) inc() {
 	*r++
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(*With)
+}
+
 func replaceUnionSelectStatement(newNode, parent SQLNode) {
 	parent.(*UnionSelect).Statement = newNode.(SelectStatement)
 }
@@ -1064,6 +1146,50 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowDefinitionName(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).Name = newNode.(ColIdent)
+}
+
+func replaceWindowDefinitionSpec(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).Spec = newNode.(*WindowSpec)
+}
+
+type replaceWindowDefinitionsItems int
+
+func (r *replaceWindowDefinitionsItems) replace(newNode, container SQLNode) {
+	container.(WindowDefinitions)[int(*r)] = newNode.(*WindowDefinition)
+}
+
+func (r *replaceWindowDefinitionsItems) inc() {
+	*r++
+}
+
+func replaceWindowSpecFrame(newNode, parent SQLNode) {
+	parent.(*WindowSpec).Frame = newNode.(*FrameClause)
+}
+
+func replaceWindowSpecName(newNode, parent SQLNode) {
+	parent.(*WindowSpec).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecOrderBy(newNode, parent SQLNode) {
+	parent.(*WindowSpec).OrderBy = newNode.(OrderBy)
+}
+
+func replaceWindowSpecPartitionBy(newNode, parent SQLNode) {
+	parent.(*WindowSpec).PartitionBy = newNode.(Exprs)
+}
+
+type replaceWithCTEs int
+
+func (r *replaceWithCTEs) replace(newNode, container SQLNode) {
+	container.(*With).CTEs[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithCTEs) inc() {
+	*r++
+}
+
 func replaceXorExprLeft(newNode, parent SQLNode) {
 	parent.(*XorExpr).Left = newNode.(Expr)
 }
@@ -1139,6 +1265,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			a.apply(node, item, replacerAlterOptionsB.replace)
 			replacerAlterOptionsB.inc()
 		}
+		a.apply(node, n.PartitionOption, replaceAlterTablePartitionOption)
 		a.apply(node, n.PartitionSpec, replaceAlterTablePartitionSpec)
 		a.apply(node, n.Table, replaceAlterTableTable)
 
@@ -1226,6 +1353,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -1323,9 +1455,17 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
 		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
 
+	case *FrameClause:
+		a.apply(node, n.End, replaceFrameClauseEnd)
+		a.apply(node, n.Start, replaceFrameClauseStart)
+
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1447,6 +1587,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+
 	case *ParenSelect:
 		a.apply(node, n.Select, replaceParenSelectSelect)
 
@@ -1454,9 +1598,21 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Exprs, replaceParenTableExprExprs)
 
 	case *PartitionDefinition:
+		a.apply(node, n.InValues, replacePartitionDefinitionInValues)
 		a.apply(node, n.Limit, replacePartitionDefinitionLimit)
 		a.apply(node, n.Name, replacePartitionDefinitionName)
 
+	case *PartitionOption:
+		a.apply(node, n.ColList, replacePartitionOptionColList)
+		replacerDefinitions := replacePartitionOptionDefinitions(0)
+		replacerDefinitionsB := &replacerDefinitions
+		for _, item := range n.Definitions {
+			a.apply(node, item, replacerDefinitionsB.replace)
+			replacerDefinitionsB.inc()
+		}
+		a.apply(node, n.Expr, replacePartitionOptionExpr)
+		a.apply(node, n.Partitions, replacePartitionOptionPartitions)
+
 	case *PartitionSpec:
 		replacerDefinitions := replacePartitionSpecDefinitions(0)
 		replacerDefinitionsB := &replacerDefinitions
@@ -1491,6 +1647,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *RenameTable:
 		a.apply(node, n.Table, replaceRenameTableTable)
 
+	case *RevertMigration:
+
 	case *Rollback:
 
 	case *SRollback:
@@ -1509,6 +1667,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1627,6 +1787,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			replacerIndexesB.inc()
 		}
 		a.apply(node, n.Options, replaceTableSpecOptions)
+		a.apply(node, n.PartitionOption, replaceTableSpecPartitionOption)
 
 	case *TablespaceOperation:
 
@@ -1647,6 +1808,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			a.apply(node, item, replacerUnionSelectsB.replace)
 			replacerUnionSelectsB.inc()
 		}
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *UnionSelect:
 		a.apply(node, n.Statement, replaceUnionSelectStatement)
@@ -1724,6 +1886,32 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowDefinition:
+		a.apply(node, n.Name, replaceWindowDefinitionName)
+		a.apply(node, n.Spec, replaceWindowDefinitionSpec)
+
+	case WindowDefinitions:
+		replacer := replaceWindowDefinitionsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
+	case *WindowSpec:
+		a.apply(node, n.Frame, replaceWindowSpecFrame)
+		a.apply(node, n.Name, replaceWindowSpecName)
+		a.apply(node, n.OrderBy, replaceWindowSpecOrderBy)
+		a.apply(node, n.PartitionBy, replaceWindowSpecPartitionBy)
+
+	case *With:
+		replacerCTEs := replaceWithCTEs(0)
+		replacerCTEsB := &replacerCTEs
+		for _, item := range n.CTEs {
+			a.apply(node, item, replacerCTEsB.replace)
+			replacerCTEsB.inc()
+		}
+
 	case *XorExpr:
 		a.apply(node, n.Left, replaceXorExprLeft)
 		a.apply(node, n.Right, replaceXorExprRight)
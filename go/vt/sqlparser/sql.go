@@ -107,6 +107,7 @@ type yySymType struct {
 	partDef                *PartitionDefinition
 	partSpec               *PartitionSpec
 	partSpecs              []*PartitionSpec
+	partitionOption        *PartitionOption
 	vindexParam            VindexParam
 	vindexParams           []VindexParam
 	showFilter             *ShowFilter
@@ -137,6 +138,16 @@ type yySymType struct {
 	alterOptions           []AlterOption
 	tableOption            *TableOption
 	tableOptions           TableOptions
+	overClause             *OverClause
+	windowSpec             *WindowSpec
+	frameClause            *FrameClause
+	framePoint             *FramePoint
+	frameUnit              FrameUnit
+	windowDefinition       *WindowDefinition
+	windowDefinitions      WindowDefinitions
+	with                   *With
+	cte                    *CommonTableExpr
+	ctes                   []*CommonTableExpr
 }
 
 const LEX_ERROR = 57346
@@ -316,259 +327,267 @@ const PARTITIONING = 57519
 const REMOVE = 57520
 const MAXVALUE = 57521
 const PARTITION = 57522
-const REORGANIZE = 57523
-const LESS = 57524
-const THAN = 57525
-const PROCEDURE = 57526
-const TRIGGER = 57527
-const VINDEX = 57528
-const VINDEXES = 57529
-const DIRECTORY = 57530
-const NAME = 57531
-const UPGRADE = 57532
-const STATUS = 57533
-const VARIABLES = 57534
-const WARNINGS = 57535
-const CASCADED = 57536
-const DEFINER = 57537
-const OPTION = 57538
-const SQL = 57539
-const UNDEFINED = 57540
-const SEQUENCE = 57541
-const MERGE = 57542
-const TEMPTABLE = 57543
-const INVOKER = 57544
-const SECURITY = 57545
-const FIRST = 57546
-const AFTER = 57547
-const LAST = 57548
-const BEGIN = 57549
-const START = 57550
-const TRANSACTION = 57551
-const COMMIT = 57552
-const ROLLBACK = 57553
-const SAVEPOINT = 57554
-const RELEASE = 57555
-const WORK = 57556
-const BIT = 57557
-const TINYINT = 57558
-const SMALLINT = 57559
-const MEDIUMINT = 57560
-const INT = 57561
-const INTEGER = 57562
-const BIGINT = 57563
-const INTNUM = 57564
-const REAL = 57565
-const DOUBLE = 57566
-const FLOAT_TYPE = 57567
-const DECIMAL = 57568
-const NUMERIC = 57569
-const TIME = 57570
-const TIMESTAMP = 57571
-const DATETIME = 57572
-const YEAR = 57573
-const CHAR = 57574
-const VARCHAR = 57575
-const BOOL = 57576
-const CHARACTER = 57577
-const VARBINARY = 57578
-const NCHAR = 57579
-const TEXT = 57580
-const TINYTEXT = 57581
-const MEDIUMTEXT = 57582
-const LONGTEXT = 57583
-const BLOB = 57584
-const TINYBLOB = 57585
-const MEDIUMBLOB = 57586
-const LONGBLOB = 57587
-const JSON = 57588
-const ENUM = 57589
-const GEOMETRY = 57590
-const POINT = 57591
-const LINESTRING = 57592
-const POLYGON = 57593
-const GEOMETRYCOLLECTION = 57594
-const MULTIPOINT = 57595
-const MULTILINESTRING = 57596
-const MULTIPOLYGON = 57597
-const NULLX = 57598
-const AUTO_INCREMENT = 57599
-const APPROXNUM = 57600
-const SIGNED = 57601
-const UNSIGNED = 57602
-const ZEROFILL = 57603
-const COLLATION = 57604
-const DATABASES = 57605
-const SCHEMAS = 57606
-const TABLES = 57607
-const VITESS_METADATA = 57608
-const VSCHEMA = 57609
-const FULL = 57610
-const PROCESSLIST = 57611
-const COLUMNS = 57612
-const FIELDS = 57613
-const ENGINES = 57614
-const PLUGINS = 57615
-const EXTENDED = 57616
-const KEYSPACES = 57617
-const VITESS_KEYSPACES = 57618
-const VITESS_SHARDS = 57619
-const VITESS_TABLETS = 57620
-const CODE = 57621
-const PRIVILEGES = 57622
-const FUNCTION = 57623
-const NAMES = 57624
-const CHARSET = 57625
-const GLOBAL = 57626
-const SESSION = 57627
-const ISOLATION = 57628
-const LEVEL = 57629
-const READ = 57630
-const WRITE = 57631
-const ONLY = 57632
-const REPEATABLE = 57633
-const COMMITTED = 57634
-const UNCOMMITTED = 57635
-const SERIALIZABLE = 57636
-const CURRENT_TIMESTAMP = 57637
-const DATABASE = 57638
-const CURRENT_DATE = 57639
-const CURRENT_TIME = 57640
-const LOCALTIME = 57641
-const LOCALTIMESTAMP = 57642
-const CURRENT_USER = 57643
-const UTC_DATE = 57644
-const UTC_TIME = 57645
-const UTC_TIMESTAMP = 57646
-const REPLACE = 57647
-const CONVERT = 57648
-const CAST = 57649
-const SUBSTR = 57650
-const SUBSTRING = 57651
-const GROUP_CONCAT = 57652
-const SEPARATOR = 57653
-const TIMESTAMPADD = 57654
-const TIMESTAMPDIFF = 57655
-const MATCH = 57656
-const AGAINST = 57657
-const BOOLEAN = 57658
-const LANGUAGE = 57659
-const WITH = 57660
-const QUERY = 57661
-const EXPANSION = 57662
-const WITHOUT = 57663
-const VALIDATION = 57664
-const UNUSED = 57665
-const ARRAY = 57666
-const CUME_DIST = 57667
-const DESCRIPTION = 57668
-const DENSE_RANK = 57669
-const EMPTY = 57670
-const EXCEPT = 57671
-const FIRST_VALUE = 57672
+const PARTITIONS = 57523
+const REORGANIZE = 57524
+const LESS = 57525
+const THAN = 57526
+const PROCEDURE = 57527
+const TRIGGER = 57528
+const LINEAR = 57529
+const LIST = 57530
+const VINDEX = 57531
+const VINDEXES = 57532
+const DIRECTORY = 57533
+const NAME = 57534
+const UPGRADE = 57535
+const STATUS = 57536
+const VARIABLES = 57537
+const WARNINGS = 57538
+const CASCADED = 57539
+const DEFINER = 57540
+const OPTION = 57541
+const SQL = 57542
+const UNDEFINED = 57543
+const SEQUENCE = 57544
+const MERGE = 57545
+const TEMPTABLE = 57546
+const INVOKER = 57547
+const SECURITY = 57548
+const FIRST = 57549
+const AFTER = 57550
+const LAST = 57551
+const BEGIN = 57552
+const START = 57553
+const TRANSACTION = 57554
+const COMMIT = 57555
+const ROLLBACK = 57556
+const SAVEPOINT = 57557
+const RELEASE = 57558
+const WORK = 57559
+const BIT = 57560
+const TINYINT = 57561
+const SMALLINT = 57562
+const MEDIUMINT = 57563
+const INT = 57564
+const INTEGER = 57565
+const BIGINT = 57566
+const INTNUM = 57567
+const REAL = 57568
+const DOUBLE = 57569
+const FLOAT_TYPE = 57570
+const DECIMAL = 57571
+const NUMERIC = 57572
+const TIME = 57573
+const TIMESTAMP = 57574
+const DATETIME = 57575
+const YEAR = 57576
+const CHAR = 57577
+const VARCHAR = 57578
+const BOOL = 57579
+const CHARACTER = 57580
+const VARBINARY = 57581
+const NCHAR = 57582
+const TEXT = 57583
+const TINYTEXT = 57584
+const MEDIUMTEXT = 57585
+const LONGTEXT = 57586
+const BLOB = 57587
+const TINYBLOB = 57588
+const MEDIUMBLOB = 57589
+const LONGBLOB = 57590
+const JSON = 57591
+const ENUM = 57592
+const GEOMETRY = 57593
+const POINT = 57594
+const LINESTRING = 57595
+const POLYGON = 57596
+const GEOMETRYCOLLECTION = 57597
+const MULTIPOINT = 57598
+const MULTILINESTRING = 57599
+const MULTIPOLYGON = 57600
+const NULLX = 57601
+const AUTO_INCREMENT = 57602
+const APPROXNUM = 57603
+const SIGNED = 57604
+const UNSIGNED = 57605
+const ZEROFILL = 57606
+const COLLATION = 57607
+const DATABASES = 57608
+const SCHEMAS = 57609
+const TABLES = 57610
+const VITESS_METADATA = 57611
+const VSCHEMA = 57612
+const FULL = 57613
+const PROCESSLIST = 57614
+const COLUMNS = 57615
+const FIELDS = 57616
+const ENGINES = 57617
+const PLUGINS = 57618
+const EXTENDED = 57619
+const KEYSPACES = 57620
+const VITESS_KEYSPACES = 57621
+const VITESS_SHARDS = 57622
+const VITESS_TABLETS = 57623
+const CODE = 57624
+const PRIVILEGES = 57625
+const FUNCTION = 57626
+const NAMES = 57627
+const CHARSET = 57628
+const GLOBAL = 57629
+const SESSION = 57630
+const ISOLATION = 57631
+const LEVEL = 57632
+const READ = 57633
+const WRITE = 57634
+const ONLY = 57635
+const REPEATABLE = 57636
+const COMMITTED = 57637
+const UNCOMMITTED = 57638
+const SERIALIZABLE = 57639
+const CURRENT_TIMESTAMP = 57640
+const DATABASE = 57641
+const CURRENT_DATE = 57642
+const CURRENT_TIME = 57643
+const LOCALTIME = 57644
+const LOCALTIMESTAMP = 57645
+const CURRENT_USER = 57646
+const UTC_DATE = 57647
+const UTC_TIME = 57648
+const UTC_TIMESTAMP = 57649
+const REPLACE = 57650
+const CONVERT = 57651
+const CAST = 57652
+const SUBSTR = 57653
+const SUBSTRING = 57654
+const GROUP_CONCAT = 57655
+const SEPARATOR = 57656
+const TIMESTAMPADD = 57657
+const TIMESTAMPDIFF = 57658
+const MATCH = 57659
+const AGAINST = 57660
+const BOOLEAN = 57661
+const LANGUAGE = 57662
+const WITH = 57663
+const QUERY = 57664
+const EXPANSION = 57665
+const WITHOUT = 57666
+const VALIDATION = 57667
+const UNUSED = 57668
+const ARRAY = 57669
+const DESCRIPTION = 57670
+const EMPTY = 57671
+const EXCEPT = 57672
 const GROUPING = 57673
 const GROUPS = 57674
 const JSON_TABLE = 57675
-const LAG = 57676
-const LAST_VALUE = 57677
-const LATERAL = 57678
-const LEAD = 57679
-const MEMBER = 57680
-const NTH_VALUE = 57681
-const NTILE = 57682
-const OF = 57683
-const OVER = 57684
-const PERCENT_RANK = 57685
-const RANK = 57686
-const RECURSIVE = 57687
-const ROW_NUMBER = 57688
-const SYSTEM = 57689
-const WINDOW = 57690
-const ACTIVE = 57691
-const ADMIN = 57692
-const BUCKETS = 57693
-const CLONE = 57694
-const COMPONENT = 57695
-const DEFINITION = 57696
-const ENFORCED = 57697
-const EXCLUDE = 57698
-const FOLLOWING = 57699
-const GEOMCOLLECTION = 57700
-const GET_MASTER_PUBLIC_KEY = 57701
-const HISTOGRAM = 57702
-const HISTORY = 57703
-const INACTIVE = 57704
-const INVISIBLE = 57705
-const LOCKED = 57706
-const MASTER_COMPRESSION_ALGORITHMS = 57707
-const MASTER_PUBLIC_KEY_PATH = 57708
-const MASTER_TLS_CIPHERSUITES = 57709
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57710
-const NESTED = 57711
-const NETWORK_NAMESPACE = 57712
-const NOWAIT = 57713
-const NULLS = 57714
-const OJ = 57715
-const OLD = 57716
-const OPTIONAL = 57717
-const ORDINALITY = 57718
-const ORGANIZATION = 57719
-const OTHERS = 57720
-const PATH = 57721
-const PERSIST = 57722
-const PERSIST_ONLY = 57723
-const PRECEDING = 57724
-const PRIVILEGE_CHECKS_USER = 57725
-const PROCESS = 57726
-const RANDOM = 57727
-const REFERENCE = 57728
-const REQUIRE_ROW_FORMAT = 57729
-const RESOURCE = 57730
-const RESPECT = 57731
-const RESTART = 57732
-const RETAIN = 57733
-const REUSE = 57734
-const ROLE = 57735
-const SECONDARY = 57736
-const SECONDARY_ENGINE = 57737
-const SECONDARY_LOAD = 57738
-const SECONDARY_UNLOAD = 57739
-const SKIP = 57740
-const SRID = 57741
-const THREAD_PRIORITY = 57742
-const TIES = 57743
-const UNBOUNDED = 57744
-const VCPU = 57745
-const VISIBLE = 57746
-const FORMAT = 57747
-const TREE = 57748
-const VITESS = 57749
-const TRADITIONAL = 57750
-const LOCAL = 57751
-const LOW_PRIORITY = 57752
-const AVG_ROW_LENGTH = 57753
-const CONNECTION = 57754
-const CHECKSUM = 57755
-const DELAY_KEY_WRITE = 57756
-const ENCRYPTION = 57757
-const ENGINE = 57758
-const INSERT_METHOD = 57759
-const MAX_ROWS = 57760
-const MIN_ROWS = 57761
-const PACK_KEYS = 57762
-const PASSWORD = 57763
-const FIXED = 57764
-const DYNAMIC = 57765
-const COMPRESSED = 57766
-const REDUNDANT = 57767
-const COMPACT = 57768
-const ROW_FORMAT = 57769
-const STATS_AUTO_RECALC = 57770
-const STATS_PERSISTENT = 57771
-const STATS_SAMPLE_PAGES = 57772
-const STORAGE = 57773
-const MEMORY = 57774
-const DISK = 57775
+const LATERAL = 57676
+const MEMBER = 57677
+const OF = 57678
+const RECURSIVE = 57679
+const SYSTEM = 57680
+const ACTIVE = 57681
+const ADMIN = 57682
+const BUCKETS = 57683
+const CLONE = 57684
+const COMPONENT = 57685
+const DEFINITION = 57686
+const ENFORCED = 57687
+const EXCLUDE = 57688
+const GEOMCOLLECTION = 57689
+const GET_MASTER_PUBLIC_KEY = 57690
+const HISTOGRAM = 57691
+const HISTORY = 57692
+const INACTIVE = 57693
+const INVISIBLE = 57694
+const LOCKED = 57695
+const MASTER_COMPRESSION_ALGORITHMS = 57696
+const MASTER_PUBLIC_KEY_PATH = 57697
+const MASTER_TLS_CIPHERSUITES = 57698
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57699
+const NESTED = 57700
+const NETWORK_NAMESPACE = 57701
+const NOWAIT = 57702
+const OJ = 57703
+const OLD = 57704
+const OPTIONAL = 57705
+const ORDINALITY = 57706
+const ORGANIZATION = 57707
+const OTHERS = 57708
+const PATH = 57709
+const PERSIST = 57710
+const PERSIST_ONLY = 57711
+const PRIVILEGE_CHECKS_USER = 57712
+const PROCESS = 57713
+const RANDOM = 57714
+const REFERENCE = 57715
+const REQUIRE_ROW_FORMAT = 57716
+const RESOURCE = 57717
+const RESTART = 57718
+const RETAIN = 57719
+const REUSE = 57720
+const REVERT = 57721
+const ROLE = 57722
+const SECONDARY = 57723
+const SECONDARY_ENGINE = 57724
+const SECONDARY_LOAD = 57725
+const SECONDARY_UNLOAD = 57726
+const SKIP = 57727
+const SRID = 57728
+const THREAD_PRIORITY = 57729
+const TIES = 57730
+const VCPU = 57731
+const VISIBLE = 57732
+const CUME_DIST = 57733
+const DENSE_RANK = 57734
+const FIRST_VALUE = 57735
+const LAG = 57736
+const LAST_VALUE = 57737
+const LEAD = 57738
+const NTH_VALUE = 57739
+const NTILE = 57740
+const OVER = 57741
+const PERCENT_RANK = 57742
+const RANK = 57743
+const ROW_NUMBER = 57744
+const WINDOW = 57745
+const CURRENT = 57746
+const FOLLOWING = 57747
+const NULLS = 57748
+const PRECEDING = 57749
+const RANGE = 57750
+const RESPECT = 57751
+const ROW = 57752
+const ROWS = 57753
+const UNBOUNDED = 57754
+const FORMAT = 57755
+const TREE = 57756
+const VITESS = 57757
+const TRADITIONAL = 57758
+const LOCAL = 57759
+const LOW_PRIORITY = 57760
+const AVG_ROW_LENGTH = 57761
+const CONNECTION = 57762
+const CHECKSUM = 57763
+const DELAY_KEY_WRITE = 57764
+const ENCRYPTION = 57765
+const ENGINE = 57766
+const INSERT_METHOD = 57767
+const MAX_ROWS = 57768
+const MIN_ROWS = 57769
+const PACK_KEYS = 57770
+const PASSWORD = 57771
+const FIXED = 57772
+const DYNAMIC = 57773
+const COMPRESSED = 57774
+const REDUNDANT = 57775
+const COMPACT = 57776
+const ROW_FORMAT = 57777
+const STATS_AUTO_RECALC = 57778
+const STATS_PERSISTENT = 57779
+const STATS_SAMPLE_PAGES = 57780
+const STORAGE = 57781
+const MEMORY = 57782
+const DISK = 57783
 
 var yyToknames = [...]string{
 	"$end",
@@ -768,11 +787,14 @@ var yyToknames = [...]string{
 	"REMOVE",
 	"MAXVALUE",
 	"PARTITION",
+	"PARTITIONS",
 	"REORGANIZE",
 	"LESS",
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"LINEAR",
+	"LIST",
 	"VINDEX",
 	"VINDEXES",
 	"DIRECTORY",
@@ -912,30 +934,17 @@ var yyToknames = [...]string{
 	"VALIDATION",
 	"UNUSED",
 	"ARRAY",
-	"CUME_DIST",
 	"DESCRIPTION",
-	"DENSE_RANK",
 	"EMPTY",
 	"EXCEPT",
-	"FIRST_VALUE",
 	"GROUPING",
 	"GROUPS",
 	"JSON_TABLE",
-	"LAG",
-	"LAST_VALUE",
 	"LATERAL",
-	"LEAD",
 	"MEMBER",
-	"NTH_VALUE",
-	"NTILE",
 	"OF",
-	"OVER",
-	"PERCENT_RANK",
-	"RANK",
 	"RECURSIVE",
-	"ROW_NUMBER",
 	"SYSTEM",
-	"WINDOW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -944,7 +953,6 @@ var yyToknames = [...]string{
 	"DEFINITION",
 	"ENFORCED",
 	"EXCLUDE",
-	"FOLLOWING",
 	"GEOMCOLLECTION",
 	"GET_MASTER_PUBLIC_KEY",
 	"HISTOGRAM",
@@ -959,7 +967,6 @@ var yyToknames = [...]string{
 	"NESTED",
 	"NETWORK_NAMESPACE",
 	"NOWAIT",
-	"NULLS",
 	"OJ",
 	"OLD",
 	"OPTIONAL",
@@ -969,17 +976,16 @@ var yyToknames = [...]string{
 	"PATH",
 	"PERSIST",
 	"PERSIST_ONLY",
-	"PRECEDING",
 	"PRIVILEGE_CHECKS_USER",
 	"PROCESS",
 	"RANDOM",
 	"REFERENCE",
 	"REQUIRE_ROW_FORMAT",
 	"RESOURCE",
-	"RESPECT",
 	"RESTART",
 	"RETAIN",
 	"REUSE",
+	"REVERT",
 	"ROLE",
 	"SECONDARY",
 	"SECONDARY_ENGINE",
@@ -989,9 +995,30 @@ var yyToknames = [...]string{
 	"SRID",
 	"THREAD_PRIORITY",
 	"TIES",
-	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"OVER",
+	"PERCENT_RANK",
+	"RANK",
+	"ROW_NUMBER",
+	"WINDOW",
+	"CURRENT",
+	"FOLLOWING",
+	"NULLS",
+	"PRECEDING",
+	"RANGE",
+	"RESPECT",
+	"ROW",
+	"ROWS",
+	"UNBOUNDED",
 	"FORMAT",
 	"TREE",
 	"VITESS",
@@ -1035,2777 +1062,2886 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 42,
-	163, 906,
-	-2, 89,
-	-1, 43,
-	1, 107,
-	451, 107,
-	-2, 113,
-	-1, 44,
-	142, 113,
-	252, 113,
-	300, 113,
-	-2, 325,
-	-1, 51,
-	34, 463,
-	163, 463,
-	175, 463,
-	208, 477,
-	209, 477,
-	-2, 465,
-	-1, 56,
-	165, 487,
-	-2, 485,
-	-1, 80,
-	55, 530,
-	-2, 538,
-	-1, 104,
-	1, 108,
-	451, 108,
-	-2, 113,
-	-1, 114,
-	168, 230,
-	169, 230,
-	-2, 319,
-	-1, 133,
-	142, 113,
-	252, 113,
-	300, 113,
-	-2, 334,
-	-1, 549,
-	149, 917,
-	-2, 913,
-	-1, 550,
-	149, 918,
-	-2, 914,
-	-1, 567,
-	55, 531,
-	-2, 543,
-	-1, 568,
-	55, 532,
-	-2, 544,
-	-1, 588,
-	117, 1244,
-	-2, 82,
-	-1, 589,
-	117, 1131,
-	-2, 83,
+	-1, 45,
+	163, 981,
+	-2, 97,
+	-1, 46,
+	1, 115,
+	459, 115,
+	-2, 121,
+	-1, 47,
+	142, 121,
+	255, 121,
+	303, 121,
+	-2, 333,
+	-1, 54,
+	34, 490,
+	163, 490,
+	175, 490,
+	211, 504,
+	212, 504,
+	-2, 492,
+	-1, 59,
+	165, 514,
+	-2, 512,
+	-1, 85,
+	55, 558,
+	-2, 566,
+	-1, 372,
+	1, 116,
+	459, 116,
+	-2, 121,
+	-1, 382,
+	168, 238,
+	169, 238,
+	-2, 327,
+	-1, 401,
+	142, 121,
+	255, 121,
+	303, 121,
+	-2, 342,
+	-1, 576,
+	149, 992,
+	-2, 988,
+	-1, 577,
+	149, 993,
+	-2, 989,
 	-1, 595,
-	117, 1179,
-	-2, 891,
-	-1, 729,
-	117, 1073,
-	-2, 888,
-	-1, 761,
-	174, 36,
-	179, 36,
-	-2, 241,
-	-1, 840,
-	1, 372,
-	451, 372,
-	-2, 113,
-	-1, 1055,
-	1, 268,
-	451, 268,
-	-2, 113,
-	-1, 1128,
-	168, 230,
-	169, 230,
-	-2, 319,
-	-1, 1137,
-	174, 37,
-	179, 37,
-	-2, 242,
-	-1, 1331,
-	149, 920,
-	-2, 916,
-	-1, 1421,
-	73, 64,
-	81, 64,
-	-2, 68,
-	-1, 1442,
-	1, 269,
-	451, 269,
-	-2, 113,
-	-1, 1829,
-	5, 785,
-	18, 785,
-	20, 785,
-	32, 785,
-	82, 785,
-	-2, 569,
-	-1, 2041,
-	45, 859,
-	-2, 857,
+	55, 559,
+	-2, 571,
+	-1, 596,
+	55, 560,
+	-2, 572,
+	-1, 620,
+	117, 1326,
+	-2, 90,
+	-1, 621,
+	117, 1208,
+	-2, 91,
+	-1, 627,
+	117, 1257,
+	-2, 966,
+	-1, 762,
+	117, 1149,
+	-2, 963,
+	-1, 796,
+	174, 38,
+	179, 38,
+	-2, 249,
+	-1, 875,
+	1, 381,
+	459, 381,
+	-2, 121,
+	-1, 1107,
+	142, 121,
+	255, 121,
+	303, 121,
+	-2, 276,
+	-1, 1181,
+	168, 238,
+	169, 238,
+	-2, 327,
+	-1, 1190,
+	174, 39,
+	179, 39,
+	-2, 250,
+	-1, 1385,
+	149, 995,
+	-2, 991,
+	-1, 1491,
+	73, 72,
+	81, 72,
+	-2, 76,
+	-1, 1512,
+	142, 121,
+	255, 121,
+	303, 121,
+	-2, 277,
+	-1, 1942,
+	5, 858,
+	18, 858,
+	20, 858,
+	32, 858,
+	82, 858,
+	-2, 597,
+	-1, 2196,
+	45, 932,
+	-2, 930,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 26381
+const yyLast = 27545
 
 var yyAct = [...]int{
-	549, 2127, 1876, 2114, 2041, 1747, 2091, 2054, 493, 1645,
-	1987, 1716, 1965, 893, 79, 3, 1809, 1504, 1810, 522,
-	1439, 1613, 1368, 1720, 508, 1873, 1003, 1646, 1354, 1806,
-	1474, 959, 491, 1010, 1459, 1704, 1703, 1632, 1479, 1418,
-	1821, 852, 142, 1768, 1573, 891, 1153, 173, 1325, 593,
-	185, 1317, 458, 185, 733, 1246, 791, 1135, 474, 1259,
-	185, 1502, 128, 1696, 1481, 77, 1047, 1040, 1031, 569,
-	1400, 1370, 756, 1407, 1008, 1013, 1033, 31, 997, 495,
-	1351, 554, 1294, 1551, 1142, 740, 1037, 1482, 474, 1030,
-	737, 474, 185, 474, 741, 484, 1225, 762, 757, 758,
-	1383, 590, 1046, 1423, 1044, 75, 1020, 1262, 563, 172,
-	1470, 833, 759, 111, 112, 1127, 560, 769, 972, 1460,
-	74, 8, 481, 7, 105, 145, 106, 973, 6, 1739,
-	1738, 1989, 1533, 1365, 1366, 1212, 2083, 1611, 2038, 1280,
-	1944, 2016, 2015, 1852, 795, 1960, 794, 534, 1961, 540,
-	541, 538, 539, 555, 537, 536, 535, 2133, 575, 579,
-	113, 577, 2088, 734, 542, 543, 2126, 1486, 185, 107,
-	76, 436, 2065, 2117, 1877, 1521, 796, 2087, 185, 1785,
-	846, 2064, 1906, 185, 482, 483, 33, 793, 1484, 68,
-	37, 38, 587, 174, 175, 176, 748, 1612, 1540, 80,
-	807, 808, 1539, 811, 812, 813, 814, 594, 97, 817,
-	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
-	828, 829, 830, 831, 1835, 485, 1328, 751, 772, 750,
-	107, 749, 1433, 773, 82, 83, 84, 85, 86, 87,
-	102, 178, 179, 180, 1836, 1837, 889, 797, 798, 799,
-	1048, 1676, 1049, 449, 1675, 1367, 462, 1677, 862, 804,
-	67, 810, 450, 102, 94, 809, 752, 1483, 1434, 1435,
-	98, 860, 447, 99, 100, 871, 872, 553, 552, 174,
-	175, 176, 171, 1687, 174, 175, 176, 1453, 166, 1937,
-	1749, 1897, 33, 34, 35, 68, 37, 38, 107, 1281,
-	1282, 1283, 873, 1895, 461, 472, 874, 871, 872, 2067,
-	1279, 444, 72, 108, 1769, 476, 470, 39, 65, 66,
-	456, 63, 1202, 150, 888, 1721, 1536, 64, 1503, 2028,
-	921, 920, 930, 931, 923, 924, 925, 926, 927, 928,
-	929, 922, 1743, 1226, 932, 2116, 863, 887, 834, 1744,
-	1234, 2084, 1235, 1236, 868, 1231, 52, 1771, 880, 861,
-	882, 1750, 462, 1203, 1680, 1204, 67, 102, 167, 866,
-	867, 462, 864, 865, 841, 1752, 462, 147, 1548, 148,
-	816, 815, 1230, 1955, 771, 1751, 1228, 1112, 165, 437,
-	438, 439, 101, 454, 455, 465, 780, 879, 881, 451,
-	453, 466, 440, 441, 468, 467, 1851, 443, 442, 1232,
-	461, 446, 463, 2012, 1773, 101, 1777, 753, 1772, 461,
-	1770, 1505, 771, 1229, 461, 1775, 1401, 789, 778, 1485,
-	788, 787, 786, 1538, 1774, 785, 185, 784, 42, 45,
-	48, 47, 50, 783, 62, 2063, 151, 1776, 1778, 782,
-	875, 878, 777, 462, 1121, 790, 156, 1956, 1555, 474,
-	474, 474, 844, 738, 1424, 885, 2134, 2103, 765, 51,
-	71, 70, 738, 104, 60, 61, 49, 474, 474, 855,
-	856, 857, 858, 859, 764, 738, 845, 771, 781, 736,
-	170, 771, 745, 903, 877, 1753, 2131, 1141, 1140, 890,
-	2055, 461, 69, 581, 1527, 894, 895, 1614, 1616, 876,
-	53, 54, 2004, 55, 56, 57, 58, 2068, 770, 101,
-	779, 1239, 897, 800, 1713, 764, 767, 768, 1535, 738,
-	1794, 771, 1793, 761, 765, 806, 464, 2029, 1792, 746,
-	1731, 771, 435, 459, 1214, 1213, 1215, 1216, 1217, 177,
-	1523, 1592, 760, 185, 1553, 2045, 770, 143, 460, 1552,
-	1553, 1547, 774, 764, 1546, 1552, 944, 945, 838, 1589,
-	942, 1001, 775, 1926, 1834, 1637, 1581, 1513, 474, 1429,
-	869, 185, 1024, 185, 185, 957, 474, 850, 1000, 1440,
-	776, 932, 474, 922, 884, 1672, 932, 1379, 854, 590,
-	906, 960, 904, 1615, 1276, 2020, 886, 905, 69, 921,
-	920, 930, 931, 923, 924, 925, 926, 927, 928, 929,
-	922, 770, 1029, 932, 912, 770, 792, 774, 764, 998,
-	1819, 1260, 764, 767, 768, 1263, 738, 775, 1227, 1014,
-	761, 765, 2129, 847, 848, 2130, 840, 2128, 835, 913,
-	836, 2005, 2003, 837, 975, 977, 979, 981, 983, 985,
-	986, 1352, 1050, 976, 978, 770, 982, 984, 1574, 987,
-	911, 909, 907, 1522, 995, 770, 839, 805, 925, 926,
-	927, 928, 929, 922, 1787, 485, 932, 912, 1116, 944,
-	945, 174, 175, 176, 970, 1319, 923, 924, 925, 926,
-	927, 928, 929, 922, 90, 594, 932, 944, 945, 1520,
-	853, 144, 149, 146, 152, 153, 154, 155, 157, 158,
-	159, 160, 1518, 1006, 1009, 1301, 780, 161, 162, 163,
-	164, 1450, 778, 185, 174, 175, 176, 1108, 909, 1299,
-	1300, 1298, 1701, 1451, 1685, 185, 1261, 1117, 1118, 91,
-	1264, 1320, 2048, 744, 912, 1381, 1352, 1002, 1599, 1017,
-	474, 67, 1137, 2135, 1587, 169, 910, 911, 909, 2121,
-	1146, 1515, 1586, 1297, 1150, 1384, 1385, 474, 474, 2120,
-	474, 1147, 474, 474, 912, 474, 474, 474, 474, 474,
-	474, 1943, 1515, 1012, 1692, 1519, 1942, 910, 911, 909,
-	474, 910, 911, 909, 185, 1186, 1181, 1182, 1119, 1120,
-	1289, 1291, 1292, 1857, 1133, 912, 1517, 1380, 1126, 912,
-	1199, 2110, 1290, 1700, 1155, 1699, 1156, 1145, 1158, 1160,
-	2136, 474, 1164, 1166, 1168, 1170, 1172, 2118, 2099, 185,
-	1489, 564, 910, 911, 909, 185, 1107, 2077, 185, 1245,
-	747, 185, 1222, 1144, 1183, 1114, 910, 911, 909, 743,
-	912, 1207, 185, 1206, 185, 2119, 1189, 1190, 1124, 1122,
-	1123, 1136, 1195, 1196, 912, 1588, 474, 474, 185, 474,
-	474, 185, 474, 474, 1796, 1143, 1143, 921, 920, 930,
-	931, 923, 924, 925, 926, 927, 928, 929, 922, 1205,
-	1248, 932, 1251, 1221, 1253, 1219, 1255, 1256, 1257, 1258,
-	2108, 910, 911, 909, 1566, 1567, 1568, 1197, 1191, 1789,
-	1184, 1266, 1267, 1759, 1269, 1270, 1209, 1272, 1273, 912,
-	1265, 1240, 1797, 1318, 1188, 1295, 1187, 1162, 2109, 1045,
-	1978, 1940, 1321, 921, 920, 930, 931, 923, 924, 925,
-	926, 927, 928, 929, 922, 1914, 474, 932, 910, 911,
-	909, 1220, 580, 1218, 1798, 750, 107, 749, 174, 175,
-	176, 1329, 1679, 1709, 1340, 1343, 912, 1322, 1323, 1697,
-	1353, 174, 175, 176, 1208, 1497, 1563, 1333, 1334, 474,
-	474, 1274, 1531, 1530, 1335, 1296, 1249, 174, 175, 176,
-	185, 1495, 174, 175, 176, 1210, 1200, 1331, 174, 175,
-	176, 1198, 474, 1330, 1194, 1193, 1250, 1192, 1746, 185,
-	564, 960, 474, 1862, 2102, 76, 185, 585, 185, 2010,
-	1375, 1862, 2061, 1862, 2046, 2009, 185, 185, 1329, 1909,
-	1359, 1360, 1875, 474, 1862, 564, 474, 1633, 582, 583,
-	1419, 1862, 2018, 1958, 564, 1723, 590, 474, 1712, 590,
-	1515, 564, 1924, 564, 1332, 1862, 1867, 1849, 1848, 1425,
-	1284, 1285, 1286, 1287, 1331, 1845, 1846, 1845, 1844, 1425,
-	1398, 1392, 564, 1448, 1394, 33, 921, 920, 930, 931,
-	923, 924, 925, 926, 927, 928, 929, 922, 1443, 1633,
-	932, 1393, 564, 1424, 1740, 1461, 1462, 1463, 1111, 1725,
-	1640, 1444, 474, 1718, 1719, 1404, 550, 1374, 1404, 564,
-	1494, 1496, 1807, 1422, 1945, 1338, 1339, 1386, 1396, 1447,
-	1426, 1818, 1641, 474, 908, 564, 1666, 1476, 1428, 474,
-	1426, 1516, 1427, 1146, 1424, 1146, 1431, 1430, 1424, 1111,
-	1110, 1056, 1055, 1514, 33, 1446, 1445, 78, 1818, 67,
-	1403, 1921, 594, 908, 33, 594, 186, 1818, 2019, 186,
-	1392, 1946, 1947, 1948, 475, 2053, 186, 1862, 1847, 1404,
-	1432, 1604, 1603, 474, 557, 1318, 1392, 1501, 1336, 1337,
-	1318, 1318, 1342, 1345, 1346, 1515, 1515, 1498, 1477, 1177,
-	1511, 1994, 1512, 1382, 475, 1493, 1487, 475, 186, 475,
-	1404, 1490, 1438, 1488, 1472, 1473, 1363, 1358, 1238, 1042,
-	1361, 1362, 1392, 755, 1507, 185, 1510, 1477, 67, 185,
-	185, 185, 185, 185, 1506, 754, 1526, 1524, 67, 185,
-	185, 1528, 1529, 185, 772, 1525, 1178, 1179, 1180, 773,
-	67, 1967, 1874, 1932, 1113, 1475, 1745, 1508, 67, 1143,
-	1471, 185, 185, 185, 1465, 511, 510, 513, 514, 515,
-	516, 1478, 1464, 1224, 512, 185, 517, 1138, 185, 474,
-	1134, 1109, 92, 1706, 186, 488, 1409, 1412, 1413, 1414,
-	1410, 171, 1411, 1415, 186, 1748, 1822, 1823, 1968, 186,
-	1705, 1557, 1949, 1486, 1174, 1822, 1823, 1561, 2123, 2115,
-	1840, 1534, 916, 1825, 919, 1807, 1714, 1277, 1295, 1242,
-	933, 934, 935, 936, 937, 938, 939, 1556, 917, 918,
-	915, 921, 920, 930, 931, 923, 924, 925, 926, 927,
-	928, 929, 922, 1828, 1706, 932, 1950, 1951, 1175, 1176,
-	1576, 1827, 1654, 1653, 1577, 1409, 1412, 1413, 1414, 1410,
-	2105, 1411, 1415, 1657, 185, 1584, 1585, 1659, 1658, 1413,
-	1414, 1591, 185, 2086, 1594, 1595, 1011, 1799, 1296, 1622,
-	1655, 1569, 1601, 1925, 1602, 1656, 1865, 1605, 1606, 1607,
-	1608, 1609, 1631, 1630, 185, 2073, 2070, 2107, 2090, 2092,
-	96, 1619, 2098, 1620, 2097, 185, 185, 185, 185, 185,
-	555, 1621, 1647, 1626, 1582, 1642, 2042, 185, 2040, 1237,
-	551, 185, 1710, 802, 185, 185, 801, 1004, 185, 185,
-	185, 1598, 1705, 1635, 896, 1664, 1348, 1638, 1733, 1005,
-	998, 1678, 1610, 1618, 1919, 1732, 168, 1662, 1663, 181,
-	1349, 1454, 1583, 1455, 1456, 1457, 1458, 1625, 1684, 108,
-	2050, 1691, 2049, 1667, 1636, 1992, 1509, 1669, 1634, 1466,
-	1467, 1468, 1469, 1152, 1649, 1650, 1151, 1652, 1139, 1248,
-	1690, 1377, 1693, 1694, 1695, 1681, 1491, 474, 1241, 1660,
-	1688, 1689, 1665, 570, 1648, 2011, 1670, 1651, 1962, 1673,
-	474, 1384, 1385, 1417, 558, 559, 474, 571, 561, 474,
-	1629, 1146, 1722, 2112, 1600, 1917, 474, 1682, 1628, 2111,
-	1726, 570, 2095, 2074, 1918, 1861, 1499, 1728, 1737, 1698,
-	1015, 1016, 573, 562, 572, 571, 185, 78, 1802, 1633,
-	1708, 1593, 1623, 1624, 1009, 1707, 1590, 1736, 1025, 1735,
-	2125, 2124, 186, 1578, 1579, 474, 185, 1018, 567, 568,
-	573, 1126, 572, 1331, 2125, 2043, 1938, 1378, 1727, 1330,
-	557, 76, 81, 73, 1596, 475, 475, 475, 1, 445,
-	1364, 996, 474, 457, 2113, 1211, 1734, 1201, 1318, 1878,
-	1964, 1868, 1492, 475, 475, 1765, 1702, 1480, 763, 133,
-	1754, 1441, 1442, 2057, 89, 731, 88, 1762, 1763, 766,
-	1767, 883, 1500, 2002, 1959, 1758, 1766, 1686, 474, 1452,
-	1756, 1936, 1757, 1839, 1683, 2047, 1062, 1060, 185, 1764,
-	1786, 1061, 1780, 1059, 1064, 1063, 1058, 1278, 474, 1779,
-	471, 1416, 1051, 1019, 474, 474, 803, 1850, 1449, 1647,
-	1808, 1765, 1275, 1532, 452, 870, 448, 940, 1627, 1674,
-	591, 1811, 584, 1813, 1795, 95, 2096, 185, 2071, 186,
-	2069, 2039, 1814, 930, 931, 923, 924, 925, 926, 927,
-	928, 929, 922, 1817, 1988, 932, 2072, 521, 2037, 2106,
-	2089, 1816, 1826, 1829, 475, 1376, 1007, 186, 1916, 186,
-	186, 1801, 475, 1830, 1597, 1832, 1831, 1833, 475, 1842,
-	1843, 1858, 969, 1350, 185, 185, 1034, 494, 1288, 474,
-	509, 506, 507, 1838, 1387, 1639, 914, 492, 486, 1026,
-	1864, 1408, 185, 1406, 1405, 1243, 1038, 184, 1824, 1853,
-	469, 1854, 1820, 1032, 1391, 1537, 1742, 184, 1869, 1879,
-	474, 474, 474, 566, 185, 1805, 93, 1347, 1872, 2027,
-	1905, 1788, 1855, 1856, 1866, 1908, 1871, 565, 59, 36,
-	578, 578, 478, 1863, 2082, 899, 574, 30, 29, 184,
-	28, 23, 946, 947, 948, 949, 950, 951, 952, 953,
-	954, 955, 22, 1887, 21, 1803, 20, 1889, 1884, 1885,
-	19, 25, 18, 17, 1893, 16, 103, 46, 1898, 1899,
-	43, 41, 921, 920, 930, 931, 923, 924, 925, 926,
-	927, 928, 929, 922, 1913, 110, 932, 1647, 109, 44,
-	40, 842, 1915, 27, 26, 15, 14, 13, 1920, 12,
-	11, 1922, 1923, 10, 9, 1927, 1929, 5, 4, 186,
-	902, 1928, 24, 958, 2, 184, 0, 0, 0, 0,
-	0, 186, 474, 474, 1934, 184, 0, 0, 0, 1935,
-	184, 0, 0, 1953, 0, 474, 475, 0, 474, 1939,
-	0, 1941, 166, 1888, 0, 1952, 1963, 0, 0, 0,
-	0, 1966, 1971, 475, 475, 0, 475, 1957, 475, 475,
-	0, 475, 475, 475, 475, 475, 475, 108, 0, 0,
-	0, 474, 474, 474, 185, 1969, 475, 150, 0, 0,
-	186, 0, 1981, 1983, 1984, 474, 1970, 474, 0, 0,
-	0, 0, 0, 474, 0, 0, 1985, 0, 1995, 1982,
-	1993, 1811, 1997, 0, 2000, 1811, 0, 475, 0, 1986,
-	1991, 1907, 0, 0, 0, 186, 0, 185, 0, 0,
-	2007, 186, 2008, 2006, 186, 0, 0, 186, 474, 185,
-	0, 147, 0, 148, 485, 0, 2014, 2017, 186, 2021,
-	186, 1930, 165, 0, 1931, 1903, 0, 1933, 0, 0,
-	0, 0, 475, 475, 186, 475, 475, 186, 475, 475,
-	0, 2023, 2024, 2025, 2026, 0, 2030, 2036, 2031, 2032,
-	2033, 2044, 2034, 2035, 1811, 0, 0, 0, 0, 0,
-	474, 474, 1977, 0, 0, 0, 2051, 0, 0, 0,
-	0, 0, 2056, 1966, 2058, 0, 0, 0, 0, 0,
-	151, 0, 0, 0, 0, 1999, 474, 0, 2066, 0,
-	156, 2001, 1647, 2075, 474, 0, 2062, 0, 0, 2078,
-	0, 0, 0, 0, 0, 2081, 1902, 2085, 0, 0,
-	0, 0, 475, 0, 2094, 2093, 0, 0, 0, 1990,
-	485, 0, 0, 0, 0, 0, 0, 0, 2104, 0,
-	0, 0, 0, 921, 920, 930, 931, 923, 924, 925,
-	926, 927, 928, 929, 922, 475, 475, 932, 2100, 2101,
-	0, 0, 0, 0, 0, 0, 186, 2122, 0, 0,
-	0, 0, 0, 184, 0, 0, 0, 2132, 475, 0,
-	0, 0, 0, 0, 0, 186, 0, 0, 475, 0,
-	0, 0, 186, 0, 186, 0, 0, 0, 0, 0,
-	0, 143, 186, 186, 0, 0, 0, 1890, 1891, 475,
-	1892, 0, 475, 1894, 1901, 1896, 0, 0, 0, 0,
-	0, 0, 0, 475, 921, 920, 930, 931, 923, 924,
-	925, 926, 927, 928, 929, 922, 0, 0, 932, 920,
-	930, 931, 923, 924, 925, 926, 927, 928, 929, 922,
-	1293, 0, 932, 1302, 1303, 1304, 1305, 1306, 1307, 1308,
-	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1900, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 475, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 475,
-	0, 0, 0, 0, 578, 475, 0, 0, 0, 0,
-	1355, 0, 0, 0, 0, 0, 0, 1575, 184, 0,
-	184, 1041, 921, 920, 930, 931, 923, 924, 925, 926,
-	927, 928, 929, 922, 0, 0, 932, 921, 920, 930,
-	931, 923, 924, 925, 926, 927, 928, 929, 922, 475,
-	0, 932, 0, 0, 0, 144, 149, 146, 152, 153,
-	154, 155, 157, 158, 159, 160, 0, 0, 0, 0,
-	0, 161, 162, 163, 164, 0, 921, 920, 930, 931,
-	923, 924, 925, 926, 927, 928, 929, 922, 0, 0,
-	932, 186, 0, 0, 0, 186, 186, 186, 186, 186,
-	0, 0, 0, 0, 0, 186, 186, 0, 0, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 520, 0,
-	0, 0, 0, 0, 0, 0, 0, 186, 186, 186,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 186, 0, 0, 186, 475, 921, 920, 930, 931,
-	923, 924, 925, 926, 927, 928, 929, 922, 0, 0,
-	932, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	184, 0, 0, 0, 0, 0, 473, 0, 0, 0,
-	0, 0, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 592, 0, 0, 735,
-	1149, 742, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	186, 0, 0, 0, 0, 1149, 1149, 0, 186, 0,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	186, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 186, 186, 186, 186, 186, 184, 0, 0, 0,
-	0, 0, 184, 186, 0, 184, 0, 186, 1247, 0,
-	186, 186, 0, 0, 186, 186, 186, 0, 0, 184,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 184, 0, 0, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1079, 1570, 1571, 1572, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 999, 0, 475, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 475, 0, 0, 0,
-	0, 0, 475, 0, 0, 475, 0, 0, 0, 0,
-	0, 0, 475, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 578, 1247, 0, 0, 0, 578,
-	578, 183, 186, 578, 578, 578, 0, 0, 0, 1149,
-	0, 477, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 475, 186, 0, 0, 0, 0, 0, 578, 578,
-	578, 578, 578, 0, 0, 0, 0, 1372, 0, 0,
-	0, 0, 0, 739, 0, 0, 1067, 0, 475, 0,
-	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
-	0, 0, 1247, 184, 0, 184, 0, 0, 0, 0,
-	0, 0, 0, 184, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 475, 0, 0, 0, 0, 1080,
-	0, 0, 0, 0, 186, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 475, 0, 0, 0, 0, 0,
-	475, 475, 0, 0, 0, 0, 0, 0, 0, 832,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 843,
-	0, 0, 0, 186, 849, 1093, 1096, 1097, 1098, 1099,
-	1100, 1101, 0, 1102, 1103, 1104, 1105, 1106, 1081, 1082,
-	1083, 1084, 1065, 1066, 1094, 0, 1068, 0, 1069, 1070,
-	1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1085, 1086,
-	1087, 1088, 1089, 1090, 1091, 1092, 0, 592, 592, 592,
-	186, 186, 0, 0, 0, 475, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 898, 900, 0, 186, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1760, 1761, 0, 0, 0, 0, 475, 475, 475, 0,
-	186, 0, 0, 0, 0, 1781, 1782, 0, 1783, 1784,
-	0, 0, 0, 0, 0, 0, 1095, 0, 0, 1790,
-	1791, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 184, 0, 0, 0, 184, 184, 184, 184,
-	184, 0, 0, 0, 0, 0, 184, 184, 0, 0,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1022, 0, 1558, 1559,
-	184, 0, 0, 0, 592, 0, 0, 0, 0, 0,
-	1052, 0, 184, 0, 0, 184, 0, 0, 0, 0,
-	1841, 0, 0, 0, 0, 0, 0, 0, 475, 475,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 475, 0, 0, 475, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 578, 578, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 475, 475, 475,
-	186, 523, 32, 0, 0, 578, 0, 851, 0, 0,
-	0, 475, 0, 475, 0, 1886, 0, 0, 0, 475,
-	0, 184, 0, 0, 0, 0, 0, 0, 0, 1372,
-	0, 0, 0, 0, 32, 0, 0, 0, 0, 0,
-	0, 0, 0, 186, 0, 0, 0, 0, 0, 0,
-	578, 184, 0, 0, 475, 186, 0, 0, 0, 0,
-	0, 1149, 184, 184, 184, 184, 184, 0, 0, 0,
-	0, 0, 0, 0, 1661, 0, 0, 556, 184, 0,
-	0, 184, 184, 0, 0, 184, 1671, 1247, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 735, 0,
-	0, 0, 0, 0, 0, 0, 475, 475, 0, 0,
-	0, 1148, 0, 0, 0, 1154, 1154, 0, 1154, 0,
-	1154, 1154, 0, 1163, 1154, 1154, 1154, 1154, 1154, 0,
-	0, 0, 475, 0, 0, 0, 1148, 1148, 735, 0,
-	475, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1028, 0, 0, 1039, 1972, 1973, 1974, 1975,
-	1976, 1149, 0, 0, 1979, 1980, 0, 0, 0, 1223,
-	0, 1247, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 184, 592, 592, 0, 592, 592, 0,
-	592, 592, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 578, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 166,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1715, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 108, 0, 130, 0, 0, 0,
-	0, 0, 0, 0, 150, 184, 0, 0, 0, 0,
-	0, 0, 0, 0, 1324, 0, 592, 0, 1149, 0,
-	0, 0, 0, 0, 1057, 0, 0, 0, 2079, 0,
-	1148, 0, 0, 0, 0, 140, 1115, 0, 0, 0,
-	129, 0, 0, 0, 184, 0, 0, 1356, 1357, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 147, 0,
-	148, 0, 0, 0, 0, 1129, 1130, 139, 138, 165,
-	1388, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1022, 0, 0, 592, 0, 0, 0, 0, 0, 0,
-	0, 184, 184, 0, 0, 1185, 0, 0, 0, 1149,
-	0, 592, 0, 0, 592, 0, 0, 0, 0, 184,
-	0, 0, 0, 0, 0, 735, 0, 134, 1131, 141,
-	0, 1128, 0, 135, 136, 0, 0, 151, 0, 0,
-	1233, 184, 0, 0, 0, 0, 1039, 156, 0, 1244,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1252, 0, 1254, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1268,
-	742, 0, 1271, 0, 0, 0, 0, 0, 0, 0,
-	892, 892, 892, 0, 0, 0, 0, 0, 0, 0,
-	0, 735, 0, 0, 0, 0, 1149, 742, 0, 0,
-	32, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 941, 943, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
-	0, 735, 956, 0, 0, 0, 961, 962, 963, 964,
-	965, 966, 967, 968, 0, 971, 974, 974, 974, 980,
-	974, 974, 980, 974, 988, 989, 990, 991, 992, 993,
-	994, 0, 0, 0, 0, 0, 0, 0, 32, 0,
-	0, 166, 0, 0, 0, 0, 0, 137, 0, 0,
-	0, 1372, 0, 0, 0, 0, 0, 0, 0, 131,
-	0, 0, 132, 0, 1035, 0, 108, 0, 130, 0,
-	1395, 0, 0, 0, 0, 0, 150, 1399, 0, 1402,
-	0, 0, 0, 0, 0, 0, 0, 0, 1421, 0,
-	0, 0, 0, 0, 184, 0, 0, 1565, 0, 0,
-	0, 0, 0, 0, 0, 0, 184, 140, 0, 0,
-	0, 0, 129, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	147, 0, 148, 0, 0, 0, 0, 117, 118, 139,
-	138, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 144, 149, 146, 152, 153, 154, 155, 157,
-	158, 159, 160, 0, 0, 0, 0, 0, 161, 162,
-	163, 164, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1149, 0, 0, 0, 0, 0, 0, 0, 134,
-	115, 141, 122, 114, 0, 135, 136, 0, 0, 151,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
-	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1148, 0, 126, 124, 119, 120, 121, 125,
-	0, 0, 0, 0, 116, 0, 0, 0, 0, 0,
-	0, 0, 0, 127, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1039, 0, 0, 0,
-	1541, 1542, 1543, 1544, 1545, 0, 0, 0, 0, 0,
-	1549, 1550, 0, 0, 1554, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1711, 0, 0, 0, 0,
-	143, 0, 0, 0, 1560, 0, 0, 0, 1717, 0,
-	0, 0, 1148, 0, 1724, 0, 1562, 1717, 0, 1564,
-	0, 0, 592, 0, 1729, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 892, 892, 137,
-	892, 892, 0, 892, 892, 0, 0, 0, 0, 0,
-	0, 131, 0, 592, 132, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	592, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1154, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 592, 0, 0, 1148,
-	0, 0, 1815, 1154, 144, 149, 146, 152, 153, 154,
-	155, 157, 158, 159, 160, 0, 1668, 0, 0, 0,
-	161, 162, 163, 164, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 166, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1125, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1420, 0, 108,
-	0, 130, 0, 0, 0, 0, 0, 0, 0, 150,
-	0, 0, 0, 0, 0, 0, 0, 735, 0, 0,
-	1148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	140, 0, 0, 0, 0, 129, 0, 0, 1880, 1881,
-	1882, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 147, 0, 148, 0, 1741, 0, 0,
-	1129, 1130, 139, 138, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1755, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1148, 0, 0,
-	0, 0, 134, 1131, 141, 0, 1128, 0, 135, 136,
-	0, 0, 151, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1800,
-	1717, 1954, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1717, 0, 0, 592, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1717,
-	1717, 1717, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1996, 0, 1998, 0, 0, 0, 0,
-	0, 1717, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 0, 1859, 1860, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1870, 0, 0, 1717, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1883, 0, 0, 0, 0,
-	0, 1580, 137, 0, 556, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 131, 0, 0, 132, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 592, 592,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1617, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1148, 0, 2076, 0, 0, 0, 0, 0,
-	0, 0, 1717, 0, 0, 1035, 0, 0, 0, 0,
-	0, 0, 1643, 1644, 0, 0, 1035, 1035, 1035, 1035,
-	1035, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1420, 0, 0, 1035, 0, 0, 0, 1035,
-	0, 0, 0, 0, 0, 0, 0, 144, 149, 146,
-	152, 153, 154, 155, 157, 158, 159, 160, 0, 0,
-	0, 0, 0, 161, 162, 163, 164, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1730, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2013, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2022, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 892, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	576, 2317, 2258, 2300, 506, 2030, 1996, 2199, 2196, 1847,
+	2267, 508, 2214, 1735, 1686, 2129, 996, 928, 84, 3,
+	2102, 1701, 1896, 81, 1989, 1068, 1434, 1920, 523, 605,
+	538, 1058, 1990, 1921, 1736, 995, 4, 91, 1917, 1820,
+	1549, 766, 1544, 1796, 1720, 1797, 1206, 1164, 1869, 1051,
+	441, 1933, 1487, 91, 1379, 473, 91, 1371, 1312, 1648,
+	1577, 489, 396, 91, 1551, 82, 410, 625, 1047, 1789,
+	1299, 786, 588, 1099, 1092, 1476, 1188, 1083, 791, 597,
+	1509, 1056, 1469, 499, 1082, 1436, 1085, 1045, 1061, 91,
+	1529, 1406, 926, 887, 582, 1348, 33, 1626, 79, 1195,
+	770, 797, 510, 1278, 1449, 1552, 773, 792, 1540, 826,
+	1089, 1098, 774, 793, 1096, 1072, 1315, 1493, 804, 1180,
+	994, 448, 440, 373, 413, 374, 89, 868, 9, 8,
+	379, 492, 496, 1009, 7, 1839, 1838, 2131, 78, 1530,
+	85, 1010, 1608, 380, 1431, 1432, 879, 2252, 1699, 2193,
+	434, 2229, 2285, 372, 2228, 1898, 1572, 1571, 2289, 1334,
+	2288, 2287, 2074, 2286, 2035, 2034, 2133, 381, 1687, 2167,
+	794, 375, 1965, 2166, 830, 376, 583, 398, 350, 351,
+	352, 353, 354, 355, 2098, 418, 777, 2099, 2323, 1097,
+	437, 829, 1556, 2257, 451, 2315, 2225, 497, 498, 2303,
+	550, 612, 556, 557, 554, 555, 1997, 553, 552, 551,
+	1596, 2256, 1265, 1554, 1886, 2224, 408, 558, 559, 2026,
+	781, 397, 1949, 1950, 80, 1700, 527, 526, 529, 530,
+	531, 532, 1504, 1505, 1100, 528, 1101, 533, 585, 415,
+	1615, 416, 1948, 1503, 1614, 845, 385, 386, 407, 406,
+	433, 2259, 2178, 957, 956, 966, 967, 959, 960, 961,
+	962, 963, 964, 965, 958, 785, 1767, 968, 580, 1766,
+	579, 1433, 1768, 93, 94, 95, 1523, 35, 439, 477,
+	72, 40, 41, 897, 365, 906, 907, 895, 2264, 93,
+	94, 95, 1553, 71, 35, 35, 1780, 71, 402, 383,
+	409, 390, 382, 924, 403, 404, 2067, 2017, 419, 2233,
+	2015, 487, 527, 526, 529, 530, 531, 532, 424, 391,
+	1729, 528, 1332, 533, 1335, 1336, 1337, 476, 1849, 370,
+	443, 444, 445, 394, 392, 387, 388, 389, 393, 370,
+	362, 2138, 1730, 491, 384, 485, 366, 1284, 1578, 367,
+	368, 71, 915, 1821, 917, 395, 489, 1255, 477, 489,
+	91, 489, 1611, 370, 435, 1843, 1279, 2253, 71, 71,
+	2302, 898, 1844, 622, 2264, 896, 869, 1570, 477, 908,
+	876, 923, 1382, 909, 906, 907, 2186, 1287, 2187, 1288,
+	1289, 922, 914, 916, 901, 902, 1852, 899, 900, 1850,
+	903, 1256, 1623, 1257, 1285, 851, 476, 850, 1283, 1851,
+	1281, 503, 2162, 1809, 2093, 1897, 2324, 787, 815, 788,
+	1580, 813, 411, 1173, 2120, 1964, 476, 788, 772, 2094,
+	1470, 824, 823, 822, 821, 820, 91, 767, 819, 607,
+	611, 818, 817, 91, 812, 881, 825, 2281, 91, 771,
+	771, 91, 1282, 799, 800, 771, 613, 1555, 880, 769,
+	778, 477, 1630, 2179, 1853, 910, 913, 1602, 831, 1292,
+	619, 405, 2149, 932, 489, 489, 489, 835, 1613, 1194,
+	1193, 1806, 2223, 399, 369, 1610, 400, 2092, 1905, 438,
+	1904, 912, 489, 489, 369, 626, 783, 375, 782, 1903,
+	806, 779, 808, 1815, 867, 2321, 911, 1813, 828, 476,
+	816, 878, 938, 814, 1831, 784, 884, 450, 369, 886,
+	2234, 842, 843, 920, 846, 847, 848, 849, 839, 939,
+	852, 853, 854, 855, 856, 857, 858, 859, 860, 861,
+	862, 863, 864, 865, 866, 617, 890, 891, 892, 893,
+	894, 780, 776, 844, 807, 614, 615, 2215, 1628, 442,
+	1598, 2260, 1494, 1627, 2204, 375, 925, 929, 930, 2261,
+	36, 806, 806, 832, 833, 834, 1702, 1704, 1668, 2056,
+	91, 412, 417, 414, 420, 421, 422, 423, 425, 426,
+	427, 428, 882, 883, 875, 1870, 73, 429, 430, 431,
+	432, 904, 1622, 1049, 91, 1621, 1947, 489, 978, 36,
+	489, 2150, 2148, 91, 1048, 91, 91, 1726, 489, 806,
+	1665, 1656, 942, 940, 489, 1588, 36, 36, 941, 1267,
+	1266, 1268, 1269, 1270, 805, 622, 997, 1499, 1872, 873,
+	1076, 799, 802, 803, 993, 771, 885, 2260, 806, 796,
+	800, 2319, 919, 1510, 2320, 2261, 2318, 968, 1628, 841,
+	980, 981, 1763, 1627, 921, 806, 1046, 1081, 795, 93,
+	94, 95, 1703, 1373, 93, 94, 95, 1062, 1445, 958,
+	358, 1080, 968, 1597, 1091, 1012, 1014, 1016, 1018, 1020,
+	1022, 1023, 945, 1013, 1015, 1313, 1019, 1021, 1874, 1024,
+	1878, 1316, 1873, 1447, 1871, 805, 805, 1329, 948, 1876,
+	2170, 1043, 809, 799, 889, 827, 980, 981, 1875, 870,
+	948, 871, 810, 1355, 872, 359, 1050, 1931, 1280, 1374,
+	1066, 1877, 1879, 1102, 1785, 943, 1888, 1353, 1354, 1352,
+	811, 957, 956, 966, 967, 959, 960, 961, 962, 963,
+	964, 965, 958, 805, 1407, 968, 874, 626, 980, 981,
+	799, 802, 803, 1168, 771, 1446, 91, 2304, 796, 800,
+	1160, 961, 962, 963, 964, 965, 958, 1595, 91, 968,
+	1169, 1170, 805, 1593, 1778, 946, 947, 945, 809, 799,
+	946, 947, 945, 1890, 2207, 489, 1663, 1190, 810, 805,
+	1649, 840, 1520, 948, 1662, 1199, 1450, 1451, 948, 1203,
+	1314, 815, 489, 489, 1521, 489, 1317, 489, 489, 1200,
+	489, 489, 489, 489, 489, 489, 888, 813, 2306, 946,
+	947, 945, 947, 945, 1109, 489, 1186, 2293, 1065, 91,
+	1239, 1407, 2073, 1675, 1234, 1235, 1167, 948, 1590, 948,
+	1343, 1345, 1346, 1179, 1590, 1252, 2307, 2072, 1976, 1208,
+	1970, 1209, 1344, 1211, 1213, 2294, 489, 1217, 1219, 1221,
+	1223, 1225, 1594, 71, 91, 1793, 1236, 1792, 1592, 1559,
+	91, 1198, 2325, 91, 1298, 1351, 91, 946, 947, 945,
+	87, 2309, 1159, 1275, 1171, 1172, 1260, 91, 1274, 91,
+	1641, 1642, 1643, 1197, 1166, 948, 1259, 1238, 1272, 1176,
+	1258, 489, 489, 91, 489, 489, 91, 489, 489, 1189,
+	1177, 1196, 1196, 982, 983, 984, 985, 986, 987, 988,
+	989, 990, 991, 1175, 959, 960, 961, 962, 963, 964,
+	965, 958, 1286, 1250, 968, 1244, 592, 1262, 1091, 2326,
+	1907, 1297, 2308, 1301, 1242, 1243, 1273, 1241, 1333, 1240,
+	1248, 1249, 1215, 2295, 1318, 1305, 1271, 1307, 1664, 1372,
+	946, 947, 945, 2275, 2245, 1237, 2115, 1060, 1375, 604,
+	2070, 1321, 1349, 2044, 1324, 1376, 1377, 1909, 948, 1304,
+	1293, 1306, 489, 1308, 1309, 1310, 1311, 1802, 1908, 783,
+	375, 782, 1790, 1319, 1320, 1261, 1322, 1323, 1638, 1325,
+	1326, 1383, 1606, 1605, 1395, 1398, 1859, 93, 94, 95,
+	1408, 1770, 93, 94, 95, 1302, 489, 489, 1263, 1390,
+	1251, 1794, 1846, 1247, 1246, 1327, 957, 956, 966, 967,
+	959, 960, 961, 962, 963, 964, 965, 958, 91, 1245,
+	968, 946, 947, 945, 1350, 946, 947, 945, 581, 1811,
+	489, 1385, 1463, 2280, 1384, 83, 93, 94, 95, 948,
+	1567, 91, 80, 948, 489, 1697, 997, 592, 91, 2155,
+	91, 944, 592, 1414, 1415, 946, 947, 945, 91, 91,
+	1463, 2221, 997, 1386, 1383, 489, 1463, 2205, 489, 2154,
+	946, 947, 945, 948, 1463, 592, 1488, 2096, 592, 489,
+	1590, 592, 622, 1810, 1995, 622, 1388, 1721, 948, 1823,
+	1721, 1440, 1805, 1489, 527, 526, 529, 530, 531, 532,
+	1458, 1452, 1918, 528, 1518, 533, 93, 94, 95, 1464,
+	1565, 1930, 1460, 1930, 1385, 1472, 1468, 1467, 1471, 592,
+	1514, 93, 94, 95, 944, 1253, 2051, 1491, 2054, 592,
+	1463, 1988, 1495, 1524, 489, 1525, 1526, 1527, 1528, 2241,
+	1461, 1495, 1564, 1566, 1513, 1962, 1961, 1958, 1959, 1958,
+	1957, 1536, 1537, 1538, 1539, 1473, 489, 1517, 1930, 1465,
+	1458, 592, 489, 1494, 1840, 1473, 1199, 1757, 1199, 1492,
+	2169, 1546, 592, 1163, 1825, 1494, 1589, 1501, 1497, 1500,
+	577, 1818, 1819, 1531, 1532, 1533, 1516, 1473, 592, 1459,
+	1579, 1463, 1515, 1496, 1463, 1462, 1591, 1576, 1163, 1162,
+	35, 1498, 1496, 1960, 626, 1473, 489, 626, 1372, 1502,
+	1494, 1108, 1107, 1372, 1372, 1680, 1679, 92, 957, 956,
+	966, 967, 959, 960, 961, 962, 963, 964, 965, 958,
+	1547, 2075, 968, 92, 1542, 1543, 92, 1458, 1590, 1558,
+	1560, 490, 1586, 92, 1587, 1563, 1557, 1599, 91, 1573,
+	1590, 1448, 91, 91, 91, 91, 91, 2029, 1458, 585,
+	1547, 1581, 91, 91, 1585, 1601, 91, 1600, 1582, 92,
+	1603, 1604, 1429, 808, 71, 1799, 1291, 1094, 2076, 2077,
+	2078, 603, 71, 1196, 91, 91, 91, 956, 966, 967,
+	959, 960, 961, 962, 963, 964, 965, 958, 91, 790,
+	968, 91, 489, 789, 957, 956, 966, 967, 959, 960,
+	961, 962, 963, 964, 965, 958, 1091, 2213, 968, 2104,
+	1616, 1617, 1618, 1619, 1620, 807, 1230, 606, 2062, 1165,
+	1624, 1625, 1347, 71, 1629, 1356, 1357, 1358, 1359, 1360,
+	1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370,
+	1545, 1391, 1392, 1349, 1635, 1397, 1400, 1401, 1609, 1845,
+	1583, 1541, 1535, 1534, 1631, 1277, 1637, 1191, 1187, 1639,
+	1161, 360, 1848, 1231, 1232, 1233, 1632, 1798, 2079, 439,
+	1413, 2105, 1636, 1416, 1417, 1934, 1935, 1227, 1556, 2312,
+	2301, 1424, 1425, 1410, 1953, 1478, 1481, 1482, 1483, 1479,
+	91, 1480, 1484, 1937, 1688, 1689, 1690, 1691, 91, 1478,
+	1481, 1482, 1483, 1479, 1918, 1480, 1484, 2283, 1644, 1934,
+	1935, 1799, 2080, 2081, 1807, 1350, 1330, 1295, 1940, 1939,
+	91, 1228, 1229, 1659, 489, 1747, 1745, 1744, 1743, 2255,
+	1748, 1746, 1910, 1710, 91, 91, 91, 91, 91, 1059,
+	2055, 1657, 1986, 583, 1737, 1719, 91, 1386, 1731, 1718,
+	1707, 91, 2239, 2236, 91, 91, 2292, 1674, 91, 91,
+	91, 2266, 1714, 2268, 598, 1732, 1708, 364, 1754, 1755,
+	2023, 1769, 1723, 1727, 1709, 2274, 1046, 1698, 599, 1749,
+	2273, 1482, 1483, 1706, 2197, 2195, 1489, 1290, 578, 1803,
+	1713, 1784, 837, 836, 1403, 1798, 931, 1052, 1833, 1832,
+	1724, 1063, 1064, 601, 1777, 600, 1758, 1722, 1404, 1053,
+	1760, 376, 1739, 1740, 2209, 1742, 436, 489, 1738, 446,
+	1750, 1741, 2208, 1759, 2136, 1584, 490, 1301, 1205, 490,
+	92, 490, 1204, 1761, 1756, 489, 1764, 1192, 2049, 1774,
+	1443, 489, 1561, 1772, 489, 1294, 1199, 1450, 1451, 2242,
+	1822, 489, 2156, 1775, 2100, 1486, 1067, 586, 587, 1717,
+	1817, 589, 2297, 1837, 83, 1791, 1828, 1716, 2296, 1826,
+	1783, 91, 1786, 1787, 1788, 1800, 1781, 1782, 957, 956,
+	966, 967, 959, 960, 961, 962, 963, 964, 965, 958,
+	489, 91, 968, 2271, 598, 2240, 1179, 2184, 2048, 1983,
+	1835, 1574, 1174, 590, 2047, 1913, 92, 1721, 599, 2314,
+	2313, 2314, 585, 92, 2122, 1669, 1385, 489, 92, 1384,
+	1834, 92, 1827, 1801, 1372, 1666, 1077, 1862, 1070, 2201,
+	2068, 595, 596, 601, 1866, 600, 1444, 80, 86, 1841,
+	77, 1, 1836, 460, 490, 490, 490, 1430, 489, 1867,
+	1044, 472, 2299, 1264, 1856, 1254, 1857, 1998, 2101, 1855,
+	1808, 1569, 490, 490, 1887, 1858, 489, 1895, 1568, 1868,
+	1865, 1771, 1974, 1881, 1562, 1795, 91, 1899, 1900, 1901,
+	1550, 1880, 1854, 798, 401, 1511, 1512, 489, 2217, 357,
+	764, 356, 801, 918, 489, 489, 1575, 1409, 2147, 2097,
+	1779, 1737, 1522, 1919, 1866, 2066, 1952, 1776, 1650, 2206,
+	1114, 1924, 1906, 1112, 536, 1113, 1111, 1116, 91, 1115,
+	1110, 1922, 1331, 1653, 1654, 1645, 1646, 1647, 957, 956,
+	966, 967, 959, 960, 961, 962, 963, 964, 965, 958,
+	1929, 1928, 968, 486, 1911, 1672, 1485, 1103, 1071, 838,
+	92, 1963, 1519, 1938, 1328, 1607, 467, 905, 463, 976,
+	1916, 1943, 1715, 1945, 1971, 1946, 1765, 91, 91, 623,
+	1944, 616, 1925, 363, 92, 488, 2272, 490, 2237, 489,
+	490, 2235, 591, 92, 2194, 92, 92, 2130, 490, 2238,
+	2028, 2192, 91, 1985, 490, 2291, 2265, 1442, 1992, 1055,
+	1951, 1967, 1966, 2046, 1912, 1673, 1006, 1405, 1086, 1999,
+	489, 489, 489, 509, 91, 1342, 2198, 2132, 2121, 2262,
+	1984, 2227, 1955, 1956, 2226, 2119, 1987, 2031, 525, 524,
+	521, 1994, 522, 1453, 1993, 1972, 1973, 957, 956, 966,
+	967, 959, 960, 961, 962, 963, 964, 965, 958, 1728,
+	950, 968, 507, 501, 2032, 1078, 1477, 1475, 1474, 1296,
+	1991, 1090, 1936, 1932, 1084, 1457, 1612, 1842, 1968, 1969,
+	594, 2033, 361, 1402, 2036, 2037, 2038, 2013, 2010, 2011,
+	2177, 2012, 2003, 2025, 2014, 593, 2016, 957, 956, 966,
+	967, 959, 960, 961, 962, 963, 964, 965, 958, 62,
+	2008, 968, 1737, 39, 493, 2251, 934, 602, 31, 30,
+	2004, 2005, 32, 29, 24, 23, 22, 21, 2050, 20,
+	26, 2058, 19, 18, 17, 2059, 371, 49, 46, 44,
+	378, 377, 47, 43, 877, 2064, 92, 28, 27, 489,
+	16, 489, 15, 489, 489, 14, 13, 12, 92, 2065,
+	11, 10, 6, 5, 937, 25, 2, 0, 489, 2045,
+	2083, 0, 2082, 0, 2086, 490, 2088, 0, 2090, 2091,
+	0, 0, 2108, 0, 0, 2103, 0, 0, 0, 0,
+	0, 0, 490, 490, 0, 490, 0, 490, 490, 0,
+	490, 490, 490, 490, 490, 490, 0, 0, 0, 0,
+	0, 489, 489, 489, 91, 490, 0, 0, 2069, 92,
+	2071, 0, 0, 0, 0, 489, 0, 489, 2106, 0,
+	0, 1860, 1861, 489, 0, 2135, 2123, 2125, 2126, 0,
+	2127, 0, 2139, 0, 0, 0, 490, 1882, 1883, 0,
+	1884, 1885, 1922, 2142, 92, 2137, 1922, 0, 2145, 2140,
+	92, 1891, 1892, 92, 0, 91, 92, 2151, 2114, 0,
+	0, 0, 2107, 0, 0, 489, 91, 92, 0, 92,
+	624, 0, 0, 768, 0, 775, 0, 0, 0, 2168,
+	0, 490, 490, 92, 490, 490, 92, 490, 490, 2165,
+	2171, 2144, 0, 0, 489, 2128, 0, 2146, 0, 0,
+	0, 0, 0, 0, 2185, 0, 0, 0, 0, 2191,
+	2152, 2183, 2153, 0, 0, 0, 0, 0, 0, 2203,
+	0, 0, 0, 2164, 0, 0, 2202, 0, 0, 1922,
+	489, 489, 0, 0, 2172, 2210, 0, 0, 0, 0,
+	2212, 0, 0, 0, 0, 0, 0, 2103, 2218, 1954,
+	2216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2231, 2230, 490, 0, 0, 489, 0, 0, 2232, 0,
+	0, 0, 0, 1737, 489, 2243, 0, 0, 0, 0,
+	0, 0, 0, 2246, 0, 0, 0, 0, 624, 624,
+	624, 2254, 2263, 0, 0, 0, 490, 490, 0, 2250,
+	0, 0, 489, 0, 2270, 2269, 933, 935, 0, 2276,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 0,
+	0, 2282, 2284, 0, 2263, 0, 0, 0, 0, 0,
+	490, 2006, 0, 0, 0, 0, 0, 0, 2032, 0,
+	0, 92, 0, 2298, 490, 0, 0, 0, 92, 0,
+	92, 489, 0, 0, 0, 2022, 0, 0, 92, 92,
+	0, 0, 0, 2311, 0, 490, 0, 2316, 490, 2263,
+	0, 0, 0, 0, 0, 0, 2322, 0, 952, 490,
+	955, 0, 0, 0, 609, 0, 969, 970, 971, 972,
+	973, 974, 975, 0, 953, 954, 951, 957, 956, 966,
+	967, 959, 960, 961, 962, 963, 964, 965, 958, 0,
+	0, 968, 0, 0, 0, 0, 0, 0, 0, 0,
+	2021, 1069, 0, 0, 1074, 0, 0, 0, 0, 0,
+	0, 0, 624, 0, 490, 0, 0, 0, 1104, 0,
+	966, 967, 959, 960, 961, 962, 963, 964, 965, 958,
+	0, 500, 968, 0, 0, 0, 490, 0, 0, 2020,
+	0, 0, 490, 957, 956, 966, 967, 959, 960, 961,
+	962, 963, 964, 965, 958, 0, 0, 968, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2109, 2110, 2111, 2112, 2113, 0, 0, 0,
+	2116, 2117, 0, 0, 0, 0, 490, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 434, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1816, 957, 956,
+	966, 967, 959, 960, 961, 962, 963, 964, 965, 958,
+	0, 376, 968, 398, 0, 0, 0, 0, 92, 0,
+	0, 418, 92, 92, 92, 92, 92, 0, 0, 0,
+	0, 0, 92, 92, 0, 0, 92, 957, 956, 966,
+	967, 959, 960, 961, 962, 963, 964, 965, 958, 0,
+	0, 968, 408, 0, 92, 92, 92, 397, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 0,
+	0, 92, 490, 0, 0, 415, 0, 416, 0, 768,
+	0, 0, 1182, 1183, 407, 406, 433, 0, 0, 0,
+	0, 434, 1201, 0, 0, 0, 1207, 1207, 0, 1207,
+	0, 1207, 1207, 0, 1216, 1207, 1207, 1207, 1207, 1207,
+	0, 0, 0, 0, 0, 0, 376, 1201, 1201, 768,
+	0, 0, 0, 0, 0, 0, 418, 0, 0, 0,
+	0, 0, 0, 0, 402, 1184, 409, 0, 1181, 0,
+	403, 404, 0, 0, 419, 0, 0, 0, 0, 0,
+	1276, 0, 0, 2247, 424, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1773, 0, 0,
+	92, 0, 0, 0, 0, 0, 0, 0, 92, 0,
+	415, 0, 416, 0, 0, 0, 0, 0, 434, 0,
+	0, 433, 0, 0, 0, 624, 624, 0, 624, 624,
+	92, 624, 624, 0, 490, 0, 2290, 0, 0, 0,
+	0, 0, 0, 376, 92, 92, 92, 92, 92, 0,
+	0, 0, 0, 418, 0, 0, 92, 0, 0, 0,
+	0, 92, 0, 0, 92, 92, 0, 0, 92, 92,
+	92, 0, 0, 0, 0, 0, 0, 0, 0, 419,
+	0, 0, 0, 0, 0, 0, 0, 0, 411, 424,
+	0, 0, 1387, 0, 1389, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1378, 415, 624, 416,
+	0, 0, 0, 0, 0, 0, 0, 0, 433, 0,
+	0, 0, 0, 1201, 0, 0, 0, 490, 0, 0,
+	1418, 1419, 1420, 1421, 1422, 0, 0, 405, 0, 0,
+	1411, 1412, 0, 0, 0, 490, 0, 1441, 0, 399,
+	0, 490, 400, 0, 490, 0, 0, 0, 0, 0,
+	0, 490, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1454, 0, 419, 0, 0, 0,
+	0, 92, 0, 0, 0, 0, 424, 949, 1074, 0,
+	0, 624, 0, 411, 0, 0, 0, 0, 0, 0,
+	490, 92, 0, 0, 0, 0, 0, 0, 0, 624,
+	0, 0, 624, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 768, 500, 0, 0, 490, 0, 0,
+	0, 0, 0, 1007, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 412, 417, 414,
+	420, 421, 422, 423, 425, 426, 427, 428, 490, 0,
+	0, 0, 0, 429, 430, 431, 432, 0, 0, 0,
+	0, 0, 0, 0, 1054, 1057, 490, 0, 775, 0,
+	0, 0, 0, 0, 0, 0, 92, 0, 0, 0,
+	411, 0, 0, 0, 0, 0, 0, 490, 0, 0,
+	768, 0, 0, 0, 490, 490, 775, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 539,
+	34, 0, 0, 0, 34, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	768, 0, 412, 417, 414, 420, 421, 422, 423, 425,
+	426, 427, 428, 0, 34, 0, 0, 0, 429, 430,
+	431, 432, 0, 0, 0, 0, 0, 92, 92, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 92, 0, 0, 0, 0, 0, 0, 584,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 490, 490, 0, 92, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1640, 0, 0, 412,
+	417, 414, 420, 421, 422, 423, 425, 426, 427, 428,
+	0, 0, 0, 0, 0, 429, 430, 431, 432, 0,
+	0, 0, 0, 1651, 0, 0, 0, 1652, 0, 0,
+	0, 0, 0, 0, 0, 0, 1658, 0, 0, 1660,
+	1661, 0, 0, 0, 0, 1667, 0, 0, 1670, 1671,
+	0, 0, 0, 0, 0, 0, 1677, 0, 1678, 0,
+	0, 1681, 1682, 1683, 1684, 1685, 0, 0, 0, 0,
+	0, 1692, 1693, 1694, 1695, 1696, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 490, 0, 490, 490, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 490, 0,
+	0, 0, 0, 0, 1303, 0, 0, 0, 1725, 0,
+	0, 0, 0, 0, 0, 1752, 1753, 1201, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 490, 490, 490, 92, 0, 0, 0, 0, 0,
+	0, 537, 0, 0, 0, 490, 0, 490, 0, 1338,
+	1339, 1340, 1341, 490, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 90, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	0, 1804, 0, 0, 449, 490, 92, 484, 0, 0,
+	0, 0, 0, 0, 449, 1393, 1394, 0, 0, 1069,
+	0, 0, 0, 1201, 0, 1824, 0, 0, 1069, 0,
+	0, 0, 0, 624, 490, 1829, 0, 0, 0, 0,
+	90, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1423, 0, 0, 1426, 1427, 1428, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 490, 0, 0, 624, 0, 0, 0, 0, 0,
+	0, 1863, 1864, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 624, 0, 0, 0, 490, 0, 0, 0, 0,
+	0, 0, 0, 0, 490, 0, 0, 1508, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1894, 927, 927, 927, 0, 0, 0, 0,
+	0, 0, 490, 0, 0, 0, 0, 0, 0, 0,
+	1207, 0, 0, 34, 0, 0, 0, 0, 0, 0,
+	0, 1926, 0, 0, 977, 979, 0, 0, 0, 0,
+	0, 624, 0, 0, 1201, 0, 1548, 0, 1927, 1207,
+	0, 0, 1941, 1942, 0, 0, 0, 0, 0, 0,
+	0, 490, 0, 0, 0, 0, 992, 0, 0, 0,
+	998, 999, 1000, 1001, 1002, 1003, 1004, 1005, 0, 1008,
+	1011, 1011, 1011, 1017, 1011, 1011, 1017, 1011, 1025, 1026,
+	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036,
+	1037, 1038, 1039, 1040, 1041, 1042, 0, 0, 0, 0,
+	0, 0, 0, 0, 34, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 768, 0, 0, 1201, 0, 0, 0,
+	0, 0, 0, 0, 1087, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2007, 2000, 2001, 2002, 2009, 0, 0,
+	0, 0, 610, 610, 0, 0, 0, 0, 2018, 2019,
+	0, 449, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2039, 0, 0, 0, 2043, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2052, 0, 2053, 0, 0, 2057, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1201, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 449, 0, 0,
+	0, 0, 0, 0, 449, 0, 0, 0, 0, 449,
+	0, 0, 449, 0, 0, 0, 2085, 2087, 0, 2089,
+	0, 0, 0, 0, 0, 0, 0, 2095, 0, 0,
+	0, 0, 1676, 1069, 0, 1069, 0, 1069, 1069, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 624, 0, 0, 0, 35, 37, 38, 72,
+	40, 41, 0, 0, 0, 0, 0, 0, 2118, 0,
+	0, 1711, 1712, 1057, 0, 0, 76, 0, 2124, 0,
+	0, 42, 68, 69, 0, 66, 0, 0, 0, 0,
+	0, 67, 0, 0, 0, 1069, 1069, 1069, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2141,
+	0, 2143, 0, 0, 0, 0, 0, 1069, 0, 0,
+	55, 2157, 2158, 0, 2159, 0, 2160, 0, 2161, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 449, 0, 0, 0, 0, 0, 2173, 2174, 2175,
+	2176, 0, 2180, 0, 2181, 2182, 610, 0, 0, 1069,
+	0, 2188, 0, 2189, 2190, 90, 0, 0, 0, 0,
+	0, 0, 0, 0, 449, 0, 449, 1093, 0, 0,
+	927, 927, 0, 927, 927, 0, 927, 927, 2200, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 45, 48, 51, 50, 53, 0, 65, 34,
+	0, 0, 0, 0, 0, 2222, 0, 0, 0, 0,
+	0, 0, 0, 0, 624, 624, 0, 0, 0, 0,
+	0, 0, 0, 54, 75, 74, 0, 0, 63, 64,
+	52, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2249, 0, 0, 0, 0, 0, 1201, 0, 0, 2244,
+	0, 0, 434, 0, 0, 0, 0, 0, 1069, 0,
+	0, 0, 0, 1178, 0, 0, 0, 56, 57, 0,
+	58, 59, 60, 61, 0, 2278, 2279, 376, 0, 398,
+	0, 0, 0, 0, 0, 0, 2200, 418, 0, 0,
+	1889, 0, 0, 0, 0, 0, 0, 0, 93, 94,
+	95, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1902, 0, 0, 0, 0, 449, 408, 0,
+	0, 0, 0, 397, 0, 0, 2310, 979, 0, 449,
+	0, 0, 0, 0, 0, 2305, 1914, 0, 0, 0,
+	0, 415, 0, 416, 0, 0, 0, 1490, 1182, 1183,
+	407, 406, 433, 0, 0, 0, 0, 0, 464, 1202,
+	0, 0, 0, 0, 0, 73, 0, 465, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 462, 36, 0,
+	0, 0, 0, 0, 1202, 1202, 0, 0, 0, 0,
+	449, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	402, 1184, 409, 0, 1181, 0, 403, 404, 0, 0,
+	419, 0, 0, 0, 0, 0, 0, 459, 0, 0,
+	424, 0, 0, 0, 0, 449, 0, 1979, 471, 1981,
+	0, 449, 0, 0, 449, 0, 70, 1300, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 449, 0,
+	449, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 449, 0, 0, 449, 0, 0,
+	477, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 452, 453, 454,
+	0, 469, 470, 480, 0, 2027, 0, 466, 468, 481,
+	455, 456, 483, 482, 0, 458, 457, 0, 476, 461,
+	478, 0, 0, 0, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 500, 0,
+	0, 0, 0, 0, 610, 1300, 2060, 0, 0, 2061,
+	610, 610, 2063, 0, 610, 610, 610, 0, 0, 0,
+	1202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 405, 0, 0, 0, 0, 0, 610,
+	610, 610, 610, 610, 0, 399, 0, 0, 400, 0,
+	610, 610, 2084, 0, 0, 0, 0, 0, 0, 1438,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1812, 0, 32, 0, 0, 0, 0,
+	0, 0, 449, 0, 0, 0, 0, 0, 1300, 449,
+	0, 449, 0, 0, 0, 0, 0, 0, 0, 449,
+	449, 0, 0, 1655, 0, 0, 584, 0, 0, 479,
+	0, 0, 0, 0, 0, 0, 474, 0, 0, 0,
+	0, 0, 2134, 500, 0, 0, 0, 0, 0, 0,
+	0, 475, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 412, 417, 414, 420, 421, 422, 423,
+	425, 426, 427, 428, 0, 1705, 0, 0, 0, 429,
+	430, 431, 432, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1087,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1733,
+	1734, 0, 0, 1087, 1087, 1087, 1087, 1087, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 34,
+	1490, 0, 0, 1087, 0, 0, 0, 1087, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1035, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 500, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 500,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 449,
+	1812, 1814, 0, 449, 449, 449, 449, 449, 0, 0,
+	0, 0, 0, 449, 449, 0, 0, 449, 0, 0,
+	0, 1830, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1633, 1634, 449, 0, 0,
+	0, 0, 0, 0, 0, 1131, 0, 0, 0, 449,
+	0, 0, 449, 0, 0, 0, 0, 0, 0, 927,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1904, 0, 0,
-	0, 0, 0, 0, 1910, 1911, 1912, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1812, 0, 32, 0, 1812, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 32, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1812, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 713, 700, 32, 2052, 651, 716, 622, 640, 725,
-	642, 645, 683, 603, 664, 321, 637, 0, 626, 599,
-	633, 600, 624, 653, 237, 657, 621, 702, 667, 715,
-	280, 0, 627, 334, 685, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 722,
-	284, 674, 0, 379, 306, 0, 0, 0, 655, 705,
-	662, 696, 650, 684, 611, 673, 717, 638, 681, 718,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 2059, 2060, 0, 0, 0, 0, 0,
-	213, 0, 219, 678, 712, 635, 680, 233, 268, 239,
-	232, 394, 682, 728, 598, 675, 0, 601, 604, 724,
-	708, 630, 631, 0, 0, 0, 0, 0, 0, 0,
-	654, 663, 693, 648, 0, 0, 0, 0, 0, 0,
-	0, 0, 628, 0, 672, 0, 0, 0, 607, 602,
-	0, 0, 0, 0, 652, 0, 0, 0, 610, 0,
-	629, 694, 0, 596, 256, 605, 307, 698, 707, 649,
-	421, 711, 647, 646, 714, 689, 608, 704, 641, 279,
-	606, 276, 188, 202, 0, 639, 317, 355, 360, 703,
-	625, 634, 224, 632, 358, 330, 409, 209, 247, 352,
-	335, 356, 671, 687, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 620, 699, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 691, 727, 329, 359, 215, 411, 378, 615, 619,
-	613, 614, 665, 666, 616, 719, 720, 721, 695, 609,
-	0, 617, 618, 0, 701, 709, 710, 670, 187, 200,
-	282, 723, 349, 250, 432, 416, 414, 597, 612, 230,
-	623, 0, 0, 636, 643, 644, 656, 658, 659, 660,
-	661, 669, 676, 677, 679, 686, 688, 690, 692, 697,
-	706, 726, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 668, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 713, 700, 0,
-	0, 651, 716, 622, 640, 725, 642, 645, 683, 603,
-	664, 321, 637, 0, 626, 599, 633, 600, 624, 653,
-	237, 657, 621, 702, 667, 715, 280, 0, 627, 334,
-	685, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 722, 284, 674, 0, 379,
-	306, 0, 0, 0, 655, 705, 662, 696, 650, 684,
-	611, 673, 717, 638, 681, 718, 270, 221, 192, 318,
-	380, 249, 0, 0, 0, 174, 175, 176, 0, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 219, 678,
-	712, 635, 680, 233, 268, 239, 232, 394, 682, 728,
-	598, 675, 0, 601, 604, 724, 708, 630, 631, 0,
-	0, 0, 0, 0, 0, 0, 654, 663, 693, 648,
-	0, 0, 0, 0, 0, 0, 1804, 0, 628, 0,
-	672, 0, 0, 0, 607, 602, 0, 0, 0, 0,
-	652, 0, 0, 0, 610, 0, 629, 694, 0, 596,
-	256, 605, 307, 698, 707, 649, 421, 711, 647, 646,
-	714, 689, 608, 704, 641, 279, 606, 276, 188, 202,
-	0, 639, 317, 355, 360, 703, 625, 634, 224, 632,
-	358, 330, 409, 209, 247, 352, 335, 356, 671, 687,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 620, 699, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 691, 727, 329,
-	359, 215, 411, 378, 615, 619, 613, 614, 665, 666,
-	616, 719, 720, 721, 695, 609, 0, 617, 618, 0,
-	701, 709, 710, 670, 187, 200, 282, 723, 349, 250,
-	432, 416, 414, 597, 612, 230, 623, 0, 0, 636,
-	643, 644, 656, 658, 659, 660, 661, 669, 676, 677,
-	679, 686, 688, 690, 692, 697, 706, 726, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 668,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 713, 700, 0, 0, 651, 716, 622,
-	640, 725, 642, 645, 683, 603, 664, 321, 637, 0,
-	626, 599, 633, 600, 624, 653, 237, 657, 621, 702,
-	667, 715, 280, 0, 627, 334, 685, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 722, 284, 674, 0, 379, 306, 0, 0, 0,
-	655, 705, 662, 696, 650, 684, 611, 673, 717, 638,
-	681, 718, 270, 221, 192, 318, 380, 249, 67, 0,
-	0, 174, 175, 176, 0, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 219, 678, 712, 635, 680, 233,
-	268, 239, 232, 394, 682, 728, 598, 675, 0, 601,
-	604, 724, 708, 630, 631, 0, 0, 0, 0, 0,
-	0, 0, 654, 663, 693, 648, 0, 0, 0, 0,
-	0, 0, 0, 0, 628, 0, 672, 0, 0, 0,
-	607, 602, 0, 0, 0, 0, 652, 0, 0, 0,
-	610, 0, 629, 694, 0, 596, 256, 605, 307, 698,
-	707, 649, 421, 711, 647, 646, 714, 689, 608, 704,
-	641, 279, 606, 276, 188, 202, 0, 639, 317, 355,
-	360, 703, 625, 634, 224, 632, 358, 330, 409, 209,
-	247, 352, 335, 356, 671, 687, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 620, 699,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 691, 727, 329, 359, 215, 411, 378,
-	615, 619, 613, 614, 665, 666, 616, 719, 720, 721,
-	695, 609, 0, 617, 618, 0, 701, 709, 710, 670,
-	187, 200, 282, 723, 349, 250, 432, 416, 414, 597,
-	612, 230, 623, 0, 0, 636, 643, 644, 656, 658,
-	659, 660, 661, 669, 676, 677, 679, 686, 688, 690,
-	692, 697, 706, 726, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 668, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 713,
-	700, 0, 0, 651, 716, 622, 640, 725, 642, 645,
-	683, 603, 664, 321, 637, 0, 626, 599, 633, 600,
-	624, 653, 237, 657, 621, 702, 667, 715, 280, 0,
-	627, 334, 685, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 722, 284, 674,
-	0, 379, 306, 0, 0, 0, 655, 705, 662, 696,
-	650, 684, 611, 673, 717, 638, 681, 718, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 678, 712, 635, 680, 233, 268, 239, 232, 394,
-	682, 728, 598, 675, 0, 601, 604, 724, 708, 630,
-	631, 0, 0, 0, 0, 0, 0, 0, 654, 663,
-	693, 648, 0, 0, 0, 0, 0, 0, 1672, 0,
-	628, 0, 672, 0, 0, 0, 607, 602, 0, 0,
-	0, 0, 652, 0, 0, 0, 610, 0, 629, 694,
-	0, 596, 256, 605, 307, 698, 707, 649, 421, 711,
-	647, 646, 714, 689, 608, 704, 641, 279, 606, 276,
-	188, 202, 0, 639, 317, 355, 360, 703, 625, 634,
-	224, 632, 358, 330, 409, 209, 247, 352, 335, 356,
-	671, 687, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 620, 699, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 691,
-	727, 329, 359, 215, 411, 378, 615, 619, 613, 614,
-	665, 666, 616, 719, 720, 721, 695, 609, 0, 617,
-	618, 0, 701, 709, 710, 670, 187, 200, 282, 723,
-	349, 250, 432, 416, 414, 597, 612, 230, 623, 0,
-	0, 636, 643, 644, 656, 658, 659, 660, 661, 669,
-	676, 677, 679, 686, 688, 690, 692, 697, 706, 726,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 668, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 713, 700, 0, 0, 651,
-	716, 622, 640, 725, 642, 645, 683, 603, 664, 321,
-	637, 0, 626, 599, 633, 600, 624, 653, 237, 657,
-	621, 702, 667, 715, 280, 0, 627, 334, 685, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 722, 284, 674, 0, 379, 306, 0,
-	0, 0, 655, 705, 662, 696, 650, 684, 611, 673,
-	717, 638, 681, 718, 270, 221, 192, 318, 380, 249,
-	0, 0, 0, 174, 175, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 678, 712, 635,
-	680, 233, 268, 239, 232, 394, 682, 728, 598, 675,
-	0, 601, 604, 724, 708, 630, 631, 0, 0, 0,
-	0, 0, 0, 0, 654, 663, 693, 648, 0, 0,
-	0, 0, 0, 0, 1397, 0, 628, 0, 672, 0,
-	0, 0, 607, 602, 0, 0, 0, 0, 652, 0,
-	0, 0, 610, 0, 629, 694, 0, 596, 256, 605,
-	307, 698, 707, 649, 421, 711, 647, 646, 714, 689,
-	608, 704, 641, 279, 606, 276, 188, 202, 0, 639,
-	317, 355, 360, 703, 625, 634, 224, 632, 358, 330,
-	409, 209, 247, 352, 335, 356, 671, 687, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	620, 699, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 691, 727, 329, 359, 215,
-	411, 378, 615, 619, 613, 614, 665, 666, 616, 719,
-	720, 721, 695, 609, 0, 617, 618, 0, 701, 709,
-	710, 670, 187, 200, 282, 723, 349, 250, 432, 416,
-	414, 597, 612, 230, 623, 0, 0, 636, 643, 644,
-	656, 658, 659, 660, 661, 669, 676, 677, 679, 686,
-	688, 690, 692, 697, 706, 726, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 668, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 713, 700, 0, 0, 651, 716, 622, 640, 725,
-	642, 645, 683, 603, 664, 321, 637, 0, 626, 599,
-	633, 600, 624, 653, 237, 657, 621, 702, 667, 715,
-	280, 0, 627, 334, 685, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 722,
-	284, 674, 0, 379, 306, 0, 0, 0, 655, 705,
-	662, 696, 650, 684, 611, 673, 717, 638, 681, 718,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 678, 712, 635, 680, 233, 268, 239,
-	232, 394, 682, 728, 598, 675, 0, 601, 604, 724,
-	708, 630, 631, 0, 0, 0, 0, 0, 0, 0,
-	654, 663, 693, 648, 0, 0, 0, 0, 0, 0,
-	0, 0, 628, 0, 672, 0, 0, 0, 607, 602,
-	0, 0, 0, 0, 652, 0, 0, 0, 610, 0,
-	629, 694, 0, 596, 256, 605, 307, 698, 707, 649,
-	421, 711, 647, 646, 714, 689, 608, 704, 641, 279,
-	606, 276, 188, 202, 0, 639, 317, 355, 360, 703,
-	625, 634, 224, 632, 358, 330, 409, 209, 247, 352,
-	335, 356, 671, 687, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 620, 699, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 691, 727, 329, 359, 215, 411, 378, 615, 619,
-	613, 614, 665, 666, 616, 719, 720, 721, 695, 609,
-	0, 617, 618, 0, 701, 709, 710, 670, 187, 200,
-	282, 723, 349, 250, 432, 416, 414, 597, 612, 230,
-	623, 0, 0, 636, 643, 644, 656, 658, 659, 660,
-	661, 669, 676, 677, 679, 686, 688, 690, 692, 697,
-	706, 726, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 668, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 713, 700, 0,
-	0, 651, 716, 622, 640, 725, 642, 645, 683, 603,
-	664, 321, 637, 0, 626, 599, 633, 600, 624, 653,
-	237, 657, 621, 702, 667, 715, 280, 0, 627, 334,
-	685, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 722, 284, 674, 0, 379,
-	306, 0, 0, 0, 655, 705, 662, 696, 650, 684,
-	611, 673, 717, 638, 681, 718, 270, 221, 192, 318,
-	380, 249, 0, 0, 0, 174, 175, 176, 0, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 219, 678,
-	712, 635, 680, 233, 268, 239, 232, 394, 682, 728,
-	598, 675, 0, 601, 604, 724, 708, 630, 631, 0,
-	0, 0, 0, 0, 0, 0, 654, 663, 693, 648,
-	0, 0, 0, 0, 0, 0, 0, 0, 628, 0,
-	672, 0, 0, 0, 607, 602, 0, 0, 0, 0,
-	652, 0, 0, 0, 610, 0, 629, 694, 0, 596,
-	256, 605, 307, 698, 707, 649, 421, 711, 647, 646,
-	714, 689, 608, 704, 641, 279, 606, 276, 188, 202,
-	0, 639, 317, 355, 360, 703, 625, 634, 224, 632,
-	358, 330, 409, 209, 247, 352, 335, 356, 671, 687,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 730, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 620, 699, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 595, 729, 589, 588, 277, 286, 691, 727, 329,
-	359, 215, 411, 378, 615, 619, 613, 614, 665, 666,
-	616, 719, 720, 721, 695, 609, 0, 617, 618, 0,
-	701, 709, 710, 670, 187, 200, 282, 723, 349, 250,
-	432, 416, 414, 597, 612, 230, 623, 0, 0, 636,
-	643, 644, 656, 658, 659, 660, 661, 669, 676, 677,
-	679, 686, 688, 690, 692, 697, 706, 726, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 668,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 713, 700, 0, 0, 651, 716, 622,
-	640, 725, 642, 645, 683, 603, 664, 321, 637, 0,
-	626, 599, 633, 600, 624, 653, 237, 657, 621, 702,
-	667, 715, 280, 0, 627, 334, 685, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 722, 284, 674, 0, 379, 306, 0, 0, 0,
-	655, 705, 662, 696, 650, 684, 611, 673, 717, 638,
-	681, 718, 270, 221, 192, 318, 380, 249, 0, 0,
-	0, 174, 175, 176, 0, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 219, 678, 712, 635, 680, 233,
-	268, 239, 232, 394, 682, 728, 598, 675, 0, 601,
-	604, 724, 708, 630, 631, 0, 0, 0, 0, 0,
-	0, 0, 654, 663, 693, 648, 0, 0, 0, 0,
-	0, 0, 0, 0, 628, 0, 672, 0, 0, 0,
-	607, 602, 0, 0, 0, 0, 652, 0, 0, 0,
-	610, 0, 629, 694, 0, 596, 256, 605, 307, 698,
-	707, 649, 421, 711, 647, 646, 714, 689, 608, 704,
-	641, 279, 606, 276, 188, 202, 0, 639, 317, 355,
-	360, 703, 625, 634, 224, 632, 358, 330, 409, 209,
-	247, 352, 335, 356, 671, 687, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 1043, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	730, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 620, 699,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 595, 729, 589,
-	588, 277, 286, 691, 727, 329, 359, 215, 411, 378,
-	615, 619, 613, 614, 665, 666, 616, 719, 720, 721,
-	695, 609, 0, 617, 618, 0, 701, 709, 710, 670,
-	187, 200, 282, 723, 349, 250, 432, 416, 414, 597,
-	612, 230, 623, 0, 0, 636, 643, 644, 656, 658,
-	659, 660, 661, 669, 676, 677, 679, 686, 688, 690,
-	692, 697, 706, 726, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 668, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 713,
-	700, 0, 0, 651, 716, 622, 640, 725, 642, 645,
-	683, 603, 664, 321, 637, 0, 626, 599, 633, 600,
-	624, 653, 237, 657, 621, 702, 667, 715, 280, 0,
-	627, 334, 685, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 722, 284, 674,
-	0, 379, 306, 0, 0, 0, 655, 705, 662, 696,
-	650, 684, 611, 673, 717, 638, 681, 718, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 678, 712, 635, 680, 233, 268, 239, 232, 394,
-	682, 728, 598, 675, 0, 601, 604, 724, 708, 630,
-	631, 0, 0, 0, 0, 0, 0, 0, 654, 663,
-	693, 648, 0, 0, 0, 0, 0, 0, 0, 0,
-	628, 0, 672, 0, 0, 0, 607, 602, 0, 0,
-	0, 0, 652, 0, 0, 0, 610, 0, 629, 694,
-	0, 596, 256, 605, 307, 698, 707, 649, 421, 711,
-	647, 646, 714, 689, 608, 704, 641, 279, 606, 276,
-	188, 202, 0, 639, 317, 355, 360, 703, 625, 634,
-	224, 632, 358, 330, 409, 209, 247, 352, 335, 356,
-	671, 687, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	586, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 730, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 620, 699, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 595, 729, 589, 588, 277, 286, 691,
-	727, 329, 359, 215, 411, 378, 615, 619, 613, 614,
-	665, 666, 616, 719, 720, 721, 695, 609, 0, 617,
-	618, 0, 701, 709, 710, 670, 187, 200, 282, 723,
-	349, 250, 432, 416, 414, 597, 612, 230, 623, 0,
-	0, 636, 643, 644, 656, 658, 659, 660, 661, 669,
-	676, 677, 679, 686, 688, 690, 692, 697, 706, 726,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 668, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 321, 0, 0, 1326, 0,
-	490, 0, 0, 0, 237, 0, 489, 0, 0, 0,
-	280, 0, 1327, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 533,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	524, 525, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 67, 0, 0, 174,
-	175, 176, 511, 510, 513, 514, 515, 516, 0, 0,
-	213, 512, 219, 517, 518, 519, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 487, 504, 0, 532, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 501, 502,
-	576, 0, 0, 0, 547, 0, 503, 0, 0, 496,
-	497, 499, 498, 500, 505, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 307, 546, 0, 0,
-	421, 0, 0, 544, 0, 0, 0, 0, 0, 279,
-	0, 276, 188, 202, 0, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 534, 545,
-	540, 541, 538, 539, 0, 537, 536, 535, 548, 526,
-	527, 528, 529, 531, 0, 542, 543, 530, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 321, 0, 0,
-	0, 0, 490, 0, 0, 0, 237, 0, 489, 0,
-	0, 0, 280, 0, 0, 334, 0, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 533, 284, 0, 0, 379, 306, 0, 0, 0,
-	0, 0, 524, 525, 0, 0, 0, 0, 0, 0,
-	1436, 0, 270, 221, 192, 318, 380, 249, 67, 0,
-	0, 174, 175, 176, 511, 510, 513, 514, 515, 516,
-	0, 0, 213, 512, 219, 517, 518, 519, 1437, 233,
-	268, 239, 232, 394, 0, 0, 0, 487, 504, 0,
-	532, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	501, 502, 0, 0, 0, 0, 547, 0, 503, 0,
-	0, 496, 497, 499, 498, 500, 505, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 307, 546,
-	0, 0, 421, 0, 0, 544, 0, 0, 0, 0,
-	0, 279, 0, 276, 188, 202, 0, 0, 317, 355,
-	360, 0, 0, 0, 224, 0, 358, 330, 409, 209,
-	247, 352, 335, 356, 0, 0, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 0, 0,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 0, 0, 329, 359, 215, 411, 378,
-	534, 545, 540, 541, 538, 539, 0, 537, 536, 535,
-	548, 526, 527, 528, 529, 531, 0, 542, 543, 530,
-	187, 200, 282, 0, 349, 250, 432, 416, 414, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 0, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 321,
-	0, 0, 0, 0, 490, 0, 0, 0, 237, 0,
-	489, 0, 0, 0, 280, 0, 0, 334, 0, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 533, 284, 0, 0, 379, 306, 0,
-	0, 0, 0, 0, 524, 525, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 221, 192, 318, 380, 249,
-	67, 0, 564, 174, 175, 176, 511, 510, 513, 514,
-	515, 516, 0, 0, 213, 512, 219, 517, 518, 519,
-	0, 233, 268, 239, 232, 394, 0, 0, 0, 487,
-	504, 0, 532, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 501, 502, 0, 0, 0, 0, 547, 0,
-	503, 0, 0, 496, 497, 499, 498, 500, 505, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	307, 546, 0, 0, 421, 0, 0, 544, 0, 0,
-	0, 0, 0, 279, 0, 276, 188, 202, 0, 0,
-	317, 355, 360, 0, 0, 0, 224, 0, 358, 330,
-	409, 209, 247, 352, 335, 356, 0, 0, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	0, 0, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 0, 0, 329, 359, 215,
-	411, 378, 534, 545, 540, 541, 538, 539, 0, 537,
-	536, 535, 548, 526, 527, 528, 529, 531, 0, 542,
-	543, 530, 187, 200, 282, 0, 349, 250, 432, 416,
-	414, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 0, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 321, 0, 0, 0, 0, 490, 0, 0, 0,
-	237, 0, 489, 0, 0, 0, 280, 0, 0, 334,
-	0, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 533, 284, 0, 0, 379,
-	306, 0, 0, 0, 0, 0, 524, 525, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 221, 192, 318,
-	380, 249, 67, 0, 0, 174, 175, 176, 511, 510,
-	513, 514, 515, 516, 0, 0, 213, 512, 219, 517,
-	518, 519, 0, 233, 268, 239, 232, 394, 0, 0,
-	0, 487, 504, 0, 532, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 501, 502, 576, 0, 0, 0,
-	547, 0, 503, 0, 0, 496, 497, 499, 498, 500,
-	505, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 307, 546, 0, 0, 421, 0, 0, 544,
-	0, 0, 0, 0, 0, 279, 0, 276, 188, 202,
-	0, 0, 317, 355, 360, 0, 0, 0, 224, 0,
-	358, 330, 409, 209, 247, 352, 335, 356, 0, 0,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 0, 0, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 0, 0, 329,
-	359, 215, 411, 378, 534, 545, 540, 541, 538, 539,
-	0, 537, 536, 535, 548, 526, 527, 528, 529, 531,
-	0, 542, 543, 530, 187, 200, 282, 0, 349, 250,
-	432, 416, 414, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 0,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 321, 0, 0, 0, 0, 490, 0,
-	0, 0, 237, 0, 489, 0, 0, 0, 280, 0,
-	0, 334, 0, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 533, 284, 0,
-	0, 379, 306, 0, 0, 0, 0, 0, 524, 525,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 221,
-	192, 318, 380, 249, 67, 0, 0, 174, 175, 176,
-	511, 1344, 513, 514, 515, 516, 0, 0, 213, 512,
-	219, 517, 518, 519, 0, 233, 268, 239, 232, 394,
-	0, 0, 0, 487, 504, 0, 532, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 501, 502, 576, 0,
-	0, 0, 547, 0, 503, 0, 0, 496, 497, 499,
-	498, 500, 505, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 307, 546, 0, 0, 421, 0,
-	0, 544, 0, 0, 0, 0, 0, 279, 0, 276,
-	188, 202, 0, 0, 317, 355, 360, 0, 0, 0,
-	224, 0, 358, 330, 409, 209, 247, 352, 335, 356,
-	0, 0, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 0, 0, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 0,
-	0, 329, 359, 215, 411, 378, 534, 545, 540, 541,
-	538, 539, 0, 537, 536, 535, 548, 526, 527, 528,
-	529, 531, 0, 542, 543, 530, 187, 200, 282, 0,
-	349, 250, 432, 416, 414, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 0, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 321, 0, 0, 0, 0,
-	490, 0, 0, 0, 237, 0, 489, 0, 0, 0,
-	280, 0, 0, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 533,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	524, 525, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 67, 0, 0, 174,
-	175, 176, 511, 1341, 513, 514, 515, 516, 0, 0,
-	213, 512, 219, 517, 518, 519, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 487, 504, 0, 532, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 501, 502,
-	576, 0, 0, 0, 547, 0, 503, 0, 0, 496,
-	497, 499, 498, 500, 505, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 307, 546, 0, 0,
-	421, 0, 0, 544, 0, 0, 0, 0, 0, 279,
-	0, 276, 188, 202, 0, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 534, 545,
-	540, 541, 538, 539, 0, 537, 536, 535, 548, 526,
-	527, 528, 529, 531, 0, 542, 543, 530, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 557, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	321, 0, 0, 0, 0, 490, 0, 0, 0, 237,
-	0, 489, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 533, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 524, 525, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 67, 0, 0, 174, 175, 176, 511, 510, 513,
-	514, 515, 516, 0, 0, 213, 512, 219, 517, 518,
-	519, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	487, 504, 0, 532, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 501, 502, 0, 0, 0, 0, 547,
-	0, 503, 0, 0, 496, 497, 499, 498, 500, 505,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 546, 0, 0, 421, 0, 0, 544, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 534, 545, 540, 541, 538, 539, 0,
-	537, 536, 535, 548, 526, 527, 528, 529, 531, 0,
-	542, 543, 530, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 0, 0, 0, 490, 0, 0,
-	0, 237, 0, 489, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 533, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 524, 525, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 67, 0, 0, 174, 175, 176, 511,
-	510, 513, 514, 515, 516, 0, 0, 213, 512, 219,
-	517, 518, 519, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 487, 504, 0, 532, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 501, 502, 0, 0, 0,
-	0, 547, 0, 503, 0, 0, 496, 497, 499, 498,
-	500, 505, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 546, 0, 0, 421, 0, 0,
-	544, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 534, 545, 540, 541, 538,
-	539, 0, 537, 536, 535, 548, 526, 527, 528, 529,
-	531, 0, 542, 543, 530, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 533, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 524,
-	525, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 67, 0, 0, 174, 175,
-	176, 511, 510, 513, 514, 515, 516, 0, 0, 213,
-	512, 219, 517, 518, 519, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 504, 0, 532, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 501, 502, 0,
-	0, 0, 0, 547, 0, 503, 0, 0, 496, 497,
-	499, 498, 500, 505, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 546, 0, 0, 421,
-	0, 0, 544, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 2080, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 534, 545, 540,
-	541, 538, 539, 0, 537, 536, 535, 548, 526, 527,
-	528, 529, 531, 0, 542, 543, 530, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	533, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 524, 525, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 67, 0, 564,
-	174, 175, 176, 511, 510, 513, 514, 515, 516, 0,
-	0, 213, 512, 219, 517, 518, 519, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 504, 0, 532,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 501,
-	502, 0, 0, 0, 0, 547, 0, 503, 0, 0,
-	496, 497, 499, 498, 500, 505, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 546, 0,
-	0, 421, 0, 0, 544, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 534,
-	545, 540, 541, 538, 539, 0, 537, 536, 535, 548,
-	526, 527, 528, 529, 531, 0, 542, 543, 530, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 533, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 524, 525, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 67,
-	0, 0, 174, 175, 176, 511, 510, 513, 514, 515,
-	516, 0, 0, 213, 512, 219, 517, 518, 519, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 504,
-	0, 532, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 501, 502, 0, 0, 0, 0, 547, 0, 503,
-	0, 0, 496, 497, 499, 498, 500, 505, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	546, 0, 0, 421, 0, 0, 544, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 534, 545, 540, 541, 538, 539, 0, 537, 536,
-	535, 548, 526, 527, 528, 529, 531, 0, 542, 543,
-	530, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 0, 0, 0, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 921,
-	920, 930, 931, 923, 924, 925, 926, 927, 928, 929,
-	922, 0, 0, 932, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 771, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 770, 421, 0, 0,
-	0, 0, 0, 0, 767, 768, 279, 738, 276, 188,
-	202, 761, 765, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 0, 0, 1021, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 0, 0, 0, 174, 175,
-	176, 0, 1023, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 910, 911, 909, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 912,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 33, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 321,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 280, 0, 0, 334, 0, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 0, 284, 0, 0, 379, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 221, 192, 318, 380, 249,
-	67, 0, 564, 174, 175, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 0, 0, 0,
-	0, 233, 268, 239, 232, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	307, 0, 0, 0, 421, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 0, 276, 188, 202, 0, 0,
-	317, 355, 360, 0, 0, 0, 224, 0, 358, 330,
-	409, 209, 247, 352, 335, 356, 0, 0, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	0, 0, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 0, 0, 329, 359, 215,
-	411, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 200, 282, 0, 349, 250, 432, 416,
-	414, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 0, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 321, 0, 0, 0, 1371, 0, 0, 0, 0,
-	237, 0, 0, 0, 0, 0, 280, 0, 0, 334,
-	0, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 0, 284, 0, 0, 379,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 221, 192, 318,
-	380, 249, 0, 0, 0, 174, 175, 176, 0, 1373,
-	0, 0, 0, 0, 0, 0, 213, 0, 219, 0,
-	0, 0, 0, 233, 268, 239, 232, 394, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 307, 0, 0, 0, 421, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 0, 276, 188, 202,
-	0, 0, 317, 355, 360, 0, 0, 0, 224, 0,
-	358, 330, 409, 209, 247, 352, 335, 356, 0, 1369,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 0, 0, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 0, 0, 329,
-	359, 215, 411, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 200, 282, 0, 349, 250,
-	432, 416, 414, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 0,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 321, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 0, 280, 0,
-	0, 334, 0, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 0, 284, 0,
-	0, 379, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 0, 0, 0, 0, 233, 268, 239, 232, 394,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 732,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 307, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 738, 276,
-	188, 202, 736, 0, 317, 355, 360, 0, 0, 0,
-	224, 0, 358, 330, 409, 209, 247, 352, 335, 356,
-	0, 0, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 0, 0, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 0,
-	0, 329, 359, 215, 411, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 200, 282, 0,
-	349, 250, 432, 416, 414, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 0, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 321, 0, 0, 0, 1371,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	280, 0, 0, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 0,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 1373, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 0, 0, 0, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 307, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	0, 276, 188, 202, 0, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 33, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	321, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 67, 0, 0, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	0, 1389, 0, 0, 1390, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 1054, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 0, 0, 0, 174, 175,
-	176, 0, 1053, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 0, 0, 564,
-	174, 175, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 0, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 67,
-	0, 0, 174, 175, 176, 0, 0, 0, 0, 0,
-	0, 0, 0, 213, 0, 219, 0, 0, 0, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 0, 0, 0, 174, 175, 176, 0, 1373, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	1023, 0, 0, 0, 0, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 1173, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 0, 0, 0, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 1171, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 0, 0, 0,
-	174, 175, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	1169, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 0, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 0,
-	0, 0, 174, 175, 176, 0, 0, 0, 0, 0,
-	0, 0, 0, 213, 0, 219, 0, 0, 0, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 1167, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 0, 0, 0, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 1165, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 1161, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 0, 0, 0, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 1159, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 0, 0, 0,
-	174, 175, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	1157, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 0, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 0,
-	0, 0, 174, 175, 176, 0, 0, 0, 0, 0,
-	0, 0, 0, 213, 0, 219, 0, 0, 0, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 1132, 0, 0, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 1036, 0, 0, 0, 0, 0, 0, 321,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 280, 0, 0, 334, 0, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 0, 284, 0, 0, 379, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 221, 192, 318, 380, 249,
-	0, 0, 0, 174, 175, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 0, 0, 0,
-	0, 233, 268, 239, 232, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	307, 0, 0, 0, 421, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 0, 276, 188, 202, 0, 0,
-	317, 355, 360, 0, 0, 0, 224, 0, 358, 330,
-	409, 209, 247, 352, 335, 356, 0, 0, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	0, 0, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 0, 0, 329, 359, 215,
-	411, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 200, 282, 0, 349, 250, 432, 416,
-	414, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 0, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 321, 0, 0, 0, 0, 0, 0, 0, 1027,
-	237, 0, 0, 0, 0, 0, 280, 0, 0, 334,
-	0, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 0, 284, 0, 0, 379,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 221, 192, 318,
-	380, 249, 0, 0, 0, 174, 175, 176, 0, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 219, 0,
-	0, 0, 0, 233, 268, 239, 232, 394, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 307, 0, 0, 0, 421, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 0, 276, 188, 202,
-	0, 0, 317, 355, 360, 0, 0, 0, 224, 0,
-	358, 330, 409, 209, 247, 352, 335, 356, 0, 0,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 0, 0, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 0, 0, 329,
-	359, 215, 411, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 200, 282, 0, 349, 250,
-	432, 416, 414, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 0,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 321, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 0, 280, 0,
-	0, 334, 0, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 0, 284, 0,
-	0, 379, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 901, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 0, 0, 0, 0, 233, 268, 239, 232, 394,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 307, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 0, 276,
-	188, 202, 0, 0, 317, 355, 360, 0, 0, 0,
-	224, 0, 358, 330, 409, 209, 247, 352, 335, 356,
-	0, 0, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 0, 0, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 0,
-	0, 329, 359, 215, 411, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 200, 282, 0,
-	349, 250, 432, 416, 414, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 0, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 321, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	280, 0, 0, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 0,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 0, 0, 0, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 480, 0, 256, 0, 307, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	0, 276, 188, 202, 0, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 479, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 321, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 280, 0, 0, 334, 0, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 0, 284, 0, 0, 379, 306, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 221, 192, 318, 380, 249, 0, 0,
-	0, 174, 175, 176, 0, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 219, 0, 0, 0, 0, 233,
-	268, 239, 232, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 307, 0,
-	182, 0, 421, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 0, 276, 188, 202, 0, 0, 317, 355,
-	360, 0, 0, 0, 224, 0, 358, 330, 409, 209,
-	247, 352, 335, 356, 0, 0, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 0, 0,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 0, 0, 329, 359, 215, 411, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 200, 282, 0, 349, 250, 432, 416, 414, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 0, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 321,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 280, 0, 0, 334, 0, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 0, 284, 0, 0, 379, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 221, 192, 318, 380, 249,
-	0, 0, 0, 174, 175, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 0, 0, 0,
-	0, 233, 268, 239, 232, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	307, 0, 0, 0, 421, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 0, 276, 188, 202, 0, 0,
-	317, 355, 360, 0, 0, 0, 224, 0, 358, 330,
-	409, 209, 247, 352, 335, 356, 0, 0, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	0, 0, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 0, 0, 329, 359, 215,
-	411, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 200, 282, 0, 349, 250, 432, 416,
-	414, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 0, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234,
+	0, 0, 610, 610, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1893, 0, 0,
+	0, 0, 0, 0, 610, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 449, 0, 0, 0, 0, 0, 1119, 0, 1438,
+	1923, 0, 34, 34, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	610, 449, 0, 0, 0, 0, 0, 1087, 0, 0,
+	0, 0, 0, 0, 1202, 449, 449, 449, 449, 449,
+	1132, 0, 0, 0, 0, 0, 0, 1751, 0, 0,
+	0, 0, 449, 0, 0, 449, 449, 0, 0, 449,
+	1762, 1300, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1975, 0,
+	1977, 1978, 0, 1980, 0, 1982, 0, 0, 0, 1145,
+	1148, 1149, 1150, 1151, 1152, 1153, 0, 1154, 1155, 1156,
+	1157, 1158, 1133, 1134, 1135, 1136, 1117, 1118, 1146, 0,
+	1120, 0, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
+	1129, 1130, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1202, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1300, 0, 0, 0, 0, 0, 2024, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 449, 2040, 2041, 2042, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1147, 0, 449, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 610, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 449, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1202, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 449,
+	0, 1923, 0, 34, 0, 1923, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2163, 0, 0, 0, 0, 449, 449,
+	34, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1202, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 449, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1923, 0,
+	34, 0, 0, 0, 0, 449, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 34, 0, 0, 0,
+	0, 0, 2211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1202, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2277, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1438, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 449, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 449, 0, 0,
+	0, 0, 0, 746, 733, 0, 0, 683, 749, 654,
+	672, 758, 674, 677, 715, 635, 696, 232, 669, 0,
+	658, 631, 665, 632, 656, 685, 147, 689, 653, 735,
+	699, 748, 190, 0, 659, 245, 717, 285, 133, 200,
+	197, 311, 156, 150, 146, 131, 175, 205, 243, 302,
+	237, 755, 194, 706, 0, 294, 217, 0, 0, 0,
+	687, 738, 694, 729, 682, 716, 643, 705, 750, 670,
+	713, 751, 180, 130, 101, 229, 295, 159, 0, 0,
+	0, 93, 94, 95, 0, 2219, 2220, 0, 0, 0,
+	0, 0, 122, 1202, 128, 710, 745, 667, 712, 143,
+	178, 149, 142, 309, 714, 761, 630, 707, 0, 633,
+	636, 757, 741, 662, 663, 0, 0, 0, 0, 0,
+	0, 0, 686, 695, 726, 680, 0, 0, 0, 0,
+	0, 0, 0, 0, 660, 0, 704, 0, 0, 0,
+	639, 634, 0, 0, 0, 0, 684, 0, 0, 0,
+	642, 0, 661, 727, 0, 628, 166, 637, 218, 731,
+	740, 681, 336, 744, 679, 678, 747, 721, 640, 737,
+	673, 189, 638, 186, 97, 111, 0, 671, 228, 267,
+	272, 736, 657, 666, 134, 664, 270, 241, 324, 118,
+	157, 264, 246, 268, 703, 719, 247, 269, 195, 313,
+	259, 323, 0, 199, 337, 338, 141, 222, 330, 306,
+	334, 346, 112, 138, 235, 299, 327, 291, 215, 310,
+	185, 290, 164, 100, 193, 104, 301, 321, 123, 281,
+	0, 0, 0, 106, 319, 298, 212, 182, 183, 105,
+	0, 263, 145, 162, 136, 231, 316, 317, 135, 348,
+	113, 333, 108, 114, 332, 224, 312, 320, 213, 204,
+	107, 318, 211, 203, 188, 155, 171, 257, 198, 258,
+	172, 220, 219, 221, 0, 102, 0, 296, 328, 349,
+	120, 652, 732, 308, 342, 345, 0, 260, 121, 163,
+	154, 256, 161, 191, 341, 343, 344, 119, 254, 169,
+	223, 115, 174, 292, 187, 196, 724, 760, 240, 271,
+	124, 326, 293, 647, 651, 645, 646, 697, 698, 648,
+	752, 753, 754, 728, 641, 0, 649, 650, 0, 734,
+	742, 743, 702, 96, 109, 192, 756, 261, 160, 347,
+	331, 329, 629, 140, 0, 0, 675, 676, 688, 692,
+	701, 711, 725, 739, 98, 99, 110, 117, 126, 139,
+	152, 158, 170, 173, 176, 177, 179, 184, 202, 206,
+	207, 208, 209, 225, 226, 227, 233, 234, 236, 238,
+	239, 242, 249, 250, 251, 253, 255, 262, 266, 273,
+	274, 276, 277, 278, 279, 280, 286, 287, 288, 289,
+	297, 300, 314, 315, 335, 339, 644, 655, 668, 690,
+	691, 693, 708, 709, 718, 720, 723, 730, 759, 132,
+	167, 230, 252, 722, 275, 282, 283, 325, 168, 322,
+	340, 0, 201, 700, 103, 129, 116, 137, 151, 153,
+	181, 210, 216, 244, 248, 165, 148, 127, 265, 125,
+	284, 303, 304, 305, 307, 214, 144, 746, 733, 0,
+	0, 683, 749, 654, 672, 758, 674, 677, 715, 635,
+	696, 232, 669, 0, 658, 631, 665, 632, 656, 685,
+	147, 689, 653, 735, 699, 748, 190, 0, 659, 245,
+	717, 285, 133, 200, 197, 311, 156, 150, 146, 131,
+	175, 205, 243, 302, 237, 755, 194, 706, 0, 294,
+	217, 0, 0, 0, 687, 738, 694, 729, 682, 716,
+	643, 705, 750, 670, 713, 751, 180, 130, 101, 229,
+	295, 159, 0, 0, 0, 93, 94, 95, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 0, 128, 710,
+	745, 667, 712, 143, 178, 149, 142, 309, 714, 761,
+	630, 707, 0, 633, 636, 757, 741, 662, 663, 0,
+	0, 0, 0, 0, 0, 0, 686, 695, 726, 680,
+	0, 0, 0, 0, 0, 0, 1915, 0, 660, 0,
+	704, 0, 0, 0, 639, 634, 0, 0, 0, 0,
+	684, 0, 0, 0, 642, 0, 661, 727, 0, 628,
+	166, 637, 218, 731, 740, 681, 336, 744, 679, 678,
+	747, 721, 640, 737, 673, 189, 638, 186, 97, 111,
+	0, 671, 228, 267, 272, 736, 657, 666, 134, 664,
+	270, 241, 324, 118, 157, 264, 246, 268, 703, 719,
+	247, 269, 195, 313, 259, 323, 0, 199, 337, 338,
+	141, 222, 330, 306, 334, 346, 112, 138, 235, 299,
+	327, 291, 215, 310, 185, 290, 164, 100, 193, 104,
+	301, 321, 123, 281, 0, 0, 0, 106, 319, 298,
+	212, 182, 183, 105, 0, 263, 145, 162, 136, 231,
+	316, 317, 135, 348, 113, 333, 108, 114, 332, 224,
+	312, 320, 213, 204, 107, 318, 211, 203, 188, 155,
+	171, 257, 198, 258, 172, 220, 219, 221, 0, 102,
+	0, 296, 328, 349, 120, 652, 732, 308, 342, 345,
+	0, 260, 121, 163, 154, 256, 161, 191, 341, 343,
+	344, 119, 254, 169, 223, 115, 174, 292, 187, 196,
+	724, 760, 240, 271, 124, 326, 293, 647, 651, 645,
+	646, 697, 698, 648, 752, 753, 754, 728, 641, 0,
+	649, 650, 0, 734, 742, 743, 702, 96, 109, 192,
+	756, 261, 160, 347, 331, 329, 629, 140, 0, 0,
+	675, 676, 688, 692, 701, 711, 725, 739, 98, 99,
+	110, 117, 126, 139, 152, 158, 170, 173, 176, 177,
+	179, 184, 202, 206, 207, 208, 209, 225, 226, 227,
+	233, 234, 236, 238, 239, 242, 249, 250, 251, 253,
+	255, 262, 266, 273, 274, 276, 277, 278, 279, 280,
+	286, 287, 288, 289, 297, 300, 314, 315, 335, 339,
+	644, 655, 668, 690, 691, 693, 708, 709, 718, 720,
+	723, 730, 759, 132, 167, 230, 252, 722, 275, 282,
+	283, 325, 168, 322, 340, 0, 201, 700, 103, 129,
+	116, 137, 151, 153, 181, 210, 216, 244, 248, 165,
+	148, 127, 265, 125, 284, 303, 304, 305, 307, 214,
+	144, 746, 733, 0, 0, 683, 749, 654, 672, 758,
+	674, 677, 715, 635, 696, 232, 669, 0, 658, 631,
+	665, 632, 656, 685, 147, 689, 653, 735, 699, 748,
+	190, 0, 659, 245, 717, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 755,
+	194, 706, 0, 294, 217, 0, 0, 0, 687, 738,
+	694, 729, 682, 716, 643, 705, 750, 670, 713, 751,
+	180, 130, 101, 229, 295, 159, 71, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 710, 745, 667, 712, 143, 178, 149,
+	142, 309, 714, 761, 630, 707, 0, 633, 636, 757,
+	741, 662, 663, 0, 0, 0, 0, 0, 0, 0,
+	686, 695, 726, 680, 0, 0, 0, 0, 0, 0,
+	0, 0, 660, 0, 704, 0, 0, 0, 639, 634,
+	0, 0, 0, 0, 684, 0, 0, 0, 642, 0,
+	661, 727, 0, 628, 166, 637, 218, 731, 740, 681,
+	336, 744, 679, 678, 747, 721, 640, 737, 673, 189,
+	638, 186, 97, 111, 0, 671, 228, 267, 272, 736,
+	657, 666, 134, 664, 270, 241, 324, 118, 157, 264,
+	246, 268, 703, 719, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 652,
+	732, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 724, 760, 240, 271, 124, 326,
+	293, 647, 651, 645, 646, 697, 698, 648, 752, 753,
+	754, 728, 641, 0, 649, 650, 0, 734, 742, 743,
+	702, 96, 109, 192, 756, 261, 160, 347, 331, 329,
+	629, 140, 0, 0, 675, 676, 688, 692, 701, 711,
+	725, 739, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 644, 655, 668, 690, 691, 693,
+	708, 709, 718, 720, 723, 730, 759, 132, 167, 230,
+	252, 722, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 700, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 746, 733, 0, 0, 683,
+	749, 654, 672, 758, 674, 677, 715, 635, 696, 232,
+	669, 0, 658, 631, 665, 632, 656, 685, 147, 689,
+	653, 735, 699, 748, 190, 0, 659, 245, 717, 285,
+	133, 200, 197, 311, 156, 150, 146, 131, 175, 205,
+	243, 302, 237, 755, 194, 706, 0, 294, 217, 0,
+	0, 0, 687, 738, 694, 729, 682, 716, 643, 705,
+	750, 670, 713, 751, 180, 130, 101, 229, 295, 159,
+	0, 0, 0, 93, 94, 95, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 0, 128, 710, 745, 667,
+	712, 143, 178, 149, 142, 309, 714, 761, 630, 707,
+	0, 633, 636, 757, 741, 662, 663, 0, 0, 0,
+	0, 0, 0, 0, 686, 695, 726, 680, 0, 0,
+	0, 0, 0, 0, 1763, 0, 660, 0, 704, 0,
+	0, 0, 639, 634, 0, 0, 0, 0, 684, 0,
+	0, 0, 642, 0, 661, 727, 0, 628, 166, 637,
+	218, 731, 740, 681, 336, 744, 679, 678, 747, 721,
+	640, 737, 673, 189, 638, 186, 97, 111, 0, 671,
+	228, 267, 272, 736, 657, 666, 134, 664, 270, 241,
+	324, 118, 157, 264, 246, 268, 703, 719, 247, 269,
+	195, 313, 259, 323, 0, 199, 337, 338, 141, 222,
+	330, 306, 334, 346, 112, 138, 235, 299, 327, 291,
+	215, 310, 185, 290, 164, 100, 193, 104, 301, 321,
+	123, 281, 0, 0, 0, 106, 319, 298, 212, 182,
+	183, 105, 0, 263, 145, 162, 136, 231, 316, 317,
+	135, 348, 113, 333, 108, 114, 332, 224, 312, 320,
+	213, 204, 107, 318, 211, 203, 188, 155, 171, 257,
+	198, 258, 172, 220, 219, 221, 0, 102, 0, 296,
+	328, 349, 120, 652, 732, 308, 342, 345, 0, 260,
+	121, 163, 154, 256, 161, 191, 341, 343, 344, 119,
+	254, 169, 223, 115, 174, 292, 187, 196, 724, 760,
+	240, 271, 124, 326, 293, 647, 651, 645, 646, 697,
+	698, 648, 752, 753, 754, 728, 641, 0, 649, 650,
+	0, 734, 742, 743, 702, 96, 109, 192, 756, 261,
+	160, 347, 331, 329, 629, 140, 0, 0, 675, 676,
+	688, 692, 701, 711, 725, 739, 98, 99, 110, 117,
+	126, 139, 152, 158, 170, 173, 176, 177, 179, 184,
+	202, 206, 207, 208, 209, 225, 226, 227, 233, 234,
+	236, 238, 239, 242, 249, 250, 251, 253, 255, 262,
+	266, 273, 274, 276, 277, 278, 279, 280, 286, 287,
+	288, 289, 297, 300, 314, 315, 335, 339, 644, 655,
+	668, 690, 691, 693, 708, 709, 718, 720, 723, 730,
+	759, 132, 167, 230, 252, 722, 275, 282, 283, 325,
+	168, 322, 340, 0, 201, 700, 103, 129, 116, 137,
+	151, 153, 181, 210, 216, 244, 248, 165, 148, 127,
+	265, 125, 284, 303, 304, 305, 307, 214, 144, 746,
+	733, 0, 0, 683, 749, 654, 672, 758, 674, 677,
+	715, 635, 696, 232, 669, 0, 658, 631, 665, 632,
+	656, 685, 147, 689, 653, 735, 699, 748, 190, 0,
+	659, 245, 717, 285, 133, 200, 197, 311, 156, 150,
+	146, 131, 175, 205, 243, 302, 237, 755, 194, 706,
+	0, 294, 217, 0, 0, 0, 687, 738, 694, 729,
+	682, 716, 643, 705, 750, 670, 713, 751, 180, 130,
+	101, 229, 295, 159, 0, 0, 0, 93, 94, 95,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 0,
+	128, 710, 745, 667, 712, 143, 178, 149, 142, 309,
+	714, 761, 630, 707, 0, 633, 636, 757, 741, 662,
+	663, 0, 0, 0, 0, 0, 0, 0, 686, 695,
+	726, 680, 0, 0, 0, 0, 0, 0, 1466, 0,
+	660, 0, 704, 0, 0, 0, 639, 634, 0, 0,
+	0, 0, 684, 0, 0, 0, 642, 0, 661, 727,
+	0, 628, 166, 637, 218, 731, 740, 681, 336, 744,
+	679, 678, 747, 721, 640, 737, 673, 189, 638, 186,
+	97, 111, 0, 671, 228, 267, 272, 736, 657, 666,
+	134, 664, 270, 241, 324, 118, 157, 264, 246, 268,
+	703, 719, 247, 269, 195, 313, 259, 323, 0, 199,
+	337, 338, 141, 222, 330, 306, 334, 346, 112, 138,
+	235, 299, 327, 291, 215, 310, 185, 290, 164, 100,
+	193, 104, 301, 321, 123, 281, 0, 0, 0, 106,
+	319, 298, 212, 182, 183, 105, 0, 263, 145, 162,
+	136, 231, 316, 317, 135, 348, 113, 333, 108, 114,
+	332, 224, 312, 320, 213, 204, 107, 318, 211, 203,
+	188, 155, 171, 257, 198, 258, 172, 220, 219, 221,
+	0, 102, 0, 296, 328, 349, 120, 652, 732, 308,
+	342, 345, 0, 260, 121, 163, 154, 256, 161, 191,
+	341, 343, 344, 119, 254, 169, 223, 115, 174, 292,
+	187, 196, 724, 760, 240, 271, 124, 326, 293, 647,
+	651, 645, 646, 697, 698, 648, 752, 753, 754, 728,
+	641, 0, 649, 650, 0, 734, 742, 743, 702, 96,
+	109, 192, 756, 261, 160, 347, 331, 329, 629, 140,
+	0, 0, 675, 676, 688, 692, 701, 711, 725, 739,
+	98, 99, 110, 117, 126, 139, 152, 158, 170, 173,
+	176, 177, 179, 184, 202, 206, 207, 208, 209, 225,
+	226, 227, 233, 234, 236, 238, 239, 242, 249, 250,
+	251, 253, 255, 262, 266, 273, 274, 276, 277, 278,
+	279, 280, 286, 287, 288, 289, 297, 300, 314, 315,
+	335, 339, 644, 655, 668, 690, 691, 693, 708, 709,
+	718, 720, 723, 730, 759, 132, 167, 230, 252, 722,
+	275, 282, 283, 325, 168, 322, 340, 0, 201, 700,
+	103, 129, 116, 137, 151, 153, 181, 210, 216, 244,
+	248, 165, 148, 127, 265, 125, 284, 303, 304, 305,
+	307, 214, 144, 746, 733, 0, 0, 683, 749, 654,
+	672, 758, 674, 677, 715, 635, 696, 232, 669, 0,
+	658, 631, 665, 632, 656, 685, 147, 689, 653, 735,
+	699, 748, 190, 0, 659, 245, 717, 285, 133, 200,
+	197, 311, 156, 150, 146, 131, 175, 205, 243, 302,
+	237, 755, 194, 706, 0, 294, 217, 0, 0, 0,
+	687, 738, 694, 729, 682, 716, 643, 705, 750, 670,
+	713, 751, 180, 130, 101, 229, 295, 159, 0, 0,
+	0, 93, 94, 95, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 0, 128, 710, 745, 667, 712, 143,
+	178, 149, 142, 309, 714, 761, 630, 707, 0, 633,
+	636, 757, 741, 662, 663, 0, 0, 0, 0, 0,
+	0, 0, 686, 695, 726, 680, 0, 0, 0, 0,
+	0, 0, 0, 0, 660, 0, 704, 0, 0, 0,
+	639, 634, 0, 0, 0, 0, 684, 0, 0, 0,
+	642, 0, 661, 727, 0, 628, 166, 637, 218, 731,
+	740, 681, 336, 744, 679, 678, 747, 721, 640, 737,
+	673, 189, 638, 186, 97, 111, 0, 671, 228, 267,
+	272, 736, 657, 666, 134, 664, 270, 241, 324, 118,
+	157, 264, 246, 268, 703, 719, 247, 269, 195, 313,
+	259, 323, 0, 199, 337, 338, 141, 222, 330, 306,
+	334, 346, 112, 138, 235, 299, 327, 291, 215, 310,
+	185, 290, 164, 100, 193, 104, 301, 321, 123, 281,
+	0, 0, 0, 106, 319, 298, 212, 182, 183, 105,
+	0, 263, 145, 162, 136, 231, 316, 317, 135, 348,
+	113, 333, 108, 114, 332, 224, 312, 320, 213, 204,
+	107, 318, 211, 203, 188, 155, 171, 257, 198, 258,
+	172, 220, 219, 221, 0, 102, 0, 296, 328, 349,
+	120, 652, 732, 308, 342, 345, 0, 260, 121, 163,
+	154, 256, 161, 191, 341, 343, 344, 119, 254, 169,
+	223, 115, 174, 292, 187, 196, 724, 760, 240, 271,
+	124, 326, 293, 647, 651, 645, 646, 697, 698, 648,
+	752, 753, 754, 728, 641, 0, 649, 650, 0, 734,
+	742, 743, 702, 96, 109, 192, 756, 261, 160, 347,
+	331, 329, 629, 140, 0, 0, 675, 676, 688, 692,
+	701, 711, 725, 739, 98, 99, 110, 117, 126, 139,
+	152, 158, 170, 173, 176, 177, 179, 184, 202, 206,
+	207, 208, 209, 225, 226, 227, 233, 234, 236, 238,
+	239, 242, 249, 250, 251, 253, 255, 262, 266, 273,
+	274, 276, 277, 278, 279, 280, 286, 287, 288, 289,
+	297, 300, 314, 315, 335, 339, 644, 655, 668, 690,
+	691, 693, 708, 709, 718, 720, 723, 730, 759, 132,
+	167, 230, 252, 722, 275, 282, 283, 325, 168, 322,
+	340, 0, 201, 700, 103, 129, 116, 137, 151, 153,
+	181, 210, 216, 244, 248, 165, 148, 127, 265, 125,
+	284, 303, 304, 305, 307, 214, 144, 746, 733, 0,
+	0, 683, 749, 654, 672, 758, 674, 677, 715, 635,
+	696, 232, 669, 0, 658, 631, 665, 632, 656, 685,
+	147, 689, 653, 735, 699, 748, 190, 0, 659, 245,
+	717, 285, 133, 200, 197, 311, 156, 150, 146, 131,
+	175, 205, 243, 302, 237, 755, 194, 706, 0, 294,
+	217, 0, 0, 0, 687, 738, 694, 729, 682, 716,
+	643, 705, 750, 670, 713, 751, 180, 130, 101, 229,
+	295, 159, 0, 0, 0, 93, 94, 95, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 0, 128, 710,
+	745, 667, 712, 143, 178, 149, 142, 309, 714, 761,
+	630, 707, 0, 633, 636, 757, 741, 662, 663, 0,
+	0, 0, 0, 0, 0, 0, 686, 695, 726, 680,
+	0, 0, 0, 0, 0, 0, 0, 0, 660, 0,
+	704, 0, 0, 0, 639, 634, 0, 0, 0, 0,
+	684, 0, 0, 0, 642, 0, 661, 727, 0, 628,
+	166, 637, 218, 731, 740, 681, 336, 744, 679, 678,
+	747, 721, 640, 737, 673, 189, 638, 186, 97, 111,
+	0, 671, 228, 267, 272, 736, 657, 666, 134, 664,
+	270, 241, 324, 118, 157, 264, 246, 268, 703, 719,
+	247, 269, 195, 313, 259, 323, 0, 199, 337, 338,
+	141, 222, 330, 306, 334, 346, 112, 138, 235, 299,
+	327, 291, 215, 310, 185, 290, 164, 100, 193, 104,
+	301, 321, 123, 281, 0, 0, 0, 106, 319, 298,
+	212, 182, 183, 105, 0, 263, 145, 162, 136, 231,
+	316, 317, 135, 348, 113, 333, 108, 763, 332, 224,
+	312, 320, 213, 204, 107, 318, 211, 203, 188, 155,
+	171, 257, 198, 258, 172, 220, 219, 221, 0, 102,
+	0, 296, 328, 349, 120, 652, 732, 308, 342, 345,
+	0, 260, 121, 163, 154, 256, 161, 191, 341, 343,
+	344, 119, 254, 169, 627, 762, 621, 620, 187, 196,
+	724, 760, 240, 271, 124, 326, 293, 647, 651, 645,
+	646, 697, 698, 648, 752, 753, 754, 728, 641, 0,
+	649, 650, 0, 734, 742, 743, 702, 96, 109, 192,
+	756, 261, 160, 347, 331, 329, 629, 140, 0, 0,
+	675, 676, 688, 692, 701, 711, 725, 739, 98, 99,
+	110, 117, 126, 139, 152, 158, 170, 173, 176, 177,
+	179, 184, 202, 206, 207, 208, 209, 225, 226, 227,
+	233, 234, 236, 238, 239, 242, 249, 250, 251, 253,
+	255, 262, 266, 273, 274, 276, 277, 278, 279, 280,
+	286, 287, 288, 289, 297, 300, 314, 315, 335, 339,
+	644, 655, 668, 690, 691, 693, 708, 709, 718, 720,
+	723, 730, 759, 132, 167, 230, 252, 722, 275, 282,
+	283, 325, 168, 322, 340, 0, 201, 700, 103, 129,
+	116, 137, 151, 153, 181, 210, 216, 244, 248, 165,
+	148, 127, 265, 125, 284, 303, 304, 305, 307, 214,
+	144, 746, 733, 0, 0, 683, 749, 654, 672, 758,
+	674, 677, 715, 635, 696, 232, 669, 0, 658, 631,
+	665, 632, 656, 685, 147, 689, 653, 735, 699, 748,
+	190, 0, 659, 245, 717, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 755,
+	194, 706, 0, 294, 217, 0, 0, 0, 687, 738,
+	694, 729, 682, 716, 643, 705, 750, 670, 713, 751,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 710, 745, 667, 712, 143, 178, 149,
+	142, 309, 714, 761, 630, 707, 0, 633, 636, 757,
+	741, 662, 663, 0, 0, 0, 0, 0, 0, 0,
+	686, 695, 726, 680, 0, 0, 0, 0, 0, 0,
+	0, 0, 660, 0, 704, 0, 0, 0, 639, 634,
+	0, 0, 0, 0, 684, 0, 0, 0, 642, 0,
+	661, 727, 0, 628, 166, 637, 218, 731, 740, 681,
+	336, 744, 679, 678, 747, 721, 640, 737, 673, 189,
+	638, 186, 97, 111, 0, 671, 228, 267, 272, 736,
+	657, 666, 134, 664, 270, 241, 324, 118, 157, 264,
+	246, 268, 703, 719, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 1095, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 763, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 652,
+	732, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 627, 762,
+	621, 620, 187, 196, 724, 760, 240, 271, 124, 326,
+	293, 647, 651, 645, 646, 697, 698, 648, 752, 753,
+	754, 728, 641, 0, 649, 650, 0, 734, 742, 743,
+	702, 96, 109, 192, 756, 261, 160, 347, 331, 329,
+	629, 140, 0, 0, 675, 676, 688, 692, 701, 711,
+	725, 739, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 644, 655, 668, 690, 691, 693,
+	708, 709, 718, 720, 723, 730, 759, 132, 167, 230,
+	252, 722, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 700, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 746, 733, 0, 0, 683,
+	749, 654, 672, 758, 674, 677, 715, 635, 696, 232,
+	669, 0, 658, 631, 665, 632, 656, 685, 147, 689,
+	653, 735, 699, 748, 190, 0, 659, 245, 717, 285,
+	133, 200, 197, 311, 156, 150, 146, 131, 175, 205,
+	243, 302, 237, 755, 194, 706, 0, 294, 217, 0,
+	0, 0, 687, 738, 694, 729, 682, 716, 643, 705,
+	750, 670, 713, 751, 180, 130, 101, 229, 295, 159,
+	0, 0, 0, 93, 94, 95, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 0, 128, 710, 745, 667,
+	712, 143, 178, 149, 142, 309, 714, 761, 630, 707,
+	0, 633, 636, 757, 741, 662, 663, 0, 0, 0,
+	0, 0, 0, 0, 686, 695, 726, 680, 0, 0,
+	0, 0, 0, 0, 0, 0, 660, 0, 704, 0,
+	0, 0, 639, 634, 0, 0, 0, 0, 684, 0,
+	0, 0, 642, 0, 661, 727, 0, 628, 166, 637,
+	218, 731, 740, 681, 336, 744, 679, 678, 747, 721,
+	640, 737, 673, 189, 638, 186, 97, 111, 0, 671,
+	228, 267, 272, 736, 657, 666, 134, 664, 270, 241,
+	324, 118, 157, 264, 246, 268, 703, 719, 247, 269,
+	195, 313, 259, 323, 0, 199, 337, 338, 141, 222,
+	330, 306, 334, 346, 112, 138, 235, 299, 327, 291,
+	215, 310, 185, 290, 164, 100, 193, 104, 301, 618,
+	123, 281, 0, 0, 0, 106, 319, 298, 212, 182,
+	183, 105, 0, 263, 145, 162, 136, 231, 316, 317,
+	135, 348, 113, 333, 108, 763, 332, 224, 312, 320,
+	213, 204, 107, 318, 211, 203, 188, 155, 171, 257,
+	198, 258, 172, 220, 219, 221, 0, 102, 0, 296,
+	328, 349, 120, 652, 732, 308, 342, 345, 0, 260,
+	121, 163, 154, 256, 161, 191, 341, 343, 344, 119,
+	254, 169, 627, 762, 621, 620, 187, 196, 724, 760,
+	240, 271, 124, 326, 293, 647, 651, 645, 646, 697,
+	698, 648, 752, 753, 754, 728, 641, 0, 649, 650,
+	0, 734, 742, 743, 702, 96, 109, 192, 756, 261,
+	160, 347, 331, 329, 629, 140, 0, 0, 675, 676,
+	688, 692, 701, 711, 725, 739, 98, 99, 110, 117,
+	126, 139, 152, 158, 170, 173, 176, 177, 179, 184,
+	202, 206, 207, 208, 209, 225, 226, 227, 233, 234,
+	236, 238, 239, 242, 249, 250, 251, 253, 255, 262,
+	266, 273, 274, 276, 277, 278, 279, 280, 286, 287,
+	288, 289, 297, 300, 314, 315, 335, 339, 644, 655,
+	668, 690, 691, 693, 708, 709, 718, 720, 723, 730,
+	759, 132, 167, 230, 252, 722, 275, 282, 283, 325,
+	168, 322, 340, 0, 201, 700, 103, 129, 116, 137,
+	151, 153, 181, 210, 216, 244, 248, 165, 148, 127,
+	265, 125, 284, 303, 304, 305, 307, 214, 144, 232,
+	0, 0, 1380, 0, 505, 0, 0, 0, 147, 0,
+	504, 0, 0, 0, 190, 0, 1381, 245, 0, 285,
+	133, 200, 197, 311, 156, 150, 146, 131, 175, 205,
+	243, 302, 237, 549, 194, 0, 0, 294, 217, 0,
+	0, 0, 0, 0, 540, 541, 0, 0, 0, 0,
+	0, 0, 0, 0, 180, 130, 101, 229, 295, 159,
+	71, 0, 0, 93, 94, 95, 527, 526, 529, 530,
+	531, 532, 0, 0, 122, 528, 128, 533, 534, 535,
+	0, 143, 178, 149, 142, 309, 0, 0, 0, 502,
+	519, 0, 548, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 517, 608, 0, 0, 0, 563, 0,
+	518, 0, 0, 511, 512, 514, 513, 515, 520, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	218, 562, 0, 0, 336, 0, 0, 560, 0, 0,
+	0, 0, 0, 189, 0, 186, 97, 111, 0, 0,
+	228, 267, 272, 0, 0, 0, 134, 0, 270, 241,
+	324, 118, 157, 264, 246, 268, 0, 0, 247, 269,
+	195, 313, 259, 323, 0, 199, 337, 338, 141, 222,
+	330, 306, 334, 346, 112, 138, 235, 299, 327, 291,
+	215, 310, 185, 290, 164, 100, 193, 104, 301, 321,
+	123, 281, 0, 0, 0, 106, 319, 298, 212, 182,
+	183, 105, 0, 263, 145, 162, 136, 231, 316, 317,
+	135, 348, 113, 333, 108, 114, 332, 224, 312, 320,
+	213, 204, 107, 318, 211, 203, 188, 155, 171, 257,
+	198, 258, 172, 220, 219, 221, 0, 102, 0, 296,
+	328, 349, 120, 0, 0, 308, 342, 345, 0, 260,
+	121, 163, 154, 256, 161, 191, 341, 343, 344, 119,
+	254, 169, 223, 115, 174, 292, 187, 196, 0, 0,
+	240, 271, 124, 326, 293, 550, 561, 556, 557, 554,
+	555, 0, 553, 552, 551, 564, 542, 543, 544, 545,
+	547, 0, 558, 559, 546, 96, 109, 192, 0, 261,
+	160, 347, 331, 329, 0, 140, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 99, 110, 117,
+	126, 139, 152, 158, 170, 173, 176, 177, 179, 184,
+	202, 206, 207, 208, 209, 225, 226, 227, 233, 234,
+	236, 238, 239, 242, 249, 250, 251, 253, 255, 262,
+	266, 273, 274, 276, 277, 278, 279, 280, 286, 287,
+	288, 289, 297, 300, 314, 315, 335, 339, 569, 567,
+	573, 571, 574, 572, 575, 570, 0, 568, 566, 565,
+	0, 132, 167, 230, 252, 0, 275, 282, 283, 325,
+	168, 322, 340, 0, 201, 0, 103, 129, 116, 137,
+	151, 153, 181, 210, 216, 244, 248, 165, 148, 127,
+	265, 125, 284, 303, 304, 305, 307, 214, 144, 232,
+	0, 0, 0, 0, 505, 0, 0, 0, 147, 0,
+	504, 0, 0, 0, 190, 0, 0, 245, 0, 285,
+	133, 200, 197, 311, 156, 150, 146, 131, 175, 205,
+	243, 302, 237, 549, 194, 0, 0, 294, 217, 0,
+	0, 0, 0, 0, 540, 541, 0, 0, 0, 0,
+	0, 0, 1506, 0, 180, 130, 101, 229, 295, 159,
+	71, 0, 0, 93, 94, 95, 527, 526, 529, 530,
+	531, 532, 0, 0, 122, 528, 128, 533, 534, 535,
+	1507, 143, 178, 149, 142, 309, 0, 0, 0, 502,
+	519, 0, 548, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 516, 517, 0, 0, 0, 0, 563, 0,
+	518, 0, 0, 511, 512, 514, 513, 515, 520, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
+	218, 562, 0, 0, 336, 0, 0, 560, 0, 0,
+	0, 0, 0, 189, 0, 186, 97, 111, 0, 0,
+	228, 267, 272, 0, 0, 0, 134, 0, 270, 241,
+	324, 118, 157, 264, 246, 268, 0, 0, 247, 269,
+	195, 313, 259, 323, 0, 199, 337, 338, 141, 222,
+	330, 306, 334, 346, 112, 138, 235, 299, 327, 291,
+	215, 310, 185, 290, 164, 100, 193, 104, 301, 321,
+	123, 281, 0, 0, 0, 106, 319, 298, 212, 182,
+	183, 105, 0, 263, 145, 162, 136, 231, 316, 317,
+	135, 348, 113, 333, 108, 114, 332, 224, 312, 320,
+	213, 204, 107, 318, 211, 203, 188, 155, 171, 257,
+	198, 258, 172, 220, 219, 221, 0, 102, 0, 296,
+	328, 349, 120, 0, 0, 308, 342, 345, 0, 260,
+	121, 163, 154, 256, 161, 191, 341, 343, 344, 119,
+	254, 169, 223, 115, 174, 292, 187, 196, 0, 0,
+	240, 271, 124, 326, 293, 550, 561, 556, 557, 554,
+	555, 0, 553, 552, 551, 564, 542, 543, 544, 545,
+	547, 0, 558, 559, 546, 96, 109, 192, 0, 261,
+	160, 347, 331, 329, 0, 140, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 99, 110, 117,
+	126, 139, 152, 158, 170, 173, 176, 177, 179, 184,
+	202, 206, 207, 208, 209, 225, 226, 227, 233, 234,
+	236, 238, 239, 242, 249, 250, 251, 253, 255, 262,
+	266, 273, 274, 276, 277, 278, 279, 280, 286, 287,
+	288, 289, 297, 300, 314, 315, 335, 339, 569, 567,
+	573, 571, 574, 572, 575, 570, 0, 568, 566, 565,
+	0, 132, 167, 230, 252, 0, 275, 282, 283, 325,
+	168, 322, 340, 0, 201, 0, 103, 129, 116, 137,
+	151, 153, 181, 210, 216, 244, 248, 165, 148, 127,
+	265, 125, 284, 303, 304, 305, 307, 214, 144, 585,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 232, 0, 0, 0, 0, 505, 0, 0,
+	0, 147, 0, 504, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 0, 93, 94, 95, 527,
+	526, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 502, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 0, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 36, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 505, 0, 0,
+	0, 147, 0, 504, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 592, 93, 94, 95, 527,
+	526, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 502, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 0, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 505, 0, 0,
+	0, 147, 0, 504, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 0, 93, 94, 95, 527,
+	526, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 502, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 608, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 505, 0, 0,
+	0, 147, 0, 504, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 0, 93, 94, 95, 527,
+	1399, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 502, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 608, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 505, 0, 0,
+	0, 147, 0, 504, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 0, 93, 94, 95, 527,
+	1396, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 502, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 608, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 505, 0, 0,
+	0, 147, 0, 504, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 0, 93, 94, 95, 527,
+	526, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 502, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 0, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 0, 93, 94, 95, 527,
+	526, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 0, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 0, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 2248,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 592, 93, 94, 95, 527,
+	526, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 0, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 0, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 549, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 540, 541, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 71, 0, 0, 93, 94, 95, 527,
+	526, 529, 530, 531, 532, 0, 0, 122, 528, 128,
+	533, 534, 535, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 0, 519, 0, 548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 516, 517, 0, 0, 0,
+	0, 563, 0, 518, 0, 0, 511, 512, 514, 513,
+	515, 520, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 562, 0, 0, 336, 0, 0,
+	560, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 550, 561,
+	556, 557, 554, 555, 0, 553, 552, 551, 564, 542,
+	543, 544, 545, 547, 0, 558, 559, 546, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 569, 567, 573, 571, 574, 572, 575, 570, 0,
+	568, 566, 565, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 0, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 0, 0, 0, 93, 94, 95, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 0, 128,
+	0, 0, 0, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 957, 956, 966, 967, 959, 960, 961, 962, 963,
+	964, 965, 958, 0, 0, 968, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 0, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 806, 0, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 0, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 0, 0, 0, 93, 94, 95, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 0, 128,
+	0, 0, 0, 0, 143, 178, 149, 142, 309, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 0, 0, 805, 336, 0, 0,
+	0, 0, 0, 0, 802, 803, 189, 771, 186, 97,
+	111, 796, 800, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 232, 0, 0, 0, 1073, 0, 0, 0,
+	0, 147, 0, 0, 0, 0, 0, 190, 0, 0,
+	245, 0, 285, 133, 200, 197, 311, 156, 150, 146,
+	131, 175, 205, 243, 302, 237, 0, 194, 0, 0,
+	294, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 180, 130, 101,
+	229, 295, 159, 0, 0, 0, 93, 94, 95, 0,
+	1075, 0, 0, 0, 0, 0, 0, 122, 0, 128,
+	0, 0, 0, 0, 143, 178, 149, 142, 309, 946,
+	947, 945, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 948, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 166, 0, 218, 0, 0, 0, 336, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 0, 186, 97,
+	111, 0, 0, 228, 267, 272, 0, 0, 0, 134,
+	0, 270, 241, 324, 118, 157, 264, 246, 268, 0,
+	0, 247, 269, 195, 313, 259, 323, 0, 199, 337,
+	338, 141, 222, 330, 306, 334, 346, 112, 138, 235,
+	299, 327, 291, 215, 310, 185, 290, 164, 100, 193,
+	104, 301, 321, 123, 281, 0, 0, 0, 106, 319,
+	298, 212, 182, 183, 105, 0, 263, 145, 162, 136,
+	231, 316, 317, 135, 348, 113, 333, 108, 114, 332,
+	224, 312, 320, 213, 204, 107, 318, 211, 203, 188,
+	155, 171, 257, 198, 258, 172, 220, 219, 221, 0,
+	102, 0, 296, 328, 349, 120, 0, 0, 308, 342,
+	345, 0, 260, 121, 163, 154, 256, 161, 191, 341,
+	343, 344, 119, 254, 169, 223, 115, 174, 292, 187,
+	196, 0, 0, 240, 271, 124, 326, 293, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 96, 109,
+	192, 0, 261, 160, 347, 331, 329, 0, 140, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	99, 110, 117, 126, 139, 152, 158, 170, 173, 176,
+	177, 179, 184, 202, 206, 207, 208, 209, 225, 226,
+	227, 233, 234, 236, 238, 239, 242, 249, 250, 251,
+	253, 255, 262, 266, 273, 274, 276, 277, 278, 279,
+	280, 286, 287, 288, 289, 297, 300, 314, 315, 335,
+	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 132, 167, 230, 252, 0, 275,
+	282, 283, 325, 168, 322, 340, 0, 201, 0, 103,
+	129, 116, 137, 151, 153, 181, 210, 216, 244, 248,
+	165, 148, 127, 265, 125, 284, 303, 304, 305, 307,
+	214, 144, 35, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 71, 0, 592, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 218, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 35, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 71,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 36, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 1437, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 1439, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 1435, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 765, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 771, 186, 97, 111, 769, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 1437, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 1439, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 1455, 0, 0,
+	1456, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 1106,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 1105, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 592, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 71,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 1439, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 1075, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1226, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1224, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1222, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1220, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1218, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1214, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1212, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	1210, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 0,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 0, 0,
+	0, 0, 0, 190, 0, 0, 245, 0, 285, 133,
+	200, 197, 311, 156, 150, 146, 131, 175, 205, 243,
+	302, 237, 0, 194, 0, 0, 294, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 180, 130, 101, 229, 295, 159, 1185,
+	0, 0, 93, 94, 95, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 0, 128, 0, 0, 0, 0,
+	143, 178, 149, 142, 309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 166, 0, 218,
+	0, 0, 0, 336, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 0, 186, 97, 111, 0, 0, 228,
+	267, 272, 0, 0, 0, 134, 0, 270, 241, 324,
+	118, 157, 264, 246, 268, 0, 0, 247, 269, 195,
+	313, 259, 323, 0, 199, 337, 338, 141, 222, 330,
+	306, 334, 346, 112, 138, 235, 299, 327, 291, 215,
+	310, 185, 290, 164, 100, 193, 104, 301, 321, 123,
+	281, 0, 0, 0, 106, 319, 298, 212, 182, 183,
+	105, 0, 263, 145, 162, 136, 231, 316, 317, 135,
+	348, 113, 333, 108, 114, 332, 224, 312, 320, 213,
+	204, 107, 318, 211, 203, 188, 155, 171, 257, 198,
+	258, 172, 220, 219, 221, 0, 102, 0, 296, 328,
+	349, 120, 0, 0, 308, 342, 345, 0, 260, 121,
+	163, 154, 256, 161, 191, 341, 343, 344, 119, 254,
+	169, 223, 115, 174, 292, 187, 196, 0, 0, 240,
+	271, 124, 326, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 96, 109, 192, 0, 261, 160,
+	347, 331, 329, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 99, 110, 117, 126,
+	139, 152, 158, 170, 173, 176, 177, 179, 184, 202,
+	206, 207, 208, 209, 225, 226, 227, 233, 234, 236,
+	238, 239, 242, 249, 250, 251, 253, 255, 262, 266,
+	273, 274, 276, 277, 278, 279, 280, 286, 287, 288,
+	289, 297, 300, 314, 315, 335, 339, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	132, 167, 230, 252, 0, 275, 282, 283, 325, 168,
+	322, 340, 0, 201, 0, 103, 129, 116, 137, 151,
+	153, 181, 210, 216, 244, 248, 165, 148, 127, 265,
+	125, 284, 303, 304, 305, 307, 214, 144, 1088, 0,
+	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 218, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 232, 0, 0, 0, 0,
+	0, 0, 0, 1079, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 218, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 936, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 218, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 495, 0, 166, 0, 218, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 494, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 218, 0, 447, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 218, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	88, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 147, 0, 0, 0, 0, 0,
+	190, 0, 0, 245, 0, 285, 133, 200, 197, 311,
+	156, 150, 146, 131, 175, 205, 243, 302, 237, 0,
+	194, 0, 0, 294, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	180, 130, 101, 229, 295, 159, 0, 0, 0, 93,
+	94, 95, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 0, 128, 0, 0, 0, 0, 143, 178, 149,
+	142, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 166, 0, 218, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	0, 186, 97, 111, 0, 0, 228, 267, 272, 0,
+	0, 0, 134, 0, 270, 241, 324, 118, 157, 264,
+	246, 268, 0, 0, 247, 269, 195, 313, 259, 323,
+	0, 199, 337, 338, 141, 222, 330, 306, 334, 346,
+	112, 138, 235, 299, 327, 291, 215, 310, 185, 290,
+	164, 100, 193, 104, 301, 321, 123, 281, 0, 0,
+	0, 106, 319, 298, 212, 182, 183, 105, 0, 263,
+	145, 162, 136, 231, 316, 317, 135, 348, 113, 333,
+	108, 114, 332, 224, 312, 320, 213, 204, 107, 318,
+	211, 203, 188, 155, 171, 257, 198, 258, 172, 220,
+	219, 221, 0, 102, 0, 296, 328, 349, 120, 0,
+	0, 308, 342, 345, 0, 260, 121, 163, 154, 256,
+	161, 191, 341, 343, 344, 119, 254, 169, 223, 115,
+	174, 292, 187, 196, 0, 0, 240, 271, 124, 326,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 96, 109, 192, 0, 261, 160, 347, 331, 329,
+	0, 140, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 99, 110, 117, 126, 139, 152, 158,
+	170, 173, 176, 177, 179, 184, 202, 206, 207, 208,
+	209, 225, 226, 227, 233, 234, 236, 238, 239, 242,
+	249, 250, 251, 253, 255, 262, 266, 273, 274, 276,
+	277, 278, 279, 280, 286, 287, 288, 289, 297, 300,
+	314, 315, 335, 339, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 167, 230,
+	252, 0, 275, 282, 283, 325, 168, 322, 340, 0,
+	201, 0, 103, 129, 116, 137, 151, 153, 181, 210,
+	216, 244, 248, 165, 148, 127, 265, 125, 284, 303,
+	304, 305, 307, 214, 144,
 }
 
 var yyPact = [...]int{
-	286, -1000, -331, 1566, -1000, -1000, -1000, -1000, -1000, -1000,
+	3750, -1000, -321, 1672, 1224, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1521, 1158, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	624, 1202, 102, 1429, 3586, 206, 925, 387, 79, 25498,
-	380, 110, 25930, -1000, 90, -1000, 74, 25930, 86, 25066,
-	-1000, -1000, -1000, 12073, 1380, -4, -5, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1178, 1483, 1490, 1516, 1020,
-	1499, -1000, 10332, 10332, 337, 337, 337, 8604, -1000, -1000,
-	15974, 25930, 25930, 1215, 325, 376, 325, -126, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1429, -1000, -1000, 124, -1000, 222,
-	1154, -1000, 1142, -1000, 355, 393, 255, 323, 291, 252,
-	246, 240, 238, 235, 234, 233, 230, 261, -1000, 509,
-	509, -193, -195, 1877, 314, 314, 314, 358, 1392, 1389,
-	-1000, 512, -1000, 509, 509, 119, 509, 509, 509, 509,
-	176, 175, 509, 509, 509, 509, 509, 509, 509, 509,
-	509, 509, 509, 509, 509, 509, 509, 25930, -1000, 136,
-	496, 559, 1429, 167, -1000, -1000, -1000, 25930, 319, 925,
-	319, 319, 25930, -1000, 438, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1588, 1224, -1000, 26646, -1000, -1000, -1000,
+	-1000, -1000, -1000, 600, 1321, 178, 1521, 145, 202, 939,
+	397, 168, 26206, 355, 3925, 27086, -1000, 116, -1000, 77,
+	27086, 111, 25766, -1000, -1000, -1000, 12533, 1488, -15, -17,
+	971, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1283,
+	1576, 1672, 1583, 1626, 1067, 1612, -1000, 1230, 27086, -1000,
+	1277, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3830,23 +3966,35 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 25930, 585, 585, 585, 585,
-	585, 585, 63, -1000, 50, 164, 161, 146, 20, 925,
-	196, -1000, 431, -1000, 138, 42, -1000, 585, 5928, 5928,
-	5928, -1000, 1403, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 357, -1000, -1000, -1000, -1000, 25930, 24634, 180, 555,
-	-1000, -1000, -1000, -1000, 1082, 695, -1000, 12073, 1203, 1170,
-	1170, -1000, -1000, 416, -1000, -1000, 13369, 13369, 13369, 13369,
-	13369, 13369, 13369, 13369, 13369, 13369, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1170, 436, -1000, 11641, 1170, 1170, 1170, 1170, 1170, 1170,
-	1170, 1170, 12073, 1170, 1170, 1170, 1170, 1170, 1170, 1170,
-	1170, 1170, 1170, 1170, 1170, 1170, 1170, 1170, 1170, -1000,
-	-1000, -1000, 25930, -1000, 1521, -1000, 1158, -1000, -1000, -1000,
-	1407, 12073, 12073, 1521, -1000, 1321, 10332, -1000, -1000, 1471,
-	-1000, -1000, -1000, -1000, 666, 1545, -1000, 14665, 433, 1536,
-	24202, -1000, 18579, 23770, 1138, 8158, -53, -1000, -1000, -1000,
-	545, 17715, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	11213, 11213, 290, 290, 290, 9000, -1000, -1000, 16959, 27086,
+	27086, 1333, 293, 338, 293, -105, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1521, -1000, -1000, 123, -1000, 222, 1252, -1000,
+	1248, -1000, 471, 543, 247, 316, 313, 245, 244, 241,
+	238, 237, 236, 235, 234, 252, -1000, 598, 598, -151,
+	-168, 2653, 283, 283, 283, 312, 1499, 1498, -1000, 636,
+	-1000, 598, 598, 103, 598, 598, 598, 598, 199, 197,
+	598, 598, 598, 598, 598, 598, 598, 598, 598, 598,
+	598, 598, 598, 598, 598, 27086, -1000, 161, 567, 639,
+	1521, 170, 27086, 291, 939, 291, 291, 27086, -1000, 497,
+	27086, 701, 701, 701, 701, 701, 701, 76, -1000, 72,
+	186, 183, 189, 94, 939, 190, -1000, 489, -1000, 179,
+	96, -1000, 701, 6276, 6276, 6276, -1000, 1505, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 308, -1000, -1000, -1000,
+	-1000, 27086, 25326, 271, 618, -1000, -1000, -1000, -1000, 1073,
+	979, -1000, 12533, 2209, 1232, 1232, -1000, -1000, 510, -1000,
+	-1000, 13853, 13853, 13853, 13853, 13853, 13853, 13853, 13853, 13853,
+	13853, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1232, 495, -1000, 10333,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 12533, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, -1000, -1000, -1000, 27086,
+	-1000, -1000, 1588, -1000, 1224, -1000, -1000, -1000, 1517, 12533,
+	12533, 1588, -1000, 1424, 11213, -1000, -1000, 1482, -1000, -1000,
+	-1000, -1000, 745, 27086, 1230, 1573, 27086, 1656, -1000, 15173,
+	491, 1654, 24886, -1000, 19159, 24446, 1226, 8546, -72, -1000,
+	-1000, -1000, 616, 18279, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3860,408 +4008,442 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1403, 1070, 25930, -1000, -1000, 2553, 925, -1000, 1201, -1000,
-	1068, -1000, 1174, 136, 25930, 579, 925, 925, -1000, -1000,
-	-1000, 509, 509, 260, 3586, 4039, -1000, -1000, -1000, 23331,
-	1200, 925, -1000, 1197, -1000, 1449, 329, 502, 502, 925,
-	-1000, -1000, 25930, 925, 1447, 1444, 25930, 25930, -1000, 22899,
-	-1000, 22467, 22035, 849, 25930, 21603, 21171, 20739, 20307, 19875,
-	-1000, 1274, -1000, 1169, -1000, -1000, -1000, 25930, 25930, 25930,
-	4, -1000, -1000, 25930, 925, -1000, -1000, 848, 846, 509,
-	509, 830, 930, 928, 927, 509, 509, 829, 924, 919,
-	142, 811, 775, 773, 896, 918, 105, 875, 873, 764,
-	25930, 1193, -1000, 129, 521, 183, 220, 193, 25930, 135,
-	1429, 1379, 1137, 356, 25930, 1464, 1247, 25930, 925, -1000,
-	7266, -1000, -1000, 909, 12073, -1000, -1000, -1000, -1000, -1000,
-	585, 25930, 585, 25930, 585, 585, 585, 585, 619, 623,
-	619, -1000, -1000, -1000, -1000, 5928, 5928, 25930, 5928, 5928,
-	25930, 5928, 5928, 623, -1000, -1000, -1000, 479, -1000, 1245,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 81, -1000, -1000,
-	-1000, -1000, -1000, 1566, -1000, -1000, -1000, -124, 12073, 12073,
-	12073, 12073, 713, 500, 13369, 681, 614, 13369, 13369, 13369,
-	13369, 13369, 13369, 13369, 13369, 13369, 13369, 13369, 13369, 13369,
-	13369, 13369, 608, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 925, -1000, 1564, 1179, 1179, 449, 449, 449, 449,
-	449, 449, 449, 449, 449, 13801, 9036, 7266, 1020, 1053,
-	1521, 10332, 10332, 12073, 12073, 11196, 10764, 10332, 1414, 548,
-	695, 25930, -1000, -1000, 12937, -1000, -1000, -1000, -1000, -1000,
-	938, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 25930, 25930,
-	10332, 10332, 10332, 10332, 10332, -1000, 1135, -1000, -172, 15542,
-	1490, 1020, 1471, 1454, 1557, 470, 736, 1122, -1000, 750,
-	1490, 17283, 1089, -1000, 1471, -1000, -1000, -1000, 25930, -1000,
-	-1000, 19443, -1000, -1000, 6820, 25930, 229, 25930, -1000, 1129,
-	1293, -1000, -1000, -1000, 1480, 16851, 25930, 1067, 1057, -1000,
-	-1000, 430, 7712, -53, -1000, 7712, 1099, -1000, -72, -38,
-	9468, 447, -1000, -1000, -1000, 1877, 14233, 1001, 634, 10,
-	-1000, -1000, -1000, 1174, -1000, 1174, 1174, 1174, 1174, 4,
-	4, 4, 4, -1000, -1000, -1000, -1000, -1000, 1192, 1184,
-	-1000, 1174, 1174, 1174, 1174, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1505, 1160, 27086, -1000, -1000, 4554, 939,
+	-1000, 1320, -1000, 1147, -1000, 1279, 161, 27086, 654, 939,
+	939, -1000, -1000, -1000, 598, 598, -1000, 229, 1625, 145,
+	3957, -1000, -1000, -1000, 23999, 1318, 939, -1000, 1317, -1000,
+	1548, 311, 542, 542, 939, -1000, -1000, 27086, 939, 1543,
+	1539, 27086, 27086, -1000, 23559, -1000, 23119, 22679, 874, 27086,
+	22239, 21799, 21359, 20919, 20479, -1000, 1387, -1000, 1326, -1000,
+	-1000, -1000, 27086, 27086, 27086, 24, -1000, -1000, 27086, 939,
+	-1000, -1000, 871, 869, 598, 598, 857, 962, 947, 946,
+	598, 598, 855, 943, 1068, 177, 822, 818, 808, 917,
+	941, 182, 878, 868, 805, 27086, 1315, -1000, 149, 611,
+	204, 246, 185, 27086, 169, 1521, 1487, 1225, 304, 27086,
+	1561, 1385, 27086, 939, -1000, 7638, -1000, -1000, 938, 12533,
+	-1000, -1000, -1000, -1000, -1000, 701, 27086, 701, 27086, 701,
+	701, 701, 701, 683, 689, 683, -1000, -1000, -1000, -1000,
+	6276, 6276, 27086, 6276, 6276, 27086, 6276, 6276, 689, -1000,
+	-1000, -1000, 582, -1000, 1384, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 90, -1000, -1000, -1000, -1000, -1000, 1672, 1224,
+	-1000, -1000, -1000, -107, 12533, 12533, 12533, 12533, 753, 596,
+	13853, 793, 612, 13853, 13853, 13853, 13853, 13853, 13853, 13853,
+	13853, 13853, 13853, 13853, 13853, 13853, 13853, 13853, 586, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 939, -1000, 232,
+	1038, 1038, 515, 515, 515, 515, 515, 515, 515, 515,
+	515, 14293, 9440, 7638, 1067, 1646, 1000, 1588, 11213, 11213,
+	12533, 12533, 12093, 11653, 11213, 1512, 641, 979, 27086, -1000,
+	-1000, 13413, -1000, -1000, -1000, -1000, -1000, 995, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 27086, 27086, 11213, 11213, 11213,
+	11213, 11213, 995, 995, 995, 995, 995, 12533, 11213, 11213,
+	12533, 12533, 12533, -1000, 1221, -1000, -164, 16519, 1583, 1067,
+	1482, 1553, 1666, 551, 684, 1200, -1000, 781, 1583, 17839,
+	1207, -1000, 1482, -1000, -1000, -1000, -1000, 1232, 1143, -1000,
+	27086, -1000, -1000, 20039, -1000, -1000, 7184, 27086, 233, 27086,
+	-1000, 1114, 1363, -1000, -1000, -1000, 1572, 16079, 27086, 1159,
+	1150, -1000, -1000, 488, 8092, -72, -1000, 8092, 1158, -1000,
+	-64, -77, 9880, 511, -1000, -1000, -1000, 2653, 14733, 1052,
+	705, -4, -1000, -1000, -1000, 1279, -1000, 1279, 1279, 1279,
+	1279, 24, 24, 24, 24, -1000, -1000, -1000, -1000, -1000,
+	1313, 1312, -1000, 1279, 1279, 1279, 1279, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1180, 1180, 1180, 1175, 1175, 298, -1000, 12073,
-	94, 25930, 1476, 752, 129, -1000, 1462, 1230, -1000, 914,
-	898, -1000, 1116, -1000, -1000, 1509, -1000, -1000, 462, 627,
-	621, 458, 25930, 107, 224, -1000, 289, -1000, 25930, 1177,
-	1437, 502, 925, -1000, 925, -1000, -1000, -1000, -1000, 428,
-	-1000, -1000, 925, 1115, -1000, 1114, 711, 617, 690, 604,
-	1115, -1000, -1000, -160, 1115, -1000, 1115, -1000, 1115, -1000,
-	1115, -1000, 1115, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 519, 25930, 107, 608, -1000, 339, -1000, -1000, 608,
-	608, -1000, -1000, -1000, -1000, 906, 905, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1311, 1311, 1311, 1300, 1300, 276,
+	-1000, 12533, 119, 27086, 1562, 791, 149, -1000, 1558, 1345,
+	-1000, 1053, 983, -1000, -48, 1198, -1000, -1000, 1624, -1000,
+	-1000, 590, 722, 706, 619, 27086, 124, 223, -1000, 275,
+	-1000, 27086, 1310, 1536, 542, 939, -1000, 939, -1000, -1000,
+	-1000, -1000, 476, -1000, -1000, 939, 1187, -1000, 1199, 773,
+	678, 767, 672, 1187, -1000, -1000, -128, 1187, -1000, 1187,
+	-1000, 1187, -1000, 1187, -1000, 1187, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 529, 27086, 124, 586, -1000, 302,
+	-1000, -1000, 586, 586, -1000, -1000, -1000, -1000, 926, 925,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -316, 25930, 364, 106, 115, 25930, 25930,
-	25930, 25930, 25930, 407, -1000, -1000, -1000, 173, 25930, 25930,
-	383, -1000, 25930, 377, -1000, -1000, -1000, -1000, -1000, -1000,
-	695, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 585,
-	25930, 25930, 25930, -1000, -1000, 585, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 25930, -1000, 899, 25930, 25930, -1000,
-	-1000, -1000, -1000, -1000, 695, 500, 563, 630, -1000, -1000,
-	817, -1000, -1000, 2268, -1000, -1000, -1000, -1000, 681, 13369,
-	13369, 13369, 481, 2268, 2159, 1543, 2060, 449, 544, 544,
-	454, 454, 454, 454, 454, 564, 564, -1000, -1000, -1000,
-	-1000, 938, -1000, -1000, -1000, 938, 10332, 10332, 1105, 1170,
-	427, -1000, 1178, -1000, -1000, 1490, 1000, 1000, 691, 852,
-	557, 1534, 1000, 539, 1529, 1000, 1000, 10332, -1000, -1000,
-	643, -1000, 12073, 938, -1000, 759, 1101, 1100, 1000, 938,
-	938, 1000, 1000, 25930, -1000, -289, -1000, -109, 437, 1170,
-	-1000, 19011, -1000, -1000, 1407, -1000, -1000, 1365, -1000, 1322,
-	12073, 12073, 12073, -1000, -1000, -1000, 1407, 1498, -1000, 1340,
-	1339, 1526, 10332, 18579, 1471, -1000, -1000, -1000, 426, 1526,
-	1079, 1170, -1000, 25930, 18579, 18579, 18579, 18579, 18579, -1000,
-	1291, 1290, -1000, 1318, 1301, 1305, 25930, -1000, 1037, 1020,
-	16851, 229, 1063, 18579, 25930, -1000, -1000, 18579, 25930, 6374,
-	-1000, 1099, -53, -54, -1000, -1000, -1000, -1000, 695, -1000,
-	885, -1000, 283, -1000, 311, -1000, -1000, -1000, -1000, 1428,
-	-1000, 647, 5, -1000, -1000, 4, 4, -1000, -1000, 447,
-	651, 447, 447, 447, 892, 892, -1000, -1000, -1000, -1000,
-	-1000, 737, -1000, -1000, -1000, 735, -1000, -1000, 660, 1268,
-	94, -1000, -1000, 509, 886, 1385, 25930, -1000, -1000, 976,
-	360, -1000, 1244, -1000, -1000, -1000, -1000, -1000, 3274, 25930,
-	1032, -1000, 103, 25930, 973, 25930, -1000, 1027, 25930, -1000,
-	925, -1000, -1000, 7266, -1000, 25930, 1170, -1000, -1000, -1000,
-	-1000, 378, 1415, 1408, 107, 103, 447, 925, -1000, -1000,
-	-1000, -1000, -1000, -320, 1022, 25930, 130, -1000, 1176, 934,
-	-1000, 1222, -1000, -1000, -1000, -1000, 87, 182, 169, 330,
-	-1000, -1000, -1000, -1000, 5928, 25930, -1000, -1000, -1000, -1000,
-	619, -1000, 619, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	481, 2268, 815, -1000, 13369, 13369, -1000, -1000, 1000, 1000,
-	10332, 7266, 1521, 1407, -1000, -1000, 171, 608, 171, 13369,
-	13369, -1000, 13369, 13369, -1000, -153, 1141, 568, -1000, 12073,
-	805, -1000, -1000, 13369, 13369, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 375, 369, 367, 25930, -1000, -1000,
-	845, 877, 1319, 695, 695, -1000, -1000, 25930, -1000, -1000,
-	-1000, -1000, 1524, 12073, -1000, 1098, -1000, 5482, 1490, 1243,
-	25930, 1170, 1566, 15110, 25930, 1086, -1000, 513, 1293, 1233,
-	1241, 1224, -1000, -1000, -1000, -1000, 1289, -1000, 1281, -1000,
-	-1000, -1000, -1000, -1000, 1020, 1526, 18579, 1034, -1000, 1034,
-	-1000, 425, -1000, -1000, -1000, -81, -65, -1000, -1000, -1000,
-	1877, -1000, -1000, 1238, 13369, -1000, -1000, -1000, 447, 447,
-	-1000, -1000, -1000, -1000, -1000, -1000, 996, -1000, 994, 1097,
-	986, 34, -1000, 1207, 1400, 509, 509, -1000, 725, -1000,
-	925, -1000, -1000, 25930, 25930, 1508, 1096, -1000, 25930, -1000,
-	-1000, 25930, -1000, -1000, 1333, 94, 984, -1000, -1000, -1000,
-	224, 25930, -1000, 1179, 103, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1172, -1000, -1000, -1000, 960, -1000, -161, 925, 25930,
-	25930, 25930, -1000, 25930, -1000, -1000, 585, 585, -1000, 13369,
-	2268, 2268, -1000, -1000, 938, -1000, 1490, -1000, 938, 1174,
-	1174, -1000, 1174, 1175, -1000, 1174, 66, 1174, 54, 938,
-	938, 2198, 2144, 2046, 1965, 1170, -146, -1000, 695, 12073,
-	1684, 958, 1170, 1170, 1170, 979, 868, 4, -1000, -1000,
-	-1000, 1500, 1507, 695, -1000, -1000, -1000, 1416, 1050, 1080,
-	-1000, -1000, 9900, 981, 1330, 424, 979, 1521, 25930, 12073,
-	-1000, -1000, 12073, 1173, -1000, 12073, -1000, -1000, -1000, 1521,
-	1521, 1034, -1000, -1000, 461, -1000, -1000, -1000, -1000, 15,
-	1556, 2268, -1000, -1000, 4, 854, 4, 708, -1000, 703,
-	-1000, -1000, -232, -1000, -1000, 1094, 1272, -1000, -1000, 1172,
-	-1000, 25930, 25930, -1000, -1000, 184, -1000, 276, 972, -1000,
-	-190, -1000, -1000, 1475, 25930, -1000, -1000, 7266, -1000, -1000,
-	1171, 1225, -1000, -1000, -1000, -1000, 2268, -1000, 1407, -1000,
-	-1000, 201, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	13369, 13369, 13369, 13369, 13369, 1490, 853, 695, 13369, 13369,
-	18147, 25930, 25930, 16406, 4, -12, -1000, 12073, 12073, 1436,
-	-1000, 1170, -1000, 1148, 25930, 1170, 25930, -1000, 1490, -1000,
-	695, 695, 25930, 695, 1490, -1000, 483, -1000, -165, 447,
-	-1000, 447, 953, 947, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1472, 1096, -1000, 213, 25930, -1000, 224, -1000,
-	-197, -198, 1158, 970, 1087, -1000, 488, 25930, 25930, -1000,
-	-1000, -1000, 759, 759, 759, 759, 202, 938, -1000, 759,
-	759, 963, -1000, 963, 963, 437, -284, -1000, 1376, 1373,
-	695, 1082, 1555, -1000, 1170, 1566, 406, 1080, -1000, -1000,
-	952, -1000, 658, 1433, -1000, 1431, -1000, -1000, -1000, -1000,
-	-1000, 1158, 1170, 1095, -1000, -1000, -1000, 165, -1000, 7266,
-	5036, 950, -1000, -1000, -1000, -1000, -1000, 938, 112, -164,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -12, 262, -1000,
-	1345, 1343, 1506, 25930, 1080, 25930, -1000, -1000, 760, -1000,
-	-1000, 165, 12505, 25930, -1000, -75, -1000, -1000, -1000, -1000,
-	-1000, 1222, -1000, 1315, -157, -175, 1349, 1351, 1351, 1373,
-	1505, 1360, 1357, -1000, 751, 1077, -1000, -1000, -1000, 759,
-	938, 942, 293, -1000, -1000, -161, -1000, 1302, -1000, 1347,
-	838, -1000, -1000, -1000, -1000, 734, -1000, 1502, 1496, -1000,
-	-1000, -1000, 1237, 132, -1000, -162, -1000, 765, -1000, -1000,
-	-1000, 692, 682, 1236, -1000, 1540, -1000, -170, -1000, -1000,
-	-1000, -1000, -1000, 1554, 465, 465, -180, -1000, -1000, -1000,
-	290, 733, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -314, 27086, 321, 139,
+	157, 27086, 27086, 27086, 27086, 27086, 448, -1000, -1000, -1000,
+	194, 27086, 27086, 481, -1000, 27086, 381, -1000, -1000, -1000,
+	-1000, -1000, -1000, 979, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 701, 27086, 27086, 27086, -1000, -1000, 701, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 27086, -1000, 921,
+	27086, 27086, -1000, 1672, -1000, -1000, -1000, -1000, 979, 596,
+	725, 584, -1000, -1000, 803, -1000, -1000, 1799, -1000, -1000,
+	-1000, -1000, 793, 13853, 13853, 13853, 613, 1799, 1640, 2250,
+	1188, 515, 637, 637, 540, 540, 540, 540, 540, 802,
+	802, -1000, -1000, -1000, -1000, 995, -1000, -1000, -1000, 995,
+	11213, 11213, 1186, 1232, 472, -1000, 1283, -1000, 1067, -1000,
+	1583, 1109, 1109, 723, 945, 608, 1653, 1109, 566, 1643,
+	1109, 1109, 11213, -1000, -1000, 728, -1000, 12533, 995, -1000,
+	1120, 1165, 1164, 1109, 995, 995, 1109, 1109, -248, -248,
+	-248, -248, -248, 864, 1109, 1109, 864, 864, 994, 27086,
+	-1000, -286, -1000, -84, 506, 1232, -1000, 19599, -1000, -1000,
+	1517, -1000, -1000, 1468, -1000, 1416, 12533, 12533, 12533, -1000,
+	-1000, -1000, 1517, 1587, -1000, 1436, 1432, 1634, 11213, 19159,
+	1482, -1000, -1000, 27086, -1000, -1000, -1000, 468, 1634, 289,
+	1232, -1000, 27086, 19159, 19159, 19159, 19159, 19159, -1000, 1406,
+	1405, -1000, 1404, 1403, 1457, 27086, -1000, 1136, 1067, 1224,
+	16079, 233, 1124, 19159, 27086, -1000, -1000, 19159, 27086, 6730,
+	-1000, 1158, -72, -42, -1000, -1000, -1000, -1000, 979, -1000,
+	934, 230, 2556, -1000, 281, -1000, -1000, -1000, -1000, 1514,
+	-1000, 687, 15, -1000, -1000, 24, 24, -1000, -1000, 511,
+	591, 511, 511, 511, 915, 915, -1000, -1000, -1000, -1000,
+	-1000, 789, -1000, -1000, -1000, 787, -1000, -1000, 949, 1375,
+	119, -1000, -1000, 598, 910, 1492, 27086, -1000, -1000, 1040,
+	317, -1000, 1382, -1000, -1000, -1000, -1000, -1000, 215, 1030,
+	217, 213, -1000, 2451, 27086, 1130, -1000, 128, 27086, 1037,
+	27086, -1000, 1122, 27086, -1000, 939, -1000, -1000, 7638, -1000,
+	27086, 1232, -1000, -1000, -1000, -1000, 352, 1509, 1508, 124,
+	128, 511, 939, -1000, -1000, -1000, -1000, -1000, -322, 1112,
+	27086, 150, -1000, 1309, 948, -1000, 1329, -1000, -1000, -1000,
+	-1000, 122, 203, 187, 299, -1000, -1000, -1000, -1000, 6276,
+	27086, -1000, -1000, -1000, -1000, 683, -1000, 683, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 613, 1799, 908, -1000, 13853,
+	13853, -1000, -248, 1109, 1109, 11213, 7638, 1588, -1000, 1517,
+	-1000, -1000, 452, 586, 452, 13853, 13853, -1000, 13853, 13853,
+	-1000, -121, 1049, 620, -1000, 12533, 679, -1000, -1000, 13853,
+	13853, -1000, -1000, -1000, -1000, -1000, -1000, 19159, -1000, -1000,
+	-1000, -1000, -248, -11, -11, -11, -11, 12533, -1000, -1000,
+	-1000, -1000, 336, 327, 325, 27086, -1000, -1000, 911, 900,
+	1414, 979, 979, -1000, -1000, 27086, -1000, -1000, -1000, -1000,
+	1631, 12533, -1000, 1154, -1000, -1000, 5822, 1583, 1372, 27086,
+	1232, 1672, 1224, 15626, 27086, 1107, -1000, 610, 1363, 1343,
+	1361, 1377, -1000, -1000, -1000, -1000, 1397, -1000, 1396, -1000,
+	-1000, -1000, -1000, -1000, 1067, 1067, 1634, 19159, 1104, -1000,
+	1104, -1000, 457, -1000, -1000, -1000, -66, -90, -1000, -1000,
+	-1000, -1000, -1000, 2653, -1000, -1000, 1352, 13853, -1000, -1000,
+	-1000, 511, 511, -1000, -1000, -1000, -1000, -1000, -1000, 1098,
+	-1000, 1096, 1152, 1094, 63, -1000, 1229, 1503, 598, 598,
+	-1000, 772, -1000, 939, -1000, -1000, 27086, 27086, 1232, 770,
+	1232, 1232, 12533, 1232, 12533, 1232, 1622, 1140, 27086, -1000,
+	-1000, 27086, -1000, -1000, 1429, 119, 1079, -1000, -1000, -1000,
+	223, 27086, -1000, 1038, 128, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1277, -1000, -1000, -1000, 1032, -1000, -132, 939, 27086,
+	27086, 27086, -1000, 27086, -1000, -1000, 701, 701, -1000, 13853,
+	1799, 1799, -1000, -1000, -1000, 995, -1000, 1583, -1000, 995,
+	1279, 1279, -1000, 1279, 1300, -1000, 1279, 70, 1279, 67,
+	995, 995, 2379, 2340, 2275, 1490, 1232, -112, -1000, 979,
+	12533, 1749, 1206, 939, -1000, -1000, -248, -258, -259, -248,
+	-248, -248, 864, 1232, 1232, 1232, 1029, 896, 24, -1000,
+	-1000, -1000, 1629, 1621, 979, -1000, -1000, -1000, 1550, 1060,
+	1075, -1000, -1000, 10773, 1672, 1077, 1427, 430, 1029, 1588,
+	27086, 12533, -1000, -1000, 12533, 1278, -1000, 12533, -1000, -1000,
+	-1000, -1000, 1588, 1588, 1104, -1000, -1000, 528, -1000, -1000,
+	-1000, -1000, 29, 1660, 1799, -1000, -1000, 24, 893, 24,
+	769, -1000, 754, -1000, -1000, -200, -1000, -1000, 1231, 1378,
+	-1000, -1000, 1277, -1000, -1000, 223, -1000, 12533, 18719, 864,
+	27086, 864, 27086, 27086, -1000, -1000, 287, -1000, 248, 1026,
+	-1000, -154, -1000, -1000, 1571, -1000, -1000, 7638, -1000, -1000,
+	1269, 1338, -1000, -1000, -1000, -1000, 1799, -1000, 1517, -1000,
+	-1000, 206, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	13853, 13853, 13853, 13853, 13853, 1583, 889, 979, 13853, 13853,
+	995, 227, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1642,
+	18719, 27086, 27086, 17399, 24, -5, -254, 12533, 12533, 1535,
+	-1000, 1232, -1000, 288, 27086, 1232, 27086, -1000, 1583, -1000,
+	979, 979, 27086, 979, 1583, -1000, 443, -1000, -115, 511,
+	-1000, 511, 1017, 997, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1569, 1026, 864, -1000, 1023, -1000, 1023, -1000,
+	1023, 1140, 211, 1232, 27086, -1000, 223, -1000, -169, -173,
+	1224, 1119, -1000, 593, 27086, 27086, -1000, -1000, -1000, 1120,
+	1120, 1120, 1120, 125, 995, -1000, 1120, 1120, -1000, 1588,
+	1620, -11, 162, 1023, -1000, 1023, 1023, 506, -281, -1000,
+	1483, 1481, -1000, 27086, 979, 1073, 1659, -1000, 1232, 1672,
+	1224, 415, 1075, -1000, -1000, 1015, -1000, 700, 1533, -1000,
+	1525, -1000, -1000, -1000, -1000, -1000, 1224, -1000, -1000, -1000,
+	-1000, -1000, 1232, 12533, 1267, -1000, -1000, -1000, 219, 7638,
+	5368, 1009, -1000, -1000, -1000, -1000, -1000, 995, 146, -143,
+	-1000, -1000, -1000, -274, 12533, -248, -1000, -1000, -1000, -1000,
+	-1000, -1000, -5, 262, -1000, 1442, 1440, 1618, 1088, -1000,
+	1566, 27086, 1075, 1672, 27086, -1000, -1000, 887, -1000, -1000,
+	219, 12973, 1000, 27086, -1000, -67, -1000, -1000, -1000, -1000,
+	-1000, 1329, -1000, 1411, -126, -147, -1000, 140, -1000, -1000,
+	1073, -1000, 1452, 1455, 1455, 1481, 1616, 1476, 1470, -1000,
+	886, 27086, 1232, 1062, -1000, -1000, -1000, 1120, 995, -1000,
+	981, 273, -1000, -1000, -132, -1000, 1389, -1000, -1000, 226,
+	-275, -261, -264, -1000, 13853, 1446, 765, -1000, -1000, -1000,
+	-1000, 876, -1000, 1591, 1585, -1000, -1000, 939, -1000, -1000,
+	1348, 154, -1000, -139, 659, -1000, -1000, -1000, -1000, -1000,
+	14293, -1000, 756, -1000, -1000, -1000, 865, 804, 995, 1347,
+	-1000, 1639, -1000, -144, 226, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1641, 474, 474, -152, -1000, -1000, -1000, -1000,
+	240, 852, -1000, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1854, 1853, 14, 77, 81, 1852, 1850, 1848, 1847,
-	128, 123, 121, 1844, 1843, 1840, 1839, 1837, 1836, 1835,
-	1834, 1833, 1831, 1830, 1829, 62, 115, 114, 1828, 1825,
-	1811, 1810, 1807, 126, 124, 473, 1806, 125, 1805, 1803,
-	1802, 1801, 1800, 1796, 1794, 1792, 1781, 1780, 1778, 1777,
-	199, 1776, 1775, 7, 1774, 83, 1772, 1769, 1768, 1767,
-	1760, 1759, 1757, 105, 1756, 44, 226, 48, 75, 1753,
-	69, 765, 1746, 96, 111, 1745, 161, 1744, 39, 89,
-	68, 1743, 40, 1742, 1738, 86, 1736, 1735, 1734, 73,
-	1733, 1731, 2601, 1729, 67, 76, 21, 37, 1728, 1727,
-	1726, 1725, 32, 1285, 1724, 1722, 24, 1721, 1720, 127,
-	1718, 82, 31, 16, 19, 18, 1717, 79, 1716, 8,
-	61, 23, 1713, 80, 1712, 1704, 1701, 1698, 33, 1696,
-	74, 100, 116, 1695, 6, 10, 1690, 1689, 1688, 1686,
-	1684, 1671, 4, 1670, 1668, 1666, 1665, 26, 1663, 11,
-	25, 70, 46, 29, 9, 1662, 119, 1660, 27, 104,
-	66, 102, 1659, 1658, 1657, 962, 1656, 59, 1655, 131,
-	1654, 1653, 41, 1652, 462, 753, 1648, 1647, 1646, 49,
-	1116, 2368, 13, 106, 1643, 1642, 1687, 55, 71, 22,
-	1641, 1640, 1637, 122, 45, 51, 939, 43, 1636, 1635,
-	1634, 1633, 1631, 1627, 1626, 387, 1625, 1624, 1623, 34,
-	20, 110, 30, 1621, 1619, 1617, 1614, 1613, 63, 54,
-	1612, 99, 98, 72, 117, 1611, 107, 84, 57, 1609,
-	56, 1606, 1605, 1604, 1603, 42, 1602, 1601, 1599, 1598,
-	94, 85, 64, 36, 35, 87, 1597, 38, 1596, 1592,
-	97, 90, 1591, 17, 113, 12, 1590, 2, 0, 1589,
-	5, 109, 1400, 112, 1587, 1585, 1, 1584, 3, 1583,
-	1581, 78, 1580, 1579, 1578, 1573, 3031, 28, 103, 1572,
-	118,
+	0, 1996, 120, 18, 96, 94, 1995, 1994, 1993, 1992,
+	134, 129, 128, 1991, 1990, 1987, 1986, 1985, 1982, 1980,
+	1978, 1977, 1974, 1973, 1972, 62, 119, 143, 1971, 1970,
+	1969, 1968, 1967, 125, 123, 153, 1966, 124, 1964, 1963,
+	1962, 1960, 1959, 1957, 1956, 1955, 1954, 1953, 1952, 1949,
+	1948, 140, 1947, 1946, 12, 1945, 97, 1944, 1943, 1939,
+	1925, 1923, 1920, 1913, 98, 1912, 59, 382, 54, 88,
+	1910, 79, 190, 1907, 103, 127, 1906, 2324, 1905, 52,
+	84, 77, 1904, 51, 1903, 1902, 110, 1901, 1899, 1898,
+	75, 1897, 1896, 68, 1895, 74, 86, 21, 44, 1893,
+	1892, 1890, 1889, 4, 411, 1873, 1872, 28, 1870, 1869,
+	141, 1868, 14, 5, 1867, 1865, 1864, 1861, 2, 1859,
+	1858, 22, 1857, 1856, 35, 126, 890, 7, 1855, 95,
+	16, 27, 30, 33, 1853, 102, 1848, 11, 60, 39,
+	1847, 91, 1846, 1845, 1844, 1843, 31, 1839, 81, 104,
+	72, 1837, 10, 15, 1836, 1835, 1831, 1829, 1827, 1824,
+	8, 1821, 1818, 1816, 1813, 49, 1812, 25, 29, 82,
+	46, 38, 13, 1811, 139, 1809, 34, 114, 73, 111,
+	1806, 1802, 1799, 201, 1798, 58, 1797, 137, 1796, 1795,
+	93, 1794, 146, 186, 1792, 1791, 1789, 67, 1210, 1754,
+	17, 115, 1788, 1787, 3261, 70, 85, 26, 1786, 1783,
+	1762, 132, 92, 57, 189, 48, 1760, 1759, 1757, 1756,
+	1755, 1753, 1750, 47, 1749, 1747, 1746, 90, 80, 108,
+	42, 1745, 1742, 1740, 1739, 1738, 69, 41, 1736, 113,
+	107, 78, 118, 1733, 116, 99, 76, 1732, 109, 1731,
+	1730, 1729, 1728, 66, 1726, 1725, 1724, 1723, 112, 106,
+	64, 43, 45, 105, 1720, 40, 1715, 1714, 101, 100,
+	24, 1712, 1711, 71, 1708, 1701, 1700, 32, 130, 20,
+	1698, 6, 0, 1697, 9, 122, 1507, 170, 1695, 1693,
+	1, 1692, 3, 1691, 1690, 87, 1687, 1683, 1681, 1680,
+	2969, 1737, 117, 1678, 133,
 }
 
 var yyR1 = [...]int{
-	0, 274, 275, 275, 1, 1, 1, 1, 1, 1,
+	0, 298, 299, 299, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 258, 258, 258, 261, 261, 21, 47,
-	3, 3, 3, 3, 2, 2, 8, 9, 4, 5,
-	5, 10, 10, 57, 57, 11, 12, 12, 12, 12,
-	278, 278, 87, 87, 85, 85, 86, 86, 151, 151,
-	13, 14, 14, 161, 161, 160, 160, 160, 162, 162,
-	162, 162, 196, 196, 15, 15, 15, 15, 15, 64,
-	64, 260, 260, 259, 257, 257, 256, 256, 255, 23,
-	24, 30, 31, 32, 262, 262, 231, 36, 36, 35,
-	35, 35, 35, 37, 37, 34, 34, 33, 33, 233,
-	233, 220, 220, 232, 232, 232, 232, 232, 232, 232,
-	219, 198, 198, 198, 198, 201, 201, 199, 199, 199,
-	199, 199, 199, 199, 199, 199, 200, 200, 200, 200,
-	200, 202, 202, 202, 202, 202, 203, 203, 203, 203,
-	203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
-	203, 204, 204, 204, 204, 204, 204, 204, 204, 218,
-	218, 205, 205, 211, 211, 212, 212, 212, 214, 214,
-	215, 215, 176, 176, 176, 207, 207, 208, 208, 213,
-	213, 209, 209, 209, 210, 210, 210, 217, 217, 217,
-	217, 217, 206, 206, 221, 247, 247, 246, 246, 242,
-	242, 242, 242, 230, 230, 239, 239, 239, 239, 239,
-	229, 229, 225, 225, 225, 226, 226, 227, 227, 224,
-	224, 228, 228, 241, 241, 240, 222, 222, 223, 223,
-	250, 250, 250, 250, 251, 267, 268, 266, 266, 266,
-	266, 266, 55, 55, 55, 177, 177, 177, 237, 237,
-	236, 236, 236, 238, 238, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 171, 171, 171, 265, 265, 265,
-	265, 265, 265, 264, 264, 264, 234, 234, 234, 263,
-	263, 120, 120, 121, 121, 28, 28, 28, 28, 28,
-	28, 27, 27, 27, 25, 25, 25, 25, 25, 25,
+	1, 1, 1, 1, 1, 282, 282, 282, 285, 285,
+	21, 47, 48, 3, 3, 3, 3, 2, 2, 8,
+	9, 4, 124, 124, 126, 126, 125, 5, 5, 10,
+	10, 58, 58, 11, 12, 12, 12, 12, 302, 302,
+	88, 88, 86, 86, 87, 87, 169, 169, 13, 14,
+	14, 179, 179, 178, 178, 178, 180, 180, 180, 180,
+	214, 214, 15, 15, 15, 15, 15, 65, 65, 284,
+	284, 283, 281, 281, 280, 280, 279, 23, 24, 30,
+	31, 32, 286, 286, 249, 36, 36, 35, 35, 35,
+	35, 37, 37, 34, 34, 33, 33, 251, 251, 238,
+	238, 250, 250, 250, 250, 250, 250, 250, 237, 216,
+	216, 216, 216, 219, 219, 217, 217, 217, 217, 217,
+	217, 217, 217, 217, 218, 218, 218, 218, 218, 220,
+	220, 220, 220, 220, 221, 221, 221, 221, 221, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 222,
+	222, 222, 222, 222, 222, 222, 222, 236, 236, 223,
+	223, 229, 229, 230, 230, 230, 232, 232, 233, 233,
+	194, 194, 194, 225, 225, 226, 226, 231, 231, 227,
+	227, 227, 228, 228, 228, 235, 235, 235, 235, 235,
+	224, 224, 239, 265, 265, 264, 264, 260, 260, 260,
+	260, 248, 248, 257, 257, 257, 257, 257, 247, 247,
+	243, 243, 243, 244, 244, 245, 245, 242, 242, 246,
+	246, 259, 259, 258, 240, 240, 241, 241, 268, 268,
+	268, 268, 269, 291, 292, 290, 290, 290, 290, 290,
+	56, 56, 56, 195, 195, 195, 255, 255, 254, 254,
+	254, 256, 256, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 253, 253, 253, 253, 253, 253, 253, 253,
+	253, 253, 189, 189, 189, 289, 289, 289, 289, 289,
+	289, 288, 288, 288, 252, 252, 252, 287, 287, 138,
+	138, 139, 139, 28, 28, 28, 28, 28, 28, 27,
+	27, 27, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 29, 29, 26, 26,
-	26, 26, 26, 26, 26, 26, 26, 16, 16, 16,
+	25, 25, 25, 25, 29, 29, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 16, 16, 16, 16, 16,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
-	254, 254, 254, 254, 216, 216, 216, 252, 252, 253,
-	253, 17, 22, 22, 18, 18, 18, 18, 19, 19,
-	38, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+	16, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
+	278, 278, 278, 234, 234, 234, 270, 270, 277, 277,
+	277, 277, 272, 272, 273, 274, 274, 274, 274, 274,
+	274, 274, 275, 275, 276, 276, 271, 271, 17, 22,
+	22, 18, 18, 18, 18, 19, 19, 38, 39, 39,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 168,
-	168, 269, 269, 170, 170, 166, 166, 169, 169, 167,
-	167, 167, 172, 172, 172, 173, 173, 273, 273, 273,
-	40, 40, 42, 42, 43, 44, 44, 191, 191, 192,
-	192, 45, 46, 56, 56, 56, 56, 56, 56, 58,
-	58, 58, 7, 7, 7, 7, 52, 52, 52, 6,
-	6, 41, 41, 48, 270, 270, 271, 272, 272, 272,
-	272, 49, 20, 279, 50, 51, 51, 63, 63, 63,
-	59, 59, 59, 62, 62, 62, 67, 67, 69, 69,
-	69, 69, 69, 70, 70, 70, 70, 70, 70, 66,
-	66, 68, 68, 68, 68, 184, 184, 184, 183, 183,
-	77, 77, 78, 78, 79, 79, 80, 80, 80, 118,
-	95, 95, 150, 150, 149, 149, 152, 152, 81, 81,
-	81, 81, 82, 82, 83, 83, 84, 84, 190, 190,
-	189, 189, 189, 188, 188, 88, 88, 88, 90, 89,
-	89, 89, 89, 91, 91, 93, 93, 92, 92, 94,
-	96, 96, 96, 96, 96, 97, 97, 76, 76, 76,
-	76, 76, 76, 76, 76, 164, 164, 99, 99, 98,
-	98, 98, 98, 98, 98, 98, 98, 98, 98, 110,
-	110, 110, 110, 110, 110, 100, 100, 100, 100, 100,
-	100, 100, 65, 65, 111, 111, 111, 117, 112, 112,
-	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
-	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
-	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
-	103, 103, 103, 103, 107, 107, 107, 107, 105, 105,
-	105, 105, 105, 105, 105, 105, 105, 105, 105, 105,
-	105, 106, 106, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 106, 106, 280, 280, 109,
-	108, 108, 108, 108, 108, 108, 108, 61, 61, 61,
-	61, 61, 195, 195, 195, 197, 197, 197, 197, 197,
-	197, 197, 197, 197, 197, 197, 197, 197, 124, 124,
-	60, 60, 122, 122, 123, 125, 125, 119, 119, 119,
-	102, 102, 102, 102, 102, 102, 102, 102, 104, 104,
-	104, 126, 126, 127, 127, 128, 128, 129, 129, 130,
-	131, 131, 131, 132, 132, 132, 132, 248, 248, 248,
-	248, 248, 243, 243, 243, 243, 244, 244, 244, 71,
-	71, 71, 71, 73, 73, 72, 72, 53, 53, 54,
-	54, 54, 74, 74, 75, 75, 75, 75, 147, 147,
-	147, 133, 133, 133, 133, 138, 138, 138, 134, 134,
-	136, 136, 136, 137, 137, 137, 135, 141, 141, 143,
-	143, 142, 142, 140, 140, 145, 145, 144, 144, 139,
-	139, 101, 101, 101, 101, 101, 148, 148, 148, 148,
-	153, 153, 113, 113, 115, 115, 114, 116, 154, 154,
-	158, 155, 155, 159, 159, 159, 159, 159, 156, 156,
-	157, 157, 185, 185, 185, 163, 163, 174, 174, 175,
-	175, 165, 165, 178, 178, 178, 146, 146, 146, 146,
-	249, 249, 245, 181, 181, 182, 182, 186, 186, 187,
-	187, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
-	179, 179, 179, 179, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 276, 277, 193, 194, 194, 194,
+	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 39, 39, 186, 186, 293, 293,
+	188, 188, 184, 184, 187, 187, 185, 185, 185, 190,
+	190, 190, 191, 191, 297, 297, 297, 40, 40, 42,
+	42, 43, 44, 44, 209, 209, 210, 210, 45, 46,
+	57, 57, 57, 57, 57, 57, 59, 59, 59, 7,
+	7, 7, 7, 7, 53, 53, 53, 6, 6, 41,
+	41, 49, 294, 294, 295, 296, 296, 296, 296, 50,
+	20, 303, 51, 52, 52, 64, 64, 64, 60, 60,
+	60, 63, 63, 63, 68, 68, 70, 70, 70, 70,
+	70, 71, 71, 71, 71, 71, 71, 67, 67, 69,
+	69, 69, 69, 202, 202, 202, 201, 201, 78, 78,
+	79, 79, 80, 80, 81, 81, 81, 136, 136, 96,
+	96, 168, 168, 167, 167, 170, 170, 82, 82, 82,
+	82, 83, 83, 84, 84, 85, 85, 208, 208, 207,
+	207, 207, 206, 206, 89, 89, 89, 91, 90, 90,
+	90, 90, 92, 92, 94, 94, 93, 93, 95, 97,
+	97, 97, 97, 97, 98, 98, 77, 77, 77, 77,
+	77, 77, 77, 77, 182, 182, 100, 100, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 128, 128,
+	128, 128, 128, 128, 101, 101, 101, 101, 101, 101,
+	101, 66, 66, 129, 129, 129, 135, 135, 130, 130,
+	104, 104, 104, 104, 104, 104, 104, 104, 104, 104,
+	104, 104, 104, 104, 104, 104, 104, 104, 104, 104,
+	104, 104, 104, 104, 104, 104, 104, 104, 104, 104,
+	104, 104, 104, 104, 104, 108, 108, 108, 108, 108,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 107, 107, 107, 107, 107, 107, 107,
+	107, 107, 107, 107, 107, 107, 107, 107, 107, 304,
+	304, 110, 109, 109, 109, 109, 109, 109, 109, 111,
+	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
+	120, 120, 120, 121, 121, 121, 112, 112, 113, 114,
+	114, 115, 115, 116, 116, 116, 117, 117, 118, 118,
+	118, 118, 118, 119, 119, 122, 122, 123, 123, 127,
+	62, 62, 62, 62, 62, 213, 213, 213, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	215, 142, 142, 61, 61, 140, 140, 141, 143, 143,
+	137, 137, 137, 103, 103, 103, 103, 103, 103, 103,
+	103, 105, 105, 105, 144, 144, 145, 145, 146, 146,
+	147, 147, 148, 149, 149, 149, 150, 150, 150, 150,
+	266, 266, 266, 266, 266, 261, 261, 261, 261, 262,
+	262, 262, 72, 72, 72, 72, 74, 74, 73, 73,
+	54, 54, 55, 55, 55, 75, 75, 76, 76, 76,
+	76, 165, 165, 165, 151, 151, 151, 151, 156, 156,
+	156, 152, 152, 154, 154, 154, 155, 155, 155, 153,
+	159, 159, 161, 161, 160, 160, 158, 158, 163, 163,
+	162, 162, 157, 157, 102, 102, 102, 102, 102, 102,
+	102, 166, 166, 166, 166, 171, 171, 131, 131, 133,
+	133, 132, 134, 172, 172, 176, 173, 173, 177, 177,
+	177, 177, 177, 174, 174, 175, 175, 203, 203, 203,
+	181, 181, 192, 192, 193, 193, 183, 183, 196, 196,
+	196, 164, 164, 164, 164, 267, 267, 263, 199, 199,
+	200, 200, 204, 204, 205, 205, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 197, 197, 197, 197,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 300, 301, 211, 212, 212, 212,
 }
 
 var yyR2 = [...]int{
-	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 0, 1, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 0, 1, 2, 3,
-	5, 6, 6, 7, 4, 6, 5, 7, 8, 1,
-	3, 7, 8, 1, 1, 9, 9, 8, 7, 7,
-	1, 1, 1, 3, 1, 3, 1, 3, 0, 4,
-	3, 5, 4, 1, 3, 3, 2, 2, 2, 2,
-	2, 1, 1, 1, 2, 2, 6, 11, 2, 0,
-	2, 0, 2, 1, 0, 2, 1, 3, 3, 4,
-	3, 7, 4, 2, 1, 1, 4, 0, 1, 1,
-	1, 2, 2, 0, 1, 4, 4, 4, 4, 2,
-	4, 1, 3, 1, 1, 3, 4, 3, 3, 3,
-	8, 3, 1, 1, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
-	2, 1, 2, 2, 2, 2, 4, 4, 2, 2,
-	3, 3, 3, 3, 1, 1, 1, 1, 1, 6,
-	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 0, 3, 0, 5, 0, 3, 5, 0, 1,
-	0, 1, 0, 1, 2, 0, 2, 0, 3, 0,
-	1, 0, 2, 2, 0, 2, 2, 0, 2, 1,
-	2, 1, 0, 2, 5, 0, 1, 1, 2, 1,
-	3, 2, 3, 0, 1, 3, 3, 3, 4, 2,
-	0, 2, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 0, 1, 1, 3, 3, 3, 1, 3, 1,
-	10, 11, 11, 12, 5, 3, 3, 1, 1, 2,
-	2, 2, 0, 1, 1, 0, 1, 2, 0, 1,
-	1, 3, 2, 1, 2, 3, 3, 4, 4, 3,
-	3, 3, 3, 4, 4, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
+	2, 3, 2, 5, 6, 6, 7, 4, 6, 5,
+	7, 9, 2, 3, 1, 3, 4, 1, 3, 7,
+	8, 1, 1, 9, 9, 8, 7, 7, 1, 1,
+	1, 3, 1, 3, 1, 3, 0, 4, 3, 5,
+	4, 1, 3, 3, 2, 2, 2, 2, 2, 1,
+	1, 1, 2, 2, 6, 11, 2, 0, 2, 0,
+	2, 1, 0, 2, 1, 3, 3, 4, 3, 7,
+	4, 2, 1, 1, 5, 0, 1, 1, 1, 2,
+	2, 0, 1, 4, 4, 4, 4, 2, 4, 1,
+	3, 1, 1, 3, 4, 3, 3, 3, 8, 3,
+	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 4, 4, 2, 2, 3, 3,
+	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
+	3, 0, 5, 0, 3, 5, 0, 1, 0, 1,
+	0, 1, 2, 0, 2, 0, 3, 0, 1, 0,
+	2, 2, 0, 2, 2, 0, 2, 1, 2, 1,
+	0, 2, 5, 0, 1, 1, 2, 1, 3, 2,
+	3, 0, 1, 3, 3, 3, 4, 2, 0, 2,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
+	1, 1, 3, 3, 3, 1, 3, 1, 10, 11,
+	11, 12, 5, 3, 3, 1, 1, 2, 2, 2,
+	0, 1, 1, 0, 1, 2, 0, 1, 1, 3,
+	2, 1, 2, 3, 3, 4, 4, 3, 3, 3,
+	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 4, 5, 0, 2, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	1, 0, 2, 0, 2, 0, 1, 5, 1, 3,
-	7, 1, 3, 3, 1, 2, 2, 2, 5, 5,
-	5, 6, 6, 5, 5, 2, 2, 2, 2, 3,
-	3, 3, 4, 1, 3, 5, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 2, 4, 4,
-	2, 10, 3, 6, 7, 5, 5, 5, 12, 7,
-	5, 9, 5, 3, 7, 4, 4, 4, 4, 3,
-	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
-	8, 3, 3, 5, 5, 6, 5, 4, 3, 2,
-	3, 3, 3, 3, 3, 3, 3, 4, 2, 4,
-	4, 4, 4, 4, 5, 7, 4, 4, 4, 4,
-	4, 4, 4, 4, 2, 4, 7, 2, 4, 5,
-	4, 3, 3, 5, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 0, 1, 0, 1, 1, 1, 0,
-	2, 2, 0, 2, 2, 0, 2, 0, 1, 1,
-	2, 1, 1, 2, 1, 1, 5, 0, 1, 0,
-	1, 2, 3, 0, 3, 3, 3, 3, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 3,
-	3, 2, 2, 3, 1, 3, 2, 1, 2, 1,
-	2, 2, 2, 0, 2, 0, 2, 1, 2, 2,
-	0, 1, 1, 0, 1, 1, 0, 1, 0, 1,
-	2, 3, 4, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
-	0, 2, 1, 3, 1, 1, 1, 3, 3, 3,
-	3, 7, 0, 3, 1, 3, 1, 3, 4, 4,
-	4, 3, 2, 4, 0, 1, 0, 2, 0, 1,
-	0, 1, 2, 1, 1, 1, 2, 2, 1, 2,
-	3, 2, 3, 2, 2, 2, 1, 1, 3, 3,
-	0, 5, 4, 5, 5, 0, 2, 1, 3, 3,
-	3, 2, 3, 1, 2, 0, 3, 1, 1, 3,
-	3, 4, 4, 5, 3, 4, 5, 6, 2, 1,
-	2, 1, 2, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 3, 1, 3,
+	4, 5, 0, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 0, 2, 0, 1, 5, 1, 3, 7, 1,
+	3, 3, 1, 2, 2, 2, 5, 5, 5, 6,
+	6, 5, 5, 2, 2, 2, 2, 3, 3, 3,
+	4, 1, 3, 5, 1, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 2, 2, 3, 4, 4, 2,
+	10, 3, 6, 7, 5, 5, 5, 12, 7, 5,
+	9, 5, 3, 7, 4, 4, 4, 4, 3, 3,
+	3, 7, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 2, 0, 2, 2, 1, 3, 8, 8,
+	7, 2, 0, 1, 5, 5, 4, 5, 4, 5,
+	4, 5, 0, 1, 0, 2, 0, 3, 3, 3,
+	5, 5, 6, 5, 4, 3, 2, 3, 3, 3,
+	3, 3, 3, 3, 4, 2, 4, 4, 4, 4,
+	4, 5, 7, 4, 4, 4, 4, 4, 4, 4,
+	4, 2, 4, 7, 2, 4, 5, 4, 3, 3,
+	5, 2, 3, 3, 3, 3, 1, 1, 1, 1,
+	0, 1, 0, 1, 1, 1, 0, 2, 2, 0,
+	2, 2, 0, 2, 0, 1, 1, 2, 1, 1,
+	2, 1, 1, 5, 0, 1, 0, 1, 2, 3,
+	0, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	2, 1, 1, 1, 0, 1, 1, 3, 3, 2,
+	2, 3, 1, 3, 2, 1, 2, 1, 2, 2,
+	2, 0, 2, 0, 2, 1, 2, 2, 0, 1,
+	1, 0, 1, 1, 0, 1, 0, 1, 2, 3,
+	4, 1, 1, 1, 1, 1, 1, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 1, 0, 2,
+	1, 3, 1, 1, 1, 3, 3, 3, 4, 3,
+	7, 0, 3, 1, 3, 1, 3, 4, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
+	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 2, 1, 1, 3, 3, 0,
+	5, 4, 5, 5, 0, 2, 1, 3, 3, 3,
+	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
+	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
+	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 1, 1, 1, 3, 4, 1, 3,
 	1, 1, 1, 1, 1, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	2, 2, 2, 2, 2, 2, 2, 2, 2, 3,
-	1, 1, 1, 1, 4, 5, 5, 6, 4, 4,
-	6, 6, 6, 8, 8, 8, 8, 9, 8, 5,
-	4, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 8, 8, 0, 2, 3,
-	4, 4, 4, 4, 4, 4, 4, 0, 3, 4,
-	7, 3, 1, 1, 1, 2, 3, 3, 1, 2,
-	2, 1, 2, 1, 2, 2, 1, 2, 0, 1,
-	0, 2, 1, 2, 4, 0, 2, 1, 3, 5,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 0, 3, 0, 2, 0, 3, 1, 3, 2,
-	0, 1, 1, 0, 2, 4, 4, 0, 2, 2,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 0,
-	3, 3, 3, 0, 3, 1, 1, 0, 4, 0,
-	1, 1, 0, 3, 1, 3, 2, 1, 0, 2,
-	4, 0, 9, 3, 5, 0, 3, 3, 0, 1,
-	0, 2, 2, 0, 2, 2, 2, 0, 3, 0,
-	3, 0, 3, 0, 4, 0, 3, 0, 4, 0,
-	1, 2, 1, 5, 4, 4, 1, 3, 3, 5,
-	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
-	3, 1, 3, 3, 3, 3, 3, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 0, 2, 0,
-	3, 0, 1, 0, 1, 1, 0, 1, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 4, 5, 5, 6, 5,
+	4, 4, 6, 6, 6, 8, 8, 8, 8, 9,
+	8, 5, 4, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 8, 8, 0,
+	2, 3, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 5, 6, 6, 6, 6, 9,
+	0, 2, 2, 0, 2, 2, 4, 2, 4, 0,
+	1, 0, 3, 0, 2, 5, 1, 1, 2, 2,
+	2, 2, 2, 1, 3, 0, 2, 1, 3, 5,
+	0, 3, 4, 7, 3, 1, 1, 1, 2, 3,
+	3, 1, 2, 2, 1, 2, 1, 2, 2, 1,
+	2, 0, 1, 0, 2, 1, 2, 4, 0, 2,
+	1, 3, 5, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 0, 3, 0, 2, 0, 3,
+	1, 3, 2, 0, 1, 1, 0, 2, 4, 4,
+	0, 2, 2, 1, 1, 3, 3, 3, 3, 3,
+	3, 3, 0, 3, 3, 3, 0, 3, 1, 1,
+	0, 4, 0, 1, 1, 0, 3, 1, 3, 2,
+	1, 0, 2, 4, 0, 9, 3, 5, 0, 3,
+	3, 0, 1, 0, 2, 2, 0, 2, 2, 2,
+	0, 3, 0, 3, 0, 3, 0, 4, 0, 3,
+	0, 4, 0, 1, 2, 1, 2, 5, 4, 4,
+	5, 1, 3, 3, 5, 0, 5, 1, 3, 1,
+	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 3, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 0, 2, 0, 3, 0, 1, 0, 1,
+	1, 0, 1, 1, 1, 0, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4300,441 +4482,479 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -274, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -19, -38, -39, -40, -42,
-	-43, -44, -45, -46, -6, -41, -20, -21, -47, -48,
-	-49, -4, -276, 6, 7, 8, -57, 10, 11, 31,
-	-23, -30, 152, -31, -24, 153, -32, 155, 154, 190,
-	156, 183, 70, 224, 225, 227, 228, 229, 230, -58,
-	188, 189, 158, 35, 41, 32, 33, 80, 9, 322,
-	185, 184, 26, -275, 451, -63, 5, -128, 16, -3,
-	-50, -279, -50, -50, -50, -50, -50, -50, -231, -233,
-	80, 125, 80, -64, 162, -146, -262, 106, 168, 171,
-	172, 313, 161, -36, -35, -34, -33, -37, 30, -28,
-	-29, -254, -27, -26, 157, 154, 198, 101, 102, 190,
-	191, 192, 156, 174, 189, 193, 188, 207, -25, 76,
-	32, 335, 338, -238, 153, 159, 160, 323, 104, 103,
-	71, 155, -235, 274, 428, -37, 430, 94, 96, 429,
-	40, 163, 431, 432, 433, 434, 173, 435, 436, 437,
-	438, 444, 445, 446, 447, 105, 5, 162, -262, -71,
-	284, 76, -261, -258, 83, 84, 85, 162, 162, 163,
-	164, -262, 162, -92, -186, -258, -180, 332, 176, 366,
-	367, 222, 76, 274, 428, 224, 238, 232, 259, 251,
-	333, 368, 177, 211, 249, 252, 300, 430, 369, 191,
-	296, 279, 287, 94, 227, 309, 443, 370, 441, 96,
-	429, 75, 47, 40, 186, 247, 243, 431, 212, 371,
-	343, 205, 104, 101, 450, 241, 46, 28, 440, 103,
-	45, 432, 372, 433, 289, 264, 44, 192, 373, 79,
-	337, 291, 242, 288, 221, 439, 158, 374, 422, 298,
-	375, 265, 269, 376, 301, 48, 377, 378, 102, 379,
-	74, 434, 236, 237, 380, 219, 175, 303, 263, 173,
-	34, 292, 334, 223, 54, 199, 304, 42, 267, 41,
-	426, 381, 262, 258, 49, 382, 383, 384, 385, 435,
-	261, 235, 257, 449, 217, 436, 58, 160, 271, 270,
-	272, 206, 299, 254, 386, 387, 388, 180, 77, 389,
-	244, 19, 390, 391, 213, 392, 52, 393, 394, 307,
-	189, 395, 50, 437, 37, 194, 438, 396, 397, 398,
-	399, 400, 297, 401, 290, 266, 268, 201, 286, 336,
-	402, 240, 193, 442, 403, 181, 195, 198, 188, 308,
-	182, 404, 405, 406, 407, 408, 409, 410, 228, 444,
-	39, 411, 412, 413, 414, 220, 216, 302, 311, 57,
-	78, 276, 415, 234, 214, 416, 225, 51, 445, 446,
-	447, 208, 448, 282, 105, 218, 43, 255, 200, 417,
-	418, 245, 246, 260, 233, 256, 226, 423, 202, 190,
-	419, 310, 215, 277, 340, 207, 339, 253, 250, 209,
-	420, 164, 203, 204, 421, 424, 293, 283, 294, 295,
-	284, 210, 338, 248, 278, 162, -156, 279, 280, 281,
-	292, 293, 298, 297, 201, -273, 301, 162, -166, 143,
-	152, 289, -170, 290, 283, 284, 210, -269, -258, 433,
-	448, 300, 252, 302, 426, 285, 291, 295, 294, -186,
-	226, -191, 231, -181, -258, -180, 229, -92, -56, 422,
-	156, -193, -193, -193, -112, -76, -98, 109, -103, 30,
-	24, -102, -99, -119, -116, -117, 143, 144, 146, 145,
-	147, 132, 133, 140, 110, 148, -107, -105, -106, -108,
-	87, 86, 95, 88, 89, 90, 91, 97, 98, 99,
-	-181, -186, -114, -276, 64, 65, 323, 324, 325, 326,
-	331, 327, 112, 53, 312, 321, 320, 319, 316, 317,
-	314, 315, 329, 330, 167, 313, 161, 138, 322, -258,
-	-180, 40, 282, 282, -5, -4, -276, 6, 21, 22,
-	-132, 18, 17, -277, 82, -59, -69, 59, 60, -70,
-	22, 36, 63, 61, -51, -68, 134, -76, -186, -68,
-	-165, 166, -165, -165, -155, -196, 226, -159, 302, 301,
-	-182, -157, -181, -179, -156, 299, 157, 341, 108, 23,
-	25, 111, 143, 17, 112, 159, 174, 142, 170, 323,
-	152, 68, 342, 314, 315, 312, 318, 325, 326, 313,
-	280, 30, 11, 344, 26, 184, 22, 36, 136, 154,
-	115, 116, 187, 24, 185, 99, 347, 20, 71, 179,
-	12, 172, 14, 348, 349, 15, 167, 166, 127, 163,
-	66, 9, 148, 27, 124, 62, 350, 29, 351, 352,
-	353, 354, 64, 125, 18, 316, 317, 32, 427, 355,
-	331, 196, 138, 69, 55, 109, 356, 357, 97, 358,
-	100, 72, 106, 16, 67, 38, 359, 197, 360, 169,
-	361, 305, 362, 126, 155, 322, 65, 363, 161, 281,
-	6, 328, 31, 183, 171, 63, 364, 162, 114, 329,
-	330, 165, 98, 5, 168, 33, 10, 70, 73, 319,
-	320, 321, 53, 335, 113, 13, 365, 306, 107, 300,
-	252, -232, 125, -219, -223, -181, 178, -251, 174, -92,
-	-241, -240, -181, -71, -175, 167, 163, -175, 322, -33,
-	-34, -156, 142, 195, 81, 81, -223, -222, -221, -263,
-	197, 178, -250, -239, 170, 179, -229, 171, 172, -224,
-	163, 29, -263, -224, 169, 179, 197, 197, 105, 197,
-	105, 197, 197, 197, 197, 197, 197, 197, 197, 197,
-	194, -230, 117, -230, 339, 339, -235, -263, -263, -263,
-	165, 34, 34, -178, -224, 165, 23, -230, -230, -156,
-	142, -230, -230, -230, -230, 205, 205, -230, -230, -230,
-	-230, -230, -230, -230, -230, -230, -230, -230, -230, -230,
-	-230, -230, -92, -74, 212, 152, 154, 157, 72, 117,
-	-35, 207, -22, -92, -174, 167, -258, -174, -174, -92,
-	149, -92, -172, 125, 13, -172, -172, -172, -172, -172,
-	208, 296, 208, 296, 208, 209, 208, 209, 208, -169,
-	-168, 287, 288, 282, 286, -258, 313, 298, -258, 201,
-	162, 202, 164, -225, 163, 34, 175, 209, 282, 204,
-	-172, -194, -276, -182, -194, -194, 31, 165, -181, -52,
-	-181, 87, -7, -3, -11, -10, -12, 117, 81, 108,
-	106, 107, 124, -76, -100, 127, 109, 125, 126, 111,
-	129, 128, 139, 132, 133, 134, 135, 136, 137, 138,
-	130, 131, 142, 117, 118, 119, 120, 121, 122, 123,
-	-164, -276, -117, -276, 150, 151, -103, -103, -103, -103,
-	-103, -103, -103, -103, -103, -103, -276, 149, -2, -112,
-	-4, -276, -276, -276, -276, -276, -276, -276, -276, -124,
-	-76, -276, -280, -109, -276, -280, -109, -280, -109, -280,
-	-276, -280, -109, -280, -109, -280, -280, -109, -276, -276,
-	-276, -276, -276, -276, -276, -193, -270, -271, -95, -92,
-	-128, -3, -50, -147, 20, 32, -76, -129, -130, -76,
-	-128, 55, -66, -68, -70, 59, 60, 93, 12, -184,
-	-183, 23, -181, 87, 149, 12, -93, 27, -92, -78,
-	-79, -80, -81, -95, -118, -276, 12, -85, -86, -92,
-	-94, -186, 81, 226, -159, -196, -161, -160, 303, 305,
-	117, -185, -181, 87, 30, 82, 81, -92, -198, -201,
-	-203, -202, -204, -199, -200, 249, 250, 143, 253, 255,
-	256, 257, 258, 259, 260, 261, 262, 263, 264, 31,
-	186, 245, 246, 247, 248, 265, 266, 267, 268, 269,
-	270, 271, 272, 232, 251, 333, 233, 234, 235, 236,
-	237, 238, 240, 241, 242, 243, 244, -261, -258, 80,
-	82, 81, -205, 80, -74, -92, 109, -258, -258, -230,
-	-230, 194, -27, -26, -254, 16, -25, -26, 157, 101,
-	102, 154, 80, -219, 80, -228, -261, -258, 80, 29,
-	169, 168, -227, -224, -227, -228, -258, -119, -181, -186,
-	-258, 29, 29, -152, -181, -152, -152, 21, -152, 21,
-	-152, 21, 88, -181, -152, 21, -152, 21, -152, 21,
-	-152, 21, -152, 21, 30, 74, 75, 30, 77, 78,
-	79, -119, -119, -219, -156, -92, -258, 88, 88, -230,
-	-230, 88, 87, 87, 87, -230, -230, 88, 87, -258,
-	87, -264, 180, 221, 223, 88, 88, 88, 88, 30,
-	87, -265, 30, 440, 439, 441, 442, 443, 88, 30,
-	88, 30, 88, -181, 80, -73, 214, 117, 203, 203,
-	162, 162, 216, -92, 215, 217, 218, 40, 81, 165,
-	-85, 24, 72, -87, -92, -258, -187, -186, -179, 87,
-	-76, -172, -92, -172, -92, -172, -172, -172, -172, -167,
-	12, 127, -226, 12, 127, -167, -194, -194, -92, -194,
-	-194, -92, -194, -194, -226, -173, 125, 72, -192, 229,
-	263, 423, 424, 425, -76, -76, -76, -76, -110, 97,
-	109, 98, 99, -103, -111, -114, -117, 92, 127, 125,
-	126, 111, -103, -103, -103, -103, -103, -103, -103, -103,
-	-103, -103, -103, -103, -103, -103, -103, -195, -258, 87,
-	143, -258, -102, -102, -181, -67, 22, 36, -66, -182,
-	-187, -179, -63, -277, -277, -128, -66, -66, -76, -76,
-	-119, 87, -66, -119, 87, -66, -66, -62, 22, 36,
-	-122, -123, 113, -119, -277, -103, -181, -181, -66, -67,
-	-67, -66, -66, 81, -272, 305, 306, 427, -189, 197,
-	-188, 23, -186, 87, -132, -277, -133, 27, 10, 127,
-	81, 19, 81, -131, 25, 26, -132, -104, -181, 88,
-	91, -77, 81, 12, -70, -92, -183, 134, -187, -92,
-	-151, 197, -92, 31, 81, -88, -90, -89, -91, 62,
-	66, 68, 63, 64, 65, 69, -190, 23, -78, -3,
-	-276, -92, -85, -278, 81, 12, 73, -278, 81, 149,
-	-159, -161, 81, 304, 306, 307, 72, 100, -76, -210,
-	142, -237, -236, -235, -219, -221, -222, -223, 82, -176,
-	97, 109, -214, 277, -205, -205, -205, -205, -205, -209,
-	-156, -209, -209, -209, 80, 80, -205, -205, -205, -205,
-	-211, 80, -211, -211, -212, 80, -212, -251, -76, -247,
-	-246, -242, -245, 173, 94, 335, 73, -240, -131, 88,
-	-73, 24, -249, -245, -258, 87, -258, 87, 81, 17,
-	-220, -219, -120, 221, -253, 197, -250, -241, 80, 29,
-	-227, -228, -228, 149, -258, 81, 27, 105, 105, 105,
-	105, 335, 154, 31, -219, -120, -195, 165, -195, -195,
-	87, 87, -171, 448, -85, 164, 220, -75, 318, 87,
-	83, -92, -92, -92, -92, -92, 157, 154, 205, -92,
-	-92, -55, 182, 177, -92, 81, -55, -172, -186, -186,
-	-92, -172, -92, 87, -92, -181, 97, 98, 99, -111,
-	-103, -103, -103, -65, 187, 108, -277, -277, -66, -66,
-	-276, 149, -5, -132, -277, -277, 81, 73, 23, 12,
-	12, -277, 12, 12, -277, -277, -66, -125, -123, 115,
-	-76, -277, -277, 81, 81, -277, -277, -277, -277, -277,
-	-271, 426, 306, -96, 70, 166, 71, -276, -188, -147,
-	38, 46, 57, -76, -76, -130, -147, -163, 20, 12,
-	53, 53, -97, 13, -68, -78, -70, 149, -97, -101,
-	31, 53, -3, -276, -276, -154, -158, -119, -79, -80,
-	-80, -79, -80, 62, 62, 62, 67, 62, 67, 62,
-	-89, -186, -277, -277, -3, -151, 73, -78, -92, -78,
-	-94, -186, 134, -160, -162, 308, 305, 311, -258, 87,
-	81, -235, -223, -207, 30, 97, -215, 278, -209, -209,
-	-210, -258, 143, -210, -210, -210, -218, 87, -218, 88,
-	88, 82, -248, -243, -244, 32, 76, -242, -230, 87,
-	37, -181, 82, 164, 72, 16, -149, -181, 81, 82,
-	-121, 222, -119, 82, -181, 82, -149, -228, -182, -181,
-	-276, 162, 30, 30, -120, -121, -210, -258, 450, 449,
-	82, -92, -72, 212, 219, 80, 84, -260, 73, 203,
-	274, 203, 206, 165, -194, -92, -167, -167, -65, 108,
-	-103, -103, -277, -277, -67, -182, -128, -147, -197, 143,
-	249, 186, 247, 243, 263, 254, 276, 245, 277, -195,
-	-197, -103, -103, -103, -103, 332, -128, 116, -76, 114,
-	-103, -103, 163, 163, 163, -152, 39, 87, 87, 58,
-	-92, -126, 14, -76, 134, -132, -153, 72, -154, -113,
-	-115, -114, -276, -148, -277, -181, -152, -97, 81, 117,
-	-83, -82, 72, 73, -84, 72, -82, 62, 62, -277,
-	-97, -78, -97, -97, 149, 305, 309, 310, -235, -208,
-	72, -103, -210, -210, 82, 81, 82, 81, 82, 81,
-	-177, 372, 109, -244, -243, -230, -230, 88, -258, -92,
-	-92, 17, 81, -219, -119, 53, -247, 82, -252, -253,
-	-92, -102, -121, -150, 80, 82, -257, 335, -259, -258,
-	-181, -181, -181, -92, -172, -172, -103, -277, -132, -277,
-	-205, -205, -205, -212, -205, 237, -205, 237, -277, -277,
-	20, 20, 20, 20, -276, -60, 328, -76, 81, 81,
-	-276, -276, -276, -277, 87, -209, -127, 15, 17, 28,
-	-153, 81, -277, -277, 81, 53, 149, -277, -128, -158,
-	-76, -76, 80, -76, -128, -97, -213, 274, 10, -209,
-	87, -209, 88, 88, 372, 30, 77, 78, 79, 30,
-	74, 75, -150, -149, -181, 199, 181, -277, 81, -216,
-	335, 338, 23, -149, -256, -255, -182, 80, 73, -147,
-	-209, -258, -103, -103, -103, -103, -103, -132, 87, -103,
-	-103, -149, -277, -149, -149, -189, -209, -135, -140, -169,
-	-76, -112, 29, -115, 53, -3, -181, -113, -181, -132,
-	-149, -132, -217, 169, 29, 168, -106, -210, -210, 82,
-	82, 23, 200, -92, -253, 339, 339, -3, 82, 81,
-	117, -149, -92, -277, -277, -277, -277, -61, 127, 335,
-	-277, -277, -277, -277, -277, -277, -96, -138, 422, -141,
-	42, -142, 43, 10, -113, 149, 82, -206, 94, 29,
-	29, -3, -276, 80, -53, 335, -255, -234, -182, 87,
-	88, 82, -277, 333, 69, 336, -135, 47, 255, -143,
-	51, -144, -139, 52, 17, -154, -181, 87, -53, -103,
-	196, -149, -54, 211, 426, -260, 58, 334, 337, -136,
-	49, -134, 48, -134, -142, 17, -145, 44, 45, 87,
-	-277, -277, 82, 174, -257, 58, -137, 50, 72, 100,
-	87, 17, 17, -267, -268, 72, 213, 335, 72, 100,
-	87, 87, -268, 72, 11, 10, 336, -266, 182, 177,
-	180, 31, -266, 337, 176, 30, 97,
+	-1000, -298, -1, -3, -124, -8, -9, -10, -11, -12,
+	-13, -14, -15, -16, -17, -18, -19, -38, -39, -40,
+	-42, -43, -44, -45, -46, -6, -41, -20, -21, -47,
+	-49, -50, -48, -4, -300, 6, 338, 7, 8, -58,
+	10, 11, 31, -23, -30, 152, -31, -24, 153, -32,
+	155, 154, 190, 156, 183, 70, 227, 228, 230, 231,
+	232, 233, -59, 188, 189, 158, 35, 41, 32, 33,
+	396, 80, 9, 325, 185, 184, 26, -299, 459, -64,
+	5, -3, -146, 16, -3, -51, -303, -126, 354, -125,
+	-204, -282, -198, 83, 84, 85, 335, 176, 356, 357,
+	225, 76, 277, 436, 227, 241, 235, 262, 254, 336,
+	358, 177, 214, 252, 255, 303, 438, 359, 191, 299,
+	282, 290, 94, 230, 312, 451, 360, 449, 96, 437,
+	75, 47, 421, 40, 186, 250, 246, 439, 215, 361,
+	345, 208, 104, 101, 458, 244, 46, 28, 448, 103,
+	45, 440, 362, 441, 292, 267, 44, 192, 363, 79,
+	340, 294, 245, 291, 224, 447, 158, 422, 430, 301,
+	364, 268, 272, 365, 304, 48, 366, 367, 102, 368,
+	74, 442, 239, 240, 369, 222, 175, 306, 266, 173,
+	34, 295, 337, 226, 54, 200, 307, 42, 270, 205,
+	41, 434, 370, 265, 261, 49, 371, 372, 373, 374,
+	443, 264, 238, 260, 457, 220, 444, 58, 160, 274,
+	273, 275, 209, 302, 257, 375, 376, 377, 180, 77,
+	423, 247, 19, 378, 379, 216, 380, 52, 381, 382,
+	310, 189, 383, 50, 445, 37, 194, 198, 446, 384,
+	385, 386, 424, 387, 300, 388, 293, 269, 271, 202,
+	289, 339, 389, 243, 193, 450, 390, 181, 195, 199,
+	188, 311, 182, 391, 392, 426, 393, 394, 395, 396,
+	397, 231, 427, 428, 452, 39, 398, 399, 400, 401,
+	223, 219, 305, 314, 57, 78, 279, 402, 237, 217,
+	403, 228, 51, 453, 454, 455, 211, 456, 285, 105,
+	221, 43, 258, 201, 404, 405, 248, 249, 263, 236,
+	259, 229, 431, 203, 190, 429, 313, 218, 280, 343,
+	210, 342, 256, 253, 212, 406, 164, 206, 207, 407,
+	432, 296, 286, 297, 298, 287, 213, 341, 251, 281,
+	-51, -51, -51, -51, -51, -51, -249, -251, 80, 125,
+	80, -65, 162, -164, -286, 106, 168, 171, 172, 316,
+	161, -36, -35, -34, -33, -37, 30, -28, -29, -278,
+	-27, -26, 157, 154, 199, 101, 102, 190, 191, 192,
+	156, 174, 189, 193, 188, 210, -25, 76, 32, 338,
+	341, -256, 153, 159, 160, 326, 104, 103, 71, 155,
+	-253, 277, 436, -37, 438, 94, 96, 437, 40, 163,
+	439, 440, 441, 442, 173, 443, 444, 445, 446, 452,
+	453, 454, 455, 105, 5, 162, -286, -72, 287, 76,
+	-285, -282, 162, 162, 163, 164, -286, 162, -93, -204,
+	162, -174, 282, 283, 284, 295, 296, 301, 300, 202,
+	-297, 304, 162, -184, 143, 152, 292, -188, 293, 286,
+	287, 213, -293, -282, 441, 456, 303, 255, 305, 434,
+	288, 294, 298, 297, -204, 229, -209, 234, -199, -282,
+	-198, 232, -93, -57, 430, 156, -211, -211, -211, -130,
+	-77, -99, 109, -104, 30, 24, -103, -100, -137, -134,
+	-135, 143, 144, 146, 145, 147, 132, 133, 140, 110,
+	148, -108, -106, -107, -109, -111, 87, 86, 95, 88,
+	89, 90, 91, 97, 98, 99, -199, -204, -132, -300,
+	64, 65, 326, 327, 328, 329, 334, 330, 112, 53,
+	315, 324, 323, 322, 319, 320, 317, 318, 332, 333,
+	167, 316, 161, 138, 325, 419, 418, 409, 417, 408,
+	415, 411, 413, 410, 412, 414, -282, -198, 40, 285,
+	285, 87, -5, -4, -300, 6, 21, 22, -150, 18,
+	17, -301, 82, -60, -70, 59, 60, -71, 22, 36,
+	63, 61, -52, 81, -126, -168, 80, -69, 134, -77,
+	-204, -69, -183, 166, -183, -183, -173, -214, 229, -177,
+	305, 304, -200, -175, -199, -197, -174, 302, 157, 344,
+	108, 23, 25, 111, 143, 17, 112, 159, 174, 142,
+	170, 326, 152, 68, 408, 317, 318, 315, 321, 328,
+	329, 316, 283, 30, 11, 409, 26, 184, 22, 36,
+	136, 154, 115, 116, 187, 24, 185, 99, 410, 20,
+	71, 179, 12, 172, 14, 348, 349, 15, 167, 166,
+	127, 163, 66, 9, 148, 27, 124, 62, 350, 29,
+	411, 412, 351, 413, 64, 125, 18, 319, 320, 32,
+	435, 352, 334, 196, 138, 69, 55, 109, 414, 415,
+	97, 353, 100, 72, 106, 16, 67, 38, 416, 197,
+	417, 169, 425, 418, 308, 354, 126, 155, 325, 65,
+	419, 161, 284, 6, 331, 31, 183, 171, 63, 355,
+	162, 114, 332, 333, 165, 98, 5, 168, 33, 10,
+	70, 73, 322, 323, 324, 53, 338, 113, 13, 420,
+	309, 107, 303, 255, -250, 125, -237, -241, -199, 178,
+	-269, 174, -93, -259, -258, -199, -72, -193, 167, 163,
+	-193, 325, -33, -34, -174, 142, -273, 195, 197, 81,
+	81, -241, -240, -239, -287, 197, 178, -268, -257, 170,
+	179, -247, 171, 172, -242, 163, 29, -287, -242, 169,
+	179, 197, 197, 105, 197, 105, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 194, -248, 117, -248, 342,
+	342, -253, -287, -287, -287, 165, 34, 34, -196, -242,
+	165, 23, -248, -248, -174, 142, -248, -248, -248, -248,
+	208, 208, -248, -248, -248, -248, -248, -248, -248, -248,
+	-248, -248, -248, -248, -248, -248, -248, -93, -75, 215,
+	152, 154, 157, 72, 117, -35, 210, -22, -93, -192,
+	167, -282, -192, -192, -93, 149, -93, -190, 125, 13,
+	-190, -190, -190, -190, -190, 211, 299, 211, 299, 211,
+	212, 211, 212, 211, -187, -186, 290, 291, 285, 289,
+	-282, 316, 301, -282, 202, 162, 203, 164, -243, 163,
+	34, 175, 212, 285, 207, -190, -212, -300, -200, -212,
+	-212, 31, 165, -199, -53, -199, 87, -7, -3, -124,
+	-11, -10, -12, 117, 81, 108, 106, 107, 124, -77,
+	-101, 127, 109, 125, 126, 111, 129, 128, 139, 132,
+	133, 134, 135, 136, 137, 138, 130, 131, 142, 117,
+	118, 119, 120, 121, 122, 123, -182, -300, -135, -300,
+	150, 151, -104, -104, -104, -104, -104, -104, -104, -104,
+	-104, -104, -300, 149, -2, -124, -130, -4, -300, -300,
+	-300, -300, -300, -300, -300, -300, -142, -77, -300, -304,
+	-110, -300, -304, -110, -304, -110, -304, -300, -304, -110,
+	-304, -110, -304, -304, -110, -300, -300, -300, -300, -300,
+	-300, -300, -300, -300, -300, -300, -300, -300, -300, -300,
+	-300, -300, -300, -211, -294, -295, -96, -93, -146, -3,
+	-51, -165, 20, 32, -77, -147, -148, -77, -146, 55,
+	-67, -69, -71, 59, 60, 93, -125, 23, -167, -199,
+	12, -202, -201, 23, -199, 87, 149, 12, -94, 27,
+	-93, -79, -80, -81, -82, -96, -136, -300, 12, -86,
+	-87, -93, -95, -204, 81, 229, -177, -214, -179, -178,
+	306, 308, 117, -203, -199, 87, 30, 82, 81, -93,
+	-216, -219, -221, -220, -222, -217, -218, 252, 253, 143,
+	256, 258, 259, 260, 261, 262, 263, 264, 265, 266,
+	267, 31, 186, 248, 249, 250, 251, 268, 269, 270,
+	271, 272, 273, 274, 275, 235, 254, 336, 236, 237,
+	238, 239, 240, 241, 243, 244, 245, 246, 247, -285,
+	-282, 80, 82, 81, -223, 80, -75, -93, 109, -282,
+	-282, -248, -248, 194, 17, -27, -26, -278, 16, -25,
+	-26, 157, 101, 102, 154, 80, -237, 80, -246, -285,
+	-282, 80, 29, 169, 168, -245, -242, -245, -246, -282,
+	-137, -199, -204, -282, 29, 29, -170, -199, -170, -170,
+	21, -170, 21, -170, 21, 88, -199, -170, 21, -170,
+	21, -170, 21, -170, 21, -170, 21, 30, 74, 75,
+	30, 77, 78, 79, -137, -137, -237, -174, -93, -282,
+	88, 88, -248, -248, 88, 87, 87, 87, -248, -248,
+	88, 87, -282, 87, -288, 180, 224, 226, 88, 88,
+	88, 88, 30, 87, -289, 30, 448, 447, 449, 450,
+	451, 88, 30, 88, 30, 88, -199, 80, -74, 217,
+	117, 206, 206, 162, 162, 219, -93, 218, 220, 221,
+	40, 81, 165, -86, 24, 72, -88, -93, -282, -205,
+	-204, -197, 87, -77, -190, -93, -190, -93, -190, -190,
+	-190, -190, -185, 12, 127, -244, 12, 127, -185, -212,
+	-212, -93, -212, -212, -93, -212, -212, -244, -191, 125,
+	72, -210, 232, -3, 266, 431, 432, 433, -77, -77,
+	-77, -77, -128, 97, 109, 98, 99, -104, -129, -132,
+	-135, 92, 127, 125, 126, 111, -104, -104, -104, -104,
+	-104, -104, -104, -104, -104, -104, -104, -104, -104, -104,
+	-104, -213, -282, 87, 143, -282, -103, -103, -199, -68,
+	22, 36, -67, -200, -205, -197, -64, -301, -2, -301,
+	-146, -67, -67, -77, -77, -137, 87, -67, -137, 87,
+	-67, -67, -63, 22, 36, -140, -141, 113, -137, -301,
+	-104, -199, -199, -67, -68, -68, -67, -67, -301, -301,
+	-301, -301, -301, -77, -67, -67, -77, -77, -77, 81,
+	-296, 308, 309, 435, -207, 197, -206, 23, -204, 87,
+	-150, -301, -151, 27, 10, 127, 81, 19, 81, -149,
+	25, 26, -150, -105, -199, 88, 91, -78, 81, 12,
+	-71, -135, 82, 81, -93, -201, 134, -205, -93, -169,
+	197, -93, 31, 81, -89, -91, -90, -92, 62, 66,
+	68, 63, 64, 65, 69, -208, 23, -79, -3, -124,
+	-300, -93, -86, -302, 81, 12, 73, -302, 81, 149,
+	-177, -179, 81, 307, 309, 310, 72, 100, -77, -228,
+	142, -255, -254, -253, -237, -239, -240, -241, 82, -194,
+	97, 109, -232, 280, -223, -223, -223, -223, -223, -227,
+	-174, -227, -227, -227, 80, 80, -223, -223, -223, -223,
+	-229, 80, -229, -229, -230, 80, -230, -269, -77, -265,
+	-264, -260, -263, 173, 94, 338, 73, -258, -149, 88,
+	-74, 24, -267, -263, -282, 87, -282, 87, -274, -275,
+	425, 205, 204, 81, 17, -238, -237, -138, 224, -277,
+	197, -268, -259, 80, 29, -245, -246, -246, 149, -282,
+	81, 27, 105, 105, 105, 105, 338, 154, 31, -237,
+	-138, -213, 165, -213, -213, 87, 87, -189, 456, -86,
+	164, 223, -76, 321, 87, 83, -93, -93, -93, -93,
+	-93, 157, 154, 208, -93, -93, -56, 182, 177, -93,
+	81, -56, -190, -204, -204, -93, -190, -93, 87, -93,
+	-199, 97, 98, 99, -129, -104, -104, -104, -66, 187,
+	108, -301, -301, -67, -67, -300, 149, -5, -301, -150,
+	-301, -301, 81, 73, 23, 12, 12, -301, 12, 12,
+	-301, -301, -67, -143, -141, 115, -77, -301, -301, 81,
+	81, -301, -301, -301, -301, -301, -112, 416, -112, -112,
+	-112, -112, -301, -301, -301, -301, -301, 81, -295, 434,
+	309, -97, 70, 166, 71, -300, -206, -165, 38, 46,
+	57, -77, -77, -148, -165, -181, 20, 12, 53, 53,
+	-98, 13, -69, -79, -71, -199, 149, -98, -102, 31,
+	53, -3, -124, -300, -300, -172, -176, -137, -80, -81,
+	-81, -80, -81, 62, 62, 62, 67, 62, 67, 62,
+	-90, -204, -301, -301, -3, -3, -169, 73, -79, -93,
+	-79, -95, -204, 134, -178, -180, 311, 308, 314, -282,
+	87, -272, -273, 81, -253, -241, -225, 30, 97, -233,
+	281, -227, -227, -228, -282, 143, -228, -228, -228, -236,
+	87, -236, 88, 88, 82, -266, -261, -262, 32, 76,
+	-260, -248, 87, 37, -199, 82, 164, 72, -276, 198,
+	83, 29, -300, 290, -300, 290, 16, -167, 81, 82,
+	-139, 225, -137, 82, -199, 82, -167, -246, -200, -199,
+	-300, 162, 30, 30, -138, -139, -228, -282, 458, 457,
+	82, -93, -73, 215, 222, 80, 84, -284, 73, 206,
+	277, 206, 209, 165, -212, -93, -185, -185, -66, 108,
+	-104, -104, -112, -301, -301, -68, -200, -146, -165, -215,
+	143, 252, 186, 250, 246, 266, 257, 279, 248, 280,
+	-213, -215, -104, -104, -104, -104, 335, -146, 116, -77,
+	114, -104, -104, -300, -199, -112, -121, 426, 166, -121,
+	-121, -121, -77, 163, 163, 163, -170, 39, 87, 87,
+	58, -93, -144, 14, -77, 134, -150, -171, 72, -172,
+	-131, -133, -132, -300, -3, -166, -301, -199, -170, -98,
+	81, 117, -84, -83, 72, 73, -85, 72, -83, 62,
+	62, -301, -301, -98, -79, -98, -98, 149, 308, 312,
+	313, -253, -226, 72, -104, -228, -228, 82, 81, 82,
+	81, 82, 81, -195, 362, 109, -262, -261, -248, -248,
+	88, -282, -93, -93, -271, -300, 88, -300, -300, -77,
+	-300, -77, -300, 17, -237, -137, 53, -265, 82, -270,
+	-277, -93, -103, -139, -168, 82, -281, 338, -283, -282,
+	-199, -199, -199, -93, -190, -190, -104, -301, -150, -301,
+	-223, -223, -223, -230, -223, 240, -223, 240, -301, -301,
+	20, 20, 20, 20, -300, -61, 331, -77, 81, 81,
+	-113, -114, -282, -112, 423, 423, -112, -112, -112, -301,
+	-300, -300, -300, -301, 87, -227, -145, 15, 17, 28,
+	-171, 81, -301, -301, 81, 53, 149, -301, -146, -176,
+	-77, -77, 80, -77, -146, -98, -231, 277, 10, -227,
+	87, -227, 88, 88, 362, 30, 77, 78, 79, 30,
+	74, 75, -168, -270, -77, -301, -167, -301, -167, -301,
+	-167, -167, 200, 127, 181, -301, 81, -234, 338, 341,
+	23, -280, -279, -200, 80, 73, -165, -227, -282, -104,
+	-104, -104, -104, -104, -150, 87, -104, -104, -301, -115,
+	197, -120, 12, -167, -301, -167, -167, -207, -227, -153,
+	-158, -187, -122, 420, -77, -130, 29, -133, 53, -3,
+	-124, -199, -131, -199, -150, -167, -150, -235, 169, 29,
+	168, -107, -228, -228, 82, 82, 23, -301, -301, -301,
+	-301, -301, 201, -300, -93, -277, 342, 342, -3, 81,
+	117, -167, -93, -301, -301, -301, -301, -62, 127, 338,
+	-301, -301, -301, -146, 17, -121, 224, 226, -301, -301,
+	-301, -97, -156, 430, -159, 42, -160, 43, -123, -127,
+	-199, 10, -131, -3, 149, 82, -224, 94, 29, 29,
+	-3, -300, -130, 80, -54, 338, -279, -252, -200, 87,
+	88, 82, -301, 336, 69, 339, -116, -117, 428, 425,
+	-130, -112, -153, 47, 258, -161, 51, -162, -157, 52,
+	17, 81, 23, -172, -199, 87, -54, -104, 196, -301,
+	-167, -55, 214, 434, -284, 58, 337, 340, -118, 111,
+	421, 429, -119, -103, 148, -154, 49, -152, 48, -152,
+	-160, 17, -163, 44, 45, 87, -127, -300, -301, -301,
+	82, 174, -281, 58, -118, 427, 424, 422, 424, 422,
+	-104, -155, 50, 72, 100, 87, 17, 17, -113, -291,
+	-292, 72, 216, 338, 108, -199, 72, 100, 87, 87,
+	-301, -292, 72, 11, 10, 339, -118, -290, 182, 177,
+	180, 31, -290, 340, 176, 30, 97,
 }
 
 var yyDef = [...]int{
-	32, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	34, -2, 2, 4, 0, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 785, 0, 523, 523, 523, 523, 523, 523, 523,
-	0, 0, -2, -2, -2, 809, 36, 0, 0, 0,
-	0, -2, 481, 482, 0, 484, -2, 0, 0, 493,
-	1304, 1304, 1304, 0, 0, 0, 0, 1302, 53, 54,
-	499, 500, 501, 1, 3, 0, 527, 793, 0, 0,
-	-2, 525, 0, 0, 901, 901, 901, 0, 84, 85,
-	0, 0, 0, 809, 899, 0, 899, 0, 907, 908,
-	909, 104, 105, 88, -2, 109, 110, 0, 114, 367,
-	328, 370, 326, 356, -2, 319, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 223,
-	223, 0, 0, -2, 319, 319, 319, 0, 0, 0,
-	353, 903, 273, 223, 223, 0, 223, 223, 223, 223,
-	0, 0, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 0, 103, 822,
-	0, 0, 113, 37, 33, 34, 35, 0, 897, 0,
-	897, 897, 0, 419, 607, 917, 918, 1054, 1055, 1056,
-	1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
-	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
-	1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
-	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
-	1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116,
-	1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
-	1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
-	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146,
-	1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
-	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
-	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
-	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
-	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
-	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
-	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
-	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
-	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
-	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
-	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
-	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
-	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
-	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
-	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
-	1297, 1298, 1299, 1300, 1301, 0, 472, 472, 472, 472,
-	472, 472, 0, 428, 0, 0, 0, 0, 0, 0,
-	0, 444, 0, 447, 0, 0, 454, 472, 1305, 1305,
-	1305, 888, 0, 478, 479, 466, 464, 461, 462, 480,
-	483, 0, 488, 491, 913, 914, 0, 506, 0, 1125,
-	498, 511, 512, 522, 38, 658, 617, 0, 623, 625,
-	0, 660, 661, 662, 663, 664, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 690, 691, 692, 693,
-	770, 771, 772, 773, 774, 775, 776, 777, 627, 628,
-	767, 0, 877, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 758, 0, 727, 727, 727, 727, 727, 727,
-	727, 727, 0, 0, 0, 0, 0, 0, 0, -2,
-	-2, 1304, 0, 521, 785, 49, 0, 523, 528, 529,
-	828, 0, 0, 785, 1303, 0, 0, -2, -2, 539,
-	545, 546, 547, 548, 524, 0, 551, 555, 0, 0,
-	0, 902, 0, 0, 70, 0, 1273, 881, -2, -2,
-	0, 0, 915, 916, 890, -2, 921, 922, 923, 924,
-	925, 926, 927, 928, 929, 930, 931, 932, 933, 934,
-	935, 936, 937, 938, 939, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 950, 951, 952, 953, 954,
-	955, 956, 957, 958, 959, 960, 961, 962, 963, 964,
-	965, 966, 967, 968, 969, 970, 971, 972, 973, 974,
-	975, 976, 977, 978, 979, 980, 981, 982, 983, 984,
-	985, 986, 987, 988, 989, 990, 991, 992, 993, 994,
-	995, 996, 997, 998, 999, 1000, 1001, 1002, 1003, 1004,
-	1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014,
-	1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024,
-	1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034,
-	1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044,
-	1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, -2,
-	1072, 0, 0, 123, 124, 0, 36, 249, 0, 119,
-	0, 243, 181, 822, 0, 0, 0, 0, 90, 111,
-	112, 223, 223, 0, 113, 113, 335, 336, 337, 0,
-	0, -2, 247, 0, 320, 0, 0, 237, 237, 241,
-	239, 240, 0, 0, 0, 0, 0, 0, 347, 0,
-	348, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	403, 0, 224, 0, 365, 366, 274, 0, 0, 0,
-	0, 345, 346, 0, 0, 904, 905, 0, 0, 223,
-	223, 0, 0, 0, 0, 223, 223, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 100, 813, 0, 0, 0, 0, 0, 0,
-	-2, 0, 411, 0, 0, 0, 0, 0, 0, 418,
-	0, 420, 421, 0, 0, 422, 423, 424, 425, 426,
-	472, 0, 472, 0, 472, 472, 472, 472, 469, 0,
-	469, 467, 468, 459, 460, 1305, 1305, 0, 1305, 1305,
-	0, 1305, 1305, 0, 232, 233, 234, 475, 451, 452,
-	455, 456, 1306, 1307, 457, 458, 889, 489, 492, 509,
-	507, 508, 510, 502, 503, 504, 505, 0, 0, 0,
-	0, 0, 0, 621, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 645, 646, 647, 648, 649, 650, 651,
-	624, 0, 638, 0, 0, 0, 680, 681, 682, 683,
-	684, 685, 686, 687, 688, 0, 536, 0, 0, 0,
-	785, 0, 0, 0, 0, 0, 0, 0, 533, 0,
-	759, 0, 711, 719, 0, 712, 720, 713, 721, 714,
-	0, 715, 722, 716, 723, 717, 718, 724, 0, 0,
-	0, 536, 536, 0, 0, 39, 513, 514, 0, 590,
-	793, 0, 538, 831, 0, 0, 794, 786, 787, 790,
-	793, 0, 560, 549, 540, 543, 544, 526, 0, 552,
-	556, 0, 558, 559, 0, 0, 68, 0, 606, 0,
-	562, 564, 565, 566, 588, 0, 0, 0, 0, 64,
-	66, 607, 0, 1273, 887, 0, 72, 73, 0, 0,
-	0, 204, 892, 893, 894, -2, 230, 0, 192, 188,
-	132, 133, 134, 181, 136, 181, 181, 181, 181, 201,
-	201, 201, 201, 164, 165, 166, 167, 168, 0, 0,
-	151, 181, 181, 181, 181, 171, 172, 173, 174, 175,
-	176, 177, 178, 137, 138, 139, 140, 141, 142, 143,
-	144, 145, 183, 183, 183, 185, 185, 0, 37, 0,
-	215, 0, 790, 0, 813, 99, 0, 910, 102, 0,
-	0, 368, 329, 357, 369, 0, 332, 333, -2, 0,
-	0, 319, 0, 321, 0, 231, 0, -2, 0, 0,
-	0, 237, 241, 238, 241, 229, 242, 349, 767, 0,
-	350, 351, 0, 383, 576, 0, 0, 0, 0, 0,
-	389, 390, 391, 0, 393, 394, 395, 396, 397, 398,
-	399, 400, 401, 402, 358, 359, 360, 361, 362, 363,
-	364, 0, 0, 321, 0, 354, 0, 275, 276, 0,
-	0, 279, 280, 281, 282, 0, 0, 285, 286, 287,
-	288, 289, 313, 314, 315, 290, 291, 292, 293, 294,
-	295, 296, 307, 308, 309, 310, 311, 312, 297, 298,
-	299, 300, 301, 304, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 810, 811, 812, 0, 0, 0,
-	262, 898, 0, 262, 62, 417, 608, 919, 920, 473,
-	474, 427, 445, 429, 448, 430, 432, 431, 433, 472,
-	0, 0, 0, 235, 236, 472, 436, 437, 438, 439,
-	440, 441, 442, 443, 0, 450, 0, 0, 0, 490,
-	494, 495, 496, 497, 659, 618, 619, 620, 622, 639,
-	0, 641, 643, 629, 630, 654, 655, 656, 0, 0,
-	0, 0, 652, 634, 0, 665, 666, 667, 668, 669,
-	670, 671, 672, 673, 674, 675, 676, 679, 742, 743,
-	744, 0, 677, 678, 689, 0, 0, 0, 537, 768,
-	0, -2, 0, 657, 876, 793, 0, 0, 0, 0,
-	662, 770, 0, 662, 770, 0, 0, 0, 534, 535,
-	765, 762, 0, 0, 728, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 516, 517, 519, 0, 610, 0,
-	591, 0, 593, 594, 828, 50, 40, 0, 829, 0,
-	0, 0, 0, 789, 791, 792, 828, 0, 778, 0,
-	0, 615, 0, 0, 541, 46, 557, 553, 0, 615,
-	0, 0, 605, 0, 0, 0, 0, 0, 0, 595,
-	0, 0, 598, 0, 0, 0, 0, 589, 0, 0,
-	0, -2, 0, 0, 0, 60, 61, 0, 0, 0,
-	882, 71, 0, 0, 76, 77, 883, 884, 885, 886,
-	0, 106, -2, 270, 125, 127, 128, 129, 120, 195,
-	193, 0, 190, 189, 135, 201, 201, 158, 159, 204,
-	0, 204, 204, 204, 0, 0, 152, 153, 154, 155,
-	146, 0, 147, 148, 149, 0, 150, 248, 0, 797,
-	216, 217, 219, 223, 0, 0, 0, 244, 245, 0,
-	0, 900, 0, 911, 115, 116, 117, 118, 113, 0,
-	0, 121, 323, 0, 0, 0, 246, 0, 0, 225,
-	241, 226, 227, 0, 352, 0, 0, 385, 386, 387,
-	388, 0, 0, 0, 321, 323, 204, 0, 277, 278,
-	283, 284, 302, 0, 0, 0, 0, 823, 824, 0,
-	827, 91, 375, 377, 376, 380, 0, 0, 0, 0,
-	412, 414, 263, 264, 1305, 0, 416, 434, 470, 471,
-	469, 449, 469, 476, 453, 486, 640, 642, 644, 631,
-	652, 635, 0, 632, 0, 0, 626, 694, 0, 0,
-	536, 0, 785, 828, 698, 699, 0, 0, 0, 0,
-	0, 735, 0, 0, 736, 0, 785, 0, 763, 0,
-	0, 710, 729, 0, 0, 730, 731, 732, 733, 734,
-	515, 518, 520, 570, 0, 0, 0, 0, 592, 42,
-	0, 0, 0, 795, 796, 788, 41, 0, 895, 896,
-	779, 780, 781, 0, 550, 561, 542, 0, 793, 870,
-	0, 0, 862, 0, 0, 615, 878, 0, 563, 584,
-	586, 0, 581, 596, 597, 599, 0, 601, 0, 603,
-	604, 567, 568, 569, 0, 615, 0, 615, 65, 615,
-	67, 0, 609, 74, 75, 0, 0, 81, 205, 206,
-	113, 272, 126, 197, 0, 194, 131, 191, 204, 204,
-	160, 202, 203, 161, 162, 163, 0, 179, 0, 0,
-	0, 265, 86, 801, 800, 223, 223, 218, 0, 221,
-	0, 912, 182, 0, 0, 0, 327, 574, 0, 338,
-	339, 0, 322, 382, 0, 215, 0, 228, 768, 577,
-	0, 0, 340, 0, 323, 343, 344, 355, 305, 306,
-	303, 572, 814, 815, 816, 0, 826, 94, 0, 0,
-	0, 0, 373, 0, 415, 63, 472, 472, 633, 0,
-	653, 636, 695, 696, 0, 769, 793, 44, 0, 181,
-	181, 748, 181, 185, 751, 181, 753, 181, 756, 0,
-	0, 0, 0, 0, 0, 0, 760, 709, 766, 0,
-	0, 0, 0, 0, 0, 0, 0, 201, 833, 830,
-	43, 783, 0, 616, 554, 47, 51, 0, 870, 861,
-	872, 874, 0, 0, 0, 866, 0, 785, 0, 0,
-	578, 585, 0, 0, 579, 0, 580, 600, 602, -2,
-	785, 615, 58, 59, 0, 78, 79, 80, 271, 199,
-	0, 196, 156, 157, 201, 0, 201, 0, 186, 0,
-	254, 266, 0, 798, 799, 0, 0, 220, 222, 572,
-	101, 0, 0, 122, 324, 0, 214, 0, 0, 407,
-	404, 341, 342, 0, 0, 825, 374, 0, 92, 93,
-	0, 0, 379, 413, 435, 446, 637, 697, 828, 700,
-	745, 201, 749, 750, 752, 754, 755, 757, 702, 701,
-	0, 0, 0, 0, 0, 793, 0, 764, 0, 0,
-	0, 0, 0, 590, 201, 853, 48, 0, 0, 0,
-	52, 0, 875, 0, 0, 0, 0, 69, 793, 879,
-	880, 582, 0, 587, 793, 57, 207, 200, 0, 204,
-	180, 204, 0, 0, 267, 802, 803, 804, 805, 806,
-	807, 808, 0, 330, 575, 0, 0, 384, 0, 392,
-	0, 0, 0, 0, 95, 96, 0, 0, 0, 45,
-	746, 747, 0, 0, 0, 0, 737, 0, 761, 0,
-	0, 0, 612, 0, 0, 610, 835, 834, 847, 851,
-	784, 782, 0, 873, 0, 865, 868, 864, 867, 55,
-	0, 56, 212, 0, 209, 211, 198, 169, 170, 184,
-	187, 0, 0, 0, 408, 405, 406, 817, 573, 0,
-	0, 0, 381, 703, 705, 704, 706, 0, 0, 0,
-	708, 725, 726, 611, 613, 614, 571, 853, 0, 846,
-	849, -2, 0, 0, 863, 0, 583, 130, 0, 208,
-	210, 817, 0, 0, 371, 819, 97, 98, 316, 317,
-	318, 91, 707, 0, 0, 0, 840, 838, 838, 851,
-	0, 855, 0, 860, 0, 871, 869, 213, 87, 0,
-	0, 0, 0, 820, 821, 94, 738, 0, 741, 843,
-	0, 836, 839, 837, 848, 0, 854, 0, 0, 852,
-	409, 410, 250, 0, 378, 739, 832, 0, 841, 842,
-	850, 0, 0, 251, 252, 0, 818, 0, 844, 845,
-	856, 858, 253, 0, 0, 0, 0, 255, 257, 258,
-	0, 0, 256, 740, 259, 260, 261,
+	31, 32, 33, 858, 0, 551, 0, 551, 551, 551,
+	551, 551, 551, 0, 0, -2, -2, -2, 882, 38,
+	0, 0, 0, 0, -2, 508, 509, 0, 511, -2,
+	0, 0, 520, 1386, 1386, 1386, 0, 0, 0, 0,
+	0, 1384, 61, 62, 526, 527, 528, 1, 3, 0,
+	555, 5, 866, 0, 0, -2, 553, 52, 0, 54,
+	601, 992, 993, 35, 36, 37, 1130, 1131, 1132, 1133,
+	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
+	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
+	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
+	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193,
+	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
+	1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213,
+	1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223,
+	1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233,
+	1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243,
+	1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253,
+	1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263,
+	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
+	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283,
+	1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293,
+	1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303,
+	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
+	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
+	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
+	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343,
+	1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353,
+	1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363,
+	1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373,
+	1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383,
+	0, 0, 976, 976, 976, 0, 92, 93, 0, 0,
+	0, 882, 974, 0, 974, 0, 982, 983, 984, 112,
+	113, 96, -2, 117, 118, 0, 122, 375, 336, 379,
+	334, 364, -2, 327, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 339, 231, 231, 0,
+	0, -2, 327, 327, 327, 0, 0, 0, 361, 978,
+	281, 231, 231, 0, 231, 231, 231, 231, 0, 0,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 0, 111, 895, 0, 0,
+	121, 39, 0, 972, 0, 972, 972, 0, 446, 636,
+	0, 499, 499, 499, 499, 499, 499, 0, 455, 0,
+	0, 0, 0, 0, 0, 0, 471, 0, 474, 0,
+	0, 481, 499, 1387, 1387, 1387, 963, 0, 505, 506,
+	493, 491, 488, 489, 507, 510, 0, 515, 518, 988,
+	989, 0, 534, 0, 1202, 525, 539, 540, 550, 40,
+	688, 646, 0, 652, 654, 0, 690, 691, 692, 693,
+	694, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 720, 721, 722, 723, 724, 843, 844, 845, 846,
+	847, 848, 849, 850, 656, 657, 840, 0, 952, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 831, 0,
+	759, 759, 759, 759, 759, 759, 759, 759, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, -2, 1386, 0,
+	549, 42, 858, 57, 0, 551, 556, 557, 901, 0,
+	0, 858, 1385, 0, 0, -2, -2, 567, 573, 574,
+	575, 576, 552, 0, 53, 0, 0, 0, 579, 583,
+	0, 0, 0, 977, 0, 0, 78, 0, 1355, 956,
+	-2, -2, 0, 0, 990, 991, 965, -2, 996, 997,
+	998, 999, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007,
+	1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017,
+	1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027,
+	1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037,
+	1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047,
+	1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057,
+	1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067,
+	1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
+	1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087,
+	1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097,
+	1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107,
+	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
+	1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127,
+	1128, 1129, -2, 1148, 0, 0, 131, 132, 0, 38,
+	257, 0, 127, 0, 251, 189, 895, 0, 0, 0,
+	0, 98, 119, 120, 231, 231, 376, 0, 0, 121,
+	121, 343, 344, 345, 0, 0, -2, 255, 0, 328,
+	0, 0, 245, 245, 249, 247, 248, 0, 0, 0,
+	0, 0, 0, 355, 0, 356, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 412, 0, 232, 0, 373,
+	374, 282, 0, 0, 0, 0, 353, 354, 0, 0,
+	979, 980, 0, 0, 231, 231, 0, 0, 0, 0,
+	231, 231, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 886, 0,
+	0, 0, 0, 0, 0, -2, 0, 438, 0, 0,
+	0, 0, 0, 0, 445, 0, 447, 448, 0, 0,
+	449, 450, 451, 452, 453, 499, 0, 499, 0, 499,
+	499, 499, 499, 496, 0, 496, 494, 495, 486, 487,
+	1387, 1387, 0, 1387, 1387, 0, 1387, 1387, 0, 240,
+	241, 242, 502, 478, 479, 482, 483, 1388, 1389, 484,
+	485, 964, 516, 519, 537, 535, 536, 538, 529, 0,
+	531, 532, 533, 0, 0, 0, 0, 0, 0, 650,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
+	675, 676, 677, 678, 679, 680, 653, 0, 667, 0,
+	0, 0, 710, 711, 712, 713, 714, 715, 716, 717,
+	718, 0, 564, 0, 0, 0, 0, 858, 0, 0,
+	0, 0, 0, 0, 0, 561, 0, 832, 0, 743,
+	751, 0, 744, 752, 745, 753, 746, 0, 747, 754,
+	748, 755, 749, 750, 756, 0, 0, 0, 564, 564,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 41, 541, 542, 0, 619, 866, 0,
+	566, 904, 0, 0, 867, 859, 860, 863, 866, 0,
+	588, 577, 568, 571, 572, 554, 55, 0, 0, 603,
+	0, 580, 584, 0, 586, 587, 0, 0, 76, 0,
+	635, 0, 590, 592, 593, 594, 617, 0, 0, 0,
+	0, 72, 74, 636, 0, 1355, 962, 0, 80, 81,
+	0, 0, 0, 212, 967, 968, 969, -2, 238, 0,
+	200, 196, 140, 141, 142, 189, 144, 189, 189, 189,
+	189, 209, 209, 209, 209, 172, 173, 174, 175, 176,
+	0, 0, 159, 189, 189, 189, 189, 179, 180, 181,
+	182, 183, 184, 185, 186, 145, 146, 147, 148, 149,
+	150, 151, 152, 153, 191, 191, 191, 193, 193, 0,
+	39, 0, 223, 0, 863, 0, 886, 107, 0, 985,
+	110, 0, 0, 377, 432, 337, 365, 378, 0, 340,
+	341, -2, 0, 0, 327, 0, 329, 0, 239, 0,
+	-2, 0, 0, 0, 245, 249, 246, 249, 237, 250,
+	357, 840, 0, 358, 359, 0, 392, 605, 0, 0,
+	0, 0, 0, 398, 399, 400, 0, 402, 403, 404,
+	405, 406, 407, 408, 409, 410, 411, 366, 367, 368,
+	369, 370, 371, 372, 0, 0, 329, 0, 362, 0,
+	283, 284, 0, 0, 287, 288, 289, 290, 0, 0,
+	293, 294, 295, 296, 297, 321, 322, 323, 298, 299,
+	300, 301, 302, 303, 304, 315, 316, 317, 318, 319,
+	320, 305, 306, 307, 308, 309, 312, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 883, 884, 885,
+	0, 0, 0, 270, 973, 0, 270, 70, 444, 637,
+	994, 995, 500, 501, 454, 472, 456, 475, 457, 459,
+	458, 460, 499, 0, 0, 0, 243, 244, 499, 463,
+	464, 465, 466, 467, 468, 469, 470, 0, 477, 0,
+	0, 0, 517, 530, 521, 522, 523, 524, 689, 647,
+	648, 649, 651, 668, 0, 670, 672, 658, 659, 683,
+	684, 685, 0, 0, 0, 0, 681, 663, 0, 695,
+	696, 697, 698, 699, 700, 701, 702, 703, 704, 705,
+	706, 709, 815, 816, 817, 0, 707, 708, 719, 0,
+	0, 0, 565, 841, 0, -2, 0, 686, 0, 951,
+	866, 0, 0, 0, 0, 692, 843, 0, 692, 843,
+	0, 0, 0, 562, 563, 838, 835, 0, 0, 760,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	544, 545, 547, 0, 639, 0, 620, 0, 622, 623,
+	901, 58, 43, 0, 902, 0, 0, 0, 0, 862,
+	864, 865, 901, 0, 851, 0, 0, 644, 0, 0,
+	569, 56, 602, 0, 49, 585, 581, 0, 644, 0,
+	0, 634, 0, 0, 0, 0, 0, 0, 624, 0,
+	0, 627, 0, 0, 0, 0, 618, 0, 0, 0,
+	0, -2, 0, 0, 0, 68, 69, 0, 0, 0,
+	957, 79, 0, 0, 84, 85, 958, 959, 960, 961,
+	0, 422, -2, 278, 133, 135, 136, 137, 128, 203,
+	201, 0, 198, 197, 143, 209, 209, 166, 167, 212,
+	0, 212, 212, 212, 0, 0, 160, 161, 162, 163,
+	154, 0, 155, 156, 157, 0, 158, 256, 0, 870,
+	224, 225, 227, 231, 0, 0, 0, 252, 253, 0,
+	0, 975, 0, 986, 123, 124, 125, 126, 434, 0,
+	0, 0, 433, 121, 0, 0, 129, 331, 0, 0,
+	0, 254, 0, 0, 233, 249, 234, 235, 0, 360,
+	0, 0, 394, 395, 396, 397, 0, 0, 0, 329,
+	331, 212, 0, 285, 286, 291, 292, 310, 0, 0,
+	0, 0, 896, 897, 0, 900, 99, 384, 386, 385,
+	389, 0, 0, 0, 0, 439, 441, 271, 272, 1387,
+	0, 443, 461, 497, 498, 496, 476, 496, 503, 480,
+	513, 669, 671, 673, 660, 681, 664, 0, 661, 0,
+	0, 655, 725, 0, 0, 564, 0, 858, 687, 901,
+	730, 731, 0, 0, 0, 0, 0, 767, 0, 0,
+	768, 0, 858, 0, 836, 0, 0, 742, 761, 0,
+	0, 762, 763, 764, 765, 766, 769, 0, 770, 771,
+	772, 773, 0, 783, 783, 783, 783, 0, 543, 546,
+	548, 599, 0, 0, 0, 0, 621, 45, 0, 0,
+	0, 868, 869, 861, 44, 0, 970, 971, 852, 853,
+	854, 0, 578, 589, 570, 604, 0, 866, 945, 0,
+	0, 935, 0, 0, 0, 644, 953, 0, 591, 613,
+	615, 0, 610, 625, 626, 628, 0, 630, 0, 632,
+	633, 595, 596, 597, 0, 0, 644, 0, 644, 73,
+	644, 75, 0, 638, 82, 83, 0, 0, 89, 213,
+	214, 114, 423, 121, 280, 134, 205, 0, 202, 139,
+	199, 212, 212, 168, 210, 211, 169, 170, 171, 0,
+	187, 0, 0, 0, 273, 94, 874, 873, 231, 231,
+	226, 0, 229, 0, 987, 190, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 0, 335, 0, 346,
+	347, 0, 330, 391, 421, 223, 0, 236, 841, 606,
+	0, 0, 348, 0, 331, 351, 352, 363, 313, 314,
+	311, 601, 887, 888, 889, 0, 899, 102, 0, 0,
+	0, 0, 382, 0, 442, 71, 499, 499, 662, 0,
+	682, 665, 729, 726, 727, 0, 842, 866, 47, 0,
+	189, 189, 821, 189, 193, 824, 189, 826, 189, 829,
+	0, 0, 0, 0, 0, 0, 0, 833, 741, 839,
+	0, 0, 0, 789, 787, 774, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 209, 906,
+	903, 46, 856, 0, 645, 582, 50, 59, 0, 945,
+	934, 947, 949, 0, 936, 0, 0, 941, 0, 858,
+	0, 0, 607, 614, 0, 0, 608, 0, 609, 629,
+	631, 598, -2, 858, 644, 66, 67, 0, 86, 87,
+	88, 279, 207, 0, 204, 164, 165, 209, 0, 209,
+	0, 194, 0, 262, 274, 0, 871, 872, 0, 0,
+	228, 230, 601, 109, 424, 0, 435, 0, 0, 0,
+	0, 0, 0, 0, 130, 332, 0, 222, 0, 0,
+	416, 413, 349, 350, 0, 898, 383, 0, 100, 101,
+	0, 0, 388, 440, 462, 473, 666, 728, 901, 732,
+	818, 209, 822, 823, 825, 827, 828, 830, 734, 733,
+	0, 0, 0, 0, 0, 866, 0, 837, 0, 0,
+	0, 791, 790, 775, 784, 785, 776, 777, 778, 780,
+	0, 0, 0, 619, 209, 926, 805, 0, 0, 0,
+	60, 0, 950, 0, 0, 0, 0, 77, 866, 954,
+	955, 611, 0, 616, 866, 65, 215, 208, 0, 212,
+	188, 212, 0, 0, 275, 875, 876, 877, 878, 879,
+	880, 881, 0, 0, 0, 426, 0, 428, 0, 430,
+	0, 338, 0, 0, 0, 393, 0, 401, 0, 0,
+	0, 103, 104, 0, 0, 0, 48, 819, 820, 0,
+	0, 0, 0, 810, 0, 834, 0, 0, 786, 858,
+	0, 783, 0, 0, 641, 0, 0, 639, 908, 907,
+	920, 924, 51, 0, 857, 855, 0, 948, 0, 939,
+	0, 943, 938, 942, 63, 0, 64, 220, 0, 217,
+	219, 206, 177, 178, 192, 195, 0, 437, 425, 427,
+	429, 431, 0, 0, 0, 417, 414, 415, 890, 0,
+	0, 0, 390, 735, 737, 736, 738, 0, 0, 0,
+	740, 757, 758, 793, 0, 0, 781, 782, 640, 642,
+	643, 600, 926, 0, 919, 922, -2, 0, 806, 807,
+	0, 0, 937, 940, 0, 612, 138, 0, 216, 218,
+	890, 0, 0, 0, 380, 892, 105, 106, 324, 325,
+	326, 99, 739, 0, 0, 0, 788, 0, 796, 797,
+	792, 779, 913, 911, 911, 924, 0, 928, 0, 933,
+	0, 0, 0, 946, 944, 221, 95, 0, 0, 420,
+	0, 0, 893, 894, 102, 811, 0, 814, 794, 0,
+	0, 0, 0, 803, 0, 916, 0, 909, 912, 910,
+	921, 0, 927, 0, 0, 925, 808, 789, 418, 419,
+	258, 0, 387, 812, 0, 798, 799, 800, 801, 802,
+	0, 905, 0, 914, 915, 923, 0, 0, 0, 259,
+	260, 0, 891, 0, 0, 804, 917, 918, 929, 931,
+	809, 261, 0, 0, 0, 0, 795, 263, 265, 266,
+	0, 0, 264, 813, 267, 268, 269,
 }
 
 var yyTok1 = [...]int{
@@ -4743,7 +4963,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 110, 3, 3, 3, 137, 129, 3,
 	80, 82, 134, 132, 81, 133, 149, 135, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 451,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 459,
 	118, 117, 119, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -4818,7 +5038,8 @@ var yyTok3 = [...]int{
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
 	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
 	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
-	57775, 450, 0,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	57780, 455, 57781, 456, 57782, 457, 57783, 458, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5160,77 +5381,89 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:407
+//line sql.y:440
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:412
+//line sql.y:445
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:413
+//line sql.y:446
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:417
+//line sql.y:450
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 32:
+	case 5:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:454
+		{
+			yyVAL.statement = SetWith(yyDollar[2].selStmt, yyDollar[1].with)
+		}
+	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:448
+//line sql.y:486
 		{
 			setParseTree(yylex, nil)
 		}
-	case 33:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:454
+//line sql.y:492
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
-	case 34:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:458
+//line sql.y:496
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
-	case 35:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:462
+//line sql.y:500
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
-	case 36:
+	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:467
+//line sql.y:505
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 37:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:471
+//line sql.y:509
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 38:
+	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:477
+//line sql.y:515
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 39:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:483
+//line sql.y:521
 		{
 			yyVAL.statement = &Load{}
 		}
-	case 40:
+	case 42:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:527
+		{
+			yyVAL.statement = &RevertMigration{UUID: string(yyDollar[2].bytes)}
+		}
+	case 43:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:489
+//line sql.y:533
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5239,27 +5472,27 @@ yydefault:
 			sel.Into = yyDollar[5].selectInto
 			yyVAL.selStmt = sel
 		}
-	case 41:
+	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:498
+//line sql.y:542
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].lock}
 		}
-	case 42:
+	case 45:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:502
+//line sql.y:546
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 43:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:506
+//line sql.y:550
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
-	case 44:
+	case 47:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:529
+//line sql.y:573
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5267,45 +5500,77 @@ yydefault:
 			sel.Lock = yyDollar[4].lock
 			yyVAL.selStmt = sel
 		}
-	case 45:
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:537
+//line sql.y:581
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 46:
+	case 49:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:543
+//line sql.y:587
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 47:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:549
+//line sql.y:593
 		{
 			yyVAL.statement = &VStream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].expr), Limit: yyDollar[7].limit}
 		}
-	case 48:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:557
+	case 51:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:601
 		{
-			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			sel := NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			sel.Windows = yyDollar[9].windowDefinitions
+			yyVAL.selStmt = sel
 		}
-	case 49:
+	case 52:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:609
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 53:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:613
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 54:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:619
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 55:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:623
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 56:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:629
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
+		}
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:563
+//line sql.y:635
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 50:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:567
+//line sql.y:639
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 51:
+	case 59:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:574
+//line sql.y:646
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -5317,9 +5582,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 52:
+	case 60:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:586
+//line sql.y:658
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -5329,210 +5594,210 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].insertAction, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 53:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:598
+//line sql.y:670
 		{
 			yyVAL.insertAction = InsertAct
 		}
-	case 54:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:602
+//line sql.y:674
 		{
 			yyVAL.insertAction = ReplaceAct
 		}
-	case 55:
+	case 63:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:608
+//line sql.y:680
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 56:
+	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:614
+//line sql.y:686
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 57:
+	case 65:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:618
+//line sql.y:690
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereClause, yyDollar[8].expr)}
 		}
-	case 58:
+	case 66:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:622
+//line sql.y:694
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 59:
+	case 67:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:626
+//line sql.y:698
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 60:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:631
+//line sql.y:703
 		{
 		}
-	case 61:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:632
+//line sql.y:704
 		{
 		}
-	case 62:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:636
+//line sql.y:708
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
-	case 63:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:640
+//line sql.y:712
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
 		}
-	case 64:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:646
+//line sql.y:718
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 65:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:650
+//line sql.y:722
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 66:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:656
+//line sql.y:728
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 67:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:660
+//line sql.y:732
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 68:
+	case 76:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:665
+//line sql.y:737
 		{
 			yyVAL.partitions = nil
 		}
-	case 69:
+	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:669
+//line sql.y:741
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 70:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:675
+//line sql.y:747
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 71:
+	case 79:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:681
+//line sql.y:753
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].scope, Characteristics: yyDollar[5].characteristics}
 		}
-	case 72:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:685
+//line sql.y:757
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics, Scope: ImplicitScope}
 		}
-	case 73:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:691
+//line sql.y:763
 		{
 			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
-	case 74:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:695
+//line sql.y:767
 		{
 			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
-	case 75:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:701
+//line sql.y:773
 		{
 			yyVAL.characteristic = yyDollar[3].isolationLevel
 		}
-	case 76:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:705
+//line sql.y:777
 		{
 			yyVAL.characteristic = ReadWrite
 		}
-	case 77:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:709
+//line sql.y:781
 		{
 			yyVAL.characteristic = ReadOnly
 		}
-	case 78:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:715
+//line sql.y:787
 		{
 			yyVAL.isolationLevel = RepeatableRead
 		}
-	case 79:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:719
+//line sql.y:791
 		{
 			yyVAL.isolationLevel = ReadCommitted
 		}
-	case 80:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:723
+//line sql.y:795
 		{
 			yyVAL.isolationLevel = ReadUncommitted
 		}
-	case 81:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:727
+//line sql.y:799
 		{
 			yyVAL.isolationLevel = Serializable
 		}
-	case 82:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:733
+//line sql.y:805
 		{
 			yyVAL.scope = SessionScope
 		}
-	case 83:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:737
+//line sql.y:809
 		{
 			yyVAL.scope = GlobalScope
 		}
-	case 84:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:743
+//line sql.y:815
 		{
 			yyDollar[1].createTable.TableSpec = yyDollar[2].TableSpec
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 85:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:749
+//line sql.y:821
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTable.OptLike = yyDollar[2].optLike
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 86:
+	case 94:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:756
+//line sql.y:828
 		{
 			yyDollar[1].createIndex.Columns = yyDollar[3].indexColumns
 			yyDollar[1].createIndex.Options = append(yyDollar[1].createIndex.Options, yyDollar[5].indexOptions...)
@@ -5540,268 +5805,269 @@ yydefault:
 			yyDollar[1].createIndex.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createIndex
 		}
-	case 87:
+	case 95:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:764
+//line sql.y:836
 		{
 			yyVAL.statement = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].boolean, Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columns, Select: yyDollar[10].selStmt, CheckOption: yyDollar[11].str}
 		}
-	case 88:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:768
+//line sql.y:840
 		{
 			yyDollar[1].createDatabase.FullyParsed = true
 			yyDollar[1].createDatabase.CreateOptions = yyDollar[2].collateAndCharsets
 			yyVAL.statement = yyDollar[1].createDatabase
 		}
-	case 89:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:775
+//line sql.y:847
 		{
 			yyVAL.boolean = false
 		}
-	case 90:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:779
+//line sql.y:851
 		{
 			yyVAL.boolean = true
 		}
-	case 91:
+	case 99:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:784
+//line sql.y:856
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 92:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:788
+//line sql.y:860
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 93:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:794
+//line sql.y:866
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 94:
+	case 102:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:799
+//line sql.y:871
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 95:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:804
+//line sql.y:876
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 96:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:810
+//line sql.y:882
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 97:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:815
+//line sql.y:887
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 98:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:821
+//line sql.y:893
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 99:
+	case 107:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:827
+//line sql.y:899
 		{
 			yyVAL.createTable = &CreateTable{Table: yyDollar[4].tableName, IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createTable)
 		}
-	case 100:
+	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:834
+//line sql.y:906
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 101:
+	case 109:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:841
+//line sql.y:913
 		{
 			yyVAL.createIndex = &CreateIndex{Constraint: yyDollar[2].str, Name: yyDollar[4].colIdent, Options: yyDollar[5].indexOptions, Table: yyDollar[7].tableName}
 			setDDL(yylex, yyVAL.createIndex)
 		}
-	case 102:
+	case 110:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:848
+//line sql.y:920
 		{
 			yyVAL.createDatabase = &CreateDatabase{DBName: string(yyDollar[4].colIdent.String()), IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createDatabase)
 		}
-	case 103:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:855
+//line sql.y:927
 		{
 			yyVAL.alterDatabase = &AlterDatabase{}
 			setDDL(yylex, yyVAL.alterDatabase)
 		}
-	case 106:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:866
+	case 114:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:938
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
+			yyVAL.TableSpec.PartitionOption = yyDollar[5].partitionOption
 		}
-	case 107:
+	case 115:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:872
+//line sql.y:945
 		{
 			yyVAL.collateAndCharsets = nil
 		}
-	case 108:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:876
+//line sql.y:949
 		{
 			yyVAL.collateAndCharsets = yyDollar[1].collateAndCharsets
 		}
-	case 109:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:882
+//line sql.y:955
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 110:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:886
+//line sql.y:959
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 111:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:890
+//line sql.y:963
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 112:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:894
+//line sql.y:967
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 113:
+	case 121:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:899
+//line sql.y:972
 		{
 			yyVAL.boolean = false
 		}
-	case 114:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:903
+//line sql.y:976
 		{
 			yyVAL.boolean = true
 		}
-	case 115:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:909
+//line sql.y:982
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 116:
+	case 124:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:913
+//line sql.y:986
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 117:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:919
+//line sql.y:992
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 118:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:923
+//line sql.y:996
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 119:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:930
+//line sql.y:1003
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 120:
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:934
+//line sql.y:1007
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 121:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:940
+//line sql.y:1013
 		{
 			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
 		}
-	case 122:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:944
+//line sql.y:1017
 		{
 			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
 		}
-	case 123:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:950
+//line sql.y:1023
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 124:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:955
+//line sql.y:1028
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
 		}
-	case 125:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:960
+//line sql.y:1033
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 126:
+	case 134:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:964
+//line sql.y:1037
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
 		}
-	case 127:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:969
+//line sql.y:1042
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 128:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:973
+//line sql.y:1046
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 129:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:977
+//line sql.y:1050
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 130:
+	case 138:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:983
+//line sql.y:1056
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolean
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -5811,1428 +6077,1437 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].literal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 131:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:994
+//line sql.y:1067
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolean
 			yyVAL.columnType.Zerofill = yyDollar[3].boolean
 		}
-	case 135:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1005
+//line sql.y:1078
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literal
 		}
-	case 136:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1010
+//line sql.y:1083
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 137:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1016
+//line sql.y:1089
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1020
+//line sql.y:1093
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1024
+//line sql.y:1097
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1028
+//line sql.y:1101
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 141:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1032
+//line sql.y:1105
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1036
+//line sql.y:1109
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 143:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1040
+//line sql.y:1113
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 144:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1044
+//line sql.y:1117
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 145:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1048
+//line sql.y:1121
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 146:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1054
+//line sql.y:1127
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 147:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1060
+//line sql.y:1133
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 148:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1066
+//line sql.y:1139
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 149:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1072
+//line sql.y:1145
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 150:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1078
+//line sql.y:1151
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 151:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1086
+//line sql.y:1159
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 152:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1090
+//line sql.y:1163
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 153:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1094
+//line sql.y:1167
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 154:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1098
+//line sql.y:1171
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 155:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1102
+//line sql.y:1175
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 156:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1108
+//line sql.y:1181
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 157:
+	case 165:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1112
+//line sql.y:1185
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 158:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1116
+//line sql.y:1189
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 159:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1120
+//line sql.y:1193
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 160:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1124
+//line sql.y:1197
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 161:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1128
+//line sql.y:1201
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 162:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1132
+//line sql.y:1205
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 163:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1136
+//line sql.y:1209
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 164:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1140
+//line sql.y:1213
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 165:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1144
+//line sql.y:1217
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 166:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1148
+//line sql.y:1221
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 167:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1152
+//line sql.y:1225
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 168:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1156
+//line sql.y:1229
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 169:
+	case 177:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1160
+//line sql.y:1233
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 170:
+	case 178:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1165
+//line sql.y:1238
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 171:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1244
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 172:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1175
+//line sql.y:1248
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 173:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1179
+//line sql.y:1252
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 174:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1183
+//line sql.y:1256
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 175:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1187
+//line sql.y:1260
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 176:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1264
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 177:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1195
+//line sql.y:1268
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 178:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1199
+//line sql.y:1272
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 179:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1205
+//line sql.y:1278
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 180:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1210
+//line sql.y:1283
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 181:
+	case 189:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1215
+//line sql.y:1288
 		{
 			yyVAL.literal = nil
 		}
-	case 182:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1219
+//line sql.y:1292
 		{
 			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
 		}
-	case 183:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1224
+//line sql.y:1297
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 184:
+	case 192:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1228
+//line sql.y:1301
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 185:
+	case 193:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1236
+//line sql.y:1309
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 186:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1240
+//line sql.y:1313
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 			}
 		}
-	case 187:
+	case 195:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1246
+//line sql.y:1319
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 188:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1254
+//line sql.y:1327
 		{
 			yyVAL.boolean = false
 		}
-	case 189:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1258
+//line sql.y:1331
 		{
 			yyVAL.boolean = true
 		}
-	case 190:
+	case 198:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1263
+//line sql.y:1336
 		{
 			yyVAL.boolean = false
 		}
-	case 191:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1267
+//line sql.y:1340
 		{
 			yyVAL.boolean = true
 		}
-	case 192:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1273
+//line sql.y:1346
 		{
 			yyVAL.boolean = false
 		}
-	case 193:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1277
+//line sql.y:1350
 		{
 			yyVAL.boolean = false
 		}
-	case 194:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1281
+//line sql.y:1354
 		{
 			yyVAL.boolean = true
 		}
-	case 195:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1286
+//line sql.y:1359
 		{
 			yyVAL.optVal = nil
 		}
-	case 196:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1290
+//line sql.y:1363
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 197:
+	case 205:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1295
+//line sql.y:1368
 		{
 			yyVAL.optVal = nil
 		}
-	case 198:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1299
+//line sql.y:1372
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 199:
+	case 207:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1304
+//line sql.y:1377
 		{
 			yyVAL.boolean = false
 		}
-	case 200:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1308
+//line sql.y:1381
 		{
 			yyVAL.boolean = true
 		}
-	case 201:
+	case 209:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1313
+//line sql.y:1386
 		{
 			yyVAL.str = ""
 		}
-	case 202:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1317
+//line sql.y:1390
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 203:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1321
+//line sql.y:1394
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 204:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1326
+//line sql.y:1399
 		{
 			yyVAL.str = ""
 		}
-	case 205:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1330
+//line sql.y:1403
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 206:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1334
+//line sql.y:1407
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 207:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1339
+//line sql.y:1412
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 208:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1343
+//line sql.y:1416
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 209:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1347
+//line sql.y:1420
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 210:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1351
+//line sql.y:1424
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 211:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1355
+//line sql.y:1428
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 212:
+	case 220:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1360
+//line sql.y:1433
 		{
 			yyVAL.literal = nil
 		}
-	case 213:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1364
+//line sql.y:1437
 		{
 			yyVAL.literal = NewStrLiteral(yyDollar[2].bytes)
 		}
-	case 214:
+	case 222:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1370
+//line sql.y:1443
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 215:
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1375
+//line sql.y:1448
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 216:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1379
+//line sql.y:1452
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
-	case 217:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1385
+//line sql.y:1458
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 218:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1389
+//line sql.y:1462
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 219:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1395
+//line sql.y:1468
 		{
 			yyVAL.indexOption = yyDollar[1].indexOption
 		}
-	case 220:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1399
+//line sql.y:1472
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 221:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1404
+//line sql.y:1477
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[2].bytes)}
 		}
-	case 222:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1408
+//line sql.y:1481
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 223:
+	case 231:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1414
+//line sql.y:1487
 		{
 			yyVAL.str = ""
 		}
-	case 224:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1418
+//line sql.y:1491
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 225:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1424
+//line sql.y:1497
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 226:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1428
+//line sql.y:1501
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 227:
+	case 235:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+//line sql.y:1505
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
-	case 228:
+	case 236:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1436
+//line sql.y:1509
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
-	case 229:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1440
+//line sql.y:1513
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 230:
+	case 238:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1445
+//line sql.y:1518
 		{
 			yyVAL.str = ""
 		}
-	case 231:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1449
+//line sql.y:1522
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 232:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1455
+//line sql.y:1528
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 233:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+//line sql.y:1532
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 234:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1463
+//line sql.y:1536
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 235:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1470
+//line sql.y:1543
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 236:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1547
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 237:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1479
+//line sql.y:1552
 		{
 			yyVAL.str = "key"
 		}
-	case 238:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1483
+//line sql.y:1556
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 239:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1489
+//line sql.y:1562
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 240:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1493
+//line sql.y:1566
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 241:
+	case 249:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1498
+//line sql.y:1571
 		{
 			yyVAL.str = ""
 		}
-	case 242:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1502
+//line sql.y:1575
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 243:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1508
+//line sql.y:1581
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 244:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1512
+//line sql.y:1585
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 245:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1518
+//line sql.y:1591
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal, Direction: yyDollar[3].orderDirection}
 		}
-	case 246:
+	case 254:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1524
+//line sql.y:1597
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 247:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1528
+//line sql.y:1601
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 248:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1534
+//line sql.y:1607
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 249:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1538
+//line sql.y:1611
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 250:
+	case 258:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1544
+//line sql.y:1617
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 251:
+	case 259:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1548
+//line sql.y:1621
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 252:
+	case 260:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1552
+//line sql.y:1625
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 253:
+	case 261:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1556
+//line sql.y:1629
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 254:
+	case 262:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1562
+//line sql.y:1635
 		{
 			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
 		}
-	case 255:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1568
+//line sql.y:1641
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 256:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1574
+//line sql.y:1647
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 257:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1580
+//line sql.y:1653
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 258:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1584
+//line sql.y:1657
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 259:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1588
+//line sql.y:1661
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 260:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1665
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 261:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1596
+//line sql.y:1669
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 262:
+	case 270:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1601
+//line sql.y:1674
 		{
 			yyVAL.str = ""
 		}
-	case 263:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1605
+//line sql.y:1678
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 264:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1609
+//line sql.y:1682
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 265:
+	case 273:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1614
+//line sql.y:1687
 		{
 			yyVAL.boolean = true
 		}
-	case 266:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1618
+//line sql.y:1691
 		{
 			yyVAL.boolean = true
 		}
-	case 267:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1622
+//line sql.y:1695
 		{
 			yyVAL.boolean = false
 		}
-	case 268:
+	case 276:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1627
+//line sql.y:1700
 		{
 			yyVAL.tableOptions = nil
 		}
-	case 269:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1631
+//line sql.y:1704
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 		}
-	case 270:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1637
+//line sql.y:1710
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 271:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1641
+//line sql.y:1714
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[3].tableOption)
 		}
-	case 272:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1645
+//line sql.y:1718
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 273:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1651
+//line sql.y:1724
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 274:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1655
+//line sql.y:1728
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 275:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1661
+//line sql.y:1734
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1665
+//line sql.y:1738
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 277:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1669
+//line sql.y:1742
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[2].bytes)), String: yyDollar[4].str}
 		}
-	case 278:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1673
+//line sql.y:1746
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[2].bytes), String: yyDollar[4].str}
 		}
-	case 279:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1677
+//line sql.y:1750
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1681
+//line sql.y:1754
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 281:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1685
+//line sql.y:1758
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1689
+//line sql.y:1762
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 283:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1693
+//line sql.y:1766
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 284:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1697
+//line sql.y:1770
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 285:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1701
+//line sql.y:1774
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 286:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1705
+//line sql.y:1778
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 287:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1709
+//line sql.y:1782
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 288:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1713
+//line sql.y:1786
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 289:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1717
+//line sql.y:1790
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 290:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1721
+//line sql.y:1794
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 291:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1725
+//line sql.y:1798
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 292:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1729
+//line sql.y:1802
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 293:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1733
+//line sql.y:1806
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 294:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1737
+//line sql.y:1810
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 295:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1741
+//line sql.y:1814
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 296:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1745
+//line sql.y:1818
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 297:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1749
+//line sql.y:1822
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 298:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1753
+//line sql.y:1826
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 299:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1757
+//line sql.y:1830
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 300:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1761
+//line sql.y:1834
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 301:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1765
+//line sql.y:1838
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 302:
+	case 310:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1769
+//line sql.y:1842
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
-	case 303:
+	case 311:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1773
+//line sql.y:1846
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[4].tableNames}
 		}
-	case 304:
+	case 312:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1778
+//line sql.y:1851
 		{
 			yyVAL.str = ""
 		}
-	case 305:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1782
+//line sql.y:1855
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 306:
+	case 314:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1786
+//line sql.y:1859
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 316:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1805
+//line sql.y:1878
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 317:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+//line sql.y:1882
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 318:
+	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1886
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 319:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1818
+//line sql.y:1891
 		{
 			yyVAL.bytes = []byte("")
 		}
-	case 321:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1824
+//line sql.y:1897
 		{
 			yyVAL.colName = nil
 		}
-	case 322:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1828
+//line sql.y:1901
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 323:
+	case 331:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1833
+//line sql.y:1906
 		{
 			yyVAL.colName = nil
 		}
-	case 324:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1837
+//line sql.y:1910
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 325:
+	case 333:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1842
+//line sql.y:1915
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 326:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1846
+//line sql.y:1919
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 327:
+	case 335:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1850
+//line sql.y:1923
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, &OrderByOption{Cols: yyDollar[5].columns})
 		}
-	case 328:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1854
+//line sql.y:1927
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 329:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1858
+//line sql.y:1931
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...)
 		}
-	case 330:
+	case 338:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1862
+//line sql.y:1935
 		{
 			yyVAL.alterOptions = append(append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...), &OrderByOption{Cols: yyDollar[7].columns})
 		}
-	case 331:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1868
+//line sql.y:1941
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 332:
+	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1872
+//line sql.y:1945
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 333:
+	case 341:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1876
+//line sql.y:1949
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 334:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1882
+//line sql.y:1955
 		{
 			yyVAL.alterOption = yyDollar[1].tableOptions
 		}
-	case 335:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1886
+//line sql.y:1959
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 336:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1890
+//line sql.y:1963
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 337:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1894
+//line sql.y:1967
 		{
 			yyVAL.alterOption = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinition}
 		}
-	case 338:
+	case 346:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1898
+//line sql.y:1971
 		{
 			yyVAL.alterOption = &AddColumns{Columns: yyDollar[4].columnDefinitions}
 		}
-	case 339:
+	case 347:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1902
+//line sql.y:1975
 		{
 			yyVAL.alterOption = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinition}, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 340:
+	case 348:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1906
+//line sql.y:1979
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: true}
 		}
-	case 341:
+	case 349:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1910
+//line sql.y:1983
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: false, DefaultVal: yyDollar[6].expr}
 		}
-	case 342:
+	case 350:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1914
+//line sql.y:1987
 		{
 			yyVAL.alterOption = &ChangeColumn{OldColumn: yyDollar[3].colName, NewColDefinition: yyDollar[4].columnDefinition, First: yyDollar[5].colName, After: yyDollar[6].colName}
 		}
-	case 343:
+	case 351:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1918
+//line sql.y:1991
 		{
 			yyVAL.alterOption = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinition, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 344:
+	case 352:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1922
+//line sql.y:1995
 		{
 			yyVAL.alterOption = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 345:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1926
+//line sql.y:1999
 		{
 			yyVAL.alterOption = &KeyState{Enable: false}
 		}
-	case 346:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1930
+//line sql.y:2003
 		{
 			yyVAL.alterOption = &KeyState{Enable: true}
 		}
-	case 347:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1934
+//line sql.y:2007
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: false}
 		}
-	case 348:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1938
+//line sql.y:2011
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: true}
 		}
-	case 349:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1942
+//line sql.y:2015
 		{
 			yyVAL.alterOption = &DropColumn{Name: yyDollar[3].colName}
 		}
-	case 350:
+	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:2019
 		{
 			yyVAL.alterOption = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}
 		}
-	case 351:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1950
+//line sql.y:2023
 		{
 			yyVAL.alterOption = &DropKey{Type: PrimaryKeyType}
 		}
-	case 352:
+	case 360:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1954
+//line sql.y:2027
 		{
 			yyVAL.alterOption = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent.String()}
 		}
-	case 353:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1958
+//line sql.y:2031
 		{
 			yyVAL.alterOption = &Force{}
 		}
-	case 354:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1962
+//line sql.y:2035
 		{
 			yyVAL.alterOption = &RenameTable{Table: yyDollar[3].tableName}
 		}
-	case 355:
+	case 363:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1966
+//line sql.y:2039
 		{
 			yyVAL.alterOption = &RenameIndex{OldName: yyDollar[3].colIdent.String(), NewName: yyDollar[5].colIdent.String()}
 		}
-	case 356:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1972
+//line sql.y:2045
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 357:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1976
+//line sql.y:2049
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 358:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1982
+//line sql.y:2055
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 359:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1986
+//line sql.y:2059
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 360:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2063
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 361:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1994
+//line sql.y:2067
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 362:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1998
+//line sql.y:2071
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 363:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2002
+//line sql.y:2075
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 364:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2006
+//line sql.y:2079
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 365:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2010
+//line sql.y:2083
 		{
 			yyVAL.alterOption = &Validation{With: true}
 		}
-	case 366:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2014
+//line sql.y:2087
 		{
 			yyVAL.alterOption = &Validation{With: false}
 		}
-	case 367:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2020
+//line sql.y:2093
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 368:
+	case 376:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2099
+		{
+			yyDollar[1].alterTable.FullyParsed = true
+			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
+			yyDollar[1].alterTable.PartitionOption = yyDollar[3].partitionOption
+			yyVAL.statement = yyDollar[1].alterTable
+		}
+	case 377:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2026
+//line sql.y:2106
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = &PartitionSpec{Action: RemoveAction}
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 369:
+	case 378:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2033
+//line sql.y:2113
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[4].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 370:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2040
+//line sql.y:2120
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[2].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 371:
+	case 380:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2046
+//line sql.y:2126
 		{
 			yyVAL.statement = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columns, Select: yyDollar[9].selStmt, CheckOption: yyDollar[10].str}
 		}
-	case 372:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2050
+//line sql.y:2130
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.AlterOptions = yyDollar[3].collateAndCharsets
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 373:
+	case 382:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2057
+//line sql.y:2137
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.UpdateDataDirectory = true
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 374:
+	case 383:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2064
+//line sql.y:2144
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -7244,9 +7519,9 @@ yydefault:
 				},
 			}
 		}
-	case 375:
+	case 384:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2076
+//line sql.y:2156
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -7256,21 +7531,21 @@ yydefault:
 				},
 			}
 		}
-	case 376:
+	case 385:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2086
+//line sql.y:2166
 		{
 			yyVAL.statement = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 377:
+	case 386:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2090
+//line sql.y:2170
 		{
 			yyVAL.statement = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 378:
+	case 387:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2094
+//line sql.y:2174
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -7283,9 +7558,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 379:
+	case 388:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2107
+//line sql.y:2187
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -7295,15 +7570,15 @@ yydefault:
 				},
 			}
 		}
-	case 380:
+	case 389:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2117
+//line sql.y:2197
 		{
 			yyVAL.statement = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 381:
+	case 390:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2121
+//line sql.y:2201
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -7314,415 +7589,529 @@ yydefault:
 				},
 			}
 		}
-	case 382:
+	case 391:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2134
+//line sql.y:2214
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDef}}
 		}
-	case 383:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2138
+//line sql.y:2218
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitions}
 		}
-	case 384:
+	case 393:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2142
+//line sql.y:2222
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitions, Definitions: yyDollar[6].partDefs}
 		}
-	case 385:
+	case 394:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2146
+//line sql.y:2226
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitions}
 		}
-	case 386:
+	case 395:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2150
+//line sql.y:2230
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
-	case 387:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2154
+//line sql.y:2234
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitions}
 		}
-	case 388:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2158
+//line sql.y:2238
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
-	case 389:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2162
+//line sql.y:2242
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitions}
 		}
-	case 390:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2166
+//line sql.y:2246
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
-	case 391:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:2250
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 392:
+	case 401:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2174
+//line sql.y:2254
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].boolean}
 		}
-	case 393:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2178
+//line sql.y:2258
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitions}
 		}
-	case 394:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2182
+//line sql.y:2262
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
-	case 395:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2186
+//line sql.y:2266
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitions}
 		}
-	case 396:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2190
+//line sql.y:2270
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
-	case 397:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2194
+//line sql.y:2274
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitions}
 		}
-	case 398:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2198
+//line sql.y:2278
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
-	case 399:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2202
+//line sql.y:2282
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitions}
 		}
-	case 400:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2206
+//line sql.y:2286
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
-	case 401:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2210
+//line sql.y:2290
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitions}
 		}
-	case 402:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2214
+//line sql.y:2294
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
-	case 403:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2218
+//line sql.y:2298
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: UpgradeAction}
 		}
-	case 404:
+	case 413:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2223
+//line sql.y:2303
 		{
 			yyVAL.boolean = false
 		}
-	case 405:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2227
+//line sql.y:2307
 		{
 			yyVAL.boolean = false
 		}
-	case 406:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2231
+//line sql.y:2311
 		{
 			yyVAL.boolean = true
 		}
-	case 407:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2238
+//line sql.y:2318
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 408:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2242
+//line sql.y:2322
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 409:
+	case 418:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2248
+//line sql.y:2328
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 410:
+	case 419:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2252
+//line sql.y:2332
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 411:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2258
-		{
-			yyVAL.statement = yyDollar[3].ddl
-		}
-	case 412:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2264
-		{
-			yyVAL.ddl = &DDL{Action: RenameDDLAction, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
-		}
-	case 413:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2268
-		{
-			yyVAL.ddl = yyDollar[1].ddl
-			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
-			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
-		}
-	case 414:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2276
-		{
-			yyVAL.statement = &DropTable{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
-		}
-	case 415:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2280
-		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AlterDDLAction, Table: yyDollar[5].tableName}
-		}
-	case 416:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2285
-		{
-			yyVAL.statement = &DropView{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
-		}
-	case 417:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2289
-		{
-			yyVAL.statement = &DropDatabase{DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
-		}
-	case 418:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2295
-		{
-			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[3].tableName}
-		}
-	case 419:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2299
-		{
-			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[2].tableName}
-		}
 	case 420:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2304
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2336
 		{
-			yyVAL.statement = &OtherRead{}
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, InValues: yyDollar[6].exprs}
 		}
 	case 421:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2310
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2340
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent}
 		}
 	case 422:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2314
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2345
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
+			yyVAL.partitionOption = nil
 		}
 	case 423:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2318
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2349
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+			yyVAL.partitionOption = yyDollar[1].partitionOption
 		}
 	case 424:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2322
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2355
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+			yyDollar[3].partitionOption.Partitions = yyDollar[4].literal
+			yyDollar[3].partitionOption.Definitions = yyDollar[5].partDefs
+			yyVAL.partitionOption = yyDollar[3].partitionOption
 		}
 	case 425:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2326
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2363
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+			if NewColIdent(string(yyDollar[2].bytes)).Lowered() != HashTypeStr {
+				yylex.Error("expecting hash, key, range or list partitioning")
+				return 1
+			}
+			yyVAL.partitionOption = &PartitionOption{Linear: yyDollar[1].boolean, Type: HashPartitionType, Expr: yyDollar[4].expr}
 		}
 	case 426:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2330
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2371
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+			yyVAL.partitionOption = &PartitionOption{Linear: yyDollar[1].boolean, Type: KeyPartitionType}
 		}
 	case 427:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2334
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2375
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
+			yyVAL.partitionOption = &PartitionOption{Linear: yyDollar[1].boolean, Type: KeyPartitionType, ColList: yyDollar[4].columns}
 		}
 	case 428:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2338
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2379
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
+			yyVAL.partitionOption = &PartitionOption{Type: RangePartitionType, Expr: yyDollar[3].expr}
 		}
 	case 429:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2342
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2383
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
+			yyVAL.partitionOption = &PartitionOption{Type: RangePartitionType, ColList: yyDollar[4].columns}
 		}
 	case 430:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2346
+//line sql.y:2387
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
+			yyVAL.partitionOption = &PartitionOption{Type: ListPartitionType, Expr: yyDollar[3].expr}
 		}
 	case 431:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2350
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2391
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
+			yyVAL.partitionOption = &PartitionOption{Type: ListPartitionType, ColList: yyDollar[4].columns}
 		}
 	case 432:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2354
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2396
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
+			yyVAL.boolean = false
 		}
 	case 433:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2358
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2400
 		{
-			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
+			yyVAL.boolean = true
 		}
 	case 434:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2405
+		{
+			yyVAL.literal = nil
+		}
+	case 435:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2409
+		{
+			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
+		}
+	case 436:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2414
+		{
+			yyVAL.partDefs = nil
+		}
+	case 437:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2418
+		{
+			yyVAL.partDefs = yyDollar[2].partDefs
+		}
+	case 438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2424
+		{
+			yyVAL.statement = yyDollar[3].ddl
+		}
+	case 439:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2430
+		{
+			yyVAL.ddl = &DDL{Action: RenameDDLAction, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 440:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2434
+		{
+			yyVAL.ddl = yyDollar[1].ddl
+			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
+			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+		}
+	case 441:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2442
+		{
+			yyVAL.statement = &DropTable{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
+		}
+	case 442:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2446
+		{
+			// Change this to an alter statement
+			yyVAL.statement = &DDL{Action: AlterDDLAction, Table: yyDollar[5].tableName}
+		}
+	case 443:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2451
+		{
+			yyVAL.statement = &DropView{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
+		}
+	case 444:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2455
+		{
+			yyVAL.statement = &DropDatabase{DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
+		}
+	case 445:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2461
+		{
+			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[3].tableName}
+		}
+	case 446:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2465
+		{
+			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[2].tableName}
+		}
+	case 447:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2470
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 448:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2476
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
+		}
+	case 449:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2480
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
+		}
+	case 450:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2484
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+		}
+	case 451:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2488
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+		}
+	case 452:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2492
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+		}
+	case 453:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2496
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
+		}
+	case 454:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2500
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
+		}
+	case 455:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2504
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
+		}
+	case 456:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2508
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
+		}
+	case 457:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2512
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
+		}
+	case 458:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2516
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
+		}
+	case 459:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2520
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
+		}
+	case 460:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2524
+		{
+			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
+		}
+	case 461:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2362
+//line sql.y:2528
 		{
 			yyVAL.statement = &Show{&ShowTableStatus{DatabaseName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 435:
+	case 462:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2366
+//line sql.y:2532
 		{
 			yyVAL.statement = &Show{&ShowColumns{Full: yyDollar[2].str, Table: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 436:
+	case 463:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2370
+//line sql.y:2536
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 437:
+	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2374
+//line sql.y:2540
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 438:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2378
+//line sql.y:2544
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 439:
+	case 466:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2383
+//line sql.y:2549
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 440:
+	case 467:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2387
+//line sql.y:2553
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 441:
+	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2391
+//line sql.y:2557
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 442:
+	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2395
+//line sql.y:2561
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 443:
+	case 470:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2399
+//line sql.y:2565
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 444:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2403
+//line sql.y:2569
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 445:
+	case 472:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2407
+//line sql.y:2573
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 446:
+	case 473:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2411
+//line sql.y:2577
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Extended: string(yyDollar[2].str), Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 447:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2416
+//line sql.y:2582
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 448:
+	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2420
+//line sql.y:2586
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 449:
+	case 476:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2424
+//line sql.y:2590
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -7732,914 +8121,926 @@ yydefault:
 				yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 			}
 		}
-	case 450:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2434
+//line sql.y:2600
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}}
 		}
-	case 451:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2439
+//line sql.y:2605
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 452:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2443
+//line sql.y:2609
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 453:
+	case 480:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2447
+//line sql.y:2613
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 454:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2451
+//line sql.y:2617
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 455:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2456
+//line sql.y:2622
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
-	case 456:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2470
+//line sql.y:2636
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 457:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2474
+//line sql.y:2640
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 458:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2478
+//line sql.y:2644
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 459:
+	case 486:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2484
+//line sql.y:2650
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 460:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2488
+//line sql.y:2654
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 461:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2494
+//line sql.y:2660
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 462:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2498
+//line sql.y:2664
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 463:
+	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2504
+//line sql.y:2670
 		{
 			yyVAL.str = ""
 		}
-	case 464:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2508
+//line sql.y:2674
 		{
 			yyVAL.str = "extended "
 		}
-	case 465:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2514
+//line sql.y:2680
 		{
 			yyVAL.str = ""
 		}
-	case 466:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2518
+//line sql.y:2684
 		{
 			yyVAL.str = "full "
 		}
-	case 467:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2524
+//line sql.y:2690
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 468:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2528
+//line sql.y:2694
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 469:
+	case 496:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2534
+//line sql.y:2700
 		{
 			yyVAL.str = ""
 		}
-	case 470:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2538
+//line sql.y:2704
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 471:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2542
+//line sql.y:2708
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 472:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2548
+//line sql.y:2714
 		{
 			yyVAL.showFilter = nil
 		}
-	case 473:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2552
+//line sql.y:2718
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 474:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2556
+//line sql.y:2722
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 475:
+	case 502:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2562
+//line sql.y:2728
 		{
 			yyVAL.showFilter = nil
 		}
-	case 476:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2566
+//line sql.y:2732
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 477:
+	case 504:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2572
+//line sql.y:2738
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 478:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2576
+//line sql.y:2742
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 479:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2580
+//line sql.y:2746
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 480:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2586
+//line sql.y:2752
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 481:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2590
+//line sql.y:2756
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 482:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2596
+//line sql.y:2762
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 483:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2600
+//line sql.y:2766
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 484:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2606
+//line sql.y:2772
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 485:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2612
+//line sql.y:2778
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 486:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2616
+//line sql.y:2782
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 487:
+	case 514:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2621
+//line sql.y:2787
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 488:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2623
+//line sql.y:2789
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 489:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2626
+//line sql.y:2792
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 490:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2628
+//line sql.y:2794
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 491:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2633
+//line sql.y:2799
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 492:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2639
+//line sql.y:2805
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 493:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2644
+//line sql.y:2810
 		{
 			yyVAL.explainType = EmptyType
 		}
-	case 494:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2648
+//line sql.y:2814
 		{
 			yyVAL.explainType = JSONType
 		}
-	case 495:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2652
+//line sql.y:2818
 		{
 			yyVAL.explainType = TreeType
 		}
-	case 496:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2656
+//line sql.y:2822
 		{
 			yyVAL.explainType = VitessType
 		}
-	case 497:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2660
+//line sql.y:2826
 		{
 			yyVAL.explainType = TraditionalType
 		}
-	case 498:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2664
+//line sql.y:2830
 		{
 			yyVAL.explainType = AnalyzeType
 		}
-	case 499:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2670
+//line sql.y:2836
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 500:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2674
+//line sql.y:2840
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 501:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2678
+//line sql.y:2844
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 502:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+//line sql.y:2850
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 503:
+	case 530:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2854
+		{
+			yyVAL.statement = SetWith(yyDollar[2].selStmt, yyDollar[1].with)
+		}
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2688
+//line sql.y:2858
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 504:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2692
+//line sql.y:2862
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 505:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2696
+//line sql.y:2866
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 506:
+	case 534:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2701
+//line sql.y:2871
 		{
 			yyVAL.str = ""
 		}
-	case 507:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2705
+//line sql.y:2875
 		{
 			yyVAL.str = ""
 		}
-	case 508:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2709
+//line sql.y:2879
 		{
 			yyVAL.str = ""
 		}
-	case 509:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2715
+//line sql.y:2885
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 510:
+	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2719
+//line sql.y:2889
 		{
 			yyVAL.statement = &Explain{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
 		}
-	case 511:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2725
+//line sql.y:2895
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 512:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2729
+//line sql.y:2899
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 513:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2735
+//line sql.y:2905
 		{
 			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
 		}
-	case 514:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2741
+//line sql.y:2911
 		{
 			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
 		}
-	case 515:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2745
+//line sql.y:2915
 		{
 			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
 		}
-	case 516:
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2751
+//line sql.y:2921
 		{
 			yyVAL.tableAndLockType = &TableAndLockType{Table: yyDollar[1].aliasedTableName, Lock: yyDollar[2].lockType}
 		}
-	case 517:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2757
+//line sql.y:2927
 		{
 			yyVAL.lockType = Read
 		}
-	case 518:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2761
+//line sql.y:2931
 		{
 			yyVAL.lockType = ReadLocal
 		}
-	case 519:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:2935
 		{
 			yyVAL.lockType = Write
 		}
-	case 520:
+	case 548:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2769
+//line sql.y:2939
 		{
 			yyVAL.lockType = LowPriorityWrite
 		}
-	case 521:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2775
+//line sql.y:2945
 		{
 			yyVAL.statement = &UnlockTables{}
 		}
-	case 522:
+	case 550:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2781
+//line sql.y:2951
 		{
 			yyVAL.statement = &DDL{Action: FlushDDLAction}
 		}
-	case 523:
+	case 551:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2785
+//line sql.y:2955
 		{
 			setAllowComments(yylex, true)
 		}
-	case 524:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2789
+//line sql.y:2959
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 525:
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2795
+//line sql.y:2965
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 526:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2799
+//line sql.y:2969
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 527:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2805
+//line sql.y:2975
 		{
 			yyVAL.boolean = true
 		}
-	case 528:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2809
+//line sql.y:2979
 		{
 			yyVAL.boolean = false
 		}
-	case 529:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2813
+//line sql.y:2983
 		{
 			yyVAL.boolean = true
 		}
-	case 530:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2818
+//line sql.y:2988
 		{
 			yyVAL.str = ""
 		}
-	case 531:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2822
+//line sql.y:2992
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 532:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2826
+//line sql.y:2996
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 533:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2831
+//line sql.y:3001
 		{
 			yyVAL.boolean = false
 		}
-	case 534:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2835
+//line sql.y:3005
 		{
 			yyVAL.boolean = true
 		}
-	case 535:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2839
+//line sql.y:3009
 		{
 			yyVAL.boolean = true
 		}
-	case 536:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2844
+//line sql.y:3014
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 537:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2848
+//line sql.y:3018
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 538:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2853
+//line sql.y:3023
 		{
 			yyVAL.strs = nil
 		}
-	case 539:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2857
+//line sql.y:3027
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 540:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2861
+//line sql.y:3031
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 541:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2865
+//line sql.y:3035
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 542:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2869
+//line sql.y:3039
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 543:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2875
+//line sql.y:3045
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 544:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2879
+//line sql.y:3049
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 545:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2883
+//line sql.y:3053
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 546:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2887
+//line sql.y:3057
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 547:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2891
+//line sql.y:3061
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 548:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2895
+//line sql.y:3065
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 549:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2901
+//line sql.y:3071
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 550:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2905
+//line sql.y:3075
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 551:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2911
+//line sql.y:3081
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 552:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2915
+//line sql.y:3085
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 553:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2919
+//line sql.y:3089
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 554:
+	case 582:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2923
+//line sql.y:3093
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 555:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:3098
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 556:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2932
+//line sql.y:3102
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 557:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2936
+//line sql.y:3106
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 559:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2943
+//line sql.y:3113
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 560:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2948
+//line sql.y:3118
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 561:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2952
+//line sql.y:3122
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 562:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2958
+//line sql.y:3128
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 563:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2962
+//line sql.y:3132
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 566:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2972
+//line sql.y:3142
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 567:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2976
+//line sql.y:3146
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].derivedTable, As: yyDollar[3].tableIdent}
 		}
-	case 568:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2980
+//line sql.y:3150
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 569:
+	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2986
+//line sql.y:3156
 		{
 			yyVAL.derivedTable = &DerivedTable{yyDollar[2].selStmt}
 		}
-	case 570:
+	case 598:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3160
+		{
+			yyVAL.derivedTable = &DerivedTable{SetWith(yyDollar[3].selStmt, yyDollar[2].with)}
+		}
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2992
+//line sql.y:3166
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 571:
+	case 600:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2996
+//line sql.y:3170
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 572:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3001
+//line sql.y:3175
 		{
 			yyVAL.columns = nil
 		}
-	case 573:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3005
+//line sql.y:3179
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
-	case 574:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3011
+//line sql.y:3185
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 575:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3015
+//line sql.y:3189
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 576:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3021
+//line sql.y:3195
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 577:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3025
+//line sql.y:3199
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 578:
+	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3038
+//line sql.y:3212
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 579:
+	case 608:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3042
+//line sql.y:3216
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 580:
+	case 609:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3046
+//line sql.y:3220
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 581:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3050
+//line sql.y:3224
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 582:
+	case 611:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3056
+//line sql.y:3230
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 583:
+	case 612:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3058
+//line sql.y:3232
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 584:
+	case 613:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3062
+//line sql.y:3236
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 585:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3064
+//line sql.y:3238
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 586:
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3068
+//line sql.y:3242
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 587:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3070
+//line sql.y:3244
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 588:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3073
+//line sql.y:3247
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 589:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3075
+//line sql.y:3249
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 590:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3078
+//line sql.y:3252
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 591:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3082
+//line sql.y:3256
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 592:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3086
+//line sql.y:3260
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 594:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3267
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 595:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3099
+//line sql.y:3273
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 596:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3103
+//line sql.y:3277
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 597:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3107
+//line sql.y:3281
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 598:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3113
+//line sql.y:3287
 		{
 			yyVAL.joinType = StraightJoinType
 		}
-	case 599:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3119
+//line sql.y:3293
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 600:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3123
+//line sql.y:3297
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 601:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3127
+//line sql.y:3301
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 602:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3131
+//line sql.y:3305
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 603:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3137
+//line sql.y:3311
 		{
 			yyVAL.joinType = NaturalJoinType
 		}
-	case 604:
+	case 633:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3141
+//line sql.y:3315
 		{
 			if yyDollar[2].joinType == LeftJoinType {
 				yyVAL.joinType = NaturalLeftJoinType
@@ -8647,489 +9048,495 @@ yydefault:
 				yyVAL.joinType = NaturalRightJoinType
 			}
 		}
-	case 605:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3151
+//line sql.y:3325
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 606:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3155
+//line sql.y:3329
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 607:
+	case 636:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3161
+//line sql.y:3335
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 608:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3165
+//line sql.y:3339
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 609:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3171
+//line sql.y:3345
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 610:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3176
+//line sql.y:3350
 		{
 			yyVAL.indexHints = nil
 		}
-	case 611:
+	case 640:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3180
+//line sql.y:3354
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columns}
 		}
-	case 612:
+	case 641:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3184
+//line sql.y:3358
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp}
 		}
-	case 613:
+	case 642:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3188
+//line sql.y:3362
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columns}
 		}
-	case 614:
+	case 643:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3192
+//line sql.y:3366
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columns}
 		}
-	case 615:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3197
+//line sql.y:3371
 		{
 			yyVAL.expr = nil
 		}
-	case 616:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3201
+//line sql.y:3375
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 617:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3207
+//line sql.y:3381
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 618:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3211
+//line sql.y:3385
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 619:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3215
+//line sql.y:3389
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 620:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3219
+//line sql.y:3393
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 621:
+	case 650:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3223
+//line sql.y:3397
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 622:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3227
+//line sql.y:3401
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
 		}
-	case 623:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3231
+//line sql.y:3405
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 624:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3235
+//line sql.y:3409
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 625:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3241
+//line sql.y:3415
 		{
 			yyVAL.str = ""
 		}
-	case 626:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3245
+//line sql.y:3419
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 627:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3251
+//line sql.y:3425
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 628:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3255
+//line sql.y:3429
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 629:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3261
+//line sql.y:3435
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
 		}
-	case 630:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3265
+//line sql.y:3439
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
 		}
-	case 631:
+	case 660:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3269
+//line sql.y:3443
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
 		}
-	case 632:
+	case 661:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3273
+//line sql.y:3447
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 633:
+	case 662:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3277
+//line sql.y:3451
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 634:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3281
+//line sql.y:3455
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
 		}
-	case 635:
+	case 664:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3285
+//line sql.y:3459
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
 		}
-	case 636:
+	case 665:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3289
+//line sql.y:3463
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 637:
+	case 666:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3293
+//line sql.y:3467
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 638:
+	case 667:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3297
+//line sql.y:3471
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 639:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3303
+//line sql.y:3477
 		{
 			yyVAL.isExprOperator = IsNullOp
 		}
-	case 640:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3307
+//line sql.y:3481
 		{
 			yyVAL.isExprOperator = IsNotNullOp
 		}
-	case 641:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3311
+//line sql.y:3485
 		{
 			yyVAL.isExprOperator = IsTrueOp
 		}
-	case 642:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3315
+//line sql.y:3489
 		{
 			yyVAL.isExprOperator = IsNotTrueOp
 		}
-	case 643:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3319
+//line sql.y:3493
 		{
 			yyVAL.isExprOperator = IsFalseOp
 		}
-	case 644:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3323
+//line sql.y:3497
 		{
 			yyVAL.isExprOperator = IsNotFalseOp
 		}
-	case 645:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3329
+//line sql.y:3503
 		{
 			yyVAL.comparisonExprOperator = EqualOp
 		}
-	case 646:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3333
+//line sql.y:3507
 		{
 			yyVAL.comparisonExprOperator = LessThanOp
 		}
-	case 647:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3337
+//line sql.y:3511
 		{
 			yyVAL.comparisonExprOperator = GreaterThanOp
 		}
-	case 648:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3341
+//line sql.y:3515
 		{
 			yyVAL.comparisonExprOperator = LessEqualOp
 		}
-	case 649:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3345
+//line sql.y:3519
 		{
 			yyVAL.comparisonExprOperator = GreaterEqualOp
 		}
-	case 650:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3349
+//line sql.y:3523
 		{
 			yyVAL.comparisonExprOperator = NotEqualOp
 		}
-	case 651:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3353
+//line sql.y:3527
 		{
 			yyVAL.comparisonExprOperator = NullSafeEqualOp
 		}
-	case 652:
+	case 681:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3358
+//line sql.y:3532
 		{
 			yyVAL.expr = nil
 		}
-	case 653:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3362
+//line sql.y:3536
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 654:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3368
+//line sql.y:3542
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 655:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3372
+//line sql.y:3546
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 656:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3376
+//line sql.y:3550
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 657:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3382
+//line sql.y:3556
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 658:
+	case 687:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3560
+		{
+			yyVAL.subquery = &Subquery{SetWith(yyDollar[3].selStmt, yyDollar[2].with)}
+		}
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3388
+//line sql.y:3566
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 659:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3392
+//line sql.y:3570
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 660:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3398
+//line sql.y:3576
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 661:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3402
+//line sql.y:3580
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 662:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3406
+//line sql.y:3584
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 663:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3410
+//line sql.y:3588
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 664:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3414
+//line sql.y:3592
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 665:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3418
+//line sql.y:3596
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
 		}
-	case 666:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3422
+//line sql.y:3600
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
 		}
-	case 667:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3426
+//line sql.y:3604
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
 		}
-	case 668:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3430
+//line sql.y:3608
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
 		}
-	case 669:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3434
+//line sql.y:3612
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
 		}
-	case 670:
+	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3438
+//line sql.y:3616
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
 		}
-	case 671:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3442
+//line sql.y:3620
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
 		}
-	case 672:
+	case 702:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3446
+//line sql.y:3624
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
 		}
-	case 673:
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3450
+//line sql.y:3628
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 674:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3454
+//line sql.y:3632
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 675:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3458
+//line sql.y:3636
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
 		}
-	case 676:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3462
+//line sql.y:3640
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
 		}
-	case 677:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3466
+//line sql.y:3644
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 678:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3470
+//line sql.y:3648
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 679:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3474
+//line sql.y:3652
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 680:
+	case 710:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3478
+//line sql.y:3656
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 681:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3482
+//line sql.y:3660
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 682:
+	case 712:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3486
+//line sql.y:3664
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
 		}
-	case 683:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3490
+//line sql.y:3668
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
 		}
-	case 684:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3494
+//line sql.y:3672
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
 		}
-	case 685:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3498
+//line sql.y:3676
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -9137,9 +9544,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 686:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3506
+//line sql.y:3684
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -9153,21 +9560,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 687:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3520
+//line sql.y:3698
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].expr}
 		}
-	case 688:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3524
+//line sql.y:3702
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].expr}
 		}
-	case 689:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3528
+//line sql.y:3706
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -9175,503 +9582,749 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 694:
+	case 725:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3546
+//line sql.y:3725
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 695:
+	case 726:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3550
+//line sql.y:3729
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 696:
+	case 727:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3554
+//line sql.y:3733
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 697:
+	case 728:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3558
+//line sql.y:3737
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 698:
+	case 729:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3741
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
+		}
+	case 730:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3568
+//line sql.y:3751
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 699:
+	case 731:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3572
+//line sql.y:3755
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 700:
+	case 732:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3576
+//line sql.y:3759
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 701:
+	case 733:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3580
+//line sql.y:3763
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 702:
+	case 734:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3584
+//line sql.y:3767
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 703:
+	case 735:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3588
+//line sql.y:3771
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 704:
+	case 736:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3592
+//line sql.y:3775
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 705:
+	case 737:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3596
+//line sql.y:3779
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 738:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3783
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 739:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:3787
+		{
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
+		}
+	case 740:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3791
+		{
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
+		}
+	case 741:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3795
+		{
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+		}
+	case 742:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3799
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+		}
+	case 743:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3809
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
+		}
+	case 744:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3813
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+		}
+	case 745:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3817
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
+		}
+	case 746:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3822
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
+		}
+	case 747:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3827
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
+		}
+	case 748:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3832
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
+		}
+	case 749:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3838
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
+		}
+	case 750:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3843
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
+		}
+	case 751:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3848
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
+		}
+	case 752:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3852
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
+		}
+	case 753:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3856
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
+		}
+	case 754:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3861
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
+		}
+	case 755:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3866
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
+		}
+	case 756:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3871
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
+		}
+	case 757:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3875
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 758:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3879
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 761:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3889
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 762:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3899
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 763:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3903
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 764:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3907
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 765:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3911
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 766:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3915
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 767:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3919
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 768:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3923
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 769:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3934
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("row_number"), Over: yyDollar[4].overClause}
+		}
+	case 770:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3938
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("rank"), Over: yyDollar[4].overClause}
+		}
+	case 771:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3942
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("dense_rank"), Over: yyDollar[4].overClause}
+		}
+	case 772:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3946
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("percent_rank"), Over: yyDollar[4].overClause}
+		}
+	case 773:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3950
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("cume_dist"), Over: yyDollar[4].overClause}
+		}
+	case 774:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3954
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("ntile"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].expr}}, Over: yyDollar[5].overClause}
+		}
+	case 775:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3958
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("lag"), Exprs: yyDollar[3].selectExprs, IgnoreNulls: yyDollar[5].boolean, Over: yyDollar[6].overClause}
+		}
+	case 776:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3962
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("lead"), Exprs: yyDollar[3].selectExprs, IgnoreNulls: yyDollar[5].boolean, Over: yyDollar[6].overClause}
+		}
+	case 777:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3966
 		{
-			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("first_value"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].expr}}, IgnoreNulls: yyDollar[5].boolean, Over: yyDollar[6].overClause}
 		}
-	case 706:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3600
+	case 778:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3970
 		{
-			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("last_value"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].expr}}, IgnoreNulls: yyDollar[5].boolean, Over: yyDollar[6].overClause}
 		}
-	case 707:
+	case 779:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3604
+//line sql.y:3974
 		{
-			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("nth_value"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[3].expr}, &AliasedExpr{Expr: yyDollar[5].expr}}, FromLast: yyDollar[7].boolean, IgnoreNulls: yyDollar[8].boolean, Over: yyDollar[9].overClause}
 		}
-	case 708:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3608
+	case 780:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3979
 		{
-			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
+			yyVAL.boolean = false
 		}
-	case 709:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3612
+	case 781:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3983
 		{
-			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+			yyVAL.boolean = false
 		}
-	case 710:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3616
+	case 782:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3987
 		{
-			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+			yyVAL.boolean = true
 		}
-	case 711:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3626
+	case 783:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3992
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
+			yyVAL.boolean = false
 		}
-	case 712:
+	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3630
+//line sql.y:3996
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
+			yyVAL.boolean = false
 		}
-	case 713:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3634
+//line sql.y:4000
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
+			yyVAL.boolean = true
 		}
-	case 714:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3639
+	case 786:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4006
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[3].windowSpec}
 		}
-	case 715:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3644
+//line sql.y:4010
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
 		}
-	case 716:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3649
+	case 788:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4016
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
+			yyVAL.windowSpec = &WindowSpec{Name: yyDollar[1].colIdent, PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].frameClause}
 		}
-	case 717:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3655
+	case 789:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4021
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
+			yyVAL.colIdent = ColIdent{}
 		}
-	case 718:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3660
+	case 790:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4025
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
+			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 719:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3665
+	case 791:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4030
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
+			yyVAL.exprs = nil
 		}
-	case 720:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3669
+	case 792:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4034
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
+			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 721:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3673
+	case 793:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4039
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
+			yyVAL.frameClause = nil
 		}
-	case 722:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3678
+//line sql.y:4043
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[2].framePoint}
 		}
-	case 723:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3683
+	case 795:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4047
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 796:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4053
+		{
+			yyVAL.frameUnit = RowsUnit
+		}
+	case 797:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4057
+		{
+			yyVAL.frameUnit = RangeUnit
 		}
-	case 724:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3688
+//line sql.y:4063
 		{
-			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
+			yyVAL.framePoint = &FramePoint{Type: CurrentRow}
 		}
-	case 725:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3692
+	case 799:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4067
 		{
-			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPreceding}
 		}
-	case 726:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3696
+	case 800:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4071
 		{
-			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowing}
 		}
-	case 729:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3706
+	case 801:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4075
 		{
-			yyVAL.expr = yyDollar[2].expr
+			yyVAL.framePoint = &FramePoint{Type: ExprPreceding, Expr: yyDollar[1].expr}
 		}
-	case 730:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3716
+	case 802:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4079
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.framePoint = &FramePoint{Type: ExprFollowing, Expr: yyDollar[1].expr}
 		}
-	case 731:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3720
+	case 804:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4088
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 732:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3724
+	case 805:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4093
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.windowDefinitions = nil
 		}
-	case 733:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3728
+	case 806:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4097
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.windowDefinitions = yyDollar[2].windowDefinitions
 		}
-	case 734:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3732
+	case 807:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4103
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.windowDefinitions = WindowDefinitions{yyDollar[1].windowDefinition}
 		}
-	case 735:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3736
+	case 808:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4107
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.windowDefinitions = append(yyDollar[1].windowDefinitions, yyDollar[3].windowDefinition)
 		}
-	case 736:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3740
+	case 809:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4113
 		{
-			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
+			yyVAL.windowDefinition = &WindowDefinition{Name: yyDollar[1].colIdent, Spec: yyDollar[4].windowSpec}
 		}
-	case 737:
+	case 810:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3746
+//line sql.y:4119
 		{
 			yyVAL.matchExprOption = NoOption
 		}
-	case 738:
+	case 811:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3750
+//line sql.y:4123
 		{
 			yyVAL.matchExprOption = BooleanModeOpt
 		}
-	case 739:
+	case 812:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3754
+//line sql.y:4127
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeOpt
 		}
-	case 740:
+	case 813:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3758
+//line sql.y:4131
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
 		}
-	case 741:
+	case 814:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3762
+//line sql.y:4135
 		{
 			yyVAL.matchExprOption = QueryExpansionOpt
 		}
-	case 742:
+	case 815:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3768
+//line sql.y:4141
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 743:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3772
+//line sql.y:4145
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 744:
+	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3776
+//line sql.y:4149
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 745:
+	case 818:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3782
+//line sql.y:4155
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 746:
+	case 819:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3786
+//line sql.y:4159
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
-	case 747:
+	case 820:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3790
+//line sql.y:4163
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 748:
+	case 821:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3794
+//line sql.y:4167
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 749:
+	case 822:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3798
+//line sql.y:4171
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 750:
+	case 823:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3802
+//line sql.y:4175
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 751:
+	case 824:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3808
+//line sql.y:4181
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 752:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3812
+//line sql.y:4185
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 753:
+	case 826:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3816
+//line sql.y:4189
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 754:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3820
+//line sql.y:4193
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 755:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3824
+//line sql.y:4197
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 756:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3828
+//line sql.y:4201
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 757:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3832
+//line sql.y:4205
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 758:
+	case 831:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3837
+//line sql.y:4210
 		{
 			yyVAL.expr = nil
 		}
-	case 759:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3841
+//line sql.y:4214
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 760:
+	case 833:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3846
+//line sql.y:4219
 		{
 			yyVAL.str = string("")
 		}
-	case 761:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3850
+//line sql.y:4223
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 762:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3856
+//line sql.y:4229
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 763:
+	case 836:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3860
+//line sql.y:4233
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 764:
+	case 837:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3866
+//line sql.y:4239
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 765:
+	case 838:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3871
+//line sql.y:4244
 		{
 			yyVAL.expr = nil
 		}
-	case 766:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3875
+//line sql.y:4248
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 767:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3881
+//line sql.y:4254
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 768:
+	case 841:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3885
+//line sql.y:4258
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 769:
+	case 842:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3889
+//line sql.y:4262
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 770:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3895
+//line sql.y:4268
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 771:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3899
+//line sql.y:4272
 		{
 			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
-	case 772:
+	case 845:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3903
+//line sql.y:4276
 		{
 			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
-	case 773:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3907
+//line sql.y:4280
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 774:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3911
+//line sql.y:4284
 		{
 			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
-	case 775:
+	case 848:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3915
+//line sql.y:4288
 		{
 			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
-	case 776:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3919
+//line sql.y:4292
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 777:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3923
+//line sql.y:4296
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 778:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3929
+//line sql.y:4302
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -9680,597 +10333,609 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 779:
+	case 852:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3938
+//line sql.y:4311
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 780:
+	case 853:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3942
+//line sql.y:4315
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 781:
+	case 854:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3947
+//line sql.y:4320
 		{
 			yyVAL.exprs = nil
 		}
-	case 782:
+	case 855:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3951
+//line sql.y:4324
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 783:
+	case 856:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3956
+//line sql.y:4329
 		{
 			yyVAL.expr = nil
 		}
-	case 784:
+	case 857:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3960
+//line sql.y:4333
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 785:
+	case 858:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3965
+//line sql.y:4338
 		{
 			yyVAL.orderBy = nil
 		}
-	case 786:
+	case 859:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3969
+//line sql.y:4342
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 787:
+	case 860:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3975
+//line sql.y:4348
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 788:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3979
+//line sql.y:4352
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 789:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3985
+//line sql.y:4358
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].orderDirection}
 		}
-	case 790:
+	case 863:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3990
+//line sql.y:4363
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 791:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3994
+//line sql.y:4367
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 792:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3998
+//line sql.y:4371
 		{
 			yyVAL.orderDirection = DescOrder
 		}
-	case 793:
+	case 866:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4003
+//line sql.y:4376
 		{
 			yyVAL.limit = nil
 		}
-	case 794:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4007
+//line sql.y:4380
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 795:
+	case 868:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4011
+//line sql.y:4384
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 796:
+	case 869:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4015
+//line sql.y:4388
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 797:
+	case 870:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4020
+//line sql.y:4393
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 798:
+	case 871:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4024
+//line sql.y:4397
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption, yyDollar[2].indexOption}
 		}
-	case 799:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4028
+//line sql.y:4401
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption, yyDollar[2].indexOption}
 		}
-	case 800:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4032
+//line sql.y:4405
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 801:
+	case 874:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4036
+//line sql.y:4409
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 802:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4043
+//line sql.y:4416
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 803:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4047
+//line sql.y:4420
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 804:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4051
+//line sql.y:4424
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 805:
+	case 878:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4055
+//line sql.y:4428
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 806:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4061
+//line sql.y:4434
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 807:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4065
+//line sql.y:4438
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 808:
+	case 881:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4069
+//line sql.y:4442
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 809:
+	case 882:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4074
+//line sql.y:4447
 		{
 			yyVAL.str = ""
 		}
-	case 810:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4078
+//line sql.y:4451
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 811:
+	case 884:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4082
+//line sql.y:4455
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 812:
+	case 885:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4086
+//line sql.y:4459
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 813:
+	case 886:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4091
+//line sql.y:4464
 		{
 			yyVAL.str = ""
 		}
-	case 814:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4095
+//line sql.y:4468
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 815:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4101
+//line sql.y:4474
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 816:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4105
+//line sql.y:4478
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 817:
+	case 890:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4110
+//line sql.y:4483
 		{
 			yyVAL.str = ""
 		}
-	case 818:
+	case 891:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4114
+//line sql.y:4487
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 819:
+	case 892:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4119
+//line sql.y:4492
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 820:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4123
+//line sql.y:4496
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 821:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4127
+//line sql.y:4500
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 822:
+	case 895:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4132
+//line sql.y:4505
 		{
 			yyVAL.str = ""
 		}
-	case 823:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4136
+//line sql.y:4509
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 824:
+	case 897:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4142
+//line sql.y:4515
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 825:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4146
+//line sql.y:4519
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 826:
+	case 899:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4150
+//line sql.y:4523
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'@" + string(yyDollar[2].bytes)
 		}
-	case 827:
+	case 900:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4154
+//line sql.y:4527
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 828:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4159
+//line sql.y:4532
 		{
 			yyVAL.lock = NoLock
 		}
-	case 829:
+	case 902:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4163
+//line sql.y:4536
 		{
 			yyVAL.lock = ForUpdateLock
 		}
-	case 830:
+	case 903:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4167
+//line sql.y:4540
 		{
 			yyVAL.lock = ShareModeLock
 		}
-	case 831:
+	case 904:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4172
+//line sql.y:4545
 		{
 			yyVAL.selectInto = nil
 		}
-	case 832:
+	case 905:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:4176
+//line sql.y:4549
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfileS3, FileName: string(yyDollar[4].bytes), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
-	case 833:
+	case 906:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4180
+//line sql.y:4553
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoDumpfile, FileName: string(yyDollar[3].bytes), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
-	case 834:
+	case 907:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4184
+//line sql.y:4557
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfile, FileName: string(yyDollar[3].bytes), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
-	case 835:
+	case 908:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4189
+//line sql.y:4562
 		{
 			yyVAL.str = ""
 		}
-	case 836:
+	case 909:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4193
+//line sql.y:4566
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 837:
+	case 910:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4197
+//line sql.y:4570
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 838:
+	case 911:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4202
+//line sql.y:4575
 		{
 			yyVAL.str = ""
 		}
-	case 839:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4206
+//line sql.y:4579
 		{
 			yyVAL.str = " header"
 		}
-	case 840:
+	case 913:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4211
+//line sql.y:4584
 		{
 			yyVAL.str = ""
 		}
-	case 841:
+	case 914:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4215
+//line sql.y:4588
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 842:
+	case 915:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4219
+//line sql.y:4592
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 843:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4224
+//line sql.y:4597
 		{
 			yyVAL.str = ""
 		}
-	case 844:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4228
+//line sql.y:4601
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 845:
+	case 918:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4232
+//line sql.y:4605
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 846:
+	case 919:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4238
+//line sql.y:4611
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 847:
+	case 920:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4243
+//line sql.y:4616
 		{
 			yyVAL.str = ""
 		}
-	case 848:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4247
+//line sql.y:4620
 		{
 			yyVAL.str = " lines" + yyDollar[2].str + yyDollar[3].str
 		}
-	case 849:
+	case 922:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4252
+//line sql.y:4625
 		{
 			yyVAL.str = ""
 		}
-	case 850:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4256
+//line sql.y:4629
 		{
 			yyVAL.str = " starting by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 851:
+	case 924:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4261
+//line sql.y:4634
 		{
 			yyVAL.str = ""
 		}
-	case 852:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4265
+//line sql.y:4638
 		{
 			yyVAL.str = " terminated by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 853:
+	case 926:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4270
+//line sql.y:4643
 		{
 			yyVAL.str = ""
 		}
-	case 854:
+	case 927:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4274
+//line sql.y:4647
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str + yyDollar[3].str + yyDollar[4].str
 		}
-	case 855:
+	case 928:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4279
+//line sql.y:4652
 		{
 			yyVAL.str = ""
 		}
-	case 856:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4283
+//line sql.y:4656
 		{
 			yyVAL.str = " escaped by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 857:
+	case 930:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4288
+//line sql.y:4661
 		{
 			yyVAL.str = ""
 		}
-	case 858:
+	case 931:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4292
+//line sql.y:4665
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by '" + string(yyDollar[4].bytes) + "'"
 		}
-	case 859:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4297
+//line sql.y:4670
 		{
 			yyVAL.str = ""
 		}
-	case 860:
+	case 933:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4301
+//line sql.y:4674
 		{
 			yyVAL.str = " optionally"
 		}
-	case 861:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4314
+//line sql.y:4687
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 862:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4318
+//line sql.y:4691
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 863:
+	case 936:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4695
+		{
+			yyVAL.ins = &Insert{Rows: SetWith(yyDollar[2].selStmt, yyDollar[1].with)}
+		}
+	case 937:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4322
+//line sql.y:4699
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 864:
+	case 938:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4326
+//line sql.y:4703
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[4].values}
 		}
-	case 865:
+	case 939:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4330
+//line sql.y:4707
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 866:
+	case 940:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4711
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: SetWith(yyDollar[5].selStmt, yyDollar[4].with)}
+		}
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4336
+//line sql.y:4717
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 867:
+	case 942:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4340
+//line sql.y:4721
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 868:
+	case 943:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4344
+//line sql.y:4725
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 869:
+	case 944:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4348
+//line sql.y:4729
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 870:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4353
+//line sql.y:4734
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 871:
+	case 946:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4357
+//line sql.y:4738
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 872:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4363
+//line sql.y:4744
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 873:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4367
+//line sql.y:4748
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 874:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4373
+//line sql.y:4754
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 875:
+	case 950:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4377
+//line sql.y:4758
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 876:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4383
+//line sql.y:4764
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 877:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4389
+//line sql.y:4770
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -10278,259 +10943,259 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 878:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4399
+//line sql.y:4780
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 879:
+	case 954:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4403
+//line sql.y:4784
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 880:
+	case 955:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4409
+//line sql.y:4790
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 881:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4415
+//line sql.y:4796
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 882:
+	case 957:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4419
+//line sql.y:4800
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 883:
+	case 958:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4425
+//line sql.y:4806
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
 		}
-	case 884:
+	case 959:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4429
+//line sql.y:4810
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
 		}
-	case 885:
+	case 960:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4433
+//line sql.y:4814
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
 		}
-	case 886:
+	case 961:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4437
+//line sql.y:4818
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
 		}
-	case 887:
+	case 962:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4441
+//line sql.y:4822
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].scope
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 889:
+	case 964:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4449
+//line sql.y:4830
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 892:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4459
+//line sql.y:4840
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 893:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4463
+//line sql.y:4844
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 894:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4467
+//line sql.y:4848
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 897:
+	case 972:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4476
+//line sql.y:4857
 		{
 			yyVAL.boolean = false
 		}
-	case 898:
+	case 973:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4478
+//line sql.y:4859
 		{
 			yyVAL.boolean = true
 		}
-	case 899:
+	case 974:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4481
+//line sql.y:4862
 		{
 			yyVAL.boolean = false
 		}
-	case 900:
+	case 975:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4483
+//line sql.y:4864
 		{
 			yyVAL.boolean = true
 		}
-	case 901:
+	case 976:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4486
+//line sql.y:4867
 		{
 			yyVAL.ignore = false
 		}
-	case 902:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4488
+//line sql.y:4869
 		{
 			yyVAL.ignore = true
 		}
-	case 903:
+	case 978:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4491
+//line sql.y:4872
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 904:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4493
+//line sql.y:4874
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 905:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4495
+//line sql.y:4876
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 906:
+	case 981:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4498
+//line sql.y:4879
 		{
 			yyVAL.str = ""
 		}
-	case 907:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4500
+//line sql.y:4881
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 908:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4502
+//line sql.y:4883
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 909:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4504
+//line sql.y:4885
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 910:
+	case 985:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4507
+//line sql.y:4888
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 911:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4509
+//line sql.y:4890
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 912:
+	case 987:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4513
+//line sql.y:4894
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[2].colIdent.String())}
 		}
-	case 913:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4519
+//line sql.y:4900
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 914:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4523
+//line sql.y:4904
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 916:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4530
+//line sql.y:4911
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 917:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4536
+//line sql.y:4917
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 918:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4540
+//line sql.y:4921
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 920:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4547
+//line sql.y:4928
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 1302:
+	case 1384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4953
+//line sql.y:5341
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1303:
+	case 1385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4962
+//line sql.y:5350
 		{
 			decNesting(yylex)
 		}
-	case 1304:
+	case 1386:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4967
+//line sql.y:5355
 		{
 			skipToEnd(yylex)
 		}
-	case 1305:
+	case 1387:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4972
+//line sql.y:5360
 		{
 			skipToEnd(yylex)
 		}
-	case 1306:
+	case 1388:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4976
+//line sql.y:5364
 		{
 			skipToEnd(yylex)
 		}
-	case 1307:
+	case 1389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4980
+//line sql.y:5368
 		{
 			skipToEnd(yylex)
 		}
@@ -117,6 +117,7 @@ func skipToEnd(yylex interface{}) {
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
   partSpecs     []*PartitionSpec
+  partitionOption *PartitionOption
   vindexParam   VindexParam
   vindexParams  []VindexParam
   showFilter    *ShowFilter
@@ -147,6 +148,16 @@ func skipToEnd(yylex interface{}) {
   alterOptions	   []AlterOption
   tableOption      *TableOption
   tableOptions     TableOptions
+  overClause       *OverClause
+  windowSpec       *WindowSpec
+  frameClause      *FrameClause
+  framePoint       *FramePoint
+  frameUnit        FrameUnit
+  windowDefinition *WindowDefinition
+  windowDefinitions WindowDefinitions
+  with             *With
+  cte              *CommonTableExpr
+  ctes             []*CommonTableExpr
 }
 
 %token LEX_ERROR
@@ -199,7 +210,7 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK INDEXES
 %token <bytes> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE COALESCE EXCHANGE REBUILD PARTITIONING REMOVE
-%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> MAXVALUE PARTITION PARTITIONS REORGANIZE LESS THAN PROCEDURE TRIGGER LINEAR LIST
 %token <bytes> VINDEX VINDEXES DIRECTORY NAME UPGRADE
 %token <bytes> STATUS VARIABLES WARNINGS CASCADED DEFINER OPTION SQL UNDEFINED
 %token <bytes> SEQUENCE MERGE TEMPTABLE INVOKER SECURITY FIRST AFTER LAST
@@ -240,13 +251,17 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
-%token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <bytes> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
-%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
+%token <bytes> UNUSED ARRAY DESCRIPTION EMPTY EXCEPT GROUPING GROUPS JSON_TABLE LATERAL MEMBER
+%token <bytes> OF RECURSIVE SYSTEM
+%token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <bytes> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
-%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
-%token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
-%token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
+%token <bytes> NESTED NETWORK_NAMESPACE NOWAIT OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRIVILEGE_CHECKS_USER PROCESS
+%token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESTART RETAIN REUSE REVERT ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
+%token <bytes> THREAD_PRIORITY TIES VCPU VISIBLE
+
+// Window functions
+%token <bytes> CUME_DIST DENSE_RANK FIRST_VALUE LAG LAST_VALUE LEAD NTH_VALUE NTILE OVER PERCENT_RANK RANK ROW_NUMBER WINDOW
+%token <bytes> CURRENT FOLLOWING NULLS PRECEDING RANGE RESPECT ROW ROWS UNBOUNDED
 
 // Explain tokens
 %token <bytes> FORMAT TREE VITESS TRADITIONAL
@@ -275,7 +290,7 @@ func skipToEnd(yylex interface{}) {
 %type <collateAndCharsets> create_options create_options_opt
 %type <boolean> default_optional
 %type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
+%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement revert_statement
 %type <statement> lock_statement unlock_statement
 %type <bytes2> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
@@ -307,6 +322,21 @@ func skipToEnd(yylex interface{}) {
 %type <ins> insert_data
 %type <expr> value value_expression num_val
 %type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict func_datetime_precision
+%type <expr> function_call_window
+%type <overClause> over_clause
+%type <windowSpec> window_spec
+%type <colIdent> window_name_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <frameUnit> frame_unit
+%type <framePoint> frame_point
+%type <expr> frame_expression
+%type <boolean> from_first_last_opt null_treatment_opt
+%type <windowDefinitions> window_opt window_definition_list
+%type <with> with_clause
+%type <cte> common_table_expr
+%type <ctes> common_table_expr_list
+%type <windowDefinition> window_definition
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list
@@ -383,7 +413,10 @@ func skipToEnd(yylex interface{}) {
 %type <indexOption> index_option lock_index algorithm_index using_index_type
 %type <indexOptions> index_option_list index_option_list_opt algorithm_lock_opt using_opt
 %type <constraintInfo> constraint_info check_constraint_info
-%type <partDefs> partition_definitions
+%type <partDefs> partition_definitions partition_definitions_opt
+%type <partitionOption> partition_options_opt partition_option partition_method
+%type <boolean> linear_opt
+%type <literal> partitions_num_opt
 %type <partDef> partition_definition
 %type <partSpec> partition_operation
 %type <vindexParam> vindex_param
@@ -417,6 +450,10 @@ command:
   {
     $$ = $1
   }
+| with_clause select_statement
+  {
+    $$ = SetWith($2, $1)
+  }
 | stream_statement
 | vstream_statement
 | insert_statement
@@ -444,6 +481,7 @@ command:
 | load_statement
 | lock_statement
 | unlock_statement
+| revert_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -484,6 +522,12 @@ load_statement:
     $$ = &Load{}
   }
 
+revert_statement:
+  REVERT STRING
+  {
+    $$ = &RevertMigration{UUID: string($2)}
+  }
+
 select_statement:
   base_select order_by_opt limit_opt lock_opt into_option
   {
@@ -552,10 +596,38 @@ vstream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8          9
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_opt
+  {
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
+  }
+
+with_clause:
+  WITH common_table_expr_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE common_table_expr_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+common_table_expr_list:
+  common_table_expr
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    $$ = []*CommonTableExpr{$1}
+  }
+| common_table_expr_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $2, Subquery: $4}
   }
 
 union_rhs:
@@ -862,10 +934,11 @@ database_or_schema:
 | SCHEMA
 
 table_spec:
-  '(' table_column_list ')' table_option_list_opt
+  '(' table_column_list ')' table_option_list_opt partition_options_opt
   {
     $$ = $2
     $$.Options = $4
+    $$.PartitionOption = $5
   }
 
 create_options_opt:
@@ -2022,6 +2095,13 @@ alter_statement:
     $1.AlterOptions = $2
     $$ = $1
   }
+| alter_table_prefix alter_commands_list partition_option
+  {
+    $1.FullyParsed = true
+    $1.AlterOptions = $2
+    $1.PartitionOption = $3
+    $$ = $1
+  }
 | alter_table_prefix alter_commands_list REMOVE PARTITIONING
   {
     $1.FullyParsed = true
@@ -2252,6 +2332,92 @@ partition_definition:
   {
     $$ = &PartitionDefinition{Name: $2, Maxvalue: true}
   }
+| PARTITION sql_id VALUES IN openb expression_list closeb
+  {
+    $$ = &PartitionDefinition{Name: $2, InValues: $6}
+  }
+| PARTITION sql_id
+  {
+    $$ = &PartitionDefinition{Name: $2}
+  }
+
+partition_options_opt:
+  {
+    $$ = nil
+  }
+| partition_option
+  {
+    $$ = $1
+  }
+
+partition_option:
+  PARTITION BY partition_method partitions_num_opt partition_definitions_opt
+  {
+    $3.Partitions = $4
+    $3.Definitions = $5
+    $$ = $3
+  }
+
+partition_method:
+  linear_opt ID openb expression closeb
+  {
+    if NewColIdent(string($2)).Lowered() != HashTypeStr {
+      yylex.Error("expecting hash, key, range or list partitioning")
+      return 1
+    }
+    $$ = &PartitionOption{Linear: $1, Type: HashPartitionType, Expr: $4}
+  }
+| linear_opt KEY openb closeb
+  {
+    $$ = &PartitionOption{Linear: $1, Type: KeyPartitionType}
+  }
+| linear_opt KEY openb column_list closeb
+  {
+    $$ = &PartitionOption{Linear: $1, Type: KeyPartitionType, ColList: $4}
+  }
+| RANGE openb expression closeb
+  {
+    $$ = &PartitionOption{Type: RangePartitionType, Expr: $3}
+  }
+| RANGE COLUMNS openb column_list closeb
+  {
+    $$ = &PartitionOption{Type: RangePartitionType, ColList: $4}
+  }
+| LIST openb expression closeb
+  {
+    $$ = &PartitionOption{Type: ListPartitionType, Expr: $3}
+  }
+| LIST COLUMNS openb column_list closeb
+  {
+    $$ = &PartitionOption{Type: ListPartitionType, ColList: $4}
+  }
+
+linear_opt:
+  {
+    $$ = false
+  }
+| LINEAR
+  {
+    $$ = true
+  }
+
+partitions_num_opt:
+  {
+    $$ = nil
+  }
+| PARTITIONS INTEGRAL
+  {
+    $$ = NewIntLiteral($2)
+  }
+
+partition_definitions_opt:
+  {
+    $$ = nil
+  }
+| openb partition_definitions closeb
+  {
+    $$ = $2
+  }
 
 rename_statement:
   RENAME TABLE rename_list
@@ -2684,6 +2850,10 @@ explainable_statement:
   {
     $$ = $1
   }
+| with_clause select_statement
+  {
+    $$ = SetWith($2, $1)
+  }
 | update_statement  
   {
     $$ = $1
@@ -2986,6 +3156,10 @@ derived_table:
   {
     $$ = &DerivedTable{$2}
   }
+| openb with_clause select_statement closeb
+  {
+    $$ = &DerivedTable{SetWith($3, $2)}
+  }
 
 aliased_table_name:
 table_name as_opt_id index_hint_list
@@ -3382,6 +3556,10 @@ subquery:
   {
     $$ = &Subquery{$2}
   }
+| openb with_clause simple_select closeb
+  {
+    $$ = &Subquery{SetWith($3, $2)}
+  }
 
 expression_list:
   expression
@@ -3536,6 +3714,7 @@ value_expression:
 | function_call_keyword
 | function_call_nonkeyword
 | function_call_conflict
+| function_call_window
 
 /*
   Regular function calls without special token or syntax, guaranteed to not
@@ -3558,6 +3737,10 @@ function_call_generic:
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
 
 /*
   Function calls using reserved keywords, with dedicated grammar rules
@@ -3741,6 +3924,196 @@ function_call_conflict:
     $$ = &FuncExpr{Name: NewColIdent("substr"), Exprs: $3}
   }
 
+/*
+  Window functions. The names are reserved keywords, and an OVER
+  clause is required. Aggregate functions can also be called with
+  an OVER clause: they are handled by function_call_generic.
+*/
+function_call_window:
+  ROW_NUMBER openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("row_number"), Over: $4}
+  }
+| RANK openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("rank"), Over: $4}
+  }
+| DENSE_RANK openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("dense_rank"), Over: $4}
+  }
+| PERCENT_RANK openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("percent_rank"), Over: $4}
+  }
+| CUME_DIST openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("cume_dist"), Over: $4}
+  }
+| NTILE openb expression closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("ntile"), Exprs: SelectExprs{&AliasedExpr{Expr: $3}}, Over: $5}
+  }
+| LAG openb select_expression_list closeb null_treatment_opt over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("lag"), Exprs: $3, IgnoreNulls: $5, Over: $6}
+  }
+| LEAD openb select_expression_list closeb null_treatment_opt over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("lead"), Exprs: $3, IgnoreNulls: $5, Over: $6}
+  }
+| FIRST_VALUE openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("first_value"), Exprs: SelectExprs{&AliasedExpr{Expr: $3}}, IgnoreNulls: $5, Over: $6}
+  }
+| LAST_VALUE openb expression closeb null_treatment_opt over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("last_value"), Exprs: SelectExprs{&AliasedExpr{Expr: $3}}, IgnoreNulls: $5, Over: $6}
+  }
+| NTH_VALUE openb expression ',' expression closeb from_first_last_opt null_treatment_opt over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("nth_value"), Exprs: SelectExprs{&AliasedExpr{Expr: $3}, &AliasedExpr{Expr: $5}}, FromLast: $7, IgnoreNulls: $8, Over: $9}
+  }
+
+from_first_last_opt:
+  {
+    $$ = false
+  }
+| FROM FIRST
+  {
+    $$ = false
+  }
+| FROM LAST
+  {
+    $$ = true
+  }
+
+null_treatment_opt:
+  {
+    $$ = false
+  }
+| RESPECT NULLS
+  {
+    $$ = false
+  }
+| IGNORE NULLS
+  {
+    $$ = true
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpec{Name: $1, PartitionBy: $2, OrderBy: $3, Frame: $4}
+  }
+
+window_name_opt:
+  {
+    $$ = ColIdent{}
+  }
+| id_or_var
+  {
+    $$ = $1
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsUnit
+  }
+| RANGE
+  {
+    $$ = RangeUnit
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRow}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPreceding}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowing}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPreceding, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowing, Expr: $1}
+  }
+
+// MySQL only allows non-negative numbers, parameters and
+// intervals (for RANGE frames) as frame bounds.
+frame_expression:
+  value
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
+window_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, Spec: $4}
+  }
+
 match_option:
 /*empty*/
   {
@@ -4318,6 +4691,10 @@ insert_data:
   {
     $$ = &Insert{Rows: $1}
   }
+| with_clause select_statement
+  {
+    $$ = &Insert{Rows: SetWith($2, $1)}
+  }
 | openb ins_column_list closeb VALUES tuple_list
   {
     $$ = &Insert{Columns: $2, Rows: $5}
@@ -4330,6 +4707,10 @@ insert_data:
   {
     $$ = &Insert{Columns: $2, Rows: $4}
   }
+| openb ins_column_list closeb with_clause select_statement
+  {
+    $$ = &Insert{Columns: $2, Rows: SetWith($5, $4)}
+  }
 
 ins_column_list:
   sql_id
@@ -4651,6 +5032,7 @@ reserved_keyword:
 | PARTITION
 | PERCENT_RANK
 | PRIMARY
+| RANGE
 | RANK
 | READ
 | RECURSIVE
@@ -4735,6 +5117,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -4801,6 +5184,7 @@ non_reserved_keyword:
 | LEVEL
 | LINES
 | LINESTRING
+| LIST
 | LOAD
 | LOCAL
 | LOCKED
@@ -4848,6 +5232,7 @@ non_reserved_keyword:
 | PACK_KEYS
 | PARSER
 | PARTITIONING
+| PARTITIONS
 | PASSWORD
 | PATH
 | PERSIST
@@ -4879,8 +5264,11 @@ non_reserved_keyword:
 | RESTART
 | RETAIN
 | REUSE
+| REVERT
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | ROW_FORMAT
 | S3
 | SECONDARY
@@ -143,6 +143,25 @@ var keywords = map[string]int{
 	"continue":            UNUSED,
 	"convert":             CONVERT,
 	"copy":                COPY,
+	"cume_dist":           CUME_DIST,
+	"current":             CURRENT,
+	"dense_rank":          DENSE_RANK,
+	"first_value":         FIRST_VALUE,
+	"following":           FOLLOWING,
+	"lag":                 LAG,
+	"last_value":          LAST_VALUE,
+	"lead":                LEAD,
+	"nth_value":           NTH_VALUE,
+	"ntile":               NTILE,
+	"nulls":               NULLS,
+	"over":                OVER,
+	"percent_rank":        PERCENT_RANK,
+	"preceding":           PRECEDING,
+	"rank":                RANK,
+	"respect":             RESPECT,
+	"row":                 ROW,
+	"row_number":          ROW_NUMBER,
+	"rows":                ROWS,
 	"substr":              SUBSTR,
 	"substring":           SUBSTRING,
 	"create":              CREATE,
@@ -281,9 +300,10 @@ var keywords = map[string]int{
 	"level":               LEVEL,
 	"like":                LIKE,
 	"limit":               LIMIT,
-	"linear":              UNUSED,
+	"linear":              LINEAR,
 	"lines":               LINES,
 	"linestring":          LINESTRING,
+	"list":                LIST,
 	"load":                LOAD,
 	"local":               LOCAL,
 	"localtime":           LOCALTIME,
@@ -344,6 +364,7 @@ var keywords = map[string]int{
 	"parser":              PARSER,
 	"partition":           PARTITION,
 	"partitioning":        PARTITIONING,
+	"partitions":          PARTITIONS,
 	"password":            PASSWORD,
 	"plugins":             PLUGINS,
 	"point":               POINT,
@@ -354,12 +375,13 @@ var keywords = map[string]int{
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
 	"rebuild":             REBUILD,
+	"recursive":           RECURSIVE,
 	"redundant":           REDUNDANT,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
@@ -375,6 +397,7 @@ var keywords = map[string]int{
 	"resignal":            UNUSED,
 	"restrict":            RESTRICT,
 	"return":              UNUSED,
+	"revert":              REVERT,
 	"revoke":              UNUSED,
 	"right":               RIGHT,
 	"rlike":               REGEXP,
@@ -421,6 +444,7 @@ var keywords = map[string]int{
 	"stored":              UNUSED,
 	"straight_join":       STRAIGHT_JOIN,
 	"stream":              STREAM,
+	"unbounded":           UNBOUNDED,
 	"vstream":             VSTREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
@@ -481,6 +505,7 @@ var keywords = map[string]int{
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"without":             WITHOUT,
 	"work":                WORK,
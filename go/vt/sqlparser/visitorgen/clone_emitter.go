@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package visitorgen
+
+import (
+	"sort"
+)
+
+// EmitCloneFunctions produces the functions that deep copy the types of the visitor plan:
+// a Clone function for every type, one for every interface that is used as the type of a
+// field, and CloneSQLNode, that switches on the type of its argument. The fields that are
+// not SQLNodes are copied as they are.
+func EmitCloneFunctions(vd *VisitorPlan, input *SourceInformation) string {
+	var sb builder
+	interfaces := make(map[string]bool)
+	for _, s := range vd.Switches {
+		for _, f := range s.Fields {
+			var typ Type
+			switch f := f.(type) {
+			case *SingleFieldItem:
+				typ = f.FieldType
+			case *ArrayFieldItem:
+				typ = f.ItemType
+			case *ArrayItem:
+				typ = f.ItemType
+			}
+			if input.interfaces[typ.toTypString()] {
+				interfaces[typ.toTypString()] = true
+			}
+		}
+	}
+	delete(interfaces, "SQLNode")
+
+	switches := append([]*SwitchCase(nil), vd.Switches...)
+	sort.SliceStable(switches, func(i, j int) bool {
+		return switches[i].Type.toTypString() < switches[j].Type.toTypString()
+	})
+
+	sb.appendF("// CloneSQLNode creates a deep clone of the input.")
+	sb.appendF("func CloneSQLNode(node SQLNode) SQLNode {")
+	sb.appendF("	switch n := node.(type) {")
+	sb.appendF("	case nil:")
+	sb.appendF("		return nil")
+	for _, s := range switches {
+		sb.appendF("	case %s:", s.Type.toTypString())
+		sb.appendF("		return %s(n)", cloneFuncName(s.Type))
+	}
+	sb.appendF("	default:")
+	sb.appendF(`		panic("unknown ast type " + reflect.TypeOf(node).String())`)
+	sb.appendF("	}")
+	sb.appendF("}")
+
+	var names []string
+	for name := range interfaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sb.newLine()
+		sb.appendF("// Clone%s creates a deep clone of the input.", name)
+		sb.appendF("func Clone%s(in %s) %s {", name, name, name)
+		sb.appendF("	if in == nil {")
+		sb.appendF("		return nil")
+		sb.appendF("	}")
+		sb.appendF("	return CloneSQLNode(in).(%s)", name)
+		sb.appendF("}")
+	}
+
+	for _, s := range switches {
+		sb.newLine()
+		sb.appendF("%s", asCloneFunc(s, input))
+	}
+	return sb.String()
+}
+
+// cloneFuncName returns the name of the Clone function of a type.
+func cloneFuncName(typ Type) string {
+	return "Clone" + cloneTypeName(typ)
+}
+
+func cloneTypeName(typ Type) string {
+	switch typ := typ.(type) {
+	case *Ref:
+		return "RefOf" + cloneTypeName(typ.inner)
+	case *Array:
+		return "SliceOf" + cloneTypeName(typ.inner)
+	}
+	return typ.toTypString()
+}
+
+// asCloneFunc returns the Clone function of the type of a switch case.
+func asCloneFunc(s *SwitchCase, input *SourceInformation) string {
+	var sb builder
+	typ := s.Type.toTypString()
+	sb.appendF("// %s creates a deep clone of the input.", cloneFuncName(s.Type))
+	sb.appendF("func %s(n %s) %s {", cloneFuncName(s.Type), typ, typ)
+
+	_, isRef := s.Type.(*Ref)
+	_, isStruct := input.structs[s.Type.rawTypeName()]
+	switch {
+	case isStruct:
+		if isRef {
+			sb.appendF("	if n == nil {")
+			sb.appendF("		return nil")
+			sb.appendF("	}")
+			sb.appendF("	out := *n")
+		} else {
+			sb.appendF("	out := n")
+		}
+		for _, f := range s.Fields {
+			switch f := f.(type) {
+			case *SingleFieldItem:
+				sb.appendF("	out.%s = %s(n.%s)", f.FieldName, cloneFuncName(f.FieldType), f.FieldName)
+			case *ArrayFieldItem:
+				sb.appendF("	if n.%s != nil {", f.FieldName)
+				sb.appendF("		out.%s = make([]%s, len(n.%s))", f.FieldName, f.ItemType.toTypString(), f.FieldName)
+				sb.appendF("		for i, item := range n.%s {", f.FieldName)
+				sb.appendF("			out.%s[i] = %s(item)", f.FieldName, cloneFuncName(f.ItemType))
+				sb.appendF("		}")
+				sb.appendF("	}")
+			}
+		}
+		if isRef {
+			sb.appendF("	return &out")
+		} else {
+			sb.appendF("	return out")
+		}
+	case !isRef && input.getItemTypeOfArray(s.Type) != nil:
+		sb.appendF("	if n == nil {")
+		sb.appendF("		return nil")
+		sb.appendF("	}")
+		sb.appendF("	out := make(%s, len(n))", typ)
+		if len(s.Fields) == 0 {
+			sb.appendF("	copy(out, n)")
+		} else {
+			item := s.Fields[0].(*ArrayItem)
+			sb.appendF("	for i, item := range n {")
+			sb.appendF("		out[i] = %s(item)", cloneFuncName(item.ItemType))
+			sb.appendF("	}")
+		}
+		sb.appendF("	return out")
+	default:
+		sb.appendF("	return n")
+	}
+	sb.appendF("}")
+	return sb.String()
+}
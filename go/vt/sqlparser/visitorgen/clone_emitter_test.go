@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package visitorgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneFunc(t *testing.T) {
+	input := &SourceInformation{
+		structs: map[string]*StructDeclaration{"Struct": {name: "Struct"}},
+		typeAliases: map[string]*TypeAlias{
+			"Exprs": {name: "Exprs", typ: &Array{&TypeString{"Expr"}}},
+		},
+		interfaces: map[string]bool{"Expr": true},
+	}
+	structType := &Ref{&TypeString{"Struct"}}
+	s := &SwitchCase{
+		Type: structType,
+		Fields: []VisitorItem{&SingleFieldItem{
+			StructType: structType,
+			FieldType:  &TypeString{"Expr"},
+			FieldName:  "Field",
+		}, &ArrayFieldItem{
+			StructType: structType,
+			ItemType:   &Ref{&TypeString{"Struct"}},
+			FieldName:  "Items",
+		}},
+	}
+	require.Equal(t, `// CloneRefOfStruct creates a deep clone of the input.
+func CloneRefOfStruct(n *Struct) *Struct {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Field = CloneExpr(n.Field)
+	if n.Items != nil {
+		out.Items = make([]*Struct, len(n.Items))
+		for i, item := range n.Items {
+			out.Items[i] = CloneRefOfStruct(item)
+		}
+	}
+	return &out
+}`, asCloneFunc(s, input))
+
+	s = &SwitchCase{
+		Type: &TypeString{"Exprs"},
+		Fields: []VisitorItem{&ArrayItem{
+			StructType: &TypeString{"Exprs"},
+			ItemType:   &TypeString{"Expr"},
+		}},
+	}
+	require.Equal(t, `// CloneExprs creates a deep clone of the input.
+func CloneExprs(n Exprs) Exprs {
+	if n == nil {
+		return nil
+	}
+	out := make(Exprs, len(n))
+	for i, item := range n {
+		out[i] = CloneExpr(item)
+	}
+	return out
+}`, asCloneFunc(s, input))
+}
@@ -34,12 +34,13 @@ import (
 var (
 	inputFile  = flag.String("input", "", "input file to use")
 	outputFile = flag.String("output", "", "output file")
+	cloneFile  = flag.String("clone", "", "output file for the clone functions, if any")
 	compare    = flag.Bool("compareOnly", false, "instead of writing to the output file, compare if the generated visitor is still valid for this ast.go")
 )
 
 const usage = `Usage of visitorgen:
 
-go run /path/to/visitorgen/main -input=/path/to/ast.go -output=/path/to/rewriter.go
+go run /path/to/visitorgen/main -input=/path/to/ast.go -output=/path/to/rewriter.go [-clone=/path/to/clone.go]
 `
 
 func main() {
@@ -74,24 +75,36 @@ func main() {
 	fmt.Fprintln(b, typeSwitch)
 	fmt.Fprintln(b, fileFooter)
 
+	output(*outputFile, b.Bytes())
+
+	if *cloneFile != "" {
+		b := &bytes.Buffer{}
+		fmt.Fprint(b, cloneFileHeader)
+		fmt.Fprintln(b)
+		fmt.Fprintln(b, visitorgen.EmitCloneFunctions(vd, vp))
+		output(*cloneFile, b.Bytes())
+	}
+}
+
+// output writes a generated file, or compares it with the current one.
+func output(fileName string, data []byte) {
 	if *compare {
-		currentFile, err := ioutil.ReadFile(*outputFile)
+		currentFile, err := ioutil.ReadFile(fileName)
 		if err != nil {
 			log.Error(err)
 			exit.Return(1)
 		}
-		if !bytes.Equal(b.Bytes(), currentFile) {
-			fmt.Println("rewriter needs to be re-generated: go generate " + *outputFile)
+		if !bytes.Equal(data, currentFile) {
+			fmt.Println("visitor needs to be re-generated: go generate " + fileName)
 			exit.Return(1)
 		}
 	} else {
-		err = ioutil.WriteFile(*outputFile, b.Bytes(), 0644)
+		err := ioutil.WriteFile(fileName, data, 0644)
 		if err != nil {
 			log.Error(err)
 			exit.Return(1)
 		}
 	}
-
 }
 
 func printUsage() {
@@ -104,7 +117,7 @@ const fileHeader = `// Code generated by visitorgen/main/main.go. DO NOT EDIT.
 
 package sqlparser
 
-//go:generate go run ./visitorgen/main -input=ast.go -output=rewriter.go
+//go:generate go run ./visitorgen/main -input=ast.go -output=rewriter.go -clone=clone.go
 
 import (
 	"reflect"
@@ -162,3 +175,12 @@ func isNilValue(i interface{}) bool {
 	isNullable := kind == reflect.Ptr || kind == reflect.Array || kind == reflect.Slice
 	return isNullable && valueOf.IsNil()
 }`
+
+const cloneFileHeader = `// Code generated by visitorgen/main/main.go. DO NOT EDIT.
+
+package sqlparser
+
+import (
+	"reflect"
+)
+`
@@ -29,5 +29,5 @@ limitations under the License.
 //			used, a VisitorPlan. This is focused on the output - it contains a list of all fields or
 //			arrays that need to be handled by the visitor produced.
 //Step 4:	The VisitorPlan is lastly turned into a string that is written as the output of
-//			this whole process.
+//			this whole process. The same plan also produces the Clone functions of the AST.
 package visitorgen
@@ -0,0 +1,608 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/yaml2"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// TopoExport is the portable representation of the data of a topo
+// server, or of a subtree of it. It is written by ExportTopo and read
+// back by ImportTopo, and can be serialized as JSON or YAML.
+type TopoExport struct {
+	// Cells has the exported files, by cell. The global cell is
+	// named topo.GlobalCell.
+	Cells map[string][]*ExportFile `json:"cells"`
+}
+
+// ExportFile is one exported topo file.
+type ExportFile struct {
+	// Path is the path of the file, relative to the root of the cell.
+	Path string `json:"path"`
+
+	// Value is the JSON representation of the files that contain
+	// a known proto, like Keyspace or Tablet files.
+	Value json.RawMessage `json:"value,omitempty"`
+
+	// Contents are the raw contents of the other files.
+	Contents []byte `json:"contents,omitempty"`
+}
+
+// ImportAction is what ImportTopo does with an exported file.
+type ImportAction string
+
+const (
+	// ImportCreate means the file doesn't exist and is created.
+	ImportCreate = ImportAction("create")
+
+	// ImportUpdate means the file exists with different contents,
+	// and is overwritten.
+	ImportUpdate = ImportAction("update")
+
+	// ImportUnchanged means the file exists with the same contents.
+	ImportUnchanged = ImportAction("unchanged")
+
+	// ImportConflict means the file exists with different contents,
+	// and is left alone.
+	ImportConflict = ImportAction("conflict")
+)
+
+// ImportResult is the action taken for one file by ImportTopo.
+type ImportResult struct {
+	Cell   string       `json:"cell"`
+	Path   string       `json:"path"`
+	Action ImportAction `json:"action"`
+}
+
+// ImportOptions are the options of ImportTopo.
+type ImportOptions struct {
+	// DryRun only reports what would be done.
+	DryRun bool
+
+	// Overwrite replaces the files that exist with different
+	// contents. Without it, ImportTopo fails if any file is in
+	// conflict. All the cells are checked before anything is
+	// written, except the cells that only exist once the global
+	// cell is imported: they are checked after the global cell is
+	// written.
+	Overwrite bool
+}
+
+// DiffType is the kind of difference found by DiffTopos.
+type DiffType string
+
+const (
+	// DiffOnlyInFrom means the file only exists in the 'from' topo.
+	DiffOnlyInFrom = DiffType("only_in_from")
+
+	// DiffOnlyInTo means the file only exists in the 'to' topo.
+	DiffOnlyInTo = DiffType("only_in_to")
+
+	// DiffContents means the file exists in both topos, with
+	// different contents.
+	DiffContents = DiffType("contents")
+)
+
+// TopoDiff is a difference between two topos.
+type TopoDiff struct {
+	Cell string   `json:"cell"`
+	Path string   `json:"path"`
+	Type DiffType `json:"type"`
+
+	// From and To are the two versions of the file, if it exists.
+	From *ExportFile `json:"from,omitempty"`
+	To   *ExportFile `json:"to,omitempty"`
+}
+
+// ExportTopo exports the files of the given cells of a topo server.
+// If cells is empty, the global cell and all the cells are exported.
+// If subtree is set, only the files under that directory are exported.
+// Ephemeral files, like locks and master elections, are never exported.
+func ExportTopo(ctx context.Context, ts *topo.Server, cells []string, subtree string) (*TopoExport, error) {
+	if len(cells) == 0 {
+		var err error
+		if cells, err = allCells(ctx, ts); err != nil {
+			return nil, err
+		}
+	}
+	export := &TopoExport{
+		Cells: make(map[string][]*ExportFile),
+	}
+	for _, cell := range cells {
+		conn, err := ts.ConnForCell(ctx, cell)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "ConnForCell(%v)", cell)
+		}
+		files, err := exportCell(ctx, conn, cell, subtree)
+		if err != nil {
+			return nil, err
+		}
+		export.Cells[cell] = files
+	}
+	return export, nil
+}
+
+// allCells returns the global cell and the cells of a topo server.
+func allCells(ctx context.Context, ts *topo.Server) ([]string, error) {
+	cells, err := ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetCellInfoNames")
+	}
+	return append([]string{topo.GlobalCell}, cells...), nil
+}
+
+// exportCell returns the files of the subtree of a cell, sorted by path.
+func exportCell(ctx context.Context, conn topo.Conn, cell, subtree string) ([]*ExportFile, error) {
+	files := make([]*ExportFile, 0)
+	if err := exportDir(ctx, conn, strings.Trim(subtree, "/"), &files); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot export cell %v", cell)
+	}
+	sortFiles(files)
+	return files, nil
+}
+
+// sortFiles sorts files by path. The files of a directory are listed
+// depth first, which is not the order of their paths: "ks-2/Keyspace"
+// sorts before "ks/Keyspace".
+func sortFiles(files []*ExportFile) {
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+}
+
+// exportDir appends the files of a directory to files, recursively.
+// A directory that doesn't exist has no files.
+func exportDir(ctx context.Context, conn topo.Conn, dir string, files *[]*ExportFile) error {
+	listPath := dir
+	if listPath == "" {
+		listPath = "/"
+	}
+	entries, err := conn.ListDir(ctx, listPath, true /*full*/)
+	switch {
+	case err == nil:
+	case topo.IsErrType(err, topo.NoNode):
+		return nil
+	default:
+		return vterrors.Wrapf(err, "ListDir(%v)", listPath)
+	}
+
+	for _, e := range entries {
+		if e.Ephemeral {
+			continue
+		}
+		p := path.Join(dir, e.Name)
+		if e.Type == topo.TypeDirectory {
+			if err := exportDir(ctx, conn, p, files); err != nil {
+				return err
+			}
+			continue
+		}
+		data, _, err := conn.Get(ctx, p)
+		switch {
+		case err == nil:
+		case topo.IsErrType(err, topo.NoNode):
+			// Deleted since we listed the directory.
+			continue
+		default:
+			return vterrors.Wrapf(err, "Get(%v)", p)
+		}
+		file, err := newExportFile(p, data)
+		if err != nil {
+			return err
+		}
+		*files = append(*files, file)
+	}
+	return nil
+}
+
+// newExportFile returns the exported form of a file.
+func newExportFile(filePath string, data []byte) (*ExportFile, error) {
+	pb := protoForFile(filePath)
+	if pb == nil {
+		return &ExportFile{Path: filePath, Contents: data}, nil
+	}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot decode %v", filePath)
+	}
+	value, err := json2.MarshalPB(pb)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot encode %v", filePath)
+	}
+	return &ExportFile{Path: filePath, Value: value}, nil
+}
+
+// protoForFile returns a new proto of the type stored in a topo file,
+// based on its name, or nil if the file doesn't contain a known proto.
+func protoForFile(filePath string) proto.Message {
+	switch path.Base(filePath) {
+	case topo.CellInfoFile:
+		return new(topodatapb.CellInfo)
+	case topo.CellsAliasFile:
+		return new(topodatapb.CellsAlias)
+	case topo.KeyspaceFile:
+		return new(topodatapb.Keyspace)
+	case topo.ShardFile:
+		return new(topodatapb.Shard)
+	case topo.VSchemaFile:
+		return new(vschemapb.Keyspace)
+	case topo.ShardReplicationFile:
+		return new(topodatapb.ShardReplication)
+	case topo.TabletFile:
+		return new(topodatapb.Tablet)
+	case topo.SrvVSchemaFile:
+		return new(vschemapb.SrvVSchema)
+	case topo.SrvKeyspaceFile:
+		return new(topodatapb.SrvKeyspace)
+	case topo.RoutingRulesFile:
+		return new(vschemapb.RoutingRules)
+	}
+	return nil
+}
+
+// data returns the contents of the file, as stored in the topo.
+func (f *ExportFile) data() ([]byte, error) {
+	if f.Value == nil {
+		return f.Contents, nil
+	}
+	pb := protoForFile(f.Path)
+	if pb == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "file %v has a value, but doesn't contain a known proto", f.Path)
+	}
+	if err := json2.Unmarshal(f.Value, pb); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot decode the value of %v", f.Path)
+	}
+	return proto.Marshal(pb)
+}
+
+// sameData returns true if two contents of a file are the same. Protos
+// are compared decoded, as equal protos can have different encodings.
+func sameData(filePath string, a, b []byte) (bool, error) {
+	pbA := protoForFile(filePath)
+	if pbA == nil {
+		return bytes.Equal(a, b), nil
+	}
+	pbB := protoForFile(filePath)
+	if err := proto.Unmarshal(a, pbA); err != nil {
+		return false, vterrors.Wrapf(err, "cannot decode %v", filePath)
+	}
+	if err := proto.Unmarshal(b, pbB); err != nil {
+		return false, vterrors.Wrapf(err, "cannot decode %v", filePath)
+	}
+	return proto.Equal(pbA, pbB), nil
+}
+
+// ImportTopo writes the files of an export to a topo server. The
+// global cell is imported first, so the cells it defines can be
+// imported next. It returns what was done, or what would be done in
+// a dry run, for each file.
+func ImportTopo(ctx context.Context, ts *topo.Server, export *TopoExport, opts ImportOptions) ([]*ImportResult, error) {
+	var results []*ImportResult
+	var plans []*cellImport
+	var pending []string
+	for _, cell := range exportCells(export) {
+		conn, err := ts.ConnForCell(ctx, cell)
+		if err != nil {
+			if topo.IsErrType(err, topo.NoNode) && cell != topo.GlobalCell {
+				// The cell may be created by the import of the
+				// global cell.
+				pending = append(pending, cell)
+				continue
+			}
+			return results, vterrors.Wrapf(err, "ConnForCell(%v)", cell)
+		}
+		plan, err := planCellImport(ctx, conn, cell, export.Cells[cell], opts)
+		results = append(results, plan.results()...)
+		if err != nil {
+			return results, err
+		}
+		plans = append(plans, plan)
+	}
+
+	if opts.DryRun {
+		for _, cell := range pending {
+			for _, f := range export.Cells[cell] {
+				results = append(results, &ImportResult{Cell: cell, Path: f.Path, Action: ImportCreate})
+			}
+		}
+		return results, nil
+	}
+	// Nothing is written unless all the cells can be imported.
+	for _, plan := range plans {
+		if err := plan.checkConflicts(); err != nil {
+			return results, err
+		}
+	}
+	for _, plan := range plans {
+		if err := plan.write(ctx); err != nil {
+			return results, err
+		}
+	}
+
+	for _, cell := range pending {
+		conn, err := ts.ConnForCell(ctx, cell)
+		if err != nil {
+			return results, vterrors.Wrapf(err, "ConnForCell(%v)", cell)
+		}
+		plan, err := planCellImport(ctx, conn, cell, export.Cells[cell], opts)
+		results = append(results, plan.results()...)
+		if err != nil {
+			return results, err
+		}
+		if err := plan.checkConflicts(); err != nil {
+			return results, err
+		}
+		if err := plan.write(ctx); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// cellImport is what ImportTopo does with the files of a cell.
+type cellImport struct {
+	cell      string
+	conn      topo.Conn
+	writes    []*fileImport
+	conflicts int
+}
+
+// fileImport is what ImportTopo does with one file.
+type fileImport struct {
+	result  *ImportResult
+	data    []byte
+	version topo.Version
+}
+
+// planCellImport compares the files of a cell with the exported ones.
+func planCellImport(ctx context.Context, conn topo.Conn, cell string, files []*ExportFile, opts ImportOptions) (*cellImport, error) {
+	plan := &cellImport{cell: cell, conn: conn}
+	for _, f := range files {
+		data, err := f.data()
+		if err != nil {
+			return plan, err
+		}
+		result := &ImportResult{Cell: cell, Path: f.Path}
+		current, version, err := conn.Get(ctx, f.Path)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			result.Action = ImportCreate
+			version = nil
+		case err != nil:
+			return plan, vterrors.Wrapf(err, "Get(%v)", f.Path)
+		default:
+			same, err := sameData(f.Path, current, data)
+			if err != nil {
+				return plan, err
+			}
+			switch {
+			case same:
+				result.Action = ImportUnchanged
+			case opts.Overwrite:
+				result.Action = ImportUpdate
+			default:
+				result.Action = ImportConflict
+				plan.conflicts++
+			}
+		}
+		plan.writes = append(plan.writes, &fileImport{result: result, data: data, version: version})
+	}
+	return plan, nil
+}
+
+func (plan *cellImport) results() []*ImportResult {
+	results := make([]*ImportResult, 0, len(plan.writes))
+	for _, w := range plan.writes {
+		results = append(results, w.result)
+	}
+	return results
+}
+
+func (plan *cellImport) checkConflicts() error {
+	if plan.conflicts > 0 {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%v file(s) of cell %v already exist with different contents", plan.conflicts, plan.cell)
+	}
+	return nil
+}
+
+func (plan *cellImport) write(ctx context.Context) error {
+	for _, w := range plan.writes {
+		var err error
+		switch w.result.Action {
+		case ImportCreate:
+			_, err = plan.conn.Create(ctx, w.result.Path, w.data)
+		case ImportUpdate:
+			_, err = plan.conn.Update(ctx, w.result.Path, w.data, w.version)
+		default:
+			continue
+		}
+		if err != nil {
+			return vterrors.Wrapf(err, "cannot %v %v in cell %v", w.result.Action, w.result.Path, plan.cell)
+		}
+	}
+	return nil
+}
+
+// exportCells returns the cells of an export, with the global cell
+// first.
+func exportCells(export *TopoExport) []string {
+	cells := make([]string, 0, len(export.Cells))
+	for cell := range export.Cells {
+		cells = append(cells, cell)
+	}
+	sort.Slice(cells, func(i, j int) bool {
+		if cells[i] == topo.GlobalCell || cells[j] == topo.GlobalCell {
+			return cells[i] == topo.GlobalCell
+		}
+		return cells[i] < cells[j]
+	})
+	return cells
+}
+
+// DiffTopos compares the files of the given cells of two topo
+// servers. If cells is empty, the global cell and the cells of both
+// servers are compared. If subtree is set, only the files under that
+// directory are compared.
+func DiffTopos(ctx context.Context, fromTS, toTS *topo.Server, cells []string, subtree string) ([]*TopoDiff, error) {
+	if len(cells) == 0 {
+		fromCells, err := allCells(ctx, fromTS)
+		if err != nil {
+			return nil, err
+		}
+		toCells, err := allCells(ctx, toTS)
+		if err != nil {
+			return nil, err
+		}
+		cells = mergeCells(fromCells, toCells)
+	}
+	var diffs []*TopoDiff
+	for _, cell := range cells {
+		d, err := DiffCells(ctx, fromTS, cell, toTS, cell, subtree)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, d...)
+	}
+	return diffs, nil
+}
+
+// mergeCells returns the union of two lists of cells.
+func mergeCells(a, b []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, cell := range append(a, b...) {
+		if !seen[cell] {
+			seen[cell] = true
+			result = append(result, cell)
+		}
+	}
+	return result
+}
+
+// DiffCells compares the files of a cell of a topo server with the
+// files of a cell of another, or of the same, topo server. The
+// differences are reported under the name of the 'from' cell.
+func DiffCells(ctx context.Context, fromTS *topo.Server, fromCell string, toTS *topo.Server, toCell, subtree string) ([]*TopoDiff, error) {
+	fromFiles, err := exportCellIfExists(ctx, fromTS, fromCell, subtree)
+	if err != nil {
+		return nil, err
+	}
+	toFiles, err := exportCellIfExists(ctx, toTS, toCell, subtree)
+	if err != nil {
+		return nil, err
+	}
+	return diffFiles(fromCell, fromFiles, toFiles)
+}
+
+// exportCellIfExists is exportCell, with no files for a cell that
+// doesn't exist.
+func exportCellIfExists(ctx context.Context, ts *topo.Server, cell, subtree string) ([]*ExportFile, error) {
+	conn, err := ts.ConnForCell(ctx, cell)
+	switch {
+	case err == nil:
+		return exportCell(ctx, conn, cell, subtree)
+	case topo.IsErrType(err, topo.NoNode):
+		return nil, nil
+	default:
+		return nil, vterrors.Wrapf(err, "ConnForCell(%v)", cell)
+	}
+}
+
+// diffFiles compares two lists of files.
+func diffFiles(cell string, fromFiles, toFiles []*ExportFile) ([]*TopoDiff, error) {
+	fromFiles = append([]*ExportFile(nil), fromFiles...)
+	sortFiles(fromFiles)
+	toFiles = append([]*ExportFile(nil), toFiles...)
+	sortFiles(toFiles)
+
+	var diffs []*TopoDiff
+	i, j := 0, 0
+	for i < len(fromFiles) || j < len(toFiles) {
+		switch {
+		case j == len(toFiles) || (i < len(fromFiles) && fromFiles[i].Path < toFiles[j].Path):
+			diffs = append(diffs, &TopoDiff{Cell: cell, Path: fromFiles[i].Path, Type: DiffOnlyInFrom, From: fromFiles[i]})
+			i++
+		case i == len(fromFiles) || toFiles[j].Path < fromFiles[i].Path:
+			diffs = append(diffs, &TopoDiff{Cell: cell, Path: toFiles[j].Path, Type: DiffOnlyInTo, To: toFiles[j]})
+			j++
+		default:
+			from, to := fromFiles[i], toFiles[j]
+			i++
+			j++
+			fromData, err := from.data()
+			if err != nil {
+				return nil, err
+			}
+			toData, err := to.data()
+			if err != nil {
+				return nil, err
+			}
+			same, err := sameData(from.Path, fromData, toData)
+			if err != nil {
+				return nil, err
+			}
+			if !same {
+				diffs = append(diffs, &TopoDiff{Cell: cell, Path: from.Path, Type: DiffContents, From: from, To: to})
+			}
+		}
+	}
+	return diffs, nil
+}
+
+// MarshalTopoExport serializes an export as YAML if yaml is true, and
+// as JSON otherwise.
+func MarshalTopoExport(export *TopoExport, yaml bool) ([]byte, error) {
+	if yaml {
+		return yaml2.Marshal(export)
+	}
+	return json.MarshalIndent(export, "", "  ")
+}
+
+// UnmarshalTopoExport reads an export in JSON or YAML. As JSON is a
+// subset of YAML, both are accepted.
+func UnmarshalTopoExport(data []byte) (*TopoExport, error) {
+	export := &TopoExport{}
+	if err := yaml2.Unmarshal(data, export); err != nil {
+		return nil, vterrors.Wrap(err, "cannot parse the topo export")
+	}
+	for cell, files := range export.Cells {
+		sort.Slice(files, func(i, j int) bool {
+			return files[i].Path < files[j].Path
+		})
+		for _, f := range files {
+			if f.Path == "" {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a file of cell %v has no path", cell)
+			}
+		}
+	}
+	return export, nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+
+	export, err := ExportTopo(ctx, fromTS, nil, "")
+	require.NoError(t, err)
+	var globalPaths []string
+	for _, f := range export.Cells[topo.GlobalCell] {
+		globalPaths = append(globalPaths, f.Path)
+	}
+	assert.Equal(t, []string{
+		"RoutingRules",
+		"cells/test_cell/CellInfo",
+		"keyspaces/test_keyspace/Keyspace",
+		"keyspaces/test_keyspace/shards/0/Shard",
+	}, globalPaths)
+	assert.Len(t, export.Cells["test_cell"], 3)
+
+	// The export survives a round trip through both formats.
+	for _, yaml := range []bool{false, true} {
+		data, err := MarshalTopoExport(export, yaml)
+		require.NoError(t, err)
+		got, err := UnmarshalTopoExport(data)
+		require.NoError(t, err)
+		diffs, err := diffFiles("test_cell", export.Cells["test_cell"], got.Cells["test_cell"])
+		require.NoError(t, err)
+		assert.Empty(t, diffs, "yaml: %v", yaml)
+	}
+
+	diffs, err := DiffTopos(ctx, fromTS, toTS, nil, "")
+	require.NoError(t, err)
+	assert.Len(t, diffs, 6)
+	for _, d := range diffs {
+		assert.Equal(t, DiffOnlyInFrom, d.Type, d.Path)
+	}
+
+	// A dry run doesn't write anything.
+	results, err := ImportTopo(ctx, toTS, export, ImportOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, &ImportResult{Cell: topo.GlobalCell, Path: "cells/test_cell/CellInfo", Action: ImportUnchanged}, results[1])
+	assert.Equal(t, &ImportResult{Cell: topo.GlobalCell, Path: "keyspaces/test_keyspace/Keyspace", Action: ImportCreate}, results[2])
+	_, err = toTS.GetKeyspace(ctx, "test_keyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+
+	_, err = ImportTopo(ctx, toTS, export, ImportOptions{})
+	require.NoError(t, err)
+	diffs, err = DiffTopos(ctx, fromTS, toTS, nil, "")
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+	tablets, err := toTS.GetTabletsByCell(ctx, "test_cell")
+	require.NoError(t, err)
+	assert.Len(t, tablets, 2)
+
+	// Changed files are conflicts, unless they are overwritten.
+	_, err = toTS.UpdateShardFields(ctx, "test_keyspace", "0", func(si *topo.ShardInfo) error {
+		si.IsMasterServing = false
+		return nil
+	})
+	require.NoError(t, err)
+	diffs, err = DiffTopos(ctx, fromTS, toTS, []string{topo.GlobalCell}, "keyspaces")
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, DiffContents, diffs[0].Type)
+	assert.Equal(t, "keyspaces/test_keyspace/shards/0/Shard", diffs[0].Path)
+
+	results, err = ImportTopo(ctx, toTS, export, ImportOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, ImportConflict, results[3].Action)
+	si, err := toTS.GetShard(ctx, "test_keyspace", "0")
+	require.NoError(t, err)
+	assert.False(t, si.IsMasterServing)
+
+	results, err = ImportTopo(ctx, toTS, export, ImportOptions{Overwrite: true})
+	require.NoError(t, err)
+	assert.Equal(t, ImportUpdate, results[3].Action)
+	si, err = toTS.GetShard(ctx, "test_keyspace", "0")
+	require.NoError(t, err)
+	assert.True(t, si.IsMasterServing)
+}
+
+func TestDiffCells(t *testing.T) {
+	ctx := context.Background()
+	fromTS, _ := createSetup(ctx, t)
+	require.NoError(t, fromTS.CreateCellInfo(ctx, "other_cell", &topodatapb.CellInfo{}))
+
+	// The tablets and the shard replication are only in test_cell.
+	diffs, err := DiffCells(ctx, fromTS, "test_cell", fromTS, "other_cell", "")
+	require.NoError(t, err)
+	assert.Len(t, diffs, 3)
+	for _, d := range diffs {
+		assert.Equal(t, "test_cell", d.Cell)
+		assert.Equal(t, DiffOnlyInFrom, d.Type, d.Path)
+	}
+}
+
+func TestDiffHyphenatedNames(t *testing.T) {
+	ctx := context.Background()
+	fromTS := memorytopo.NewServer("test_cell")
+	toTS := memorytopo.NewServer("test_cell")
+	require.NoError(t, fromTS.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+	require.NoError(t, fromTS.CreateKeyspace(ctx, "ks-2", &topodatapb.Keyspace{}))
+	require.NoError(t, toTS.CreateKeyspace(ctx, "ks-2", &topodatapb.Keyspace{}))
+
+	// "keyspaces/ks-2/Keyspace" sorts before "keyspaces/ks/Keyspace".
+	diffs, err := DiffTopos(ctx, fromTS, toTS, []string{topo.GlobalCell}, "keyspaces")
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, DiffOnlyInFrom, diffs[0].Type)
+	assert.Equal(t, "keyspaces/ks/Keyspace", diffs[0].Path)
+}
+
+func TestImportConflictInCell(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+	export, err := ExportTopo(ctx, fromTS, nil, "")
+	require.NoError(t, err)
+
+	// A conflict in test_cell prevents the import of the global cell.
+	tablet, err := fromTS.GetTablet(ctx, &topodatapb.TabletAlias{Cell: "test_cell", Uid: 123})
+	require.NoError(t, err)
+	tablet.Hostname = "otherhost"
+	require.NoError(t, toTS.CreateTablet(ctx, tablet.Tablet))
+
+	results, err := ImportTopo(ctx, toTS, export, ImportOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cell test_cell")
+	assert.Equal(t, ImportCreate, results[2].Action)
+	_, err = toTS.GetKeyspace(ctx, "test_keyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// ListDir is part of the topo.Conn interface.
+func (s *Server) ListDir(ctx context.Context, dirPath string, full bool) ([]topo.DirEntry, error) {
+	nodePath := path.Join(s.root, dirPath) + "/"
+	if nodePath == "//" {
+		// Special case where s.root is "/", dirPath is empty,
+		// we would end up with "//". in that case, we want "/".
+		nodePath = "/"
+	}
+
+	query := fmt.Sprintf("SELECT path FROM %v WHERE path LIKE %v", s.files, encodeString(escapeLike(nodePath)+"%"))
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return nil, convertError(err, nodePath)
+	}
+	if len(qr.Rows) == 0 {
+		// No file starts with this prefix, means the directory
+		// doesn't exist.
+		return nil, topo.NewError(topo.NoNode, nodePath)
+	}
+
+	// Keep only the part until the first '/', a name can be
+	// there many times.
+	types := make(map[string]topo.DirEntryType)
+	for _, row := range qr.Rows {
+		p := strings.TrimPrefix(row[0].ToString(), nodePath)
+		t := topo.TypeFile
+		if i := strings.Index(p, "/"); i >= 0 {
+			p = p[:i]
+			t = topo.TypeDirectory
+		}
+		if _, ok := types[p]; !ok || t == topo.TypeDirectory {
+			types[p] = t
+		}
+	}
+
+	result := make([]topo.DirEntry, 0, len(types))
+	for name, t := range types {
+		e := topo.DirEntry{
+			Name: name,
+		}
+		if full {
+			e.Type = t
+		}
+		result = append(result, e)
+	}
+	topo.DirEntriesSortByName(result)
+	return result, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
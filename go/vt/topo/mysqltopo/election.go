@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// NewMasterParticipation is part of the topo.Server interface
+func (s *Server) NewMasterParticipation(name, id string) (topo.MasterParticipation, error) {
+	return &mysqlMasterParticipation{
+		s:    s,
+		name: name,
+		id:   id,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}, nil
+}
+
+// mysqlMasterParticipation implements topo.MasterParticipation.
+//
+// The master holds the lease row <root>/elections/<name> of the locks
+// table, that contains its id.
+type mysqlMasterParticipation struct {
+	// s is our parent MySQL topo Server
+	s *Server
+
+	// name is the name of this MasterParticipation
+	name string
+
+	// id is the process's current id.
+	id string
+
+	// stop is a channel closed when Stop is called.
+	stop chan struct{}
+
+	// done is a channel closed when we're done processing the Stop
+	done chan struct{}
+}
+
+// WaitForMastership is part of the topo.MasterParticipation interface.
+func (mp *mysqlMasterParticipation) WaitForMastership() (context.Context, error) {
+	// If Stop was already called, mp.done is closed, so we are interrupted.
+	select {
+	case <-mp.done:
+		return nil, topo.NewError(topo.Interrupted, "mastership")
+	default:
+	}
+
+	electionPath := path.Join(mp.s.root, electionsPath, mp.name)
+
+	// mu protects ld and stopped, which are shared with the routine
+	// that handles Stop.
+	var mu sync.Mutex
+	var ld *mysqlLockDescriptor
+	stopped := false
+
+	// We use a cancelable context here. If stop is closed,
+	// we just cancel that context.
+	lockCtx, lockCancel := context.WithCancel(context.Background())
+	go func() {
+		<-mp.stop
+		mu.Lock()
+		stopped = true
+		held := ld
+		mu.Unlock()
+		lockCancel()
+		if held != nil {
+			if err := held.Unlock(context.Background()); err != nil {
+				log.Errorf("failed to unlock electionPath %v: %v", electionPath, err)
+			}
+		}
+		close(mp.done)
+	}()
+
+	// Try to get the mastership, by getting the lease.
+	held, err := mp.s.lock(lockCtx, electionPath, mp.id)
+	if err != nil {
+		// It can be that we were interrupted.
+		return nil, err
+	}
+	mu.Lock()
+	if stopped {
+		mu.Unlock()
+		if err := held.Unlock(context.Background()); err != nil {
+			log.Errorf("failed to unlock electionPath %v: %v", electionPath, err)
+		}
+		return nil, topo.NewError(topo.Interrupted, "mastership")
+	}
+	ld = held
+	mu.Unlock()
+
+	// If the lease is lost, we're not the master any more.
+	go func() {
+		select {
+		case <-held.lost:
+			lockCancel()
+		case <-lockCtx.Done():
+		}
+	}()
+
+	// We got the lease. Return the lockContext. If Stop() is called,
+	// it will cancel the lockCtx, and cancel the returned context.
+	return lockCtx, nil
+}
+
+// Stop is part of the topo.MasterParticipation interface
+func (mp *mysqlMasterParticipation) Stop() {
+	close(mp.stop)
+	<-mp.done
+}
+
+// GetCurrentMasterID is part of the topo.MasterParticipation interface
+func (mp *mysqlMasterParticipation) GetCurrentMasterID(ctx context.Context) (string, error) {
+	electionPath := path.Join(mp.s.root, electionsPath, mp.name)
+	query := fmt.Sprintf("SELECT contents FROM %v WHERE path = %v AND expiration >= NOW(6)", mp.s.locks, encodeString(electionPath))
+	qr, err := mp.s.exec(ctx, electionPath, query)
+	if err != nil {
+		return "", convertError(err, electionPath)
+	}
+	if len(qr.Rows) == 0 {
+		// Nobody is the master.
+		return "", nil
+	}
+	return qr.Rows[0][0].ToString(), nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// Create is part of the topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	query := fmt.Sprintf("INSERT INTO %v (path, contents, version) VALUES (%v, %v, 1)", s.files, encodeString(nodePath), encodeBytes(contents))
+	if _, err := s.exec(ctx, nodePath, query); err != nil {
+		if isDupEntry(err) {
+			return nil, topo.NewError(topo.NodeExists, nodePath)
+		}
+		return nil, convertError(err, nodePath)
+	}
+	return MySQLVersion(1), nil
+}
+
+// Update is part of the topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+
+	if version != nil {
+		// Compare-and-set on the version column.
+		v := version.(MySQLVersion)
+		query := fmt.Sprintf("UPDATE %v SET contents = %v, version = version + 1 WHERE path = %v AND version = %v", s.files, encodeBytes(contents), encodeString(nodePath), uint64(v))
+		qr, err := s.exec(ctx, nodePath, query)
+		if err != nil {
+			return nil, convertError(err, nodePath)
+		}
+		if qr.RowsAffected == 0 {
+			// Either the file doesn't exist, or its version changed.
+			if _, _, err := s.get(ctx, nodePath); err != nil {
+				return nil, err
+			}
+			return nil, topo.NewError(topo.BadVersion, nodePath)
+		}
+		return v + 1, nil
+	}
+
+	// Unconditional update, which creates the file if needed. The
+	// row is locked until the end of the transaction, so we read the
+	// version we wrote.
+	var newVersion MySQLVersion
+	err := s.inTransaction(ctx, nodePath, func(conn *dbconnpool.PooledDBConnection) error {
+		query := fmt.Sprintf("INSERT INTO %v (path, contents, version) VALUES (%v, %v, 1) ON DUPLICATE KEY UPDATE contents = VALUES(contents), version = version + 1", s.files, encodeString(nodePath), encodeBytes(contents))
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return err
+		}
+		var err error
+		newVersion, err = s.readVersion(conn, nodePath)
+		return err
+	})
+	if err != nil {
+		return nil, convertError(err, nodePath)
+	}
+	return newVersion, nil
+}
+
+// readVersion returns the version of a file, in a transaction.
+func (s *Server) readVersion(conn *dbconnpool.PooledDBConnection, nodePath string) (MySQLVersion, error) {
+	qr, err := conn.ExecuteFetch(fmt.Sprintf("SELECT version FROM %v WHERE path = %v", s.files, encodeString(nodePath)), 1, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 {
+		return 0, topo.NewError(topo.NoNode, nodePath)
+	}
+	v, err := qr.Rows[0][0].ToUint64()
+	return MySQLVersion(v), err
+}
+
+// Get is part of the topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	nodePath := path.Join(s.root, filePath)
+	return s.get(ctx, nodePath)
+}
+
+// get returns the contents and version of the file at nodePath.
+func (s *Server) get(ctx context.Context, nodePath string) ([]byte, topo.Version, error) {
+	query := fmt.Sprintf("SELECT contents, version FROM %v WHERE path = %v", s.files, encodeString(nodePath))
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return nil, nil, convertError(err, nodePath)
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil, topo.NewError(topo.NoNode, nodePath)
+	}
+	v, err := qr.Rows[0][1].ToUint64()
+	if err != nil {
+		return nil, nil, err
+	}
+	return qr.Rows[0][0].ToBytes(), MySQLVersion(v), nil
+}
+
+// Delete is part of the topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	nodePath := path.Join(s.root, filePath)
+
+	query := fmt.Sprintf("DELETE FROM %v WHERE path = %v", s.files, encodeString(nodePath))
+	if version != nil {
+		query += fmt.Sprintf(" AND version = %v", uint64(version.(MySQLVersion)))
+	}
+	qr, err := s.exec(ctx, nodePath, query)
+	if err != nil {
+		return convertError(err, nodePath)
+	}
+	if qr.RowsAffected == 1 {
+		return nil
+	}
+	if version == nil {
+		return topo.NewError(topo.NoNode, nodePath)
+	}
+
+	// See if the file didn't exist, or had another version.
+	if _, _, err := s.get(ctx, nodePath); err != nil {
+		return err
+	}
+	return topo.NewError(topo.BadVersion, nodePath)
+}
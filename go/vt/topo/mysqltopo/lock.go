@@ -0,0 +1,212 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	lockTTL          = flag.Duration("topo_mysql_lock_ttl", 30*time.Second, "lease of the locks and master elections on the MySQL topo server. Their holders renew it every third of this time.")
+	lockPollInterval = flag.Duration("topo_mysql_lock_poll_interval", 100*time.Millisecond, "how often to retry to take a lock that is held on the MySQL topo server")
+)
+
+// mysqlLockDescriptor implements topo.LockDescriptor.
+type mysqlLockDescriptor struct {
+	s        *Server
+	lockPath string
+	owner    string
+
+	// lost is closed when the lease couldn't be renewed.
+	lost chan struct{}
+
+	// stop is closed to stop renewing the lease, and done is closed
+	// when the renewal loop exited.
+	stop chan struct{}
+	done chan struct{}
+
+	// mu protects released.
+	mu       sync.Mutex
+	released bool
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	// We list the directory first to make sure it exists.
+	if _, err := s.ListDir(ctx, dirPath, false /*full*/); err != nil {
+		// We need to return the right error codes, like
+		// topo.ErrNoNode and topo.ErrInterrupted, and the
+		// easiest way to do this is to return convertError(err).
+		// It may lose some of the context, if this is an issue,
+		// maybe logging the error would work here.
+		return nil, convertError(err, dirPath)
+	}
+
+	return s.lock(ctx, path.Join(s.root, dirPath), contents)
+}
+
+// lock takes the lease row of lockPath, waiting for it to be released
+// or to expire if someone else has it.
+func (s *Server) lock(ctx context.Context, lockPath, contents string) (*mysqlLockDescriptor, error) {
+	owner, err := newOwner()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		acquired, err := s.tryLock(ctx, lockPath, contents, owner)
+		if err != nil {
+			return nil, convertError(err, lockPath)
+		}
+		if acquired {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, convertError(ctx.Err(), lockPath)
+		case <-time.After(*lockPollInterval):
+		}
+	}
+
+	ld := &mysqlLockDescriptor{
+		s:        s,
+		lockPath: lockPath,
+		owner:    owner,
+		lost:     make(chan struct{}),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go ld.renew()
+	return ld, nil
+}
+
+// tryLock takes the lease row of lockPath if it is free or expired.
+// The expiration uses the clock of the database, so the clocks of the
+// clients don't matter.
+func (s *Server) tryLock(ctx context.Context, lockPath, contents, owner string) (bool, error) {
+	expired := fmt.Sprintf("DELETE FROM %v WHERE path = %v AND expiration < NOW(6)", s.locks, encodeString(lockPath))
+	if _, err := s.exec(ctx, lockPath, expired); err != nil {
+		return false, err
+	}
+	insert := fmt.Sprintf("INSERT INTO %v (path, contents, owner, expiration) VALUES (%v, %v, %v, %v)", s.locks, encodeString(lockPath), encodeString(contents), encodeString(owner), expiration())
+	if _, err := s.exec(ctx, lockPath, insert); err != nil {
+		if isDupEntry(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// renew extends the lease until stop is closed. If the row is gone, or
+// the lease expired before it could be renewed, lost is closed.
+func (ld *mysqlLockDescriptor) renew() {
+	defer close(ld.done)
+	renewed := time.Now()
+	ticker := time.NewTicker(*lockTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ld.stop:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), *lockTTL/3)
+		query := fmt.Sprintf("UPDATE %v SET expiration = %v WHERE path = %v AND owner = %v", ld.s.locks, expiration(), encodeString(ld.lockPath), encodeString(ld.owner))
+		qr, err := ld.s.exec(ctx, ld.lockPath, query)
+		cancel()
+		switch {
+		case err != nil:
+			log.Warningf("cannot renew lock %v: %v", ld.lockPath, err)
+			if time.Since(renewed) < *lockTTL {
+				continue
+			}
+		case qr.RowsAffected == 1:
+			renewed = time.Now()
+			continue
+		}
+		log.Errorf("lost lock %v", ld.lockPath)
+		close(ld.lost)
+		return
+	}
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *mysqlLockDescriptor) Check(ctx context.Context) error {
+	select {
+	case <-ld.lost:
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "lost lock %v", ld.lockPath)
+	default:
+	}
+	return nil
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *mysqlLockDescriptor) Unlock(ctx context.Context) error {
+	ld.mu.Lock()
+	released := ld.released
+	ld.released = true
+	ld.mu.Unlock()
+	if released {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unlock: lock %v not held", ld.lockPath)
+	}
+
+	close(ld.stop)
+	<-ld.done
+
+	query := fmt.Sprintf("DELETE FROM %v WHERE path = %v AND owner = %v", ld.s.locks, encodeString(ld.lockPath), encodeString(ld.owner))
+	qr, err := ld.s.exec(ctx, ld.lockPath, query)
+	if err != nil {
+		return convertError(err, ld.lockPath)
+	}
+	if qr.RowsAffected == 0 {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "unlock: lock %v was lost", ld.lockPath)
+	}
+	return nil
+}
+
+// expiration returns the SQL expression of the expiration of a lease
+// that starts now.
+func expiration() string {
+	return fmt.Sprintf("NOW(6) + INTERVAL %v MICROSECOND", lockTTL.Microseconds())
+}
+
+// newOwner returns a unique identifier for a lease holder.
+func newOwner() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v-%v-%v", hostname, os.Getpid(), hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package mysqltopo implements topo.Server with a MySQL database as the
+backend.
+
+Files are rows of the topo_files table, keyed by their full path, with a
+version column that is used for compare-and-set updates. Locks and master
+elections are lease rows in the topo_locks table, that their holder keeps
+renewing. Watches poll the file row.
+
+The server address is either host:port, or the path of a unix socket.
+Cells can share a database, as long as they have distinct roots.
+*/
+package mysqltopo
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	mysqlUser     = flag.String("topo_mysql_user", "root", "user to connect to the MySQL topo server with")
+	mysqlPassword = flag.String("topo_mysql_password", "", "password to connect to the MySQL topo server with")
+	mysqlDatabase = flag.String("topo_mysql_database", "vt_topo", "database of the MySQL topo server that contains the topo tables. It is created if it doesn't exist.")
+	mysqlPoolSize = flag.Int("topo_mysql_pool_size", 10, "number of connections to each MySQL topo server")
+)
+
+// maxRows is the maximum number of rows a query of this package returns.
+const maxRows = 1 << 20
+
+// Factory is the mysql topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return NewServer(serverAddr, root)
+}
+
+// Server is the implementation of topo.Server for MySQL.
+type Server struct {
+	// pool has the connections to the database.
+	pool *dbconnpool.ConnectionPool
+
+	// root is the root path for this client.
+	root string
+
+	// files and locks are the escaped names of the tables.
+	files string
+	locks string
+}
+
+// NewServer returns a new mysqltopo.Server. It creates the database and
+// the tables if they don't exist.
+func NewServer(serverAddr, root string) (*Server, error) {
+	params, err := connParams(serverAddr)
+	if err != nil {
+		return nil, err
+	}
+	db := sqlescape.EscapeID(*mysqlDatabase)
+	s := &Server{
+		root:  root,
+		files: db + "." + sqlescape.EscapeID(filesTable),
+		locks: db + "." + sqlescape.EscapeID(locksTable),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	conn, err := mysql.Connect(ctx, params)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot connect to the MySQL topo server %v", serverAddr)
+	}
+	defer conn.Close()
+	for _, query := range []string{
+		"CREATE DATABASE IF NOT EXISTS " + db,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %v (
+  path VARBINARY(512) NOT NULL,
+  contents LONGBLOB NOT NULL,
+  version BIGINT UNSIGNED NOT NULL,
+  PRIMARY KEY (path)
+) ENGINE=InnoDB`, s.files),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %v (
+  path VARBINARY(512) NOT NULL,
+  contents BLOB NOT NULL,
+  owner VARBINARY(255) NOT NULL,
+  expiration DATETIME(6) NOT NULL,
+  PRIMARY KEY (path)
+) ENGINE=InnoDB`, s.locks),
+	} {
+		if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+			return nil, vterrors.Wrapf(err, "cannot create the topo tables on %v", serverAddr)
+		}
+	}
+
+	params.DbName = *mysqlDatabase
+	s.pool = dbconnpool.NewConnectionPool("", *mysqlPoolSize, time.Minute, 0)
+	s.pool.Open(dbconfigs.New(params))
+	return s, nil
+}
+
+// connParams returns the connection parameters for a server address.
+func connParams(serverAddr string) (*mysql.ConnParams, error) {
+	params := &mysql.ConnParams{
+		Uname:   *mysqlUser,
+		Pass:    *mysqlPassword,
+		Charset: "utf8mb4",
+	}
+	if strings.HasPrefix(serverAddr, "/") {
+		params.UnixSocket = serverAddr
+		return params, nil
+	}
+	host, port, err := net.SplitHostPort(serverAddr)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid MySQL topo server address %q, expected host:port or the path of a unix socket", serverAddr)
+	}
+	params.Host = host
+	if params.Port, err = strconv.Atoi(port); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid port in MySQL topo server address %q", serverAddr)
+	}
+	return params, nil
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the pool, so any attempt to re-use this server will
+// panic.
+func (s *Server) Close() {
+	s.pool.Close()
+	s.pool = nil
+}
+
+// getConn returns a connection of the pool. It must be recycled.
+func (s *Server) getConn(ctx context.Context, nodePath string) (*dbconnpool.PooledDBConnection, error) {
+	conn, err := s.pool.Get(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, convertError(ctx.Err(), nodePath)
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// exec runs one query.
+func (s *Server) exec(ctx context.Context, nodePath, query string) (*sqltypes.Result, error) {
+	conn, err := s.getConn(ctx, nodePath)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	return conn.ExecuteFetch(query, maxRows, false)
+}
+
+// inTransaction runs f in a transaction, which is committed if f
+// succeeds.
+func (s *Server) inTransaction(ctx context.Context, nodePath string, f func(conn *dbconnpool.PooledDBConnection) error) error {
+	conn, err := s.getConn(ctx, nodePath)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+	if _, err := conn.ExecuteFetch("begin", 0, false); err != nil {
+		return err
+	}
+	if err := f(conn); err != nil {
+		conn.ExecuteFetch("rollback", 0, false)
+		return err
+	}
+	_, err = conn.ExecuteFetch("commit", 0, false)
+	return err
+}
+
+// encodeString returns the SQL literal of a string.
+func encodeString(in string) string {
+	buf := bytes.NewBuffer(nil)
+	sqltypes.NewVarBinary(in).EncodeSQL(buf)
+	return buf.String()
+}
+
+// encodeBytes returns the SQL hex literal of binary contents.
+func encodeBytes(in []byte) string {
+	return fmt.Sprintf("X'%x'", in)
+}
+
+// isDupEntry returns true if err is a duplicate key error.
+func isDupEntry(err error) bool {
+	sqlErr, ok := err.(*mysql.SQLError)
+	return ok && sqlErr.Number() == mysql.ERDupEntry
+}
+
+func init() {
+	topo.RegisterFactory("mysql", Factory{})
+}
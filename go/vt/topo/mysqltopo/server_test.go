@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/test"
+	"vitess.io/vitess/go/vt/vttest"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vttestpb "vitess.io/vitess/go/vt/proto/vttest"
+)
+
+// startMySQL starts a mysqld, and returns the server address to connect
+// to, after setting the credentials flags.
+func startMySQL(t *testing.T) (*vttest.LocalCluster, string) {
+	cluster := &vttest.LocalCluster{
+		Config: vttest.Config{
+			Topology: &vttestpb.VTTestTopology{
+				Keyspaces: []*vttestpb.Keyspace{{
+					Name:   "vttest",
+					Shards: []*vttestpb.Shard{{Name: "0", DbNameOverride: "vttest"}},
+				}},
+			},
+			OnlyMySQL: true,
+		},
+	}
+	if err := cluster.Setup(); err != nil {
+		t.Fatalf("could not launch mysql: %v", err)
+	}
+	params := cluster.MySQLConnParams()
+	*mysqlUser = params.Uname
+	*mysqlPassword = params.Pass
+	if params.UnixSocket != "" {
+		return cluster, params.UnixSocket
+	}
+	return cluster, fmt.Sprintf("%v:%v", params.Host, params.Port)
+}
+
+func TestMySQLTopo(t *testing.T) {
+	// One test is going to wait that full period, so make it shorter.
+	*watchPollInterval = 100 * time.Millisecond
+	*lockPollInterval = 10 * time.Millisecond
+
+	cluster, serverAddr := startMySQL(t)
+	defer cluster.TearDown()
+
+	// Run the TopoServerTestSuite tests.
+	testIndex := 0
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		// Each test will use its own sub-directories.
+		testRoot := fmt.Sprintf("/test-%v", testIndex)
+		testIndex++
+
+		// Create the server on the new root.
+		ts, err := topo.OpenServer("mysql", serverAddr, path.Join(testRoot, topo.GlobalCell))
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+
+		// Create the CellInfo.
+		if err := ts.CreateCellInfo(context.Background(), test.LocalCellName, &topodatapb.CellInfo{
+			ServerAddress: serverAddr,
+			Root:          path.Join(testRoot, test.LocalCellName),
+		}); err != nil {
+			t.Fatalf("CreateCellInfo() failed: %v", err)
+		}
+
+		return ts
+	})
+}
+
+func TestMySQLTopoLockExpires(t *testing.T) {
+	*lockPollInterval = 10 * time.Millisecond
+	savedTTL := *lockTTL
+	*lockTTL = 300 * time.Millisecond
+	defer func() { *lockTTL = savedTTL }()
+
+	cluster, serverAddr := startMySQL(t)
+	defer cluster.TearDown()
+
+	s, err := NewServer(serverAddr, "/expires")
+	if err != nil {
+		t.Fatalf("NewServer() failed: %v", err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+	if _, err := s.Create(ctx, "/keyspaces/ks/Keyspace", []byte("ks")); err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+
+	// The lease of a lock is renewed while it's held.
+	ld, err := s.Lock(ctx, "/keyspaces/ks", "first")
+	if err != nil {
+		t.Fatalf("Lock() failed: %v", err)
+	}
+	fastCtx, cancel := context.WithTimeout(ctx, time.Second)
+	if _, err := s.Lock(fastCtx, "/keyspaces/ks", "second"); !topo.IsErrType(err, topo.Timeout) {
+		t.Fatalf("Lock(second) returned %v, expected a timeout", err)
+	}
+	cancel()
+
+	// A holder that died doesn't keep the lock: stop renewing the
+	// lease without releasing it.
+	close(ld.(*mysqlLockDescriptor).stop)
+	ld2, err := s.Lock(ctx, "/keyspaces/ks", "third")
+	if err != nil {
+		t.Fatalf("Lock(third) failed: %v", err)
+	}
+	if err := ld2.Unlock(ctx); err != nil {
+		t.Errorf("Unlock(third) failed: %v", err)
+	}
+}
+
+func TestEscapeLike(t *testing.T) {
+	if got, want := escapeLike(`/a_b/c%d\e/`), `/a\_b/c\%d\\e/`; got != want {
+		t.Errorf("escapeLike() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"fmt"
+)
+
+// MySQLVersion is the version of a file, stored in its row.
+// It implements topo.Version.
+// It starts at 1 when the file is created, and is incremented by every
+// update.
+type MySQLVersion uint64
+
+// String is part of the topo.Version interface.
+func (v MySQLVersion) String() string {
+	return fmt.Sprintf("%v", uint64(v))
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqltopo
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"path"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	watchPollInterval = flag.Duration("topo_mysql_watch_poll_interval", time.Second, "how often watches poll the watched file on the MySQL topo server")
+)
+
+// Watch is part of the topo.Conn interface.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	// Initial get.
+	nodePath := path.Join(s.root, filePath)
+	contents, version, err := s.get(ctx, nodePath)
+	if err != nil {
+		return &topo.WatchData{Err: err}, nil, nil
+	}
+
+	// Initial value to return.
+	wd := &topo.WatchData{
+		Contents: contents,
+		Version:  version,
+	}
+
+	// Create a context, will be used to cancel the watch.
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+
+	// Create the notifications channel, send updates to it.
+	notifications := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(notifications)
+
+		ticker := time.NewTicker(*watchPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-watchCtx.Done():
+				notifications <- &topo.WatchData{
+					Err: convertError(watchCtx.Err(), nodePath),
+				}
+				return
+			case <-ticker.C:
+			}
+
+			// The server should answer well within the poll interval,
+			// or we assume we've lost contact.
+			getCtx, cancel := context.WithTimeout(watchCtx, 2**watchPollInterval+5*time.Second)
+			newContents, newVersion, err := s.get(getCtx, nodePath)
+			cancel()
+			if err != nil {
+				// Serious error, context cancelled, or the node
+				// disappeared.
+				if watchCtx.Err() != nil {
+					err = convertError(watchCtx.Err(), nodePath)
+				}
+				notifications <- &topo.WatchData{
+					Err: err,
+				}
+				return
+			}
+
+			// If we got a new value, send it. A file that was deleted
+			// and created again between two polls can have the same
+			// version, so the contents are compared too.
+			if newVersion != version || !bytes.Equal(newContents, contents) {
+				contents, version = newContents, newVersion
+				notifications <- &topo.WatchData{
+					Contents: contents,
+					Version:  version,
+				}
+			}
+		}
+	}()
+
+	return wd, notifications, topo.CancelFunc(watchCancel)
+}
@@ -21,9 +21,11 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strings"
 
 	"context"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -34,24 +36,29 @@ func init() {
 	addCommand("Shards", command{
 		"ListBackups",
 		commandListBackups,
-		"<keyspace/shard>",
-		"Lists all the backups for a shard."})
+		"[-chains] [-verification] <keyspace/shard>",
+		"Lists all the backups for a shard. With -chains, each backup is followed by the backups a restore of it applies first, down to a full backup. With -verification, each backup is followed by the result of its last verification, if any."})
 	addCommand("Shards", command{
 		"BackupShard",
 		commandBackupShard,
-		"[-allow_master=false] <keyspace/shard>",
+		"[-allow_master=false] [-incremental] <keyspace/shard>",
 		"Chooses a tablet and creates a backup for a shard."})
 	addCommand("Shards", command{
 		"RemoveBackup",
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
 		"Removes a backup for the BackupStorage."})
+	addCommand("Shards", command{
+		"PruneBackups",
+		commandPruneBackups,
+		"[-keep_count=N] [-keep_daily_days=D] [-min_age=duration] [-dry_run] <keyspace/shard>",
+		"Removes the backups of a shard that the retention policy doesn't keep: the N most recent ones, the most recent one of each of the last D days, and the ones more recent than min_age. The most recent complete backup, and the backups that kept incremental backups are based on, are never removed. With -dry_run, only lists the backups that would be removed."})
 
 	addCommand("Tablets", command{
 		"Backup",
 		commandBackup,
-		"[-concurrency=4] [-allow_master=false] <tablet alias>",
-		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes."})
+		"[-concurrency=4] [-allow_master=false] [-incremental] <tablet alias>",
+		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes. With -incremental, mysqld keeps running and only the binlogs since the previous backup are stored."})
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
@@ -62,6 +69,7 @@ func init() {
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Allows backups to be taken on master. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incremental := subFlags.Bool("incremental", false, "Only stores the binlogs since the previous backup of the shard (builtin backup engine only)")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -79,12 +87,13 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 		return err
 	}
 
-	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowMaster, *incremental)
 }
 
 func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowMaster := subFlags.Bool("allow_master", false, "Whether to use master tablet for backup. Warning!! If you are using the builtin backup engine, this will shutdown your master mysql for as long as it takes to create a backup ")
+	incremental := subFlags.Bool("incremental", false, "Only stores the binlogs since the previous backup of the shard (builtin backup engine only)")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -146,12 +155,12 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return errors.New("no tablet available for backup")
 	}
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster)
+	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster, *incremental)
 }
 
 // execBackup is shared by Backup and BackupShard
-func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) error {
-	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster)
+func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowMaster, incremental bool) error {
+	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowMaster, incremental)
 	if err != nil {
 		return err
 	}
@@ -169,6 +178,8 @@ func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.T
 }
 
 func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	chains := subFlags.Bool("chains", false, "For each backup, also lists the backups it is based on")
+	verification := subFlags.Bool("verification", false, "For each backup, also shows the result of its last verification")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -191,8 +202,37 @@ func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if err != nil {
 		return err
 	}
+	var verifications map[string]*mysqlctl.BackupVerification
+	if *verification {
+		if verifications, err = mysqlctl.GetBackupVerifications(ctx, bs, keyspace, shard); err != nil {
+			return err
+		}
+	}
 	for _, bh := range bhs {
-		wr.Logger().Printf("%v\n", bh.Name())
+		line := bh.Name()
+		if *chains {
+			chain, err := mysqlctl.FindBackupChain(ctx, bhs, bh)
+			if err != nil {
+				line = fmt.Sprintf("%v (incomplete: %v)", bh.Name(), err)
+			} else {
+				names := make([]string, 0, len(chain))
+				for i := len(chain) - 1; i >= 0; i-- {
+					names = append(names, chain[i].Name())
+				}
+				line = strings.Join(names, " <- ")
+			}
+		}
+		if *verification {
+			switch v := verifications[bh.Name()]; {
+			case v == nil:
+				line += " [not verified]"
+			case v.Success:
+				line += fmt.Sprintf(" [verified at %v]", v.VerifyTime)
+			default:
+				line += fmt.Sprintf(" [failed verification at %v]", v.VerifyTime)
+			}
+		}
+		wr.Logger().Printf("%v\n", line)
 	}
 	return nil
 }
@@ -252,3 +292,38 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 		}
 	}
 }
+
+func commandPruneBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepCount := subFlags.Int("keep_count", 0, "Keeps this many of the most recent complete backups")
+	keepDailyDays := subFlags.Int("keep_daily_days", 0, "Keeps the most recent complete backup of each day (in UTC) for this many days")
+	minAge := subFlags.Duration("min_age", 0, "Keeps the backups more recent than this")
+	dryRun := subFlags.Bool("dry_run", false, "Only lists the backups that would be removed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneBackups requires <keyspace/shard>")
+	}
+	if *keepCount <= 0 && *keepDailyDays <= 0 && *minAge <= 0 {
+		return fmt.Errorf("action PruneBackups requires at least one of -keep_count, -keep_daily_days or -min_age")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	policy := mysqlctl.RetentionPolicy{
+		KeepCount:     *keepCount,
+		KeepDailyDays: *keepDailyDays,
+		MinAge:        *minAge,
+	}
+	_, err = mysqlctl.PruneBackups(ctx, bs, bucket, policy, *dryRun, wr.Logger())
+	return err
+}
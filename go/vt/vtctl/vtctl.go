@@ -106,7 +106,9 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/schemamanager"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -397,7 +399,10 @@ var commands = []commandGroup{
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
 				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. ddl_strategy is used to intruct migrations via gh-ost or pt-osc with optional parameters"},
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. ddl_strategy is used to intruct migrations via vreplication (online), gh-ost or pt-osc with optional parameters. A completed online migration is reverted with -sql=\"REVERT '<uuid>'\""},
+			{"ApplyDesiredSchema", commandApplyDesiredSchema,
+				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] [-dry_run] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Brings the schema of the specified keyspace to the desired state, given as the full list of CREATE TABLE statements of the keyspace. The current schema is read from the master of the first shard, and the CREATE, ALTER and DROP TABLE statements that transform it into the desired schema are applied as with ApplySchema, using ddl_strategy. With -dry_run, the statements are only printed."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -2761,7 +2766,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
-	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'online', 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2795,6 +2800,101 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	)
 }
 
+func commandApplyDesiredSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
+	sql := subFlags.String("sql", "", "A list of semicolon-delimited CREATE TABLE statements describing the desired schema")
+	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the desired schema")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'online', 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
+	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
+	dryRun := subFlags.Bool("dry_run", false, "Only print the statements that would be applied")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ApplyDesiredSchema command")
+	}
+
+	keyspace := subFlags.Arg(0)
+	desiredSQL, err := getFileParam(*sql, *sqlFile, "sql")
+	if err != nil {
+		return err
+	}
+	desired, err := schemadiff.NewSchemaFromSQL(desiredSQL)
+	if err != nil {
+		return err
+	}
+
+	shards, err := wr.TopoServer().GetShardNames(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if len(shards) == 0 {
+		return fmt.Errorf("no shards in keyspace %v", keyspace)
+	}
+	sort.Strings(shards)
+	si, err := wr.TopoServer().GetShard(ctx, keyspace, shards[0])
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return fmt.Errorf("no master in shard %v/%v", keyspace, shards[0])
+	}
+	sd, err := wr.GetSchema(ctx, si.MasterAlias, nil, nil, false)
+	if err != nil {
+		return err
+	}
+	var currentQueries []string
+	for _, td := range sd.TableDefinitions {
+		// tables created and owned by online DDL and table lifecycle are not part of the desired schema
+		if td.Type != tmutils.TableBaseTable || schema.IsInternalOperationTableName(td.Name) {
+			continue
+		}
+		currentQueries = append(currentQueries, td.Schema)
+	}
+	current, err := schemadiff.NewSchemaFromQueries(currentQueries)
+	if err != nil {
+		return err
+	}
+
+	stmts, err := current.Diff(desired)
+	if err != nil {
+		return err
+	}
+	if len(stmts) == 0 {
+		wr.Logger().Printf("Schema of keyspace %v is up to date\n", keyspace)
+		return nil
+	}
+	queries := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		queries = append(queries, sqlparser.String(stmt))
+	}
+	if *dryRun {
+		for _, query := range queries {
+			wr.Logger().Printf("%s;\n", query)
+		}
+		return nil
+	}
+
+	executionUUID, err := schema.CreateUUID()
+	if err != nil {
+		return err
+	}
+	requestContext := fmt.Sprintf("vtctl:%s", executionUUID)
+	executor := schemamanager.NewTabletExecutor(requestContext, wr, *waitReplicasTimeout)
+	if *allowLongUnavailability {
+		executor.AllowBigSchemaChange()
+	}
+	if err := executor.SetDDLStrategy(*ddlStrategy); err != nil {
+		return err
+	}
+
+	return schemamanager.Run(
+		ctx,
+		schemamanager.NewPlainController(strings.Join(queries, ";\n"), keyspace),
+		executor,
+	)
+}
+
 func commandOnlineDDL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
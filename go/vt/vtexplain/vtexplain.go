@@ -75,6 +75,10 @@ type Options struct {
 	// Target is used to override the "database" target in the
 	// vtgate session to simulate `USE <target>`
 	Target string
+
+	// TableStats are the statistics of the tables, by table name or
+	// keyspace.table name, used to estimate the cost of plans.
+	TableStats map[string]*TableStats
 }
 
 // TabletQuery defines a query that was sent to a given tablet and how it was
@@ -165,6 +169,8 @@ func Init(vSchemaStr, sqlSchema, ksShardMapStr string, opts *Options) error {
 		return fmt.Errorf("initVtgateExecutor: %v", err)
 	}
 
+	tableStats = opts.TableStats
+
 	return nil
 }
 
@@ -0,0 +1,526 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/jsonutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// unknownRows is the EstimatedRows of a PlanNode when there is not
+// enough information to estimate it.
+const unknownRows = -1
+
+// tableStats are the statistics of the tables, set at Init.
+var tableStats map[string]*TableStats
+
+// TableStats are statistics about a table, that are used to estimate
+// the cost of plans.
+type TableStats struct {
+	// Rows is the number of rows of the table, across all its shards.
+	Rows int64
+}
+
+// ParseTableStats parses a JSON map of table statistics, by table name
+// or keyspace.table name, like {"user": {"Rows": 1000}}.
+func ParseTableStats(tableStatsStr string) (map[string]*TableStats, error) {
+	stats := make(map[string]*TableStats)
+	if tableStatsStr == "" {
+		return stats, nil
+	}
+	if err := json2.Unmarshal([]byte(tableStatsStr), &stats); err != nil {
+		return nil, fmt.Errorf("invalid table stats: %v", err)
+	}
+	return stats, nil
+}
+
+// StatementPlan has the plan trees of a statement.
+type StatementPlan struct {
+	// SQL is the original statement.
+	SQL string
+
+	// Plans are the vtgate plan(s) of the statement.
+	Plans []*PlanNode
+}
+
+// PlanNode is a node of a vtgate plan tree, with its cost estimates.
+// The estimates ignore the filters that don't select shards, so they
+// are upper bounds.
+type PlanNode struct {
+	// Description describes the primitive, without its inputs.
+	Description engine.PrimitiveDescription
+
+	// EstimatedShards is the number of shards a route or a DML sends
+	// its query to, for each execution. It is 0 for the other nodes.
+	EstimatedShards int
+
+	// EstimatedRows is the number of rows the node returns or, for a
+	// DML, changes. It is unknownRows if the statistics of the tables
+	// are missing.
+	EstimatedRows int64
+
+	// Inputs are the inputs of the primitive.
+	Inputs []*PlanNode
+}
+
+// MarshalJSON renders the node as its plan description, with the
+// estimates added.
+func (node *PlanNode) MarshalJSON() ([]byte, error) {
+	desc, err := json.Marshal(node.Description)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(desc[:len(desc)-1])
+	if node.EstimatedShards > 0 {
+		fmt.Fprintf(buf, `,"EstimatedShards":%d`, node.EstimatedShards)
+	}
+	if node.EstimatedRows != unknownRows {
+		fmt.Fprintf(buf, `,"EstimatedRows":%d`, node.EstimatedRows)
+	}
+	if len(node.Inputs) > 0 {
+		inputs, err := json.Marshal(node.Inputs)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString(`,"Inputs":`)
+		buf.Write(inputs)
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// ExplainPlans returns the plan trees of the explained statements.
+func ExplainPlans(explains []*Explain) []*StatementPlan {
+	result := make([]*StatementPlan, 0, len(explains))
+	for _, explain := range explains {
+		sp := &StatementPlan{SQL: explain.SQL}
+		for _, plan := range explain.Plans {
+			sp.Plans = append(sp.Plans, newPlanNode(plan.Instructions))
+		}
+		result = append(result, sp)
+	}
+	return result
+}
+
+// newPlanNode returns the plan tree of a primitive.
+func newPlanNode(p engine.Primitive) *PlanNode {
+	desc := engine.PrimitiveToPlanDescription(p)
+	desc.Inputs = nil
+	node := &PlanNode{
+		Description:   desc,
+		EstimatedRows: unknownRows,
+	}
+	for _, input := range p.Inputs() {
+		node.Inputs = append(node.Inputs, newPlanNode(input))
+	}
+
+	switch p := p.(type) {
+	case *engine.Route:
+		node.EstimatedShards, node.EstimatedRows = estimateRoute(p)
+	case *engine.Update:
+		node.EstimatedShards, node.EstimatedRows = estimateDML(&p.DML)
+	case *engine.Delete:
+		node.EstimatedShards, node.EstimatedRows = estimateDML(&p.DML)
+	case *engine.Insert:
+		node.EstimatedShards, node.EstimatedRows = estimateInsert(p)
+	case *engine.Join:
+		left, right := node.Inputs[0].EstimatedRows, node.Inputs[1].EstimatedRows
+		if left != unknownRows && right != unknownRows {
+			// The right side is executed for each row of the left side.
+			if right != 0 && left > math.MaxInt64/right {
+				node.EstimatedRows = math.MaxInt64
+			} else {
+				node.EstimatedRows = left * right
+			}
+			if p.Opcode == engine.LeftJoin && node.EstimatedRows < left {
+				node.EstimatedRows = left
+			}
+		}
+	case *engine.Limit:
+		node.EstimatedRows = node.Inputs[0].EstimatedRows
+		if count, ok := planValueInt(p.Count); ok && (node.EstimatedRows == unknownRows || count < node.EstimatedRows) {
+			node.EstimatedRows = count
+		}
+	case *engine.OrderedAggregate:
+		node.EstimatedRows = node.Inputs[0].EstimatedRows
+		if len(p.Keys) == 0 {
+			node.EstimatedRows = 1
+		}
+	case *engine.Concatenate:
+		node.EstimatedRows = 0
+		for _, input := range node.Inputs {
+			if input.EstimatedRows == unknownRows {
+				node.EstimatedRows = unknownRows
+				break
+			}
+			node.EstimatedRows += input.EstimatedRows
+		}
+	case *engine.SingleRow:
+		node.EstimatedRows = 1
+	default:
+		// Most other primitives, like sorts and projections, return
+		// as many rows as their input.
+		if len(node.Inputs) == 1 {
+			node.EstimatedRows = node.Inputs[0].EstimatedRows
+		}
+	}
+	return node
+}
+
+// estimateRoute returns the number of shards a route sends its query
+// to, and the number of rows it returns.
+func estimateRoute(route *engine.Route) (int, int64) {
+	sel, _ := parseSelect(route.Query)
+	shards, rows := estimateRouteTables(route, routeTables(sel, route.TableName))
+	if perShard, ok := maxRowsPerShard(sel); ok && shards > 0 {
+		if max := perShard * int64(shards); rows == unknownRows || max < rows {
+			rows = max
+		}
+	}
+	return shards, rows
+}
+
+// estimateRouteTables returns the number of shards a route sends its
+// query to, and the number of rows of its tables in those shards.
+func estimateRouteTables(route *engine.Route, tables []string) (int, int64) {
+	if route.Keyspace == nil {
+		return 0, unknownRows
+	}
+	numShards := keyspaceShards(route.Keyspace)
+	rows := tableRows(route.Keyspace, tables)
+	switch route.Opcode {
+	case engine.SelectNone:
+		return 0, 0
+	case engine.SelectNext:
+		return 1, 1
+	case engine.SelectUnsharded, engine.SelectReference:
+		return 1, rows
+	case engine.SelectDBA:
+		return 1, unknownRows
+	case engine.SelectEqualUnique:
+		return 1, 1
+	case engine.SelectEqual:
+		return 1, fraction(rows, 1, numShards)
+	case engine.SelectIN, engine.SelectMultiEqual:
+		shards := numShards
+		values, ok := listLen(route.Values)
+		if ok && values < shards {
+			shards = values
+		}
+		if ok && route.Vindex != nil && route.Vindex.IsUnique() {
+			return shards, int64(values)
+		}
+		return shards, fraction(rows, shards, numShards)
+	}
+	// Scatter, and routes to an explicit destination.
+	return numShards, rows
+}
+
+// estimateDML returns the number of shards an update or a delete sends
+// its query to, and the number of rows it changes.
+func estimateDML(dml *engine.DML) (int, int64) {
+	if dml.Keyspace == nil {
+		return 0, unknownRows
+	}
+	numShards := keyspaceShards(dml.Keyspace)
+	rows := int64(unknownRows)
+	if dml.Table != nil {
+		rows = tableRows(dml.Keyspace, []string{dml.Table.Name.String()})
+	}
+	unique := dml.Vindex != nil && dml.Vindex.IsUnique()
+	switch dml.Opcode {
+	case engine.Unsharded:
+		return 1, rows
+	case engine.Equal:
+		if unique {
+			return 1, 1
+		}
+		return 1, fraction(rows, 1, numShards)
+	case engine.In:
+		shards := numShards
+		values, ok := listLen(dml.Values)
+		if ok && values < shards {
+			shards = values
+		}
+		if ok && unique {
+			return shards, int64(values)
+		}
+		return shards, fraction(rows, shards, numShards)
+	}
+	return numShards, rows
+}
+
+// estimateInsert returns the number of shards an insert sends its rows
+// to, and the number of rows it inserts.
+func estimateInsert(ins *engine.Insert) (int, int64) {
+	if ins.Keyspace == nil {
+		return 0, unknownRows
+	}
+	if ins.Opcode == engine.InsertUnsharded {
+		return 1, unknownRows
+	}
+	// VindexValues has the values of each column of each vindex,
+	// for each row.
+	if len(ins.VindexValues) == 0 || len(ins.VindexValues[0].Values) == 0 {
+		return keyspaceShards(ins.Keyspace), unknownRows
+	}
+	rows := len(ins.VindexValues[0].Values[0].Values)
+	shards := keyspaceShards(ins.Keyspace)
+	if rows < shards {
+		shards = rows
+	}
+	return shards, int64(rows)
+}
+
+// parseSelect parses the query of a route, if it's a select.
+func parseSelect(query string) (*sqlparser.Select, bool) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, false
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	return sel, ok
+}
+
+// routeTables returns the tables a route reads: the tables of the FROM
+// clause of its select, or its comma-separated tableName otherwise. It
+// returns nil if the select reads a derived table, whose number of rows
+// is unknown.
+func routeTables(sel *sqlparser.Select, tableName string) []string {
+	if sel == nil {
+		if tableName == "" {
+			return nil
+		}
+		tables := strings.Split(tableName, ",")
+		for i := range tables {
+			tables[i] = strings.TrimSpace(tables[i])
+		}
+		return tables
+	}
+	var tables []string
+	derived := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			name, ok := node.Expr.(sqlparser.TableName)
+			if !ok {
+				derived = true
+				return false, nil
+			}
+			tables = append(tables, name.Name.String())
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, sel.From)
+	if derived {
+		return nil
+	}
+	return tables
+}
+
+// maxRowsPerShard returns the most rows a select returns from each
+// shard, if it's known at planning time: a select that aggregates
+// without grouping returns one row, and a select with a LIMIT returns at
+// most that many rows.
+func maxRowsPerShard(sel *sqlparser.Select) (int64, bool) {
+	if sel == nil {
+		return 0, false
+	}
+	rows, known := int64(0), false
+	if len(sel.GroupBy) == 0 && hasAggregates(sel.SelectExprs) {
+		rows, known = 1, true
+	}
+	if sel.Limit != nil {
+		if count, ok := sel.Limit.Rowcount.(*sqlparser.Literal); ok && count.Type == sqlparser.IntVal {
+			if n, err := strconv.ParseInt(string(count.Val), 10, 64); err == nil && (!known || n < rows) {
+				rows, known = n, true
+			}
+		}
+	}
+	return rows, known
+}
+
+// hasAggregates returns true if a node calls an aggregate function,
+// outside of subqueries.
+func hasAggregates(node sqlparser.SQLNode) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsAggregate() {
+				found = true
+			}
+		case *sqlparser.GroupConcatExpr:
+			found = true
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return !found, nil
+	}, node)
+	return found
+}
+
+// keyspaceShards returns the number of shards of a keyspace.
+func keyspaceShards(ks *vindexes.Keyspace) int {
+	if !ks.Sharded {
+		return 1
+	}
+	explainTopo.Lock.Lock()
+	defer explainTopo.Lock.Unlock()
+	if n := len(explainTopo.KeyspaceShards[ks.Name]); n > 0 {
+		return n
+	}
+	return 1
+}
+
+// tableRows returns the number of rows of the tables of a route. For a
+// join, it is the product of the numbers of rows of its tables, the size
+// of their cross product. It is unknownRows if a table has no stats.
+func tableRows(ks *vindexes.Keyspace, tables []string) int64 {
+	if len(tables) == 0 {
+		return unknownRows
+	}
+	rows := int64(1)
+	for _, name := range tables {
+		stats, ok := tableStats[ks.Name+"."+name]
+		if !ok {
+			stats, ok = tableStats[name]
+		}
+		if !ok {
+			return unknownRows
+		}
+		if stats.Rows != 0 && rows > math.MaxInt64/stats.Rows {
+			rows = math.MaxInt64
+			continue
+		}
+		rows *= stats.Rows
+	}
+	return rows
+}
+
+// fraction returns the number of rows in some of the shards, assuming
+// rows are evenly spread.
+func fraction(rows int64, shards, numShards int) int64 {
+	if rows == unknownRows || numShards == 0 {
+		return rows
+	}
+	return (rows*int64(shards) + int64(numShards) - 1) / int64(numShards)
+}
+
+// listLen returns the number of values of a list of routing values, if
+// it's known at planning time.
+func listLen(values []sqltypes.PlanValue) (int, bool) {
+	if len(values) != 1 || !values[0].IsList() || values[0].ListKey != "" {
+		return 0, false
+	}
+	return len(values[0].Values), true
+}
+
+// planValueInt returns the value of a literal integer PlanValue.
+func planValueInt(pv sqltypes.PlanValue) (int64, bool) {
+	if pv.Key != "" || pv.Value.IsNull() {
+		return 0, false
+	}
+	v, err := pv.Value.ToInt64()
+	return v, err == nil
+}
+
+// PlansAsText returns a text representation of the plan trees.
+func PlansAsText(plans []*StatementPlan) string {
+	var b bytes.Buffer
+	for _, sp := range plans {
+		fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+		fmt.Fprintf(&b, "%s\n\n", sp.SQL)
+		for _, node := range sp.Plans {
+			writePlanNode(&b, node, "")
+			fmt.Fprintf(&b, "\n")
+		}
+	}
+	fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
+	return b.String()
+}
+
+// writePlanNode writes a node and its inputs, indented.
+func writePlanNode(b *bytes.Buffer, node *PlanNode, indent string) {
+	desc := node.Description
+	fmt.Fprintf(b, "%s%s", indent, desc.OperatorType)
+	if desc.Variant != "" {
+		fmt.Fprintf(b, " %s", desc.Variant)
+	}
+	if desc.Keyspace != nil {
+		fmt.Fprintf(b, " on %s", desc.Keyspace.Name)
+	}
+	var estimates []string
+	if node.EstimatedShards > 0 {
+		estimates = append(estimates, fmt.Sprintf("shards: %d", node.EstimatedShards))
+	}
+	if node.EstimatedRows != unknownRows {
+		estimates = append(estimates, fmt.Sprintf("rows: %d", node.EstimatedRows))
+	}
+	if len(estimates) > 0 {
+		fmt.Fprintf(b, " (%s)", strings.Join(estimates, ", "))
+	}
+	fmt.Fprintf(b, "\n")
+
+	if desc.TargetDestination != nil {
+		fmt.Fprintf(b, "%s    TargetDestination: %s\n", indent, desc.TargetDestination.String())
+	}
+	keys := make([]string, 0, len(desc.Other))
+	for k, v := range desc.Other {
+		if v == "" || v == nil || v == 0 {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := desc.Other[k]
+		s, ok := v.(string)
+		if !ok {
+			data, err := jsonutil.MarshalNoEscape(v)
+			if err != nil {
+				s = fmt.Sprintf("%v", v)
+			} else {
+				s = strings.TrimSpace(string(data))
+			}
+		}
+		fmt.Fprintf(b, "%s    %s: %s\n", indent, k, s)
+	}
+
+	for _, input := range node.Inputs {
+		writePlanNode(b, input, indent+"  ")
+	}
+}
+
+// PlansAsJSON returns a json representation of the plan trees.
+func PlansAsJSON(plans []*StatementPlan) string {
+	plansJSON, _ := jsonutil.MarshalIndentNoEscape(plans, "", "    ")
+	return string(plansJSON)
+}
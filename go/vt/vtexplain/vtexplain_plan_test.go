@@ -0,0 +1,188 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanEstimates(t *testing.T) {
+	opts := defaultTestOpts()
+	// Normalized IN lists are bind variables, whose length is unknown.
+	opts.Normalize = false
+	var err error
+	opts.TableStats, err = ParseTableStats(`{"user": {"Rows": 1000}, "ks_sharded.music": {"Rows": 4000}, "t1": {"Rows": 10}}`)
+	require.NoError(t, err)
+	initTest(ModeMulti, opts, &testopts{}, t)
+
+	tests := []struct {
+		sql    string
+		root   string
+		shards int
+		rows   int64
+	}{{
+		sql:    "select * from user where id = 1",
+		root:   "Route",
+		shards: 1,
+		rows:   1,
+	}, {
+		sql:    "select * from user",
+		root:   "Route",
+		shards: 4,
+		rows:   1000,
+	}, {
+		sql:    "select * from user where id in (1, 2, 3)",
+		root:   "Route",
+		shards: 3,
+		rows:   3,
+	}, {
+		sql:  "select count(*) from music",
+		root: "Aggregate",
+		rows: 1,
+	}, {
+		sql:    "select u.id from user u join music m on u.id = m.user_id",
+		root:   "Route",
+		shards: 4,
+		rows:   4000000,
+	}, {
+		sql:    "select * from t1",
+		root:   "Route",
+		shards: 1,
+		rows:   10,
+	}, {
+		sql:    "update user set nickname = 'x' where id = 1",
+		root:   "Update",
+		shards: 1,
+		rows:   1,
+	}, {
+		sql:    "select * from music_extra",
+		root:   "Route",
+		shards: 4,
+		rows:   unknownRows,
+	}}
+	for _, test := range tests {
+		t.Run(test.sql, func(t *testing.T) {
+			explains, err := Run(test.sql)
+			require.NoError(t, err)
+			plans := ExplainPlans(explains)
+			require.Len(t, plans, 1)
+			require.Len(t, plans[0].Plans, 1)
+			root := plans[0].Plans[0]
+			assert.Equal(t, test.root, root.Description.OperatorType)
+			assert.Equal(t, test.shards, root.EstimatedShards)
+			assert.Equal(t, test.rows, root.EstimatedRows)
+		})
+	}
+}
+
+func TestMaxRowsPerShard(t *testing.T) {
+	tests := []struct {
+		query string
+		rows  int64
+		known bool
+	}{{
+		query: "select * from user",
+	}, {
+		query: "select count(*), max(id) from user",
+		rows:  1,
+		known: true,
+	}, {
+		query: "select name, count(*) from user group by name",
+	}, {
+		query: "select * from user limit 10",
+		rows:  10,
+		known: true,
+	}, {
+		query: "select count(*) from user limit 10",
+		rows:  1,
+		known: true,
+	}, {
+		query: "select * from user limit :__upper_limit",
+	}, {
+		query: "select id, (select count(*) from music) from user",
+	}, {
+		query: "update user set name = 'x'",
+	}}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			sel, _ := parseSelect(test.query)
+			rows, known := maxRowsPerShard(sel)
+			assert.Equal(t, test.known, known)
+			assert.Equal(t, test.rows, rows)
+		})
+	}
+}
+
+func TestPlanOutput(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.TableStats = map[string]*TableStats{"music": {Rows: 4000}}
+	initTest(ModeMulti, opts, &testopts{}, t)
+
+	explains, err := Run("select count(*) from music")
+	require.NoError(t, err)
+	plans := ExplainPlans(explains)
+
+	want := `----------------------------------------------------------------------
+select count(*) from music
+
+Aggregate Ordered (rows: 1)
+    Aggregates: count(0)
+    Distinct: false
+  Route SelectScatter on ks_sharded (shards: 4, rows: 4)
+      FieldQuery: select count(*) from music where 1 != 1
+      Query: select count(*) from music
+      Table: music
+
+----------------------------------------------------------------------
+`
+	assert.Equal(t, want, PlansAsText(plans))
+
+	var got []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(PlansAsJSON(plans)), &got))
+	require.Len(t, got, 1)
+	root := got[0]["Plans"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "Aggregate", root["OperatorType"])
+	assert.EqualValues(t, 1, root["EstimatedRows"])
+	route := root["Inputs"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "SelectScatter", route["Variant"])
+	assert.EqualValues(t, 4, route["EstimatedShards"])
+	assert.EqualValues(t, 4, route["EstimatedRows"])
+	assert.True(t, strings.HasPrefix(route["Query"].(string), "select count(*)"))
+}
+
+func TestPlanEstimatesSaturate(t *testing.T) {
+	opts := defaultTestOpts()
+	var err error
+	opts.TableStats, err = ParseTableStats(`{"user": {"Rows": 4000000000000}, "t1": {"Rows": 4000000000000}}`)
+	require.NoError(t, err)
+	initTest(ModeMulti, opts, &testopts{}, t)
+
+	explains, err := Run("select u.id from user u join t1 on u.id = t1.id")
+	require.NoError(t, err)
+	plans := ExplainPlans(explains)
+	require.Len(t, plans, 1)
+	require.Len(t, plans[0].Plans, 1)
+	root := plans[0].Plans[0]
+	assert.Equal(t, "Join", root.Description.OperatorType)
+	assert.EqualValues(t, math.MaxInt64, root.EstimatedRows)
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*CorrelatedSubquery)(nil)
+
+// CorrelatedSubquery filters the rows of the Outer primitive
+// using a subquery that references columns of those rows.
+// For every outer row, the columns listed in Vars are bound as
+// bind variables, just like a Join does for its RHS, and the
+// Subquery is executed. The outer row is returned only if the
+// subquery result satisfies the Opcode.
+// Outer rows that produce identical bind variables share the
+// same subquery result, which means that the subquery is executed
+// only once per distinct set of values within a query. When
+// streaming, the results are only shared within a batch of outer
+// rows, so that they don't pile up for the whole stream.
+type CorrelatedSubquery struct {
+	Opcode CorrelatedOpcode
+
+	// Outer and Subquery are the primitives being correlated.
+	Outer, Subquery Primitive `json:",omitempty"`
+
+	// Vars defines the list of bind variables that need to
+	// be built from the outer row before invoking the subquery.
+	Vars map[string]int `json:",omitempty"`
+
+	// Comparand is the column of the outer row that is compared
+	// against the values returned by the subquery. It's used only
+	// by CorrelatedIn and CorrelatedNotIn.
+	Comparand int `json:",omitempty"`
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (cs *CorrelatedSubquery) RouteType() string {
+	return cs.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (cs *CorrelatedSubquery) GetKeyspaceName() string {
+	return cs.Outer.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (cs *CorrelatedSubquery) GetTableName() string {
+	return cs.Outer.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (cs *CorrelatedSubquery) SetTruncateColumnCount(count int) {
+	cs.TruncateColumnCount = count
+}
+
+// Execute satisfies the Primitive interface.
+func (cs *CorrelatedSubquery) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	oresult, err := cs.Outer.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := cs.filter(vcursor, bindVars, oresult.Rows, make(map[string]*sqltypes.Result))
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{
+		Fields:       oresult.Fields,
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+	}
+	return result.Truncate(cs.TruncateColumnCount), nil
+}
+
+// StreamExecute performs a streaming exec.
+func (cs *CorrelatedSubquery) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return cs.Outer.StreamExecute(vcursor, bindVars, wantfields, func(oresult *sqltypes.Result) error {
+		rows, err := cs.filter(vcursor, bindVars, oresult.Rows, make(map[string]*sqltypes.Result))
+		if err != nil {
+			return err
+		}
+		if len(oresult.Fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		result := &sqltypes.Result{
+			Fields: oresult.Fields,
+			Rows:   rows,
+		}
+		return callback(result.Truncate(cs.TruncateColumnCount))
+	})
+}
+
+// GetFields fetches the field info.
+func (cs *CorrelatedSubquery) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := cs.Outer.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(cs.TruncateColumnCount), nil
+}
+
+// Inputs returns the input primitives for this correlated subquery
+func (cs *CorrelatedSubquery) Inputs() []Primitive {
+	return []Primitive{cs.Outer, cs.Subquery}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (cs *CorrelatedSubquery) NeedsTransaction() bool {
+	return cs.Outer.NeedsTransaction() || cs.Subquery.NeedsTransaction()
+}
+
+// filter returns the outer rows that satisfy the subquery condition.
+// Subquery results are memoized in cache, keyed by the values of
+// the correlated bind variables.
+func (cs *CorrelatedSubquery) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value, cache map[string]*sqltypes.Result) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	names := sortedVarNames(cs.Vars)
+	corrVars := make(map[string]*querypb.BindVariable, len(cs.Vars))
+	for _, row := range rows {
+		key := cs.bindRow(row, names, corrVars)
+		sresult, ok := cache[key]
+		if !ok {
+			var err error
+			sresult, err = cs.Subquery.Execute(vcursor, combineVars(bindVars, corrVars), false)
+			if err != nil {
+				return nil, err
+			}
+			cache[key] = sresult
+		}
+		match, err := cs.evaluate(row, sresult)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			out = append(out, row)
+		}
+		if vcursor.ExceedsMaxMemoryRows(len(out)) {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return out, nil
+}
+
+// bindRow sets the correlated bind variables from row, and returns
+// a key that uniquely identifies their values.
+// The names must be sorted for the key to be stable.
+func (cs *CorrelatedSubquery) bindRow(row []sqltypes.Value, names []string, corrVars map[string]*querypb.BindVariable) string {
+	var key strings.Builder
+	for _, k := range names {
+		v := row[cs.Vars[k]]
+		corrVars[k] = sqltypes.ValueBindVariable(v)
+		fmt.Fprintf(&key, "%d:%d:%s|", v.Type(), v.Len(), v.Raw())
+	}
+	return key.String()
+}
+
+// evaluate applies the opcode to the subquery result for the outer row.
+func (cs *CorrelatedSubquery) evaluate(row []sqltypes.Value, sresult *sqltypes.Result) (bool, error) {
+	switch cs.Opcode {
+	case CorrelatedExists:
+		return len(sresult.Rows) != 0, nil
+	case CorrelatedNotExists:
+		return len(sresult.Rows) == 0, nil
+	}
+
+	// CorrelatedIn and CorrelatedNotIn follow the SQL rules for NULL:
+	// a NULL comparand or a NULL in a non-matching list yield unknown,
+	// which filters out the row.
+	if len(sresult.Rows) == 0 {
+		return cs.Opcode == CorrelatedNotIn, nil
+	}
+	if len(sresult.Rows[0]) != 1 {
+		return false, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "subquery returned more than one column")
+	}
+	comparand := row[cs.Comparand]
+	if comparand.IsNull() {
+		return false, nil
+	}
+	sawNull := false
+	for _, srow := range sresult.Rows {
+		if srow[0].IsNull() {
+			sawNull = true
+			continue
+		}
+		cmp, err := evalengine.NullsafeCompare(comparand, srow[0])
+		if err != nil {
+			return false, err
+		}
+		if cmp == 0 {
+			return cs.Opcode == CorrelatedIn, nil
+		}
+	}
+	if sawNull {
+		return false, nil
+	}
+	return cs.Opcode == CorrelatedNotIn, nil
+}
+
+func (cs *CorrelatedSubquery) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Vars": strings.Join(sortedVarNames(cs.Vars), ","),
+	}
+	if cs.Opcode == CorrelatedIn || cs.Opcode == CorrelatedNotIn {
+		other["Comparand"] = cs.Comparand
+	}
+	return PrimitiveDescription{
+		OperatorType: "Subquery",
+		Variant:      cs.Opcode.String(),
+		Other:        other,
+	}
+}
+
+func sortedVarNames(vars map[string]int) []string {
+	names := make([]string, 0, len(vars))
+	for k := range vars {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CorrelatedOpcode is a number representing the opcode
+// for the CorrelatedSubquery primitive.
+type CorrelatedOpcode int
+
+// This is the list of CorrelatedOpcode values.
+const (
+	CorrelatedExists = CorrelatedOpcode(iota)
+	CorrelatedNotExists
+	CorrelatedIn
+	CorrelatedNotIn
+)
+
+var correlatedName = map[CorrelatedOpcode]string{
+	CorrelatedExists:    "CorrelatedExists",
+	CorrelatedNotExists: "CorrelatedNotExists",
+	CorrelatedIn:        "CorrelatedIn",
+	CorrelatedNotIn:     "CorrelatedNotIn",
+}
+
+func (code CorrelatedOpcode) String() string {
+	return correlatedName[code]
+}
+
+// MarshalJSON serializes the CorrelatedOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code CorrelatedOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
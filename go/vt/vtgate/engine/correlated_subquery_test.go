@@ -0,0 +1,237 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestCorrelatedSubqueryExists(t *testing.T) {
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+	outerFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|int64",
+	)
+	ofp := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				outerFields,
+				"1|5",
+				"2|6",
+				"3|5",
+			),
+		},
+	}
+	subFields := sqltypes.MakeTestFields(
+		"1",
+		"int64",
+	)
+	sfp := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subFields, "1"),
+			sqltypes.MakeTestResult(subFields),
+		},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:              CorrelatedExists,
+		Outer:               ofp,
+		Subquery:            sfp,
+		Vars:                map[string]int{"col": 1},
+		TruncateColumnCount: 1,
+	}
+
+	r, err := cs.Execute(noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	ofp.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	// The third row reuses the result of the first one.
+	sfp.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" col: type:INT64 value:"5"  false`,
+		`Execute a: type:INT64 value:"10" col: type:INT64 value:"6"  false`,
+	})
+	expectResult(t, "cs.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"3",
+	))
+
+	// NOT EXISTS keeps the complementary rows.
+	ofp.rewind()
+	sfp.rewind()
+	cs.Opcode = CorrelatedNotExists
+	r, err = cs.Execute(noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "cs.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"2",
+	))
+}
+
+func TestCorrelatedSubqueryIn(t *testing.T) {
+	outerFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|int64",
+	)
+	subFields := sqltypes.MakeTestFields(
+		"col",
+		"int64",
+	)
+	testcases := []struct {
+		opcode CorrelatedOpcode
+		outer  []string
+		sub    [][]string
+		want   []string
+	}{{
+		opcode: CorrelatedIn,
+		outer:  []string{"1|5", "2|6", "3|null"},
+		sub:    [][]string{{"5", "7"}, {"7"}, {"5"}},
+		want:   []string{"1"},
+	}, {
+		opcode: CorrelatedNotIn,
+		outer:  []string{"1|5", "2|6", "3|null"},
+		sub:    [][]string{{"5", "7"}, {"7"}, {"5"}},
+		want:   []string{"2"},
+	}, {
+		// A NULL in the subquery result makes NOT IN unknown
+		// for values that are not found.
+		opcode: CorrelatedNotIn,
+		outer:  []string{"1|5", "2|6"},
+		sub:    [][]string{{"null"}, {}},
+		want:   []string{"2"},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.opcode.String(), func(t *testing.T) {
+			ofp := &fakePrimitive{
+				results: []*sqltypes.Result{sqltypes.MakeTestResult(outerFields, tc.outer...)},
+			}
+			sfp := &fakePrimitive{}
+			for _, rows := range tc.sub {
+				sfp.results = append(sfp.results, sqltypes.MakeTestResult(subFields, rows...))
+			}
+			cs := &CorrelatedSubquery{
+				Opcode:              tc.opcode,
+				Outer:               ofp,
+				Subquery:            sfp,
+				Vars:                map[string]int{"id": 0},
+				Comparand:           1,
+				TruncateColumnCount: 1,
+			}
+			r, err := cs.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+			require.NoError(t, err)
+			want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), tc.want...)
+			require.Equal(t, want.Rows, r.Rows)
+		})
+	}
+}
+
+func TestCorrelatedSubqueryStreamExecute(t *testing.T) {
+	outerFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|int64",
+	)
+	ofp := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				outerFields,
+				"1|5",
+				"2|6",
+				"3|5",
+			),
+		},
+	}
+	subFields := sqltypes.MakeTestFields(
+		"1",
+		"int64",
+	)
+	sfp := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(subFields, "1"),
+			sqltypes.MakeTestResult(subFields),
+			sqltypes.MakeTestResult(subFields, "1"),
+		},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:   CorrelatedExists,
+		Outer:    ofp,
+		Subquery: sfp,
+		Vars:     map[string]int{"col": 1},
+	}
+
+	// The fake streams the outer rows two at a time: 1|5 and 2|6,
+	// then 3|5. The subquery results are only reused within a batch,
+	// so the subquery is executed again for 5.
+	r, err := wrapStreamExecute(cs, noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	sfp.ExpectLog(t, []string{
+		`Execute col: type:INT64 value:"5"  false`,
+		`Execute col: type:INT64 value:"6"  false`,
+		`Execute col: type:INT64 value:"5"  false`,
+	})
+	expectResult(t, "cs.StreamExecute", r, sqltypes.MakeTestResult(
+		outerFields,
+		"1|5",
+		"3|5",
+	))
+}
+
+func TestCorrelatedSubqueryBadColumns(t *testing.T) {
+	ofp := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+			),
+		},
+	}
+	sfp := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|int64",
+				),
+				"1|1",
+			),
+		},
+	}
+	cs := &CorrelatedSubquery{
+		Opcode:   CorrelatedIn,
+		Outer:    ofp,
+		Subquery: sfp,
+		Vars:     map[string]int{"id": 0},
+	}
+
+	_, err := cs.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "cs.Execute", err, "subquery returned more than one column")
+}
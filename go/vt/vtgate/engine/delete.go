@@ -187,14 +187,37 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 	return execMultiShard(vcursor, rss, queries, del.MultiShardAutocommit)
 }
 
+// execDeleteShards deletes in every shard of rss with the bind
+// variables of the shard in bvs. The shards are changed in parallel.
+func (del *Delete) execDeleteShards(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	err := allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+	if len(del.Table.Owned) > 0 {
+		err = del.deleteShardVindexEntries(vcursor, rss, bvs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return execMultiShard(vcursor, rss, getQueries(del.Query, bvs), del.MultiShardAutocommit)
+}
+
 // deleteVindexEntries performs an delete if table owns vindex.
 // Note: the commit order may be different from the DML order because it's possible
 // for DMLs to reuse existing transactions.
 func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
-	queries := make([]*querypb.BoundQuery, len(rss))
+	bvs := make([]map[string]*querypb.BindVariable, len(rss))
 	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: bindVars}
+		bvs[i] = bindVars
 	}
+	return del.deleteShardVindexEntries(vcursor, rss, bvs)
+}
+
+// deleteShardVindexEntries is like deleteVindexEntries, with
+// the bind variables of every shard of rss in bvs.
+func (del *Delete) deleteShardVindexEntries(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
+	queries := getQueries(del.OwnedVindexQuery, bvs)
 	subQueryResults, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
 		if err != nil {
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that performs the FILTER operation.
+// It evaluates the Predicate on every row returned by Input,
+// and only returns the rows for which it's true.
+type Filter struct {
+	Predicate evalengine.Expr
+	Input     Primitive
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (f *Filter) SetTruncateColumnCount(count int) {
+	f.TruncateColumnCount = count
+}
+
+// Execute satisfies the Primitive interface.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := f.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := f.filter(bindVars, result.Fields, result.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.Rows = rows
+	result.RowsAffected = uint64(len(rows))
+	return result.Truncate(f.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// The fields are only sent with the first result.
+	var fields []*querypb.Field
+	return f.Input.StreamExecute(vcursor, bindVars, wantfields, func(result *sqltypes.Result) error {
+		if result.Fields != nil {
+			fields = result.Fields
+		}
+		rows, err := f.filter(bindVars, fields, result.Rows)
+		if err != nil {
+			return err
+		}
+		if len(result.Fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		result.Rows = rows
+		return callback(result.Truncate(f.TruncateColumnCount))
+	})
+}
+
+// GetFields satisfies the Primitive interface.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := f.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(f.TruncateColumnCount), nil
+}
+
+// Inputs returns the input to the filter
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (f *Filter) NeedsTransaction() bool {
+	return f.Input.NeedsTransaction()
+}
+
+// filter returns the rows for which the predicate is true. The
+// fields, if known, give the collations of the columns.
+func (f *Filter) filter(bindVars map[string]*querypb.BindVariable, fields []*querypb.Field, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		env.Row = row
+		result, err := f.Predicate.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if result.ToBoolean() {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func (f *Filter) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "Filter",
+		Other: map[string]interface{}{
+			"Predicate": f.Predicate.String(),
+		},
+	}
+}
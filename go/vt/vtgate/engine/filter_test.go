@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// greaterThan returns the predicate `column > val`.
+func greaterThan(offset int, val int64) evalengine.Expr {
+	return &evalengine.BinaryOp{
+		Expr:  &evalengine.GreaterThan{},
+		Left:  evalengine.NewColumn(offset),
+		Right: evalengine.NewLiteralInt(val),
+	}
+}
+
+func TestFilterExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|count(*)",
+		"varchar|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|6",
+			"c|null",
+			"d|10",
+		)},
+	}
+	f := &Filter{
+		Predicate: greaterThan(1, 5),
+		Input:     fp,
+	}
+
+	result, err := f.Execute(nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(
+		fields,
+		"b|6",
+		"d|10",
+	), result)
+	fp.ExpectLog(t, []string{`Execute  false`})
+}
+
+func TestFilterTruncate(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"varchar|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|6",
+		)},
+	}
+	f := &Filter{
+		Predicate:           greaterThan(1, 5),
+		Input:               fp,
+		TruncateColumnCount: 1,
+	}
+
+	result, err := f.Execute(nil, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("col1", "varchar"),
+		"b",
+	), result)
+}
+
+func TestFilterStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"varchar|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|6",
+			"c|3",
+			"d|2",
+			"e|8",
+		)},
+	}
+	f := &Filter{
+		Predicate: greaterThan(1, 5),
+		Input:     fp,
+	}
+
+	result, err := wrapStreamExecute(f, nil, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(
+		fields,
+		"b|6",
+		"e|8",
+	), result)
+}
+
+func TestFilterGetFields(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"varchar|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields)},
+	}
+	f := &Filter{
+		Predicate:           greaterThan(1, 5),
+		Input:               fp,
+		TruncateColumnCount: 1,
+	}
+
+	result, err := f.GetFields(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("col1", "varchar")), result)
+}
+
+func TestFilterInputError(t *testing.T) {
+	f := &Filter{
+		Predicate: greaterThan(1, 5),
+		Input:     &fakePrimitive{sendErr: errors.New("input err")},
+	}
+
+	_, err := f.Execute(nil, nil, false)
+	require.EqualError(t, err, "input err")
+
+	_, err = wrapStreamExecute(f, nil, nil, false)
+	require.EqualError(t, err, "input err")
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -72,6 +73,13 @@ type Insert struct {
 	Mid    []string
 	Suffix string
 
+	// ReplaceColumns and ReplaceValues are for sharded REPLACE plans of
+	// tables with owned vindexes. ReplaceValues[i][j] is the SQL expression
+	// of the value of ReplaceColumns[j] in row i. They are used to find
+	// the existing rows that the REPLACE deletes.
+	ReplaceColumns []sqlparser.ColIdent
+	ReplaceValues  [][]string
+
 	// Option to override the standard behavior and allow a multi-shard insert
 	// to use single round trip autocommit.
 	//
@@ -150,12 +158,17 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertShardedReplace is for REPLACE. The owned vindex
+	// entries of the rows it deletes are deleted before the
+	// entries of the new rows are created.
+	InsertShardedReplace
 )
 
 var insName = map[InsertOpcode]string{
-	InsertUnsharded:     "InsertUnsharded",
-	InsertSharded:       "InsertSharded",
-	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertUnsharded:      "InsertUnsharded",
+	InsertSharded:        "InsertSharded",
+	InsertShardedIgnore:  "InsertShardedIgnore",
+	InsertShardedReplace: "InsertShardedReplace",
 }
 
 // String returns the opcode
@@ -197,7 +210,7 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 	switch ins.Opcode {
 	case InsertUnsharded:
 		return ins.execInsertUnsharded(vcursor, bindVars)
-	case InsertSharded, InsertShardedIgnore:
+	case InsertSharded, InsertShardedIgnore, InsertShardedReplace:
 		return ins.execInsertSharded(vcursor, bindVars)
 	default:
 		// Unreachable.
@@ -399,10 +412,20 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
 	}
 
+	var existing [][]bool
+	if ins.Opcode == InsertShardedReplace {
+		existing, err = ins.processReplaced(vcursor, bindVars, vindexRowsValues, keyspaceIDs)
+		if err != nil {
+			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		}
+	}
+
 	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
 		colVindex := ins.Table.ColumnVindexes[vIdx]
 		var err error
-		if colVindex.Owned {
+		if colVindex.Owned && existing != nil {
+			err = ins.processOwnedReplace(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs, existing[vIdx])
+		} else if colVindex.Owned {
 			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
 		} else {
 			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
@@ -538,6 +561,214 @@ func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.
 	return nil
 }
 
+// uniqueKeysQuery fetches the columns of the unique keys of a table.
+const uniqueKeysQuery = "select index_name, column_name from information_schema.statistics " +
+	"where table_schema = database() and table_name = :table_name and non_unique = 0 " +
+	"order by index_name, seq_in_index"
+
+// processReplaced deletes the owned vindex entries of the existing rows
+// that a REPLACE deletes. Those are the rows of the shard of a new row
+// that have the same values in all the columns of a unique key. Entries
+// that the new rows would create again are kept, and reported as
+// existing[vIdx][rowNum] for processOwnedReplace. It returns nil if
+// the table has no owned vindexes.
+func (ins *Insert) processReplaced(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, ksids [][]byte) ([][]bool, error) {
+	if len(ins.Table.Owned) == 0 {
+		return nil, nil
+	}
+	if len(ins.ReplaceValues) != len(ksids) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: replace values don't match the rows: %d %d", len(ins.ReplaceValues), len(ksids))
+	}
+	// The rows are locked before the REPLACE is sent, and the
+	// vindex entries are changed. None of it can autocommit.
+	_ = vcursor.AutocommitApproval()
+
+	indexes := make([]*querypb.Value, len(ksids))
+	destinations := make([]key.Destination, len(ksids))
+	for i, ksid := range ksids {
+		indexes[i] = &querypb.Value{Value: strconv.AppendInt(nil, int64(i), 10)}
+		destinations[i] = key.DestinationKeyspaceID(ksid)
+	}
+	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	if err != nil {
+		return nil, err
+	}
+	uniqueKeys, err := ins.uniqueKeys(vcursor, rss[0])
+	if err != nil {
+		return nil, err
+	}
+	existing := make([][]bool, len(ins.Table.ColumnVindexes))
+	for vIdx := range existing {
+		existing[vIdx] = make([]bool, len(ksids))
+	}
+	if len(uniqueKeys) == 0 {
+		return existing, nil
+	}
+
+	// The bind variables of the vindex columns are referenced by the values.
+	bv := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		bv[k] = v
+	}
+	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		for rowNum, rowColumnKeys := range vindexRowsValues[vIdx] {
+			for colIdx, vindexKey := range rowColumnKeys {
+				bv[InsertVarName(colVindex.Columns[colIdx], rowNum)] = sqltypes.ValueBindVariable(vindexKey)
+			}
+		}
+	}
+
+	primary := ins.Table.ColumnVindexes[0]
+	for i, rs := range rss {
+		var rowNums []int
+		for _, indexValue := range indexesPerRss[i] {
+			index, _ := strconv.Atoi(string(indexValue.Value))
+			rowNums = append(rowNums, index)
+		}
+		qr, err := execShard(vcursor, ins.replacedRowsQuery(uniqueKeys, rowNums), bv, rs, false /* rollbackOnError */, false /* canAutocommit */)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range qr.Rows {
+			dests, err := vindexes.Map(primary.Vindex, vcursor, [][]sqltypes.Value{row[:len(primary.Columns)]})
+			if err != nil {
+				return nil, err
+			}
+			ksid, ok := dests[0].(key.DestinationKeyspaceID)
+			if !ok {
+				return nil, fmt.Errorf("could not map %v to a unique keyspace id: %v", row[:len(primary.Columns)], dests[0])
+			}
+			colnum := len(primary.Columns)
+			for vIdx, colVindex := range ins.Table.ColumnVindexes {
+				if vIdx == 0 || !colVindex.Owned {
+					continue
+				}
+				// Fetch the column values. colnum must keep incrementing.
+				fromIds := row[colnum : colnum+len(colVindex.Columns)]
+				colnum += len(colVindex.Columns)
+				rowNum := matchVindexEntry(vindexRowsValues[vIdx], ksids, fromIds, ksid)
+				if rowNum >= 0 {
+					existing[vIdx][rowNum] = true
+					continue
+				}
+				if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return existing, nil
+}
+
+// uniqueKeys returns the columns of every unique key of the table.
+// The keys are the same in all shards, so only rs is asked. They are
+// read for every statement, within its transaction: vtgate isn't told
+// about schema changes, and a REPLACE that misses a new unique key
+// would leave behind the lookup vindex rows of the rows it deletes.
+func (ins *Insert) uniqueKeys(vcursor VCursor, rs *srvtopo.ResolvedShard) ([][]sqlparser.ColIdent, error) {
+	bv := map[string]*querypb.BindVariable{
+		"table_name": sqltypes.StringBindVariable(ins.Table.Name.String()),
+	}
+	qr, err := execShard(vcursor, uniqueKeysQuery, bv, rs, false /* rollbackOnError */, false /* canAutocommit */)
+	if err != nil {
+		return nil, err
+	}
+	var keys [][]sqlparser.ColIdent
+	lastIndex := ""
+	for _, row := range qr.Rows {
+		if len(keys) == 0 || row[0].ToString() != lastIndex {
+			keys = append(keys, nil)
+			lastIndex = row[0].ToString()
+		}
+		keys[len(keys)-1] = append(keys[len(keys)-1], sqlparser.NewColIdent(row[1].ToString()))
+	}
+	return keys, nil
+}
+
+// replacedRowsQuery builds the query that locks the rows that conflict
+// with the new rows rowNums, and selects the columns of their primary
+// and owned vindexes. A unique key column that is absent from the
+// REPLACE gets its default value.
+func (ins *Insert) replacedRowsQuery(uniqueKeys [][]sqlparser.ColIdent, rowNums []int) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	sep := ""
+	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		if vIdx != 0 && !colVindex.Owned {
+			continue
+		}
+		for _, col := range colVindex.Columns {
+			buf.Myprintf("%s%v", sep, col)
+			sep = ", "
+		}
+	}
+	buf.Myprintf(" from %v where ", ins.Table.Name)
+	sep = ""
+	for _, rowNum := range rowNums {
+		for _, uniqueKey := range uniqueKeys {
+			buf.Myprintf("%s(", sep)
+			for i, col := range uniqueKey {
+				if i != 0 {
+					buf.Myprintf(" and ")
+				}
+				buf.Myprintf("%v = %s", col, ins.replaceValue(col, rowNum))
+			}
+			buf.Myprintf(")")
+			sep = " or "
+		}
+	}
+	buf.Myprintf(" for update")
+	return buf.String()
+}
+
+// replaceValue returns the SQL expression of the value of col in a
+// new row.
+func (ins *Insert) replaceValue(col sqlparser.ColIdent, rowNum int) string {
+	for i, column := range ins.ReplaceColumns {
+		if column.Equal(col) {
+			return ins.ReplaceValues[rowNum][i]
+		}
+	}
+	return fmt.Sprintf("default(%s)", sqlparser.String(col))
+}
+
+// matchVindexEntry returns the number of the new row that has the
+// vindex entry of values and ksid, or -1 if there is none.
+func matchVindexEntry(rowsValues [][]sqltypes.Value, ksids [][]byte, values []sqltypes.Value, ksid []byte) int {
+outer:
+	for rowNum, rowValues := range rowsValues {
+		if !bytes.Equal(ksids[rowNum], ksid) {
+			continue
+		}
+		for i, v := range rowValues {
+			cmp, err := evalengine.NullsafeCompare(v, values[i])
+			if err != nil || cmp != 0 {
+				continue outer
+			}
+		}
+		return rowNum
+	}
+	return -1
+}
+
+// processOwnedReplace creates the vindex entries for the values of an
+// owned column of a REPLACE, except for the existing ones.
+func (ins *Insert) processOwnedReplace(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte, existing []bool) error {
+	var createKeys [][]sqltypes.Value
+	var createKsids [][]byte
+	for rowNum, rowColumnKeys := range vindexColumnsKeys {
+		if existing[rowNum] {
+			continue
+		}
+		createKeys = append(createKeys, rowColumnKeys)
+		createKsids = append(createKsids, ksids[rowNum])
+	}
+	if createKeys == nil {
+		return nil
+	}
+	return colVindex.Vindex.(vindexes.Lookup).Create(vcursor, createKeys, createKsids, false /* ignoreMode */)
+}
+
 // processUnowned either reverse maps or validates the values for an unowned column.
 func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte) error {
 	reverseIndexes := []int{}
@@ -0,0 +1,309 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*InsertSelect)(nil)
+
+// DefaultInsertSelectBatchSize is the number of rows inserted
+// at a time if InsertSelect.BatchSize is not set.
+const DefaultInsertSelectBatchSize = 500
+
+// InsertSelect represents the instructions to insert the rows
+// produced by a SELECT. The input is streamed in the session, and
+// its rows are inserted in batches as they arrive, so they're never
+// all held in memory. Streaming queries don't run in the transaction:
+// the input reads the committed rows, and it doesn't see the rows it
+// inserts. Every batch is routed like the rows of a VALUES clause
+// would be by Insert: sequence values are generated, owned lookup
+// vindex entries are created, and every row is sent to the shard of
+// its keyspace id.
+type InsertSelect struct {
+	// Insert contains the routing information for the rows.
+	// Its Prefix and Suffix are used to build the query for
+	// every batch, and its Generate Values are ignored.
+	Insert *Insert
+
+	// VindexColumns holds the offsets in a row of the columns
+	// of every column vindex of Insert.Table.
+	VindexColumns [][]int
+
+	// AutoIncrementColumn is the offset in a row of the
+	// auto-increment column. It's used only if Insert.Generate
+	// is set.
+	AutoIncrementColumn int
+
+	// InputColumnCount is the number of columns produced by Input,
+	// or 0 if it's not known, in which case the rows are inserted
+	// as they are. ColumnCount is the number of columns inserted.
+	// The columns that follow those of the input are inserted as NULL.
+	InputColumnCount int
+	ColumnCount      int
+
+	// BatchSize is the maximum number of rows per insert.
+	BatchSize int
+
+	// Input produces the rows to insert.
+	Input Primitive
+
+	// InsertSelect needs tx handling
+	txNeeded
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (is *InsertSelect) RouteType() string {
+	return is.Insert.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (is *InsertSelect) GetKeyspaceName() string {
+	return is.Insert.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (is *InsertSelect) GetTableName() string {
+	return is.Insert.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (is *InsertSelect) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if is.Insert.QueryTimeout != 0 {
+		cancel := vcursor.SetContextTimeout(time.Duration(is.Insert.QueryTimeout) * time.Millisecond)
+		defer cancel()
+	}
+
+	batchSize := is.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultInsertSelectBatchSize
+	}
+	result := &sqltypes.Result{}
+	var rows [][]sqltypes.Value
+	insert := func(rows [][]sqltypes.Value) error {
+		batch, err := is.insertRows(vcursor, bindVars, rows)
+		if err != nil {
+			return err
+		}
+		result.RowsAffected += batch.RowsAffected
+		if result.InsertID == 0 {
+			result.InsertID = batch.InsertID
+		}
+		return nil
+	}
+	err := is.Input.StreamExecute(vcursor, bindVars, false, func(qr *sqltypes.Result) error {
+		for _, row := range qr.Rows {
+			if is.InputColumnCount != 0 && len(row) != is.InputColumnCount {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column count doesn't match value count: %d, %d", is.InputColumnCount, len(row))
+			}
+		}
+		rows = append(rows, qr.Rows...)
+		for len(rows) >= batchSize {
+			if err := insert(rows[:batchSize]); err != nil {
+				return err
+			}
+			rows = rows[batchSize:]
+		}
+		return nil
+	})
+	if err == nil && len(rows) != 0 {
+		err = insert(rows)
+	}
+	if err != nil {
+		return nil, vterrors.Wrap(err, "InsertSelect")
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (is *InsertSelect) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return fmt.Errorf("query %q cannot be used for streaming", is.Insert.Query)
+}
+
+// GetFields fetches the field info.
+func (is *InsertSelect) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unreachable code for %q", is.Insert.Query)
+}
+
+// Inputs returns the input of the InsertSelect.
+func (is *InsertSelect) Inputs() []Primitive {
+	return []Primitive{is.Input}
+}
+
+// insertRows inserts a batch of rows. The rows are not inserted
+// in autocommit mode, because other batches may follow.
+func (is *InsertSelect) insertRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) (*sqltypes.Result, error) {
+	bv := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		bv[k] = v
+	}
+	ins := is.batchInsert(rows)
+	insertID, err := ins.processGenerate(vcursor, bv)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *sqltypes.Result
+	switch ins.Opcode {
+	case InsertUnsharded:
+		rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+		if err != nil {
+			return nil, err
+		}
+		if len(rss) != 1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Keyspace does not have exactly one shard: %v", rss)
+		}
+		if err := allowOnlyMaster(rss...); err != nil {
+			return nil, err
+		}
+		result, err = execShard(vcursor, ins.Prefix+strings.Join(ins.Mid, ",")+ins.Suffix, bv, rss[0], true, false /* canAutocommit */)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		rss, queries, err := ins.getInsertShardedRoute(vcursor, bv)
+		if err != nil {
+			return nil, err
+		}
+		if len(rss) == 0 {
+			// Every row was dropped by an insert ignore.
+			return &sqltypes.Result{}, nil
+		}
+		if err := allowOnlyMaster(rss...); err != nil {
+			return nil, err
+		}
+		var errs []error
+		result, errs = vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, false /* autocommit */)
+		if errs != nil {
+			return nil, vterrors.Aggregate(errs)
+		}
+	}
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// batchInsert returns an Insert that inserts rows. The values of
+// the vindex and auto-increment columns are bind variables that
+// are resolved by the Insert, the other values are inlined.
+func (is *InsertSelect) batchInsert(rows [][]sqltypes.Value) *Insert {
+	ins := *is.Insert
+	ins.Mid = make([]string, len(rows))
+
+	if ins.Generate != nil {
+		gen := *ins.Generate
+		gen.Values = sqltypes.PlanValue{Values: make([]sqltypes.PlanValue, len(rows))}
+		for rowNum, row := range rows {
+			gen.Values.Values[rowNum] = sqltypes.PlanValue{Value: is.value(row, is.AutoIncrementColumn)}
+		}
+		ins.Generate = &gen
+	}
+	ins.VindexValues = make([]sqltypes.PlanValue, len(is.VindexColumns))
+	varNames := make(map[int]sqlparser.ColIdent)
+	for vIdx, cols := range is.VindexColumns {
+		colVindex := ins.Table.ColumnVindexes[vIdx]
+		ins.VindexValues[vIdx].Values = make([]sqltypes.PlanValue, len(cols))
+		for colIdx, col := range cols {
+			varNames[col] = colVindex.Columns[colIdx]
+			values := make([]sqltypes.PlanValue, len(rows))
+			for rowNum, row := range rows {
+				// The values of an auto-increment column are
+				// known once the sequence values are generated.
+				if ins.Generate != nil && col == is.AutoIncrementColumn {
+					values[rowNum] = sqltypes.PlanValue{Key: SeqVarName + strconv.Itoa(rowNum)}
+					continue
+				}
+				values[rowNum] = sqltypes.PlanValue{Value: is.value(row, col)}
+			}
+			ins.VindexValues[vIdx].Values[colIdx].Values = values
+		}
+	}
+
+	buf := &strings.Builder{}
+	for rowNum, row := range rows {
+		columnCount := is.ColumnCount
+		if is.InputColumnCount == 0 {
+			columnCount = len(row)
+		}
+		buf.Reset()
+		buf.WriteByte('(')
+		for col := 0; col < columnCount; col++ {
+			if col > 0 {
+				buf.WriteString(", ")
+			}
+			if name, ok := varNames[col]; ok {
+				buf.WriteString(":" + InsertVarName(name, rowNum))
+				continue
+			}
+			if ins.Generate != nil && col == is.AutoIncrementColumn {
+				buf.WriteString(":" + SeqVarName + strconv.Itoa(rowNum))
+				continue
+			}
+			is.value(row, col).EncodeSQL(buf)
+		}
+		buf.WriteByte(')')
+		ins.Mid[rowNum] = buf.String()
+	}
+	return &ins
+}
+
+// value returns the value of a column in a row.
+func (is *InsertSelect) value(row []sqltypes.Value, col int) sqltypes.Value {
+	if col >= len(row) {
+		return sqltypes.NULL
+	}
+	return row[col]
+}
+
+func (is *InsertSelect) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"TableName":            is.GetTableName(),
+		"Prefix":               is.Insert.Prefix,
+		"Suffix":               is.Insert.Suffix,
+		"MultiShardAutocommit": is.Insert.MultiShardAutocommit,
+		"QueryTimeout":         is.Insert.QueryTimeout,
+	}
+	if is.VindexColumns != nil {
+		other["VindexColumns"] = is.VindexColumns
+	}
+	if is.Insert.Generate != nil {
+		other["AutoIncrementColumn"] = is.AutoIncrementColumn
+		other["Sequence"] = is.Insert.Generate.Query
+	}
+	return PrimitiveDescription{
+		OperatorType:     "InsertSelect",
+		Keyspace:         is.Insert.Keyspace,
+		Variant:          is.Insert.Opcode.String(),
+		TargetTabletType: topodatapb.TabletType_MASTER,
+		Other:            other,
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestInsertSelectSharded(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	require.NoError(t, err)
+	ks := vs.Keyspaces["sharded"]
+
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|val",
+				"int64|varchar",
+			),
+			"1|a",
+			"2|b'c",
+			"3|d",
+		)},
+	}
+	ins := &InsertSelect{
+		Insert:           NewInsert(InsertSharded, ks.Keyspace, nil, ks.Tables["t1"], "insert into t1(id, val) values ", nil, ""),
+		VindexColumns:    [][]int{{0}},
+		InputColumnCount: 2,
+		ColumnCount:      2,
+		BatchSize:        2,
+		Input:            input,
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{`StreamExecute  false`})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: insert into t1(id, val) values (:_id_0, 'a') {_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`sharded.-20: insert into t1(id, val) values (:_id_1, 'b\'c') {_id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`true false`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: insert into t1(id, val) values (:_id_0, 'd') {_id_0: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+}
+
+func TestInsertSelectShardedGenerate(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	require.NoError(t, err)
+	ks := vs.Keyspaces["sharded"]
+
+	// The id column is not selected and gets generated for every row.
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"val",
+				"varchar",
+			),
+			"a",
+			"b",
+		)},
+	}
+	ins := &InsertSelect{
+		Insert:              NewInsert(InsertSharded, ks.Keyspace, nil, ks.Tables["t1"], "insert into t1(val, id) values ", nil, ""),
+		VindexColumns:       [][]int{{1}},
+		AutoIncrementColumn: 1,
+		InputColumnCount:    1,
+		ColumnCount:         2,
+		Input:               input,
+	}
+	ins.Insert.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query: "dummy_generate",
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20"}
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"1",
+		),
+		{RowsAffected: 2},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2"  ks2 -20`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: insert into t1(val, id) values ('a', :_id_0) ` +
+			`{__seq0: type:INT64 value:"1" __seq1: type:INT64 value:"2" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`sharded.-20: insert into t1(val, id) values ('b', :_id_1) ` +
+			`{__seq0: type:INT64 value:"1" __seq1: type:INT64 value:"2" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2, InsertID: 1})
+}
+
+func TestInsertSelectUnsharded(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|val",
+				"int64|varchar",
+			),
+			"4|a",
+			"null|b",
+		)},
+	}
+	ins := &InsertSelect{
+		Insert: &Insert{
+			Opcode: InsertUnsharded,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: false,
+			},
+			Prefix: "insert into t1(id, val) values ",
+			Generate: &Generate{
+				Keyspace: &vindexes.Keyspace{
+					Name:    "ks2",
+					Sharded: false,
+				},
+				Query: "dummy_generate",
+			},
+		},
+		InputColumnCount: 2,
+		ColumnCount:      2,
+		Input:            input,
+	}
+
+	vc := newDMLTestVCursor("0")
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"7",
+		),
+		{RowsAffected: 2},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 0`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: insert into t1(id, val) values (:__seq0, 'a'),(:__seq1, 'b') ` +
+			`{__seq0: type:INT64 value:"4" __seq1: type:INT64 value:"7" } true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2, InsertID: 7})
+}
+
+func TestInsertSelectErrors(t *testing.T) {
+	ins := &InsertSelect{
+		Insert: &Insert{
+			Opcode: InsertUnsharded,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: false,
+			},
+			Prefix: "insert into t1(id, val) values ",
+		},
+		InputColumnCount: 2,
+		ColumnCount:      2,
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+			)},
+		},
+	}
+	_, err := ins.Execute(newDMLTestVCursor("0"), map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "InsertSelect: column count doesn't match value count: 2, 1")
+
+	ins.Input = &fakePrimitive{sendErr: errors.New("input err")}
+	_, err = ins.Execute(newDMLTestVCursor("0"), map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "InsertSelect: input err")
+
+}
+
+func TestInsertSelectStreamsInput(t *testing.T) {
+	// The input is streamed, so it may produce more rows than
+	// MaxMemoryRows, and every batch is inserted as it arrives.
+	savedMax := testMaxMemoryRows
+	defer func() { testMaxMemoryRows = savedMax }()
+	testMaxMemoryRows = 1
+	ins := &InsertSelect{
+		Insert: &Insert{
+			Opcode: InsertUnsharded,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: false,
+			},
+			Prefix: "insert into t1(id, val) values ",
+		},
+		InputColumnCount: 2,
+		ColumnCount:      2,
+		BatchSize:        2,
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|val",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			)},
+		},
+	}
+	vc := newDMLTestVCursor("0")
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: insert into t1(id, val) values (1, 'a'),(2, 'b') {} true false`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: insert into t1(id, val) values (3, 'c') {} true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+}
@@ -20,7 +20,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -1621,3 +1624,195 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertShardedReplaceOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}, {
+					Value: sqltypes.NewInt64(11),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.ReplaceColumns = sqlparser.Columns{sqlparser.NewColIdent("id"), sqlparser.NewColIdent("c3"), sqlparser.NewColIdent("name")}
+	ins.ReplaceValues = [][]string{{":_id_0", ":_c3_0", "'a'"}, {":_id_1", ":_c3_1", "'b'"}}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-", "-20"}
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("index_name|column_name", "varchar|varchar"),
+			"PRIMARY|id",
+			"uk|name",
+			"uk|code",
+		),
+		// The existing row of id 1 keeps its vindex entry.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|c3", "int64|int64"),
+			"1|10",
+		),
+		// The existing row of name 'b' has another entry.
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|c3", "int64|int64"),
+			"3|5",
+		),
+	}
+
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: ` + uniqueKeysQuery + ` {table_name: type:VARBINARY value:"t1" } false false`,
+		"ExecuteMultiShard sharded.20-: select id, c3 from t1 where (id = :_id_0) or (`name` = 'a' and `code` = default(`code`)) for update " +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } false false`,
+		"ExecuteMultiShard sharded.-20: select id, c3 from t1 where (id = :_id_1) or (`name` = 'b' and `code` = default(`code`)) for update " +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } false false`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"5" toc: type:VARBINARY value:"N\261\220\311\242\372\026\234"  true`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) ` +
+			`from_0: type:INT64 value:"11" toc_0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix mid1 suffix ` +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix mid2 suffix ` +
+			`{_c3_0: type:INT64 value:"10" _c3_1: type:INT64 value:"11" _id_0: type:INT64 value:"1" _id_1: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+}
+
+func TestInsertShardedReplaceNoUniqueKeys(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}},
+			}},
+		}, {
+			Values: []sqltypes.PlanValue{{
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(10),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1"},
+		" suffix",
+	)
+	ins.ReplaceColumns = sqlparser.Columns{sqlparser.NewColIdent("id"), sqlparser.NewColIdent("c3")}
+	ins.ReplaceValues = [][]string{{":_id_0", ":_c3_0"}}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "20-"}
+
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.20-: ` + uniqueKeysQuery + ` {table_name: type:VARBINARY value:"t1" } false false`,
+		`Execute insert into lkp1(from, toc) values(:from_0, :toc_0) ` +
+			`from_0: type:INT64 value:"10" toc_0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.20-: prefix mid1 suffix {_c3_0: type:INT64 value:"10" _id_0: type:INT64 value:"1" } true true`,
+	})
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*LimitDML)(nil)
+
+// DMLLimitVarName is the bind variable that holds the
+// number of rows to change in a shard for a LimitDML.
+const DMLLimitVarName = "__dml_limit"
+
+// LimitDML represents the instructions to perform an UPDATE or
+// DELETE with a LIMIT that targets more than one shard.
+// Input is a merge-sorted and limited scatter select of the primary
+// vindex values of the rows to change. DML is sent in parallel to
+// every shard that has rows to change, restricted to them by the list
+// bind variable DMLKeysVarName, which holds the primary vindex values
+// of the shard. The primary vindex column may not be unique, so the
+// DML keeps its ORDER BY, and its LIMIT is the bind variable
+// DMLLimitVarName, which holds the number of rows of the shard.
+type LimitDML struct {
+	// Input selects the primary vindex values of the rows to change.
+	// They are the first column of its rows.
+	Input Primitive
+
+	// Vindex is the primary vindex of the table.
+	Vindex vindexes.SingleColumn
+
+	// DML is the scatter Update or Delete.
+	DML Primitive
+
+	// LimitDML needs tx handling
+	txNeeded
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (l *LimitDML) RouteType() string {
+	return l.DML.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (l *LimitDML) GetKeyspaceName() string {
+	return l.DML.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (l *LimitDML) GetTableName() string {
+	return l.DML.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (l *LimitDML) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	dml, err := l.dml()
+	if err != nil {
+		return nil, err
+	}
+	if dml.QueryTimeout != 0 {
+		cancel := vcursor.SetContextTimeout(time.Duration(dml.QueryTimeout) * time.Millisecond)
+		defer cancel()
+	}
+	// The select locks the rows before the shards are changed.
+	// None of them can autocommit.
+	_ = vcursor.AutocommitApproval()
+
+	qr, err := l.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "LimitDML")
+	}
+	if len(qr.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	keys := make([]sqltypes.Value, len(qr.Rows))
+	ids := make([]*querypb.Value, len(qr.Rows))
+	for i, row := range qr.Rows {
+		keys[i] = row[0]
+		ids[i] = sqltypes.ValueToProto(row[0])
+	}
+	destinations, err := l.Vindex.Map(vcursor, keys)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "LimitDML")
+	}
+	rss, values, err := vcursor.ResolveDestinations(dml.Keyspace.Name, ids, destinations)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "LimitDML")
+	}
+
+	bvs := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		bv := make(map[string]*querypb.BindVariable, len(bindVars)+2)
+		for k, v := range bindVars {
+			bv[k] = v
+		}
+		bv[DMLKeysVarName] = &querypb.BindVariable{Type: querypb.Type_TUPLE, Values: values[i]}
+		bv[DMLLimitVarName] = sqltypes.Int64BindVariable(int64(len(values[i])))
+		bvs[i] = bv
+	}
+	result, err := l.execShards(vcursor, bindVars, rss, bvs)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "LimitDML")
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (l *LimitDML) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return fmt.Errorf("DML with limit on %s cannot be used for streaming", l.GetTableName())
+}
+
+// GetFields fetches the field info.
+func (l *LimitDML) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "BUG: unreachable code for DML with limit")
+}
+
+// Inputs returns the input and the DML of the LimitDML.
+func (l *LimitDML) Inputs() []Primitive {
+	return []Primitive{l.Input, l.DML}
+}
+
+// execShards executes DML in every shard of rss with the bind
+// variables of the shard in bvs.
+func (l *LimitDML) execShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	switch dml := l.DML.(type) {
+	case *Delete:
+		return dml.execDeleteShards(vcursor, rss, bvs)
+	case *Update:
+		return dml.execUpdateShards(vcursor, bindVars, rss, bvs)
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected DML type: %T", l.DML)
+}
+
+func (l *LimitDML) dml() (*DML, error) {
+	switch dml := l.DML.(type) {
+	case *Delete:
+		return &dml.DML, nil
+	case *Update:
+		return &dml.DML, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected DML type: %T", l.DML)
+}
+
+func (l *LimitDML) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "LimitDML",
+		Other: map[string]interface{}{
+			"Vindex": l.Vindex.String(),
+		},
+	}
+}
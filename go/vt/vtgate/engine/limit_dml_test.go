@@ -0,0 +1,122 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestLimitDMLDelete(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id",
+				"int64",
+			),
+			"1",
+			"2",
+			"3",
+		)},
+	}
+	ldml := &LimitDML{
+		Input:  input,
+		Vindex: ks.Vindexes["hash"].(vindexes.SingleColumn),
+		DML: &Delete{
+			DML: DML{
+				Opcode:   Scatter,
+				Keyspace: ks.Keyspace,
+				Query:    "dummy_delete",
+				Table:    ks.Tables["t2"],
+			},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{{RowsAffected: 3}}
+	result, err := ldml.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{`Execute  false`})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [type:INT64 value:"1"  type:INT64 value:"2"  type:INT64 value:"3" ] ` +
+			`Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"3" > __dml_limit: type:INT64 value:"2" } ` +
+			`sharded.-20: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"2" > __dml_limit: type:INT64 value:"1" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3})
+}
+
+func TestLimitDMLNoRows(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ldml := &LimitDML{
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+			)},
+		},
+		Vindex: ks.Vindexes["hash"].(vindexes.SingleColumn),
+		DML: &Update{
+			DML: DML{
+				Opcode:   Scatter,
+				Keyspace: ks.Keyspace,
+				Query:    "dummy_update",
+				Table:    ks.Tables["t2"],
+			},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	result, err := ldml.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, nil)
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+}
+
+func TestLimitDMLErrors(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	ldml := &LimitDML{
+		Input:  &fakePrimitive{sendErr: errors.New("input err")},
+		Vindex: ks.Vindexes["hash"].(vindexes.SingleColumn),
+		DML: &Delete{
+			DML: DML{
+				Opcode:   Scatter,
+				Keyspace: ks.Keyspace,
+				Query:    "dummy_delete",
+				Table:    ks.Tables["t2"],
+			},
+		},
+	}
+	_, err := ldml.Execute(newDMLTestVCursor("-20", "20-"), map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "LimitDML: input err")
+
+	err = ldml.StreamExecute(nil, nil, false, nil)
+	require.EqualError(t, err, "DML with limit on t2 cannot be used for streaming")
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*MultiTableDML)(nil)
+
+const (
+	// DMLKeysVarName is the list bind variable that holds the
+	// distinct key values selected by a MultiTableDML, or the
+	// primary vindex values of a shard selected by a LimitDML.
+	DMLKeysVarName = "__dml_keys"
+	// DMLVindexValuesVarName is the list bind variable that holds
+	// the distinct primary vindex values selected by a MultiTableDML.
+	DMLVindexValuesVarName = "__dml_vindex_values"
+)
+
+// MultiTableDML represents the instructions to perform an UPDATE
+// or DELETE that joins tables which can't be sent to a single route.
+// Input selects the rows of the target table that must change, and
+// DML is a single-table Update or Delete of the target table that is
+// restricted to those rows by the list bind variables DMLKeysVarName
+// and DMLVindexValuesVarName. Owned vindexes are maintained by DML.
+type MultiTableDML struct {
+	// Input selects the rows of the target table that must change.
+	Input Primitive
+
+	// KeyColumn is the offset in the rows of Input of the column
+	// that identifies the rows to change, or -1 if there is none.
+	KeyColumn int
+
+	// VindexColumn is the offset in the rows of Input of the primary
+	// vindex column of the target table, or -1 if the target table
+	// is unsharded.
+	VindexColumn int
+
+	// DML is the Update or Delete of the target table.
+	DML Primitive
+
+	// MultiTableDML needs tx handling
+	txNeeded
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (m *MultiTableDML) RouteType() string {
+	return m.DML.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (m *MultiTableDML) GetKeyspaceName() string {
+	return m.DML.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (m *MultiTableDML) GetTableName() string {
+	return m.DML.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (m *MultiTableDML) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	// The select opens transactions on the shards it reads from, which
+	// the DML must not bypass by autocommitting. Claiming the approval
+	// keeps the DML inside the session transaction.
+	_ = vcursor.AutocommitApproval()
+
+	result, err := m.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "MultiTableDML")
+	}
+	if len(result.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	bv := make(map[string]*querypb.BindVariable, len(bindVars)+2)
+	for k, v := range bindVars {
+		bv[k] = v
+	}
+	if m.KeyColumn >= 0 {
+		keys, err := distinctValues(result.Rows, m.KeyColumn)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "MultiTableDML")
+		}
+		bv[DMLKeysVarName] = keys
+	}
+	if m.VindexColumn >= 0 {
+		values, err := distinctValues(result.Rows, m.VindexColumn)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "MultiTableDML")
+		}
+		bv[DMLVindexValuesVarName] = values
+	}
+	return m.DML.Execute(vcursor, bv, wantfields)
+}
+
+// StreamExecute performs a streaming exec.
+func (m *MultiTableDML) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return fmt.Errorf("multi-table DML on %s cannot be used for streaming", m.GetTableName())
+}
+
+// GetFields fetches the field info.
+func (m *MultiTableDML) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "BUG: unreachable code for multi-table DML")
+}
+
+// Inputs returns the input and the DML of the MultiTableDML.
+func (m *MultiTableDML) Inputs() []Primitive {
+	return []Primitive{m.Input, m.DML}
+}
+
+// distinctValues returns a list bind variable with the distinct
+// values of a column of rows. A NULL value cannot be matched by
+// an IN clause and is rejected.
+func distinctValues(rows [][]sqltypes.Value, col int) (*querypb.BindVariable, error) {
+	bv := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		if col >= len(row) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: column offset %d out of range", col)
+		}
+		v := row[col]
+		if v.IsNull() {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: NULL value in the join column of the target table")
+		}
+		key := v.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		bv.Values = append(bv.Values, sqltypes.ValueToProto(v))
+	}
+	return bv, nil
+}
+
+func (m *MultiTableDML) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"KeyColumn":    m.KeyColumn,
+		"VindexColumn": m.VindexColumn,
+	}
+	return PrimitiveDescription{
+		OperatorType: "MultiTableDML",
+		Other:        other,
+	}
+}
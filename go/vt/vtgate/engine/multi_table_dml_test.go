@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestMultiTableDMLSharded(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"extra_id|id",
+				"int64|int64",
+			),
+			"10|1",
+			"20|2",
+			"10|1",
+		)},
+	}
+	mdml := &MultiTableDML{
+		Input:        input,
+		KeyColumn:    0,
+		VindexColumn: 1,
+		DML: &Delete{
+			DML: DML{
+				Opcode:   In,
+				Keyspace: ks.Keyspace,
+				Query:    "dummy_delete",
+				Table:    ks.Tables["t2"],
+				Vindex:   ks.Vindexes["hash"].(vindexes.SingleColumn),
+				Values:   []sqltypes.PlanValue{{ListKey: DMLVindexValuesVarName}},
+			},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}}
+	result, err := mdml.Execute(vc, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1)}, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{`Execute a: type:INT64 value:"1"  false`})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: dummy_delete {__dml_keys: type:TUPLE values:<type:INT64 value:"10" > values:<type:INT64 value:"20" > ` +
+			`__dml_vindex_values: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > a: type:INT64 value:"1" } true true`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2})
+}
+
+func TestMultiTableDMLNoRows(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id",
+				"int64",
+			),
+		)},
+	}
+	mdml := &MultiTableDML{
+		Input:        input,
+		KeyColumn:    0,
+		VindexColumn: -1,
+		DML: &Update{
+			DML: DML{
+				Opcode: Unsharded,
+				Keyspace: &vindexes.Keyspace{
+					Name:    "ks",
+					Sharded: false,
+				},
+				Query: "dummy_update",
+			},
+		},
+	}
+
+	vc := newDMLTestVCursor("0")
+	result, err := mdml.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, nil)
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+}
+
+func TestMultiTableDMLErrors(t *testing.T) {
+	mdml := &MultiTableDML{
+		Input: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+				"null",
+			)},
+		},
+		KeyColumn:    0,
+		VindexColumn: -1,
+		DML: &Delete{
+			DML: DML{
+				Opcode: Unsharded,
+				Keyspace: &vindexes.Keyspace{
+					Name:    "ks",
+					Sharded: false,
+				},
+				Query: "dummy_delete",
+			},
+		},
+	}
+	_, err := mdml.Execute(newDMLTestVCursor("0"), map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "MultiTableDML: unsupported: NULL value in the join column of the target table")
+
+	mdml.Input = &fakePrimitive{sendErr: errors.New("input err")}
+	_, err = mdml.Execute(newDMLTestVCursor("0"), map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "MultiTableDML: input err")
+}
@@ -35,6 +35,7 @@ func (p *Projection) Execute(vcursor VCursor, bindVars map[string]*querypb.BindV
 
 	env := evalengine.ExpressionEnv{
 		BindVars: bindVars,
+		Fields:   result.Fields,
 	}
 
 	if wantfields {
@@ -67,6 +68,7 @@ func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb
 
 	env := evalengine.ExpressionEnv{
 		BindVars: bindVars,
+		Fields:   result.Fields,
 	}
 
 	if wantields {
@@ -104,7 +106,7 @@ func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bin
 }
 
 func (p *Projection) addFields(qr *sqltypes.Result, bindVars map[string]*querypb.BindVariable) error {
-	env := evalengine.ExpressionEnv{BindVars: bindVars}
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: qr.Fields}
 	for i, col := range p.Cols {
 		q, err := p.Exprs[i].Type(env)
 		if err != nil {
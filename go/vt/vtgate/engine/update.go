@@ -197,6 +197,21 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 	return execMultiShard(vcursor, rss, queries, upd.MultiShardAutocommit)
 }
 
+// execUpdateShards updates every shard of rss with the bind variables
+// of the shard in bvs. The shards are changed in parallel.
+func (upd *Update) execUpdateShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	err := allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+	if len(upd.ChangedVindexValues) != 0 {
+		if err := upd.updateShardVindexEntries(vcursor, bindVars, rss, bvs); err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateShards")
+		}
+	}
+	return execMultiShard(vcursor, rss, getQueries(upd.Query, bvs), upd.MultiShardAutocommit)
+}
+
 // updateVindexEntries performs an update when a vindex is being modified
 // by the statement.
 // Note: the commit order may be different from the DML order because it's possible
@@ -204,10 +219,18 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 // Note 2: While changes are being committed, the changing row could be
 // unreachable by either the new or old column values.
 func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
-	queries := make([]*querypb.BoundQuery, len(rss))
+	bvs := make([]map[string]*querypb.BindVariable, len(rss))
 	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: bindVars}
+		bvs[i] = bindVars
 	}
+	return upd.updateShardVindexEntries(vcursor, bindVars, rss, bvs)
+}
+
+// updateShardVindexEntries is like updateVindexEntries, with the bind
+// variables of every shard of rss in bvs. The new vindex values are
+// resolved with bindVars.
+func (upd *Update) updateShardVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
+	queries := getQueries(upd.OwnedVindexQuery, bvs)
 	subQueryResult, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
 		if err != nil {
@@ -0,0 +1,593 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates window functions.
+// It expects the underlying primitive to return rows sorted
+// by the PartitionBy columns followed by the OrderBy columns.
+// Rows are buffered one partition at a time, and the result of
+// every window function is written into the column that
+// held its argument.
+type Window struct {
+	// PartitionBy specifies the input columns that split
+	// the rows into partitions.
+	PartitionBy []int `json:",omitempty"`
+
+	// OrderBy specifies the ordering of rows within a partition.
+	// Rows that compare equal on these columns are peers.
+	OrderBy []OrderbyParams `json:",omitempty"`
+
+	// Funcs lists the window functions to evaluate.
+	Funcs []WindowFunc
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// WindowFunc specifies a window function to evaluate.
+type WindowFunc struct {
+	Opcode WindowOpcode
+	// Col is the input column that holds the argument of the
+	// function. The result of the function replaces its value.
+	Col int
+	// Alias is the name of the resulting column.
+	Alias string `json:",omitempty"`
+	// Frame is the frame of the function. If nil, the frame is
+	// the entire partition if there is no ordering, and all the
+	// rows up to the last peer of the current row otherwise.
+	Frame *WindowFrame `json:",omitempty"`
+	// N is the number of buckets for ntile, the offset for lag
+	// and lead, and the row number within the frame for nth_value.
+	N int64 `json:",omitempty"`
+	// Default is the value returned by lag and lead if the row
+	// at the requested offset is outside the partition.
+	Default sqltypes.Value
+}
+
+func (wf WindowFunc) String() string {
+	var args string
+	switch wf.Opcode {
+	case WindowNtile:
+		args = fmt.Sprintf("%d", wf.N)
+	case WindowLag, WindowLead:
+		args = fmt.Sprintf("%d, %d, %s", wf.Col, wf.N, wf.Default.String())
+	case WindowNthValue:
+		args = fmt.Sprintf("%d, %d", wf.Col, wf.N)
+	default:
+		args = fmt.Sprintf("%d", wf.Col)
+	}
+	val := fmt.Sprintf("%s(%s)", wf.Opcode.String(), args)
+	if wf.Frame != nil {
+		val += " " + wf.Frame.String()
+	}
+	if wf.Alias != "" {
+		val += " AS " + wf.Alias
+	}
+	return val
+}
+
+// WindowFrame is the frame of a window function.
+type WindowFrame struct {
+	// Range is set for RANGE frames. Otherwise, the frame is a ROWS frame.
+	// Only CURRENT ROW and UNBOUNDED bounds are supported for RANGE frames.
+	Range bool `json:",omitempty"`
+	Start FrameBound
+	End   FrameBound
+}
+
+func (wf *WindowFrame) String() string {
+	unit := "rows"
+	if wf.Range {
+		unit = "range"
+	}
+	return fmt.Sprintf("%s between %s and %s", unit, wf.Start.format("preceding"), wf.End.format("following"))
+}
+
+// FrameBound is a bound of a window frame.
+type FrameBound struct {
+	// Unbounded is set for UNBOUNDED PRECEDING when used as the
+	// start of a frame, and UNBOUNDED FOLLOWING when used as the end.
+	Unbounded bool `json:",omitempty"`
+	// Offset is the position of the bound relative to the current row.
+	// It's negative for PRECEDING, positive for FOLLOWING and 0 for
+	// CURRENT ROW.
+	Offset int64 `json:",omitempty"`
+}
+
+func (fb FrameBound) format(unbounded string) string {
+	switch {
+	case fb.Unbounded:
+		return "unbounded " + unbounded
+	case fb.Offset < 0:
+		return fmt.Sprintf("%d preceding", -fb.Offset)
+	case fb.Offset > 0:
+		return fmt.Sprintf("%d following", fb.Offset)
+	}
+	return "current row"
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowPercentRank
+	WindowCumeDist
+	WindowNtile
+	WindowLag
+	WindowLead
+	WindowFirstValue
+	WindowLastValue
+	WindowNthValue
+	WindowCount
+	WindowSum
+	WindowMin
+	WindowMax
+)
+
+// SupportedWindowFuncs maps the list of supported window
+// functions to their opcodes.
+var SupportedWindowFuncs = map[string]WindowOpcode{
+	"row_number":   WindowRowNumber,
+	"rank":         WindowRank,
+	"dense_rank":   WindowDenseRank,
+	"percent_rank": WindowPercentRank,
+	"cume_dist":    WindowCumeDist,
+	"ntile":        WindowNtile,
+	"lag":          WindowLag,
+	"lead":         WindowLead,
+	"first_value":  WindowFirstValue,
+	"last_value":   WindowLastValue,
+	"nth_value":    WindowNthValue,
+	"count":        WindowCount,
+	"sum":          WindowSum,
+	"min":          WindowMin,
+	"max":          WindowMax,
+}
+
+var windowName = map[WindowOpcode]string{
+	WindowRowNumber:   "row_number",
+	WindowRank:        "rank",
+	WindowDenseRank:   "dense_rank",
+	WindowPercentRank: "percent_rank",
+	WindowCumeDist:    "cume_dist",
+	WindowNtile:       "ntile",
+	WindowLag:         "lag",
+	WindowLead:        "lead",
+	WindowFirstValue:  "first_value",
+	WindowLastValue:   "last_value",
+	WindowNthValue:    "nth_value",
+	WindowCount:       "count",
+	WindowSum:         "sum",
+	WindowMin:         "min",
+	WindowMax:         "max",
+}
+
+func (code WindowOpcode) String() string {
+	if name, ok := windowName[code]; ok {
+		return name
+	}
+	return fmt.Sprintf("WindowOpcode(%d)", int(code))
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (w *Window) SetTruncateColumnCount(count int) {
+	w.TruncateColumnCount = count
+}
+
+// Execute satisfies the Primitive interface.
+func (w *Window) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := w.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result.Fields = w.convertFields(result.Fields)
+	start := 0
+	for i := 1; i <= len(result.Rows); i++ {
+		if i < len(result.Rows) {
+			equal, err := keysEqual(w.PartitionBy, result.Rows[start], result.Rows[i])
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				continue
+			}
+		}
+		if err := w.evaluate(result.Rows[start:i]); err != nil {
+			return nil, err
+		}
+		start = i
+	}
+	return result.Truncate(w.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (w *Window) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var partition [][]sqltypes.Value
+
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(w.TruncateColumnCount))
+	}
+	flush := func() error {
+		if len(partition) == 0 {
+			return nil
+		}
+		if err := w.evaluate(partition); err != nil {
+			return err
+		}
+		rows := partition
+		partition = nil
+		return cb(&sqltypes.Result{Rows: rows})
+	}
+
+	err := w.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			if err := cb(&sqltypes.Result{Fields: w.convertFields(qr.Fields)}); err != nil {
+				return err
+			}
+		}
+		for _, row := range qr.Rows {
+			if len(partition) != 0 {
+				equal, err := keysEqual(w.PartitionBy, partition[0], row)
+				if err != nil {
+					return err
+				}
+				if !equal {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+			partition = append(partition, row)
+		}
+		if vcursor.ExceedsMaxMemoryRows(len(partition)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// GetFields satisfies the Primitive interface.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{Fields: w.convertFields(qr.Fields)}
+	return qr.Truncate(w.TruncateColumnCount), nil
+}
+
+// Inputs returns the input to the window primitive
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+// convertFields changes the names and types of the columns
+// that hold the results of the window functions.
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	for _, wf := range w.Funcs {
+		field := &querypb.Field{
+			Name: fields[wf.Col].Name,
+			Type: fields[wf.Col].Type,
+		}
+		if wf.Alias != "" {
+			field.Name = wf.Alias
+		}
+		switch wf.Opcode {
+		case WindowRowNumber, WindowRank, WindowDenseRank, WindowNtile, WindowCount:
+			field.Type = sqltypes.Int64
+		case WindowPercentRank, WindowCumeDist:
+			field.Type = sqltypes.Float64
+		case WindowSum:
+			if !sqltypes.IsFloat(field.Type) {
+				field.Type = sqltypes.Decimal
+			}
+		}
+		fields[wf.Col] = field
+	}
+	return fields
+}
+
+// evaluate computes the window functions for the rows of a partition.
+// All the functions are evaluated before any of the rows are modified,
+// because a function can read the argument of other rows.
+func (w *Window) evaluate(rows [][]sqltypes.Value) error {
+	p, err := w.newWindowPartition(rows)
+	if err != nil {
+		return err
+	}
+	results := make([][]sqltypes.Value, len(w.Funcs))
+	for i, wf := range w.Funcs {
+		results[i] = make([]sqltypes.Value, len(rows))
+		for row := range rows {
+			results[i][row], err = p.evaluate(wf, row)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for i, wf := range w.Funcs {
+		for row := range rows {
+			rows[row][wf.Col] = results[i][row]
+		}
+	}
+	return nil
+}
+
+// windowPartition holds the rows of a partition along with
+// the boundaries of the peer group of every row.
+type windowPartition struct {
+	rows      [][]sqltypes.Value
+	ordered   bool
+	peerStart []int
+	peerEnd   []int
+	// group is the ordinal of the peer group of every row.
+	group []int
+}
+
+func (w *Window) newWindowPartition(rows [][]sqltypes.Value) (*windowPartition, error) {
+	p := &windowPartition{
+		rows:      rows,
+		ordered:   len(w.OrderBy) != 0,
+		peerStart: make([]int, len(rows)),
+		peerEnd:   make([]int, len(rows)),
+		group:     make([]int, len(rows)),
+	}
+	cols := make([]int, 0, len(w.OrderBy))
+	for _, order := range w.OrderBy {
+		cols = append(cols, order.Col)
+	}
+	group := 0
+	for start := 0; start < len(rows); group++ {
+		end := start + 1
+		for ; end < len(rows); end++ {
+			equal, err := keysEqual(cols, rows[start], rows[end])
+			if err != nil {
+				return nil, err
+			}
+			if !equal {
+				break
+			}
+		}
+		for i := start; i < end; i++ {
+			p.peerStart[i] = start
+			p.peerEnd[i] = end - 1
+			p.group[i] = group
+		}
+		start = end
+	}
+	return p, nil
+}
+
+func (p *windowPartition) evaluate(wf WindowFunc, row int) (sqltypes.Value, error) {
+	n := len(p.rows)
+	switch wf.Opcode {
+	case WindowRowNumber:
+		return sqltypes.NewInt64(int64(row + 1)), nil
+	case WindowRank:
+		return sqltypes.NewInt64(int64(p.peerStart[row] + 1)), nil
+	case WindowDenseRank:
+		return sqltypes.NewInt64(int64(p.group[row] + 1)), nil
+	case WindowPercentRank:
+		if n == 1 {
+			return sqltypes.NewFloat64(0), nil
+		}
+		return sqltypes.NewFloat64(float64(p.peerStart[row]) / float64(n-1)), nil
+	case WindowCumeDist:
+		return sqltypes.NewFloat64(float64(p.peerEnd[row]+1) / float64(n)), nil
+	case WindowNtile:
+		return sqltypes.NewInt64(ntile(row, n, int(wf.N))), nil
+	case WindowLag, WindowLead:
+		offset := int(wf.N)
+		if wf.Opcode == WindowLag {
+			offset = -offset
+		}
+		if target := row + offset; target >= 0 && target < n {
+			return p.rows[target][wf.Col], nil
+		}
+		return wf.Default, nil
+	}
+
+	lo, hi := p.frame(wf.Frame, row)
+	switch wf.Opcode {
+	case WindowFirstValue:
+		if lo > hi {
+			return sqltypes.NULL, nil
+		}
+		return p.rows[lo][wf.Col], nil
+	case WindowLastValue:
+		if lo > hi {
+			return sqltypes.NULL, nil
+		}
+		return p.rows[hi][wf.Col], nil
+	case WindowNthValue:
+		target := lo + int(wf.N) - 1
+		if target > hi {
+			return sqltypes.NULL, nil
+		}
+		return p.rows[target][wf.Col], nil
+	case WindowCount:
+		count := int64(0)
+		for i := lo; i <= hi; i++ {
+			if !p.rows[i][wf.Col].IsNull() {
+				count++
+			}
+		}
+		return sqltypes.NewInt64(count), nil
+	case WindowSum:
+		sum := sqltypes.NULL
+		for i := lo; i <= hi; i++ {
+			val := p.rows[i][wf.Col]
+			if val.IsNull() {
+				continue
+			}
+			resultType := sqltypes.Decimal
+			if sqltypes.IsFloat(val.Type()) || sqltypes.IsFloat(sum.Type()) {
+				resultType = sqltypes.Float64
+			}
+			sum = evalengine.NullsafeAdd(sum, val, resultType)
+		}
+		return sum, nil
+	case WindowMin, WindowMax:
+		result := sqltypes.NULL
+		for i := lo; i <= hi; i++ {
+			var err error
+			if wf.Opcode == WindowMin {
+				result, err = evalengine.Min(result, p.rows[i][wf.Col])
+			} else {
+				result, err = evalengine.Max(result, p.rows[i][wf.Col])
+			}
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+		}
+		return result, nil
+	}
+	return sqltypes.NULL, fmt.Errorf("BUG: Unexpected window function opcode: %v", wf.Opcode)
+}
+
+// frame returns the first and last rows of the frame of the specified row.
+// The frame is empty if lo is greater than hi.
+func (p *windowPartition) frame(frame *WindowFrame, row int) (lo, hi int) {
+	n := len(p.rows)
+	if frame == nil {
+		if !p.ordered {
+			return 0, n - 1
+		}
+		return 0, p.peerEnd[row]
+	}
+	switch {
+	case frame.Start.Unbounded:
+		lo = 0
+	case frame.Range:
+		lo = p.peerStart[row]
+	default:
+		lo = row + int(frame.Start.Offset)
+	}
+	switch {
+	case frame.End.Unbounded:
+		hi = n - 1
+	case frame.Range:
+		hi = p.peerEnd[row]
+	default:
+		hi = row + int(frame.End.Offset)
+	}
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > n-1 {
+		hi = n - 1
+	}
+	return lo, hi
+}
+
+// ntile returns the bucket of the specified row if n rows are
+// split into the specified number of buckets. Like MySQL, the
+// first buckets receive one extra row if the rows can't be
+// split evenly.
+func ntile(row, n, buckets int) int64 {
+	size, extra := n/buckets, n%buckets
+	if row < extra*(size+1) {
+		return int64(row/(size+1) + 1)
+	}
+	return int64(extra + (row-extra*(size+1))/size + 1)
+}
+
+func keysEqual(keys []int, row1, row2 []sqltypes.Value) (bool, error) {
+	for _, key := range keys {
+		cmp, err := evalengine.NullsafeCompare(row1[key], row2[key])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func windowFuncToString(i interface{}) string {
+	return i.(WindowFunc).String()
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Funcs": GenericJoin(w.Funcs, windowFuncToString),
+	}
+	if len(w.PartitionBy) != 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, intToString)
+	}
+	if len(w.OrderBy) != 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, orderByParamsToString)
+	}
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestWindowRanking(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"dept|salary|1|1|1|1|1|1",
+				"varbinary|int64|int64|int64|int64|int64|int64|int64",
+			),
+			"a|30|1|1|1|1|1|1",
+			"a|20|1|1|1|1|1|1",
+			"a|20|1|1|1|1|1|1",
+			"a|10|1|1|1|1|1|1",
+			"b|50|1|1|1|1|1|1",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{0},
+		OrderBy:     []OrderbyParams{{Col: 1, Desc: true}},
+		Funcs: []WindowFunc{
+			{Opcode: WindowRowNumber, Col: 2, Alias: "rn"},
+			{Opcode: WindowRank, Col: 3, Alias: "rk"},
+			{Opcode: WindowDenseRank, Col: 4, Alias: "drk"},
+			{Opcode: WindowPercentRank, Col: 5, Alias: "pr"},
+			{Opcode: WindowCumeDist, Col: 6, Alias: "cd"},
+			{Opcode: WindowNtile, Col: 7, Alias: "nt", N: 3},
+		},
+		Input: fp,
+	}
+
+	result, err := w.Execute(nil, nil, true)
+	require.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"dept|salary|rn|rk|drk|pr|cd|nt",
+			"varbinary|int64|int64|int64|int64|float64|float64|int64",
+		),
+		"a|30|1|1|1|0|0.25|1",
+		"a|20|2|2|2|0.3333333333333333|0.75|1",
+		"a|20|3|2|2|0.3333333333333333|0.75|2",
+		"a|10|4|4|3|1|1|3",
+		"b|50|1|1|1|0|1|1",
+	)
+	assert.Equal(t, wantResult, result)
+	fp.ExpectLog(t, []string{`Execute  true`})
+}
+
+func TestWindowOffsetFuncs(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|val|val|val|val|val",
+				"int64|varbinary|varbinary|varbinary|varbinary|varbinary",
+			),
+			"1|a|a|a|a|a",
+			"2|b|b|b|b|b",
+			"3|c|c|c|c|c",
+		)},
+	}
+	w := &Window{
+		OrderBy: []OrderbyParams{{Col: 0}},
+		Funcs: []WindowFunc{
+			{Opcode: WindowLag, Col: 1, N: 1, Default: sqltypes.NewVarBinary("z")},
+			{Opcode: WindowLead, Col: 2, N: 1, Default: sqltypes.NULL},
+			{Opcode: WindowFirstValue, Col: 3},
+			{Opcode: WindowLastValue, Col: 4},
+			{Opcode: WindowNthValue, Col: 5, N: 2},
+		},
+		Input: fp,
+	}
+
+	result, err := w.Execute(nil, nil, false)
+	require.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|val|val|val|val|val",
+			"int64|varbinary|varbinary|varbinary|varbinary|varbinary",
+		),
+		"1|z|b|a|a|null",
+		"2|a|c|a|b|b",
+		"3|b|null|a|c|b",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowFrames(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|val|val|val|val|val",
+		"int64|int64|int64|int64|int64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|1|1|1|1|1",
+			"2|2|2|2|2|2",
+			"2|3|3|3|3|3",
+			"4|null|null|null|null|null",
+		)},
+	}
+	w := &Window{
+		OrderBy: []OrderbyParams{{Col: 0}},
+		Funcs: []WindowFunc{
+			// Default frame: all rows up to the last peer.
+			{Opcode: WindowSum, Col: 1},
+			{Opcode: WindowCount, Col: 2, Frame: &WindowFrame{
+				Start: FrameBound{Offset: -1},
+				End:   FrameBound{Offset: 1},
+			}},
+			{Opcode: WindowMax, Col: 3, Frame: &WindowFrame{
+				Start: FrameBound{Offset: 1},
+				End:   FrameBound{Unbounded: true},
+			}},
+			{Opcode: WindowMin, Col: 4, Frame: &WindowFrame{
+				Range: true,
+				Start: FrameBound{},
+				End:   FrameBound{Unbounded: true},
+			}},
+			{Opcode: WindowSum, Col: 5, Frame: &WindowFrame{
+				Start: FrameBound{Offset: 1},
+				End:   FrameBound{Offset: 1},
+			}},
+		},
+		Input: fp,
+	}
+
+	result, err := w.Execute(nil, nil, true)
+	require.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|val|val|val|val|val",
+			"int64|decimal|int64|int64|int64|decimal",
+		),
+		"1|1|2|3|1|2",
+		"2|6|3|3|2|3",
+		"2|6|2|null|2|null",
+		"4|6|1|null|null|null",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|1",
+		"varbinary|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"a|1",
+			"a|1",
+			"b|1",
+			"c|1",
+			"c|1",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{0},
+		Funcs: []WindowFunc{{
+			Opcode: WindowRowNumber,
+			Col:    1,
+			Alias:  "rn",
+		}},
+		Input: fp,
+	}
+
+	var results []*sqltypes.Result
+	err := w.StreamExecute(noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	wantResults := sqltypes.MakeTestStreamingResults(
+		sqltypes.MakeTestFields(
+			"col|rn",
+			"varbinary|int64",
+		),
+		"a|1",
+		"a|2",
+		"a|3",
+		"---",
+		"b|1",
+		"---",
+		"c|1",
+		"c|2",
+	)
+	assert.Equal(t, wantResults, results)
+}
+
+func TestWindowTruncate(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"1|col|weight_string(col)",
+				"int64|varchar|varbinary",
+			),
+			"1|A|a",
+			"1|a|a",
+			"1|b|b",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{2},
+		Funcs: []WindowFunc{{
+			Opcode: WindowCount,
+			Col:    0,
+			Alias:  "count(*) over (partition by col)",
+		}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := w.Execute(nil, nil, true)
+	require.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"count(*) over (partition by col)|col",
+			"int64|varchar",
+		),
+		"2|A",
+		"2|a",
+		"1|b",
+	)
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowGetFields(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|val",
+		"int64|float64",
+	)
+	fp := &fakePrimitive{results: []*sqltypes.Result{{Fields: fields}}}
+	w := &Window{
+		Funcs: []WindowFunc{{Opcode: WindowSum, Col: 1, Alias: "s"}},
+		Input: fp,
+	}
+
+	result, err := w.GetFields(nil, nil)
+	require.NoError(t, err)
+	wantResult := &sqltypes.Result{Fields: sqltypes.MakeTestFields(
+		"col|s",
+		"int64|float64",
+	)}
+	assert.Equal(t, wantResult, result)
+}
+
+func TestWindowInputError(t *testing.T) {
+	w := &Window{
+		Funcs: []WindowFunc{{Opcode: WindowRowNumber}},
+		Input: &fakePrimitive{sendErr: errors.New("input err")},
+	}
+
+	_, err := w.Execute(nil, nil, false)
+	require.EqualError(t, err, "input err")
+
+	err = w.StreamExecute(noopVCursor{}, nil, false, func(*sqltypes.Result) error { return nil })
+	require.EqualError(t, err, "input err")
+}
+
+func TestWindowOpcodeString(t *testing.T) {
+	for name, code := range SupportedWindowFuncs {
+		assert.Equal(t, name, code.String())
+	}
+	assert.Equal(t, "WindowOpcode(100)", WindowOpcode(100).String())
+}
@@ -56,3 +56,10 @@ func (e *EvalResult) ToBooleanStrict() (bool, error) {
 	}
 	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "is not a boolean")
 }
+
+// ToBoolean returns the truth value of e the way a WHERE clause
+// interprets it: NULL and zero values are not true.
+func (e *EvalResult) ToBoolean() bool {
+	val, _ := toBool(*e)
+	return val
+}
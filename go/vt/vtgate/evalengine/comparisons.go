@@ -0,0 +1,529 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// collation describes how a string EvalResult is compared.
+// Literals and bind variables use the default collation, which
+// is case-insensitive like the default collation of MySQL. Values
+// read from binary columns, or from text columns with a binary
+// collation if the fields of the row are known, compare byte by
+// byte, and they force a binary comparison on the other operand too.
+type collation int8
+
+const (
+	collationDefault = collation(iota)
+	collationCaseInsensitive
+	collationBinary
+)
+
+type (
+	// Comparison ops
+	Equal         struct{}
+	NotEqual      struct{}
+	NullSafeEqual struct{}
+	LessThan      struct{}
+	LessEqual     struct{}
+	GreaterThan   struct{}
+	GreaterEqual  struct{}
+
+	// InExpr evaluates `Left IN (Right...)`, or NOT IN if Negate is set
+	InExpr struct {
+		Left   Expr
+		Right  []Expr
+		Negate bool
+	}
+
+	// LikeExpr evaluates `Left LIKE Right`, or NOT LIKE if Negate is set
+	LikeExpr struct {
+		Left, Right Expr
+		Escape      byte
+		Negate      bool
+
+		// literal holds the regexps of a literal pattern, compiled
+		// once by NewLikeExpr: the case-sensitive one, then the
+		// case-insensitive one. Other patterns are compiled by every
+		// evaluation.
+		literal []*regexp.Regexp
+	}
+)
+
+var _ BinaryExpr = (*Equal)(nil)
+var _ BinaryExpr = (*NotEqual)(nil)
+var _ BinaryExpr = (*NullSafeEqual)(nil)
+var _ BinaryExpr = (*LessThan)(nil)
+var _ BinaryExpr = (*LessEqual)(nil)
+var _ BinaryExpr = (*GreaterThan)(nil)
+var _ BinaryExpr = (*GreaterEqual)(nil)
+var _ Expr = (*InExpr)(nil)
+var _ Expr = (*LikeExpr)(nil)
+
+var resultNull = EvalResult{typ: sqltypes.Null}
+
+func (e EvalResult) isNull() bool {
+	return e.typ == sqltypes.Null
+}
+
+func (e EvalResult) isString() bool {
+	return sqltypes.IsText(e.typ) || sqltypes.IsBinary(e.typ)
+}
+
+func (e EvalResult) isTemporal() bool {
+	switch e.typ {
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time:
+		return true
+	}
+	return false
+}
+
+func newEvalBool(b bool) EvalResult {
+	if b {
+		return EvalResult{typ: sqltypes.Int64, ival: 1}
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: 0}
+}
+
+// mergeCollations returns the collation used to compare
+// or combine two strings.
+func mergeCollations(l, r collation) collation {
+	if l == collationBinary || r == collationBinary {
+		return collationBinary
+	}
+	if l == collationCaseInsensitive || r == collationCaseInsensitive {
+		return collationCaseInsensitive
+	}
+	return collationDefault
+}
+
+// compareStrings compares two strings using their merged collation.
+func compareStrings(l, r EvalResult) int {
+	if mergeCollations(l.collation, r.collation) == collationBinary {
+		return bytes.Compare(l.bytes, r.bytes)
+	}
+	return bytes.Compare(bytes.ToLower(l.bytes), bytes.ToLower(r.bytes))
+}
+
+// compareValues compares two non-NULL values following the MySQL rules
+// for type conversion in comparisons:
+//   - numbers are compared numerically,
+//   - strings are compared using their collation,
+//   - temporal values are compared as dates with strings and temporals,
+//   - anything else is compared as floating point numbers.
+func compareValues(l, r EvalResult) (int, error) {
+	switch {
+	case l.isString() && r.isString():
+		return compareStrings(l, r), nil
+	case (l.isTemporal() || l.isString()) && (r.isTemporal() || r.isString()):
+		lt, lok := parseDateTime(l.bytes)
+		rt, rok := parseDateTime(r.bytes)
+		if !lok || !rok {
+			return compareStrings(l, r), nil
+		}
+		switch {
+		case lt.Before(rt):
+			return -1, nil
+		case lt.After(rt):
+			return 1, nil
+		}
+		return 0, nil
+	case l.isNumeric() && r.isNumeric():
+		return compareNumeric(toNumeric(l), toNumeric(r))
+	}
+	lf, rf := toFloat64(l), toFloat64(r)
+	switch {
+	case lf < rf:
+		return -1, nil
+	case lf > rf:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func (e EvalResult) isNumeric() bool {
+	return sqltypes.IsNumber(e.typ)
+}
+
+// toNumeric normalizes e into an Int64, Uint64 or Float64 result.
+// Strings are converted using their longest numeric prefix.
+func toNumeric(e EvalResult) EvalResult {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return EvalResult{typ: sqltypes.Int64, ival: e.ival}
+	case sqltypes.IsUnsigned(e.typ):
+		return EvalResult{typ: sqltypes.Uint64, uval: e.uval}
+	case sqltypes.IsFloat(e.typ) || e.typ == sqltypes.Decimal:
+		return EvalResult{typ: sqltypes.Float64, fval: e.fval}
+	}
+	prefix := numericPrefix(e.bytes)
+	if ival, err := strconv.ParseInt(prefix, 10, 64); err == nil {
+		return EvalResult{typ: sqltypes.Int64, ival: ival}
+	}
+	fval, _ := strconv.ParseFloat(prefix, 64)
+	return EvalResult{typ: sqltypes.Float64, fval: fval}
+}
+
+func toFloat64(e EvalResult) float64 {
+	n := toNumeric(e)
+	switch n.typ {
+	case sqltypes.Int64:
+		return float64(n.ival)
+	case sqltypes.Uint64:
+		return float64(n.uval)
+	}
+	return n.fval
+}
+
+func toInt64(e EvalResult) int64 {
+	n := toNumeric(e)
+	switch n.typ {
+	case sqltypes.Int64:
+		return n.ival
+	case sqltypes.Uint64:
+		return int64(n.uval)
+	}
+	return int64(math.Round(n.fval))
+}
+
+func isZero(e EvalResult) bool {
+	return toFloat64(e) == 0
+}
+
+// numericPrefix returns the longest prefix of b that
+// is a number, the same way MySQL converts strings.
+func numericPrefix(b []byte) string {
+	s := strings.TrimLeft(string(b), " \t\n")
+	end, digits := 0, false
+	if end < len(s) && (s[end] == '-' || s[end] == '+') {
+		end++
+	}
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+		digits = true
+	}
+	if end < len(s) && s[end] == '.' {
+		end++
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+			digits = true
+		}
+	}
+	if !digits {
+		return "0"
+	}
+	if end < len(s) && (s[end] == 'e' || s[end] == 'E') {
+		exp := end + 1
+		if exp < len(s) && (s[exp] == '-' || s[exp] == '+') {
+			exp++
+		}
+		if exp < len(s) && s[exp] >= '0' && s[exp] <= '9' {
+			for exp < len(s) && s[exp] >= '0' && s[exp] <= '9' {
+				exp++
+			}
+			end = exp
+		}
+	}
+	return s[:end]
+}
+
+var dateTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+	"15:04:05.999999999",
+}
+
+// parseDateTime parses the textual representations of
+// DATE, DATETIME, TIMESTAMP and TIME values.
+func parseDateTime(b []byte) (time.Time, bool) {
+	s := strings.TrimSpace(string(b))
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// compare evaluates a comparison, returning NULL if any side is NULL
+func compare(left, right EvalResult, test func(int) bool) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	cmp, err := compareValues(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return newEvalBool(test(cmp)), nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *Equal) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compare(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (n *NotEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compare(left, right, func(cmp int) bool { return cmp != 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (n *NullSafeEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return newEvalBool(left.isNull() && right.isNull()), nil
+	}
+	return compare(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (l *LessThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compare(left, right, func(cmp int) bool { return cmp < 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (l *LessEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compare(left, right, func(cmp int) bool { return cmp <= 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (g *GreaterThan) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compare(left, right, func(cmp int) bool { return cmp > 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (g *GreaterEqual) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compare(left, right, func(cmp int) bool { return cmp >= 0 })
+}
+
+// Type implements the BinaryExpr interface
+func (e *Equal) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (n *NotEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (n *NullSafeEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (l *LessThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (l *LessEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (g *GreaterThan) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (g *GreaterEqual) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String implements the BinaryExpr interface
+func (e *Equal) String() string {
+	return "="
+}
+
+// String implements the BinaryExpr interface
+func (n *NotEqual) String() string {
+	return "!="
+}
+
+// String implements the BinaryExpr interface
+func (n *NullSafeEqual) String() string {
+	return "<=>"
+}
+
+// String implements the BinaryExpr interface
+func (l *LessThan) String() string {
+	return "<"
+}
+
+// String implements the BinaryExpr interface
+func (l *LessEqual) String() string {
+	return "<="
+}
+
+// String implements the BinaryExpr interface
+func (g *GreaterThan) String() string {
+	return ">"
+}
+
+// String implements the BinaryExpr interface
+func (g *GreaterEqual) String() string {
+	return ">="
+}
+
+// Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.isNull() {
+		return resultNull, nil
+	}
+	sawNull := false
+	for _, expr := range i.Right {
+		right, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if right.isNull() {
+			sawNull = true
+			continue
+		}
+		cmp, err := compareValues(left, right)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if cmp == 0 {
+			return newEvalBool(!i.Negate), nil
+		}
+	}
+	if sawNull {
+		return resultNull, nil
+	}
+	return newEvalBool(i.Negate), nil
+}
+
+// Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (i *InExpr) String() string {
+	var exprs []string
+	for _, expr := range i.Right {
+		exprs = append(exprs, expr.String())
+	}
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + "(" + strings.Join(exprs, ", ") + ")"
+}
+
+// NewLikeExpr returns a LikeExpr. If the pattern is a literal, its
+// regexps are compiled here, once.
+func NewLikeExpr(left, right Expr, escape byte, negate bool) (*LikeExpr, error) {
+	l := &LikeExpr{Left: left, Right: right, Escape: escape, Negate: negate}
+	lit, ok := right.(*Literal)
+	if !ok || !lit.Val.isString() {
+		return l, nil
+	}
+	for _, caseInsensitive := range []bool{false, true} {
+		re, err := likeToRegexp(lit.Val.bytes, escape, caseInsensitive)
+		if err != nil {
+			return nil, err
+		}
+		l.literal = append(l.literal, re)
+	}
+	return l, nil
+}
+
+// Evaluate implements the Expr interface
+func (l *LikeExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := l.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := l.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
+	caseInsensitive := mergeCollations(left.collation, right.collation) != collationBinary
+	var re *regexp.Regexp
+	switch {
+	case l.literal == nil:
+		re, err = likeToRegexp(toBytes(right), l.Escape, caseInsensitive)
+		if err != nil {
+			return EvalResult{}, err
+		}
+	case caseInsensitive:
+		re = l.literal[1]
+	default:
+		re = l.literal[0]
+	}
+	return newEvalBool(re.Match(toBytes(left)) != l.Negate), nil
+}
+
+// Type implements the Expr interface
+func (l *LikeExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (l *LikeExpr) String() string {
+	op := " like "
+	if l.Negate {
+		op = " not like "
+	}
+	return l.Left.String() + op + l.Right.String()
+}
+
+// likeToRegexp converts a LIKE pattern into an anchored regular expression.
+func likeToRegexp(pattern []byte, escape byte, caseInsensitive bool) (*regexp.Regexp, error) {
+	var re strings.Builder
+	if caseInsensitive {
+		re.WriteString("(?i)")
+	}
+	re.WriteString("(?s)^")
+	runes := []rune(string(pattern))
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == rune(escape) && i+1 < len(runes):
+			i++
+			re.WriteString(regexp.QuoteMeta(string(runes[i])))
+		case c == '%':
+			re.WriteString(".*")
+		case c == '_':
+			re.WriteString(".")
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	re.WriteString("$")
+	return regexp.Compile(re.String())
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// Date functions return NULL for values that are not valid dates,
+// like MySQL does. Only the deterministic functions are supported:
+// functions that depend on the current time must be evaluated by MySQL.
+
+// toTime converts a non-NULL value to a time.
+func toTime(e EvalResult) (time.Time, bool) {
+	if e.isNumeric() {
+		// MySQL accepts numbers in the YYYYMMDD and YYYYMMDDhhmmss formats
+		s := string(toBytes(e))
+		for _, layout := range []string{"20060102", "20060102150405"} {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+	return parseDateTime(e.bytes)
+}
+
+func newEvalInt(i int) EvalResult {
+	return EvalResult{typ: sqltypes.Int64, ival: int64(i)}
+}
+
+// dateField wraps a function that extracts a part of a date.
+func dateField(f func(t time.Time) int) func(args []EvalResult) (EvalResult, error) {
+	return strict(func(args []EvalResult) (EvalResult, error) {
+		t, ok := toTime(args[0])
+		if !ok {
+			return resultNull, nil
+		}
+		return newEvalInt(f(t)), nil
+	})
+}
+
+func evalYear(t time.Time) int {
+	return t.Year()
+}
+
+func evalMonth(t time.Time) int {
+	return int(t.Month())
+}
+
+func evalDay(t time.Time) int {
+	return t.Day()
+}
+
+func evalHour(t time.Time) int {
+	return t.Hour()
+}
+
+func evalMinute(t time.Time) int {
+	return t.Minute()
+}
+
+func evalSecond(t time.Time) int {
+	return t.Second()
+}
+
+// evalDayOfWeek returns 1 for Sunday, 2 for Monday, ..., 7 for Saturday
+func evalDayOfWeek(t time.Time) int {
+	return int(t.Weekday()) + 1
+}
+
+// evalWeekday returns 0 for Monday, 1 for Tuesday, ..., 6 for Sunday
+func evalWeekday(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+func evalDayOfYear(t time.Time) int {
+	return t.YearDay()
+}
+
+func evalDate(args []EvalResult) (EvalResult, error) {
+	t, ok := toTime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	return EvalResult{typ: sqltypes.Date, bytes: []byte(t.Format("2006-01-02"))}, nil
+}
+
+func evalDateDiff(args []EvalResult) (EvalResult, error) {
+	t1, ok1 := toTime(args[0])
+	t2, ok2 := toTime(args[1])
+	if !ok1 || !ok2 {
+		return resultNull, nil
+	}
+	// Only the date parts are used in the calculation.
+	d1 := time.Date(t1.Year(), t1.Month(), t1.Day(), 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(t2.Year(), t2.Month(), t2.Day(), 0, 0, 0, 0, time.UTC)
+	return EvalResult{typ: sqltypes.Int64, ival: int64(d1.Sub(d2).Hours() / 24)}, nil
+}
+
+// dateFormatSpecifiers maps the DATE_FORMAT specifiers to their values.
+var dateFormatSpecifiers = map[byte]func(t time.Time) string{
+	'a': func(t time.Time) string { return t.Format("Mon") },
+	'b': func(t time.Time) string { return t.Format("Jan") },
+	'c': func(t time.Time) string { return fmt.Sprintf("%d", t.Month()) },
+	'D': func(t time.Time) string { return fmt.Sprintf("%d%s", t.Day(), ordinalSuffix(t.Day())) },
+	'd': func(t time.Time) string { return t.Format("02") },
+	'e': func(t time.Time) string { return fmt.Sprintf("%d", t.Day()) },
+	'f': func(t time.Time) string { return fmt.Sprintf("%06d", t.Nanosecond()/1000) },
+	'H': func(t time.Time) string { return t.Format("15") },
+	'h': func(t time.Time) string { return t.Format("03") },
+	'I': func(t time.Time) string { return t.Format("03") },
+	'i': func(t time.Time) string { return t.Format("04") },
+	'j': func(t time.Time) string { return fmt.Sprintf("%03d", t.YearDay()) },
+	'k': func(t time.Time) string { return fmt.Sprintf("%d", t.Hour()) },
+	'l': func(t time.Time) string { return t.Format("3") },
+	'M': func(t time.Time) string { return t.Format("January") },
+	'm': func(t time.Time) string { return t.Format("01") },
+	'p': func(t time.Time) string { return t.Format("PM") },
+	'r': func(t time.Time) string { return t.Format("03:04:05 PM") },
+	'S': func(t time.Time) string { return t.Format("05") },
+	's': func(t time.Time) string { return t.Format("05") },
+	'T': func(t time.Time) string { return t.Format("15:04:05") },
+	'W': func(t time.Time) string { return t.Format("Monday") },
+	'w': func(t time.Time) string { return fmt.Sprintf("%d", t.Weekday()) },
+	'Y': func(t time.Time) string { return fmt.Sprintf("%04d", t.Year()) },
+	'y': func(t time.Time) string { return t.Format("06") },
+	'%': func(t time.Time) string { return "%" },
+}
+
+func ordinalSuffix(day int) string {
+	switch {
+	case day >= 11 && day <= 13:
+		return "th"
+	case day%10 == 1:
+		return "st"
+	case day%10 == 2:
+		return "nd"
+	case day%10 == 3:
+		return "rd"
+	}
+	return "th"
+}
+
+func evalDateFormat(args []EvalResult) (EvalResult, error) {
+	t, ok := toTime(args[0])
+	if !ok {
+		return resultNull, nil
+	}
+	format := toBytes(args[1])
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		if spec, ok := dateFormatSpecifiers[format[i]]; ok {
+			b.WriteString(spec(t))
+		} else {
+			// Unknown specifiers are copied without the '%'
+			b.WriteByte(format[i])
+		}
+	}
+	return newEvalString([]byte(b.String()), collationDefault), nil
+}
@@ -143,8 +143,10 @@ func ToNative(v sqltypes.Value) (interface{}, error) {
 func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 	raw := v.Raw()
 	switch {
-	case v.IsBinary() || v.IsText():
-		return EvalResult{bytes: raw, typ: sqltypes.VarBinary}, nil
+	case v.IsBinary():
+		return EvalResult{bytes: raw, typ: sqltypes.VarBinary, collation: collationBinary}, nil
+	case v.IsText():
+		return EvalResult{bytes: raw, typ: sqltypes.VarBinary, collation: collationCaseInsensitive}, nil
 	case v.IsSigned():
 		ival, err := strconv.ParseInt(string(raw), 10, 64)
 		if err != nil {
@@ -29,17 +29,20 @@ import (
 
 type (
 	EvalResult struct {
-		typ   querypb.Type
-		ival  int64
-		uval  uint64
-		fval  float64
-		bytes []byte
+		typ       querypb.Type
+		ival      int64
+		uval      uint64
+		fval      float64
+		bytes     []byte
+		collation collation
 	}
 	//ExpressionEnv contains the environment that the expression
-	//evaluates in, such as the current row and bindvars
+	//evaluates in, such as the current row and bindvars.
+	//Fields is optional, and describes the columns of Row
 	ExpressionEnv struct {
 		BindVars map[string]*querypb.BindVariable
 		Row      []sqltypes.Value
+		Fields   []*querypb.Field
 	}
 
 	// Expr is the interface that all evaluating expressions must implement
@@ -105,6 +108,11 @@ func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
 
+//NewLiteralNull returns a literal NULL expression
+func NewLiteralNull() Expr {
+	return &Literal{resultNull}
+}
+
 //NewBindVar returns a bind variable
 func NewBindVar(key string) Expr {
 	return &BindVariable{Key: key}
@@ -157,27 +165,49 @@ func (b *BindVariable) Evaluate(env ExpressionEnv) (EvalResult, error) {
 //Evaluate implements the Expr interface
 func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	value := env.Row[c.Offset]
-	numeric, err := newEvalResult(value)
-	return numeric, err
+	result, err := newEvalResult(value)
+	if err == nil && c.Offset < len(env.Fields) && hasBinaryCollation(env.Fields[c.Offset]) {
+		result.collation = collationBinary
+	}
+	return result, err
+}
+
+// hasBinaryCollation returns true if field is a text column with a
+// binary collation, e.g. utf8mb4_bin, which MySQL flags as binary.
+func hasBinaryCollation(field *querypb.Field) bool {
+	return sqltypes.IsText(field.Type) && field.Flags&uint32(querypb.MySqlFlag_BINARY_FLAG) != 0
 }
 
 //Evaluate implements the BinaryOp interface
 func (a *Addition) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
 	return addNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
 func (s *Subtraction) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
 	return subtractNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
 func (m *Multiplication) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() {
+		return resultNull, nil
+	}
 	return multiplyNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
 func (d *Division) Evaluate(left, right EvalResult) (EvalResult, error) {
+	if left.isNull() || right.isNull() || isZero(right) {
+		// MySQL returns NULL for a division by zero
+		return resultNull, nil
+	}
 	return divideNumericWithError(left, right)
 }
 
@@ -231,7 +261,10 @@ func (l *Literal) Type(ExpressionEnv) (querypb.Type, error) {
 }
 
 //Type implements the Expr interface
-func (c *Column) Type(ExpressionEnv) (querypb.Type, error) {
+func (c *Column) Type(env ExpressionEnv) (querypb.Type, error) {
+	if c.Offset < len(env.Fields) {
+		return env.Fields[c.Offset].Type, nil
+	}
 	return sqltypes.Float64, nil
 }
 
@@ -105,3 +105,46 @@ func TestBinaryOpTypes(t *testing.T) {
 		}
 	}
 }
+
+func TestLikeLiteralPattern(t *testing.T) {
+	like, err := NewLikeExpr(NewColumn(0), NewLiteralString([]byte("vit%")), '\\', false)
+	assert.NoError(t, err)
+	assert.Len(t, like.literal, 2, "a literal pattern is compiled once")
+	eval := func(like *LikeExpr, row ...sqltypes.Value) bool {
+		env := ExpressionEnv{
+			Row: row,
+			Fields: []*querypb.Field{
+				{Type: sqltypes.VarChar},
+				{Type: sqltypes.VarChar, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)},
+			},
+		}
+		r, err := like.Evaluate(env)
+		assert.NoError(t, err)
+		return r.Value().ToString() == "1"
+	}
+
+	assert.True(t, eval(like, sqltypes.NewVarChar("Vitess")))
+	assert.False(t, eval(like, sqltypes.NewVarChar("mysql")))
+	assert.False(t, eval(like, sqltypes.NewVarBinary("Vitess")))
+
+	// Other patterns are compiled by every evaluation.
+	like, err = NewLikeExpr(NewColumn(0), NewColumn(1), '\\', false)
+	assert.NoError(t, err)
+	assert.Nil(t, like.literal)
+	assert.True(t, eval(like, sqltypes.NewVarChar("a_c"), sqltypes.NewVarChar("a\\_c")))
+	assert.False(t, eval(like, sqltypes.NewVarChar("abc"), sqltypes.NewVarChar("a\\_c")))
+}
+
+func TestColumnBinaryCollation(t *testing.T) {
+	eq := &BinaryOp{Expr: &Equal{}, Left: NewColumn(0), Right: NewLiteralString([]byte("vitess"))}
+	row := []sqltypes.Value{sqltypes.NewVarChar("Vitess")}
+
+	// Text columns are case-insensitive, unless their
+	// fields tell that they use a binary collation.
+	r, err := eq.Evaluate(ExpressionEnv{Row: row})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", r.Value().ToString())
+	r, err = eq.Evaluate(ExpressionEnv{Row: row, Fields: []*querypb.Field{{Type: sqltypes.VarChar, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "0", r.Value().ToString())
+}
@@ -0,0 +1,374 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// Call is the invocation of one of the builtin functions
+	Call struct {
+		Name string
+		Args []Expr
+		fn   *builtin
+	}
+
+	// CaseExpr evaluates a CASE expression. If Base is set, the
+	// conditions of Whens are compared to it, otherwise they are
+	// evaluated as booleans.
+	CaseExpr struct {
+		Base  Expr
+		Whens []WhenThen
+		Else  Expr
+	}
+
+	// WhenThen is a single WHEN ... THEN ... branch of a CaseExpr
+	WhenThen struct {
+		When, Then Expr
+	}
+)
+
+// builtin describes a function that can be evaluated by the engine.
+// Most functions compute their result from the values of all their
+// arguments through eval. Control flow functions use lazy instead,
+// which only evaluates the arguments that are needed.
+type builtin struct {
+	minArgs, maxArgs int
+	eval             func(args []EvalResult) (EvalResult, error)
+	lazy             func(env ExpressionEnv, args []Expr) (EvalResult, error)
+	typ              func(args []querypb.Type) querypb.Type
+}
+
+const variadic = -1
+
+var builtinFunctions = map[string]*builtin{
+	// Control flow
+	"if":       {minArgs: 3, maxArgs: 3, lazy: evalIf, typ: argType(1)},
+	"ifnull":   {minArgs: 2, maxArgs: 2, lazy: evalCoalesce, typ: argType(0)},
+	"coalesce": {minArgs: 1, maxArgs: variadic, lazy: evalCoalesce, typ: argType(0)},
+	"nullif":   {minArgs: 2, maxArgs: 2, eval: evalNullif, typ: argType(0)},
+	"isnull":   {minArgs: 1, maxArgs: 1, eval: evalIsnull, typ: fixedType(sqltypes.Int64)},
+	"greatest": {minArgs: 2, maxArgs: variadic, eval: strict(evalGreatest), typ: mergedType},
+	"least":    {minArgs: 2, maxArgs: variadic, eval: strict(evalLeast), typ: mergedType},
+
+	// String functions
+	"concat":           {minArgs: 1, maxArgs: variadic, eval: strict(evalConcat), typ: stringType},
+	"concat_ws":        {minArgs: 2, maxArgs: variadic, eval: evalConcatWs, typ: stringType},
+	"lower":            {minArgs: 1, maxArgs: 1, eval: strict(evalLower), typ: stringType},
+	"lcase":            {minArgs: 1, maxArgs: 1, eval: strict(evalLower), typ: stringType},
+	"upper":            {minArgs: 1, maxArgs: 1, eval: strict(evalUpper), typ: stringType},
+	"ucase":            {minArgs: 1, maxArgs: 1, eval: strict(evalUpper), typ: stringType},
+	"length":           {minArgs: 1, maxArgs: 1, eval: strict(evalLength), typ: fixedType(sqltypes.Int64)},
+	"octet_length":     {minArgs: 1, maxArgs: 1, eval: strict(evalLength), typ: fixedType(sqltypes.Int64)},
+	"char_length":      {minArgs: 1, maxArgs: 1, eval: strict(evalCharLength), typ: fixedType(sqltypes.Int64)},
+	"character_length": {minArgs: 1, maxArgs: 1, eval: strict(evalCharLength), typ: fixedType(sqltypes.Int64)},
+	"substring":        {minArgs: 2, maxArgs: 3, eval: strict(evalSubstring), typ: stringType},
+	"substr":           {minArgs: 2, maxArgs: 3, eval: strict(evalSubstring), typ: stringType},
+	"mid":              {minArgs: 3, maxArgs: 3, eval: strict(evalSubstring), typ: stringType},
+	"left":             {minArgs: 2, maxArgs: 2, eval: strict(evalLeft), typ: stringType},
+	"right":            {minArgs: 2, maxArgs: 2, eval: strict(evalRight), typ: stringType},
+	"trim":             {minArgs: 1, maxArgs: 1, eval: strict(evalTrim), typ: stringType},
+	"ltrim":            {minArgs: 1, maxArgs: 1, eval: strict(evalLtrim), typ: stringType},
+	"rtrim":            {minArgs: 1, maxArgs: 1, eval: strict(evalRtrim), typ: stringType},
+	"replace":          {minArgs: 3, maxArgs: 3, eval: strict(evalReplace), typ: stringType},
+	"reverse":          {minArgs: 1, maxArgs: 1, eval: strict(evalReverse), typ: stringType},
+	"repeat":           {minArgs: 2, maxArgs: 2, eval: strict(evalRepeat), typ: stringType},
+	"lpad":             {minArgs: 3, maxArgs: 3, eval: strict(evalLpad), typ: stringType},
+	"rpad":             {minArgs: 3, maxArgs: 3, eval: strict(evalRpad), typ: stringType},
+	"instr":            {minArgs: 2, maxArgs: 2, eval: strict(evalInstr), typ: fixedType(sqltypes.Int64)},
+	"locate":           {minArgs: 2, maxArgs: 3, eval: strict(evalLocate), typ: fixedType(sqltypes.Int64)},
+
+	// Math functions
+	"abs":      {minArgs: 1, maxArgs: 1, eval: strict(evalAbs), typ: numericType},
+	"ceil":     {minArgs: 1, maxArgs: 1, eval: strict(evalCeil), typ: fixedType(sqltypes.Int64)},
+	"ceiling":  {minArgs: 1, maxArgs: 1, eval: strict(evalCeil), typ: fixedType(sqltypes.Int64)},
+	"floor":    {minArgs: 1, maxArgs: 1, eval: strict(evalFloor), typ: fixedType(sqltypes.Int64)},
+	"round":    {minArgs: 1, maxArgs: 2, eval: strict(evalRound), typ: numericType},
+	"truncate": {minArgs: 2, maxArgs: 2, eval: strict(evalTruncate), typ: numericType},
+	"mod":      {minArgs: 2, maxArgs: 2, eval: strict(evalMod), typ: numericType},
+	"sign":     {minArgs: 1, maxArgs: 1, eval: strict(evalSign), typ: fixedType(sqltypes.Int64)},
+	"pow":      {minArgs: 2, maxArgs: 2, eval: strict(evalPow), typ: fixedType(sqltypes.Float64)},
+	"power":    {minArgs: 2, maxArgs: 2, eval: strict(evalPow), typ: fixedType(sqltypes.Float64)},
+	"sqrt":     {minArgs: 1, maxArgs: 1, eval: strict(evalSqrt), typ: fixedType(sqltypes.Float64)},
+
+	// Date functions
+	"date":        {minArgs: 1, maxArgs: 1, eval: strict(evalDate), typ: fixedType(sqltypes.Date)},
+	"year":        {minArgs: 1, maxArgs: 1, eval: dateField(evalYear), typ: fixedType(sqltypes.Int64)},
+	"month":       {minArgs: 1, maxArgs: 1, eval: dateField(evalMonth), typ: fixedType(sqltypes.Int64)},
+	"day":         {minArgs: 1, maxArgs: 1, eval: dateField(evalDay), typ: fixedType(sqltypes.Int64)},
+	"dayofmonth":  {minArgs: 1, maxArgs: 1, eval: dateField(evalDay), typ: fixedType(sqltypes.Int64)},
+	"hour":        {minArgs: 1, maxArgs: 1, eval: dateField(evalHour), typ: fixedType(sqltypes.Int64)},
+	"minute":      {minArgs: 1, maxArgs: 1, eval: dateField(evalMinute), typ: fixedType(sqltypes.Int64)},
+	"second":      {minArgs: 1, maxArgs: 1, eval: dateField(evalSecond), typ: fixedType(sqltypes.Int64)},
+	"dayofweek":   {minArgs: 1, maxArgs: 1, eval: dateField(evalDayOfWeek), typ: fixedType(sqltypes.Int64)},
+	"weekday":     {minArgs: 1, maxArgs: 1, eval: dateField(evalWeekday), typ: fixedType(sqltypes.Int64)},
+	"dayofyear":   {minArgs: 1, maxArgs: 1, eval: dateField(evalDayOfYear), typ: fixedType(sqltypes.Int64)},
+	"datediff":    {minArgs: 2, maxArgs: 2, eval: strict(evalDateDiff), typ: fixedType(sqltypes.Int64)},
+	"date_format": {minArgs: 2, maxArgs: 2, eval: strict(evalDateFormat), typ: fixedType(sqltypes.VarChar)},
+}
+
+// IsBuiltin returns true if the function can be evaluated by the engine.
+func IsBuiltin(name string) bool {
+	_, ok := builtinFunctions[strings.ToLower(name)]
+	return ok
+}
+
+// NewCall returns the invocation of a builtin function.
+func NewCall(name string, args []Expr) (Expr, error) {
+	name = strings.ToLower(name)
+	fn, ok := builtinFunctions[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "function %s is not supported", name)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs != variadic && len(args) > fn.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", name)
+	}
+	return &Call{Name: name, Args: args, fn: fn}, nil
+}
+
+var _ Expr = (*Call)(nil)
+var _ Expr = (*CaseExpr)(nil)
+
+// Evaluate implements the Expr interface
+func (c *Call) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	if c.fn.lazy != nil {
+		return c.fn.lazy(env, c.Args)
+	}
+	args := make([]EvalResult, len(c.Args))
+	for i, arg := range c.Args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		args[i] = val
+	}
+	return c.fn.eval(args)
+}
+
+// Type implements the Expr interface
+func (c *Call) Type(env ExpressionEnv) (querypb.Type, error) {
+	types := make([]querypb.Type, len(c.Args))
+	for i, arg := range c.Args {
+		typ, err := arg.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types[i] = typ
+	}
+	return c.fn.typ(types), nil
+}
+
+// String implements the Expr interface
+func (c *Call) String() string {
+	var args []string
+	for _, arg := range c.Args {
+		args = append(args, arg.String())
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		base, err = c.Base.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+	}
+	for _, wt := range c.Whens {
+		when, err := wt.When.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		var match bool
+		if c.Base != nil {
+			cmp, err := compare(base, when, func(cmp int) bool { return cmp == 0 })
+			if err != nil {
+				return EvalResult{}, err
+			}
+			match, _ = toBool(cmp)
+		} else {
+			match, _ = toBool(when)
+		}
+		if match {
+			return wt.Then.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return resultNull, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+// Type implements the Expr interface
+func (c *CaseExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	var types []querypb.Type
+	for _, wt := range c.Whens {
+		typ, err := wt.Then.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	if c.Else != nil {
+		typ, err := c.Else.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		types = append(types, typ)
+	}
+	return mergedType(types), nil
+}
+
+// String implements the Expr interface
+func (c *CaseExpr) String() string {
+	var b strings.Builder
+	b.WriteString("case")
+	if c.Base != nil {
+		b.WriteString(" " + c.Base.String())
+	}
+	for _, wt := range c.Whens {
+		b.WriteString(" when " + wt.When.String() + " then " + wt.Then.String())
+	}
+	if c.Else != nil {
+		b.WriteString(" else " + c.Else.String())
+	}
+	b.WriteString(" end")
+	return b.String()
+}
+
+// strict wraps a function that returns NULL if any of its arguments is NULL.
+func strict(eval func(args []EvalResult) (EvalResult, error)) func(args []EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		for _, arg := range args {
+			if arg.isNull() {
+				return resultNull, nil
+			}
+		}
+		return eval(args)
+	}
+}
+
+func fixedType(typ querypb.Type) func([]querypb.Type) querypb.Type {
+	return func([]querypb.Type) querypb.Type {
+		return typ
+	}
+}
+
+func argType(i int) func([]querypb.Type) querypb.Type {
+	return func(types []querypb.Type) querypb.Type {
+		return types[i]
+	}
+}
+
+// mergedType returns the type of a result that can come from any
+// of the given types. NULL types are ignored.
+func mergedType(types []querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	for _, typ := range types {
+		switch {
+		case typ == sqltypes.Null:
+		case result == sqltypes.Null:
+			result = typ
+		case sqltypes.IsNumber(result) && sqltypes.IsNumber(typ):
+			result = mergeNumericalTypes(result, typ)
+		case result != typ:
+			return sqltypes.VarChar
+		}
+	}
+	return result
+}
+
+func numericType(types []querypb.Type) querypb.Type {
+	switch {
+	case sqltypes.IsSigned(types[0]):
+		return sqltypes.Int64
+	case sqltypes.IsUnsigned(types[0]):
+		return sqltypes.Uint64
+	}
+	return sqltypes.Float64
+}
+
+func evalIf(env ExpressionEnv, args []Expr) (EvalResult, error) {
+	cond, err := args[0].Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if val, _ := toBool(cond); val {
+		return args[1].Evaluate(env)
+	}
+	return args[2].Evaluate(env)
+}
+
+func evalCoalesce(env ExpressionEnv, args []Expr) (EvalResult, error) {
+	for _, arg := range args {
+		val, err := arg.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if !val.isNull() {
+			return val, nil
+		}
+	}
+	return resultNull, nil
+}
+
+func evalNullif(args []EvalResult) (EvalResult, error) {
+	eq, err := (&Equal{}).Evaluate(args[0], args[1])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if val, _ := toBool(eq); val {
+		return resultNull, nil
+	}
+	return args[0], nil
+}
+
+func evalIsnull(args []EvalResult) (EvalResult, error) {
+	return newEvalBool(args[0].isNull()), nil
+}
+
+func evalGreatest(args []EvalResult) (EvalResult, error) {
+	return extreme(args, func(cmp int) bool { return cmp > 0 })
+}
+
+func evalLeast(args []EvalResult) (EvalResult, error) {
+	return extreme(args, func(cmp int) bool { return cmp < 0 })
+}
+
+func extreme(args []EvalResult, better func(int) bool) (EvalResult, error) {
+	result := args[0]
+	for _, arg := range args[1:] {
+		cmp, err := compareValues(arg, result)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if better(cmp) {
+			result = arg
+		}
+	}
+	return result, nil
+}
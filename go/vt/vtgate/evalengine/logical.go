@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// Logical ops. They follow the three-valued logic of SQL
+	And struct{}
+	Or  struct{}
+	Xor struct{}
+
+	// NotExpr negates the truth value of Inner
+	NotExpr struct {
+		Inner Expr
+	}
+
+	// IsExpr evaluates `Inner IS [NOT] NULL|TRUE|FALSE`
+	IsExpr struct {
+		Op    IsOp
+		Inner Expr
+	}
+
+	// IsOp is the test performed by an IsExpr
+	IsOp int
+)
+
+// These are the supported IsOp values
+const (
+	IsNull = IsOp(iota)
+	IsNotNull
+	IsTrue
+	IsNotTrue
+	IsFalse
+	IsNotFalse
+)
+
+var isOpNames = map[IsOp]string{
+	IsNull:     "is null",
+	IsNotNull:  "is not null",
+	IsTrue:     "is true",
+	IsNotTrue:  "is not true",
+	IsFalse:    "is false",
+	IsNotFalse: "is not false",
+}
+
+var _ BinaryExpr = (*And)(nil)
+var _ BinaryExpr = (*Or)(nil)
+var _ BinaryExpr = (*Xor)(nil)
+var _ Expr = (*NotExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+
+// toBool returns the truth value of e, and whether it is unknown, i.e. NULL
+func toBool(e EvalResult) (val bool, unknown bool) {
+	if e.isNull() {
+		return false, true
+	}
+	if e.isNumeric() {
+		n := toNumeric(e)
+		return n.ival != 0 || n.uval != 0 || n.fval != 0, false
+	}
+	return toFloat64(e) != 0, false
+}
+
+// Evaluate implements the BinaryExpr interface
+func (a *And) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lnull := toBool(left)
+	r, rnull := toBool(right)
+	switch {
+	case (!l && !lnull) || (!r && !rnull):
+		return newEvalBool(false), nil
+	case lnull || rnull:
+		return resultNull, nil
+	}
+	return newEvalBool(true), nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (o *Or) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lnull := toBool(left)
+	r, rnull := toBool(right)
+	switch {
+	case l || r:
+		return newEvalBool(true), nil
+	case lnull || rnull:
+		return resultNull, nil
+	}
+	return newEvalBool(false), nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (x *Xor) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lnull := toBool(left)
+	r, rnull := toBool(right)
+	if lnull || rnull {
+		return resultNull, nil
+	}
+	return newEvalBool(l != r), nil
+}
+
+// Type implements the BinaryExpr interface
+func (a *And) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (o *Or) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// Type implements the BinaryExpr interface
+func (x *Xor) Type(querypb.Type) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String implements the BinaryExpr interface
+func (a *And) String() string {
+	return "and"
+}
+
+// String implements the BinaryExpr interface
+func (o *Or) String() string {
+	return "or"
+}
+
+// String implements the BinaryExpr interface
+func (x *Xor) String() string {
+	return "xor"
+}
+
+// Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	inner, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	val, unknown := toBool(inner)
+	if unknown {
+		return resultNull, nil
+	}
+	return newEvalBool(!val), nil
+}
+
+// Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Inner.String()
+}
+
+// Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	inner, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	val, unknown := toBool(inner)
+	var result bool
+	switch i.Op {
+	case IsNull:
+		result = unknown
+	case IsNotNull:
+		result = !unknown
+	case IsTrue:
+		result = !unknown && val
+	case IsNotTrue:
+		result = unknown || !val
+	case IsFalse:
+		result = !unknown && !val
+	case IsNotFalse:
+		result = unknown || val
+	}
+	return newEvalBool(result), nil
+}
+
+// Type implements the Expr interface
+func (i *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (i *IsExpr) String() string {
+	return i.Inner.String() + " " + isOpNames[i.Op]
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func newEvalFloat(f float64) EvalResult {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return resultNull
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: f}
+}
+
+func evalAbs(args []EvalResult) (EvalResult, error) {
+	n := toNumeric(args[0])
+	switch n.typ {
+	case sqltypes.Int64:
+		if n.ival < 0 {
+			if n.ival == math.MinInt64 {
+				return EvalResult{typ: sqltypes.Uint64, uval: uint64(math.MaxInt64) + 1}, nil
+			}
+			n.ival = -n.ival
+		}
+	case sqltypes.Float64:
+		n.fval = math.Abs(n.fval)
+	}
+	return n, nil
+}
+
+// integerOf applies f to a floating point value and returns
+// the result as an integer if it fits in one.
+func integerOf(e EvalResult, f func(float64) float64) EvalResult {
+	n := toNumeric(e)
+	if n.typ != sqltypes.Float64 {
+		return n
+	}
+	r := f(n.fval)
+	if r >= math.MinInt64 && r < math.MaxInt64 {
+		return EvalResult{typ: sqltypes.Int64, ival: int64(r)}
+	}
+	return newEvalFloat(r)
+}
+
+func evalCeil(args []EvalResult) (EvalResult, error) {
+	return integerOf(args[0], math.Ceil), nil
+}
+
+func evalFloor(args []EvalResult) (EvalResult, error) {
+	return integerOf(args[0], math.Floor), nil
+}
+
+// roundTo rounds or truncates n to the given number of decimals.
+// Negative decimals apply to the integral part.
+func roundTo(n EvalResult, decimals int64, f func(float64) float64) EvalResult {
+	if decimals > 30 {
+		decimals = 30
+	}
+	if decimals < -30 {
+		decimals = -30
+	}
+	scale := math.Pow(10, float64(decimals))
+	switch n.typ {
+	case sqltypes.Int64:
+		if decimals >= 0 {
+			return n
+		}
+		return EvalResult{typ: sqltypes.Int64, ival: int64(f(float64(n.ival)*scale) / scale)}
+	case sqltypes.Uint64:
+		if decimals >= 0 {
+			return n
+		}
+		return EvalResult{typ: sqltypes.Uint64, uval: uint64(f(float64(n.uval)*scale) / scale)}
+	}
+	return newEvalFloat(f(n.fval*scale) / scale)
+}
+
+func evalRound(args []EvalResult) (EvalResult, error) {
+	var decimals int64
+	if len(args) == 2 {
+		decimals = toInt64(args[1])
+	}
+	// math.Round rounds half away from zero, like MySQL does for exact values
+	return roundTo(toNumeric(args[0]), decimals, math.Round), nil
+}
+
+func evalTruncate(args []EvalResult) (EvalResult, error) {
+	return roundTo(toNumeric(args[0]), toInt64(args[1]), math.Trunc), nil
+}
+
+func evalMod(args []EvalResult) (EvalResult, error) {
+	l, r := toNumeric(args[0]), toNumeric(args[1])
+	if isZero(r) {
+		return resultNull, nil
+	}
+	switch {
+	case l.typ == sqltypes.Int64 && r.typ == sqltypes.Int64:
+		return EvalResult{typ: sqltypes.Int64, ival: l.ival % r.ival}, nil
+	case l.typ == sqltypes.Uint64 && r.typ == sqltypes.Uint64:
+		return EvalResult{typ: sqltypes.Uint64, uval: l.uval % r.uval}, nil
+	}
+	return newEvalFloat(math.Mod(toFloat64(l), toFloat64(r))), nil
+}
+
+func evalSign(args []EvalResult) (EvalResult, error) {
+	f := toFloat64(args[0])
+	switch {
+	case f > 0:
+		return EvalResult{typ: sqltypes.Int64, ival: 1}, nil
+	case f < 0:
+		return EvalResult{typ: sqltypes.Int64, ival: -1}, nil
+	}
+	return EvalResult{typ: sqltypes.Int64}, nil
+}
+
+func evalPow(args []EvalResult) (EvalResult, error) {
+	return newEvalFloat(math.Pow(toFloat64(args[0]), toFloat64(args[1]))), nil
+}
+
+func evalSqrt(args []EvalResult) (EvalResult, error) {
+	return newEvalFloat(math.Sqrt(toFloat64(args[0]))), nil
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// String functions operate on characters, unless one of their
+// arguments has a binary collation, in which case they operate on bytes.
+
+// maxRepeatLength caps the size of the strings built by REPEAT, LPAD and RPAD,
+// like max_allowed_packet does in MySQL.
+const maxRepeatLength = 64 * 1024 * 1024
+
+// toBytes returns the textual representation of a non-NULL value.
+func toBytes(e EvalResult) []byte {
+	if e.isString() || e.isTemporal() {
+		return e.bytes
+	}
+	n := e
+	if e.isNumeric() {
+		n = toNumeric(e)
+	}
+	return n.Value().Raw()
+}
+
+func newEvalString(b []byte, coll collation) EvalResult {
+	if coll == collationBinary {
+		return EvalResult{typ: sqltypes.VarBinary, bytes: b, collation: coll}
+	}
+	return EvalResult{typ: sqltypes.VarChar, bytes: b, collation: coll}
+}
+
+func collationOf(args []EvalResult) collation {
+	coll := collationDefault
+	for _, arg := range args {
+		if arg.isString() {
+			coll = mergeCollations(coll, arg.collation)
+		}
+	}
+	return coll
+}
+
+// stringType returns the type of a string function result. String
+// literals are typed VARBINARY, so only the other binary types are
+// taken into account.
+func stringType(types []querypb.Type) querypb.Type {
+	for _, typ := range types {
+		if sqltypes.IsBinary(typ) && typ != sqltypes.VarBinary {
+			return sqltypes.VarBinary
+		}
+	}
+	return sqltypes.VarChar
+}
+
+// chars splits a string into characters. A binary
+// string is split into single bytes.
+func chars(b []byte, coll collation) [][]byte {
+	out := make([][]byte, 0, len(b))
+	for len(b) > 0 {
+		size := 1
+		if coll != collationBinary {
+			_, size = utf8.DecodeRune(b)
+		}
+		out = append(out, b[:size])
+		b = b[size:]
+	}
+	return out
+}
+
+func evalConcat(args []EvalResult) (EvalResult, error) {
+	var buf bytes.Buffer
+	for _, arg := range args {
+		buf.Write(toBytes(arg))
+	}
+	return newEvalString(buf.Bytes(), collationOf(args)), nil
+}
+
+func evalConcatWs(args []EvalResult) (EvalResult, error) {
+	if args[0].isNull() {
+		return resultNull, nil
+	}
+	sep := toBytes(args[0])
+	var parts [][]byte
+	for _, arg := range args[1:] {
+		if !arg.isNull() {
+			parts = append(parts, toBytes(arg))
+		}
+	}
+	return newEvalString(bytes.Join(parts, sep), collationOf(args)), nil
+}
+
+func evalLower(args []EvalResult) (EvalResult, error) {
+	coll := collationOf(args)
+	if coll == collationBinary {
+		return args[0], nil
+	}
+	return newEvalString(bytes.ToLower(toBytes(args[0])), coll), nil
+}
+
+func evalUpper(args []EvalResult) (EvalResult, error) {
+	coll := collationOf(args)
+	if coll == collationBinary {
+		return args[0], nil
+	}
+	return newEvalString(bytes.ToUpper(toBytes(args[0])), coll), nil
+}
+
+func evalLength(args []EvalResult) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.Int64, ival: int64(len(toBytes(args[0])))}, nil
+}
+
+func evalCharLength(args []EvalResult) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.Int64, ival: int64(len(chars(toBytes(args[0]), args[0].collation)))}, nil
+}
+
+// substring returns up to length characters of str, starting at
+// the 1-based position pos. A negative pos counts from the end.
+func substring(str [][]byte, pos, length int64) [][]byte {
+	size := int64(len(str))
+	switch {
+	case pos > 0:
+		pos--
+	case pos < 0:
+		pos += size
+	default:
+		return nil
+	}
+	if pos < 0 || pos >= size || length <= 0 {
+		return nil
+	}
+	end := size
+	if length < size-pos {
+		end = pos + length
+	}
+	return str[pos:end]
+}
+
+func evalSubstring(args []EvalResult) (EvalResult, error) {
+	coll := collationOf(args)
+	str := chars(toBytes(args[0]), coll)
+	length := int64(len(str))
+	if len(args) == 3 {
+		length = toInt64(args[2])
+	}
+	return newEvalString(bytes.Join(substring(str, toInt64(args[1]), length), nil), coll), nil
+}
+
+func evalLeft(args []EvalResult) (EvalResult, error) {
+	coll := collationOf(args)
+	str := chars(toBytes(args[0]), coll)
+	return newEvalString(bytes.Join(substring(str, 1, toInt64(args[1])), nil), coll), nil
+}
+
+func evalRight(args []EvalResult) (EvalResult, error) {
+	coll := collationOf(args)
+	str := chars(toBytes(args[0]), coll)
+	length := toInt64(args[1])
+	if length > int64(len(str)) {
+		length = int64(len(str))
+	}
+	if length <= 0 {
+		return newEvalString(nil, coll), nil
+	}
+	return newEvalString(bytes.Join(substring(str, -length, length), nil), coll), nil
+}
+
+func evalTrim(args []EvalResult) (EvalResult, error) {
+	return newEvalString(bytes.Trim(toBytes(args[0]), " "), collationOf(args)), nil
+}
+
+func evalLtrim(args []EvalResult) (EvalResult, error) {
+	return newEvalString(bytes.TrimLeft(toBytes(args[0]), " "), collationOf(args)), nil
+}
+
+func evalRtrim(args []EvalResult) (EvalResult, error) {
+	return newEvalString(bytes.TrimRight(toBytes(args[0]), " "), collationOf(args)), nil
+}
+
+func evalReplace(args []EvalResult) (EvalResult, error) {
+	// REPLACE is case-sensitive regardless of the collation.
+	str, from, to := toBytes(args[0]), toBytes(args[1]), toBytes(args[2])
+	if len(from) == 0 {
+		return newEvalString(str, collationOf(args)), nil
+	}
+	return newEvalString(bytes.ReplaceAll(str, from, to), collationOf(args)), nil
+}
+
+func evalReverse(args []EvalResult) (EvalResult, error) {
+	coll := collationOf(args)
+	str := chars(toBytes(args[0]), coll)
+	for i, j := 0, len(str)-1; i < j; i, j = i+1, j-1 {
+		str[i], str[j] = str[j], str[i]
+	}
+	return newEvalString(bytes.Join(str, nil), coll), nil
+}
+
+func evalRepeat(args []EvalResult) (EvalResult, error) {
+	str := toBytes(args[0])
+	count := toInt64(args[1])
+	if count <= 0 {
+		return newEvalString(nil, collationOf(args)), nil
+	}
+	if len(str) > 0 && count > maxRepeatLength/int64(len(str)) {
+		return resultNull, nil
+	}
+	return newEvalString(bytes.Repeat(str, int(count)), collationOf(args)), nil
+}
+
+func evalLpad(args []EvalResult) (EvalResult, error) {
+	return pad(args, true)
+}
+
+func evalRpad(args []EvalResult) (EvalResult, error) {
+	return pad(args, false)
+}
+
+// pad implements LPAD and RPAD. Strings longer than
+// the requested length are truncated.
+func pad(args []EvalResult, left bool) (EvalResult, error) {
+	coll := collationOf(args)
+	str := chars(toBytes(args[0]), coll)
+	length := toInt64(args[1])
+	padding := chars(toBytes(args[2]), coll)
+	switch {
+	case length < 0 || length > maxRepeatLength:
+		return resultNull, nil
+	case length <= int64(len(str)):
+		return newEvalString(bytes.Join(str[:length], nil), coll), nil
+	case len(padding) == 0:
+		return resultNull, nil
+	}
+	fill := make([][]byte, 0, length-int64(len(str)))
+	for i := 0; int64(len(fill)) < length-int64(len(str)); i++ {
+		fill = append(fill, padding[i%len(padding)])
+	}
+	if left {
+		return newEvalString(append(bytes.Join(fill, nil), bytes.Join(str, nil)...), coll), nil
+	}
+	return newEvalString(append(bytes.Join(str, nil), bytes.Join(fill, nil)...), coll), nil
+}
+
+func evalInstr(args []EvalResult) (EvalResult, error) {
+	return locate(args[1], args[0], 1)
+}
+
+func evalLocate(args []EvalResult) (EvalResult, error) {
+	pos := int64(1)
+	if len(args) == 3 {
+		pos = toInt64(args[2])
+	}
+	return locate(args[0], args[1], pos)
+}
+
+// locate returns the 1-based position of the first occurrence
+// of substr in str, starting at pos. It returns 0 if not found.
+func locate(substr, str EvalResult, pos int64) (EvalResult, error) {
+	coll := mergeCollations(substr.collation, str.collation)
+	haystack := chars(toBytes(str), coll)
+	needle := chars(toBytes(substr), coll)
+	if pos < 1 || pos > int64(len(haystack))+1 {
+		return EvalResult{typ: sqltypes.Int64}, nil
+	}
+	for i := int(pos - 1); i+len(needle) <= len(haystack); i++ {
+		matched := true
+		for j, c := range needle {
+			if !charEqual(haystack[i+j], c, coll) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return EvalResult{typ: sqltypes.Int64, ival: int64(i + 1)}, nil
+		}
+	}
+	return EvalResult{typ: sqltypes.Int64}, nil
+}
+
+func charEqual(a, b []byte, coll collation) bool {
+	if coll == collationBinary {
+		return bytes.Equal(a, b)
+	}
+	return bytes.EqualFold(a, b)
+}
@@ -180,7 +180,7 @@ func findScatter(p engine.Primitive) bool {
 
 func isUpdating(p engine.Primitive) bool {
 	switch p.(type) {
-	case *engine.Update, *engine.Delete, *engine.Insert:
+	case *engine.Update, *engine.Delete, *engine.Insert, *engine.InsertSelect, *engine.MultiTableDML, *engine.LimitDML:
 		return true
 	default:
 		return false
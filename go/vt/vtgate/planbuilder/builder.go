@@ -110,6 +110,8 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 		return buildGeneralDDLPlan(query, stmt, vschema)
 	case *sqlparser.AlterVschema:
 		return buildVSchemaDDLPlan(stmt, vschema)
+	case *sqlparser.RevertMigration:
+		return buildRevertMigrationPlan(stmt, vschema)
 	case *sqlparser.Use:
 		return buildUsePlan(stmt, vschema)
 	case *sqlparser.Explain:
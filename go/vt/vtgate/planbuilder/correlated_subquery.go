@@ -0,0 +1,147 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ logicalPlan = (*correlatedSubquery)(nil)
+
+// correlatedSubquery is the logicalPlan for engine.CorrelatedSubquery.
+// This gets built if a subquery in a WHERE clause references columns
+// of the outer query, and cannot be merged into the outer route.
+// The outer plan is executed first, and the subquery is executed
+// for its rows, with the referenced columns supplied as join vars.
+// Consequently, the subquery is ordered after the outer plan, which
+// is the reverse of what a pulloutSubquery does.
+type correlatedSubquery struct {
+	order      int
+	outerOrder int
+	outer      logicalPlan
+	subquery   logicalPlan
+
+	// comparand is the LHS of an IN or NOT IN construct.
+	comparand *sqlparser.ColName
+
+	eSubquery *engine.CorrelatedSubquery
+}
+
+// newCorrelatedSubquery builds a new correlatedSubquery.
+func newCorrelatedSubquery(opcode engine.CorrelatedOpcode, comparand *sqlparser.ColName, subquery logicalPlan) *correlatedSubquery {
+	return &correlatedSubquery{
+		subquery:  subquery,
+		comparand: comparand,
+		eSubquery: &engine.CorrelatedSubquery{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+	}
+}
+
+// setUnderlying sets the outer primitive.
+func (cs *correlatedSubquery) setUnderlying(underlying logicalPlan) {
+	cs.outer = underlying
+	cs.Reorder(0)
+}
+
+// Order implements the logicalPlan interface
+func (cs *correlatedSubquery) Order() int {
+	return cs.order
+}
+
+// Reorder implements the logicalPlan interface
+func (cs *correlatedSubquery) Reorder(order int) {
+	cs.outer.Reorder(order)
+	cs.outerOrder = cs.outer.Order()
+	cs.subquery.Reorder(cs.outerOrder)
+	cs.order = cs.subquery.Order() + 1
+}
+
+// Primitive implements the logicalPlan interface
+func (cs *correlatedSubquery) Primitive() engine.Primitive {
+	cs.eSubquery.Outer = cs.outer.Primitive()
+	cs.eSubquery.Subquery = cs.subquery.Primitive()
+	return cs.eSubquery
+}
+
+// ResultColumns implements the logicalPlan interface
+func (cs *correlatedSubquery) ResultColumns() []*resultColumn {
+	return cs.outer.ResultColumns()
+}
+
+// Wireup implements the logicalPlan interface
+func (cs *correlatedSubquery) Wireup(plan logicalPlan, jt *jointab) error {
+	// The columns supplied from here on are only needed
+	// to evaluate the subquery. They must not be returned.
+	count := len(cs.outer.ResultColumns())
+	if cs.comparand != nil {
+		_, cs.eSubquery.Comparand = cs.outer.SupplyCol(cs.comparand)
+	}
+	if err := cs.subquery.Wireup(plan, jt); err != nil {
+		return err
+	}
+	if len(cs.outer.ResultColumns()) > count {
+		cs.eSubquery.TruncateColumnCount = count
+	}
+	return cs.outer.Wireup(plan, jt)
+}
+
+// SupplyVar implements the logicalPlan interface
+func (cs *correlatedSubquery) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if from > cs.outerOrder {
+		cs.subquery.SupplyVar(from, to, col, varname)
+		return
+	}
+	if to <= cs.outerOrder {
+		cs.outer.SupplyVar(from, to, col, varname)
+		return
+	}
+	if _, ok := cs.eSubquery.Vars[varname]; ok {
+		// Looks like somebody else already requested this.
+		return
+	}
+	_, cs.eSubquery.Vars[varname] = cs.outer.SupplyCol(col)
+}
+
+// SupplyCol implements the logicalPlan interface
+func (cs *correlatedSubquery) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	return cs.outer.SupplyCol(col)
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (cs *correlatedSubquery) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	return cs.outer.SupplyWeightString(colNumber)
+}
+
+// Rewrite implements the logicalPlan interface
+func (cs *correlatedSubquery) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "correlatedSubquery: wrong number of inputs")
+	}
+	cs.outer = inputs[0]
+	cs.subquery = inputs[1]
+	return nil
+}
+
+// Inputs implements the logicalPlan interface
+func (cs *correlatedSubquery) Inputs() []logicalPlan {
+	return []logicalPlan{cs.outer, cs.subquery}
+}
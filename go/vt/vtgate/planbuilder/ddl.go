@@ -246,3 +246,17 @@ func buildDropViewOrTable(vschema ContextVSchema, ddlStatement sqlparser.DDLStat
 	}
 	return destination, keyspace, nil
 }
+
+// buildRevertMigrationPlan builds the plan of a REVERT statement, which is submitted as an online
+// DDL migration to all the shards of the keyspace.
+func buildRevertMigrationPlan(stmt *sqlparser.RevertMigration, vschema ContextVSchema) (engine.Primitive, error) {
+	keyspace, err := vschema.DefaultKeyspace()
+	if err != nil {
+		return nil, err
+	}
+	return &engine.RevertMigration{
+		Keyspace: keyspace,
+		Stmt:     stmt,
+		Query:    sqlparser.String(stmt),
+	}, nil
+}
@@ -26,6 +26,13 @@ import (
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	del := stmt.(*sqlparser.Delete)
+	multiTable, err := isMultiTableDML(vschema, del, del.TableExprs)
+	if err != nil {
+		return nil, err
+	}
+	if multiTable {
+		return buildMultiTableDeletePlan(del, vschema)
+	}
 	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
@@ -46,10 +53,25 @@ func buildDeletePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "Unknown table '%s' in MULTI DELETE", del.Targets[0].Name.String())
 	}
 
+	var input engine.Primitive
+	if edel.Opcode == engine.Scatter && del.Limit != nil {
+		if input, del.Where, err = buildLimitDMLInput(vschema, del.TableExprs, del.Where, del.OrderBy, del.Limit, ksidCol); err != nil {
+			return nil, err
+		}
+		edel.Query = generateQuery(del)
+	}
+
 	if len(edel.Table.Owned) > 0 {
 		edel.OwnedVindexQuery = generateDMLSubquery(del.Where, del.OrderBy, del.Limit, edel.Table, ksidCol)
 		edel.KsidVindex = ksidVindex
 	}
 
+	if input != nil {
+		return &engine.LimitDML{
+			Input:  input,
+			Vindex: ksidVindex,
+			DML:    edel,
+		}, nil
+	}
 	return edel, nil
 }
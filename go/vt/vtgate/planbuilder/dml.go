@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"strings"
+
 	"vitess.io/vitess/go/sqltypes"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -162,8 +164,8 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 
 	edml.Opcode = routingType
 	if routingType == engine.Scatter {
-		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
+		if limit != nil && limit.Offset != nil {
+			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit offset", dmlType)
 		}
 	} else {
 		edml.Vindex = vindex
@@ -173,6 +175,45 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	return edml, ksidVindex, ksidCol, nil
 }
 
+// buildLimitDMLInput builds the input of a LimitDML for a scatter DML
+// with a limit: a merge-sorted and limited scatter select of the primary
+// vindex values of the rows to change. It returns the WHERE clause that
+// restricts the DML to the values of a shard, and the limit of the DML is
+// replaced by the bind variable that holds the number of rows of the shard.
+func buildLimitDMLInput(vschema ContextVSchema, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, ksidCol string) (engine.Primitive, *sqlparser.Where, error) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %s", ksidCol)
+	// The columns of the ORDER BY are needed to merge-sort the rows.
+	selected := map[string]bool{strings.ToLower(ksidCol): true}
+	for _, order := range orderBy {
+		expr := strings.ToLower(sqlparser.String(order.Expr))
+		if selected[expr] {
+			continue
+		}
+		selected[expr] = true
+		buf.Myprintf(", %v", order.Expr)
+	}
+	buf.Myprintf(" from %v%v%v%v for update", tableExprs, where, orderBy, limit)
+	sel, err := sqlparser.Parse(buf.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	input, err := buildSelectPlan("")(sel, vschema)
+	if err != nil {
+		return nil, nil, err
+	}
+	var expr sqlparser.Expr = &sqlparser.ComparisonExpr{
+		Operator: sqlparser.InOp,
+		Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent(ksidCol)},
+		Right:    sqlparser.ListArg("::" + engine.DMLKeysVarName),
+	}
+	if where != nil {
+		expr = &sqlparser.AndExpr{Left: where.Expr, Right: expr}
+	}
+	limit.Rowcount = sqlparser.NewArgument([]byte(":" + engine.DMLLimitVarName))
+	return input, sqlparser.NewWhere(sqlparser.WhereClause, expr), nil
+}
+
 func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
 	buf := sqlparser.NewTrackedBuffer(nil)
 	buf.Myprintf("select %s", ksidCol)
@@ -57,10 +57,11 @@ type subqueryInfo struct {
 // external references.
 //
 // Once the target origin is identified, we have to verify that the subquery's
-// route can be merged with it. If it cannot, we fail the query. This is because
-// we don't have the ability to wire up subqueries through expression evaluation
-// primitives. Consequently, if the plan for a subquery comes out as a Join,
-// we can immediately error out.
+// route can be merged with it. If it cannot, the query fails unless the
+// subquery can be executed separately: either it's uncorrelated and gets
+// pulled out, or it's a correlated filter as described below. This is
+// because we don't have the ability to wire up subqueries through expression
+// evaluation primitives.
 //
 // Since findOrigin can itself be called from within a subquery, it has to assume
 // that some of the external references may actually be pointing to an outer
@@ -69,7 +70,13 @@ type subqueryInfo struct {
 //
 // If an expression has no references to the current query, then the left-most
 // origin is chosen as the default.
-func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pulloutSubquery, origin logicalPlan, pushExpr sqlparser.Expr, err error) {
+//
+// If allowCorrelated is set, a correlated subquery that cannot be merged is
+// still acceptable if it makes up the entire expression, like in
+// 'exists (subquery)' or 'a in (subquery)'. Such a subquery is turned into
+// a correlatedSubquery that filters the rows of the current plan. In this
+// case, the returned pushExpr is nil because there is nothing left to push.
+func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr, allowCorrelated bool) (pullouts []subqueryWrapper, origin logicalPlan, pushExpr sqlparser.Expr, err error) {
 	// highestOrigin tracks the highest origin referenced by the expression.
 	// Default is the First.
 	highestOrigin := First(pb.plan)
@@ -149,7 +156,14 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
-			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			if !allowCorrelated || len(subqueries) != 1 {
+				return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			}
+			cs, err := pb.buildCorrelatedSubquery(expr, constructsMap[sqi.ast], sqi)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			return []subqueryWrapper{cs}, highestOrigin, nil, nil
 		}
 
 		sqName, hasValues := pb.jt.GenerateSubqueryVars()
@@ -208,6 +222,37 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 	return pullouts, highestOrigin, expr, nil
 }
 
+// buildCorrelatedSubquery builds a correlatedSubquery for expr, which must
+// consist of only the construct that contains the subquery.
+func (pb *primitiveBuilder) buildCorrelatedSubquery(expr, construct sqlparser.Expr, sqi subqueryInfo) (*correlatedSubquery, error) {
+	switch construct := construct.(type) {
+	case *sqlparser.ExistsExpr:
+		if expr == construct {
+			return newCorrelatedSubquery(engine.CorrelatedExists, nil, sqi.plan), nil
+		}
+		if not, ok := expr.(*sqlparser.NotExpr); ok && not.Expr == construct {
+			return newCorrelatedSubquery(engine.CorrelatedNotExists, nil, sqi.plan), nil
+		}
+	case *sqlparser.ComparisonExpr:
+		if expr != construct {
+			break
+		}
+		col, ok := construct.Left.(*sqlparser.ColName)
+		if !ok {
+			return nil, errors.New("unsupported: cross-shard correlated subquery with an expression on the left of IN")
+		}
+		if _, isLocal, err := pb.st.Find(col); err != nil || !isLocal {
+			return nil, errors.New("unsupported: cross-shard correlated subquery with an outer column on the left of IN")
+		}
+		opcode := engine.CorrelatedIn
+		if construct.Operator == sqlparser.NotInOp {
+			opcode = engine.CorrelatedNotIn
+		}
+		return newCorrelatedSubquery(opcode, col, sqi.plan), nil
+	}
+	return nil, errors.New("unsupported: cross-shard correlated subquery")
+}
+
 func hasSubquery(node sqlparser.SQLNode) bool {
 	has := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ logicalPlan = (*filter)(nil)
+
+// filter is the logicalPlan for engine.Filter.
+// This gets built if a predicate can't be pushed down to
+// a route, because it references values that are only
+// available in vtgate: the results of a scatter aggregation,
+// of a cross-shard subquery, or the right side of a
+// cross-shard left join.
+type filter struct {
+	resultsBuilder
+	efilter   *engine.Filter
+	predicate sqlparser.Expr
+}
+
+// newFilter builds a new filter that evaluates expr on the
+// rows returned by plan.
+func newFilter(pb *primitiveBuilder, plan logicalPlan, expr sqlparser.Expr) (*filter, error) {
+	efilter := &engine.Filter{}
+	f := &filter{
+		resultsBuilder: newResultsBuilder(plan, efilter),
+		efilter:        efilter,
+	}
+	if oa, ok := plan.(*orderedAggregate); ok {
+		var err error
+		if expr, err = f.pushAggregates(pb, oa, expr); err != nil {
+			return nil, err
+		}
+	}
+	f.predicate = expr
+	// Fail early if the predicate can't be evaluated by vtgate.
+	// The columns it references are only requested during Wireup.
+	if _, err := sqlparser.Convert(expr, func(*sqlparser.ColName) (int, error) { return 0, nil }); err != nil {
+		if err == sqlparser.ErrExprNotSupported {
+			return nil, fmt.Errorf("unsupported: filter cannot be evaluated by vtgate: %s", sqlparser.String(expr))
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+// pushAggregates requests oa to compute the aggregates referenced
+// by expr, and returns expr with the aggregates replaced by
+// references to the columns returned by oa.
+func (f *filter) pushAggregates(pb *primitiveBuilder, oa *orderedAggregate, expr sqlparser.Expr) (sqlparser.Expr, error) {
+	// pushAggr changes pb.plan, which must keep pointing
+	// to the plan being built.
+	plan := pb.plan
+	defer func() { pb.plan = plan }()
+
+	var err error
+	result := sqlparser.Rewrite(expr, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			return false
+		case *sqlparser.FuncExpr:
+			if _, ok := engine.SupportedAggregates[node.Name.Lowered()]; !ok || err != nil {
+				return true
+			}
+			var rc *resultColumn
+			rc, _, err = oa.pushAggr(pb, &sqlparser.AliasedExpr{Expr: node}, oa.input)
+			if err != nil {
+				return false
+			}
+			cursor.Replace(&sqlparser.ColName{
+				Name:     sqlparser.NewColIdent(sqlparser.String(node)),
+				Metadata: rc.column,
+			})
+			return false
+		}
+		return true
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.(sqlparser.Expr), nil
+}
+
+// Primitive implements the logicalPlan interface
+func (f *filter) Primitive() engine.Primitive {
+	f.efilter.Input = f.input.Primitive()
+	return f.efilter
+}
+
+// Wireup implements the logicalPlan interface
+// The columns referenced by the predicate are requested from
+// the input at this point, after all the columns that must be
+// returned by the filter are known. The extra columns are
+// truncated from the result.
+func (f *filter) Wireup(plan logicalPlan, jt *jointab) error {
+	predicate, err := sqlparser.Convert(f.predicate, func(col *sqlparser.ColName) (int, error) {
+		_, colNumber := f.input.SupplyCol(col)
+		return colNumber, nil
+	})
+	if err != nil {
+		return err
+	}
+	f.efilter.Predicate = predicate
+	if len(f.input.ResultColumns()) > len(f.resultColumns) {
+		f.efilter.TruncateColumnCount = len(f.resultColumns)
+	}
+	return f.input.Wireup(plan, jt)
+}
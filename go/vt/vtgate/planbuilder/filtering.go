@@ -27,7 +27,7 @@ import (
 )
 
 // planFilter solves this particular expression, either by pushing it down to a child or changing this logicalPlan
-func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr, whereType string, origin logicalPlan) (logicalPlan, error) {
+func planFilter(pb *primitiveBuilder, input logicalPlan, expr sqlparser.Expr, whereType string, origin logicalPlan) (logicalPlan, error) {
 	switch node := input.(type) {
 	case *join:
 		isLeft := true
@@ -36,13 +36,16 @@ func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr,
 			in = node.Left
 		} else {
 			if node.ejoin.Opcode == engine.LeftJoin {
-				return nil, errors.New("unsupported: cross-shard left join and where clause")
+				// Pushing the filter to the right side would turn it into
+				// a join condition. It must instead be applied to the rows
+				// produced by the join, after the outer rows are added.
+				return newFilter(pb, node, expr)
 			}
 			isLeft = false
 			in = node.Right
 		}
 
-		filtered, err := planFilter(pb, in, filter, whereType, origin)
+		filtered, err := planFilter(pb, in, expr, whereType, origin)
 		if err != nil {
 			return nil, err
 		}
@@ -57,18 +60,47 @@ func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr,
 		sel := node.Select.(*sqlparser.Select)
 		switch whereType {
 		case sqlparser.WhereStr:
-			sel.AddWhere(filter)
+			sel.AddWhere(expr)
 		case sqlparser.HavingStr:
-			sel.AddHaving(filter)
+			sel.AddHaving(expr)
 		}
-		node.UpdatePlan(pb, filter)
+		node.UpdatePlan(pb, expr)
+		return node, nil
+	case *correlatedSubquery:
+		filtered, err := planFilter(pb, node.outer, expr, whereType, origin)
+		if err != nil {
+			return nil, err
+		}
+		node.outer = filtered
 		return node, nil
 	case *vindexFunc:
-		return filterVindexFunc(node, filter)
-	case *subquery:
-		return nil, errors.New("unsupported: filtering on results of cross-shard subquery")
-	case *orderedAggregate:
-		return nil, errors.New("unsupported: filtering on results of aggregates")
+		return filterVindexFunc(node, expr)
+	case *filter:
+		filtered, err := planFilter(pb, node.input, expr, whereType, origin)
+		if err != nil {
+			return nil, err
+		}
+		if newFilter, ok := filtered.(*filter); ok && newFilter.input == node.input {
+			// Both filters are applied to the same rows: merge them.
+			node.predicate = &sqlparser.AndExpr{Left: node.predicate, Right: newFilter.predicate}
+			return node, nil
+		}
+		node.input = filtered
+		return node, nil
+	case *window:
+		// HAVING is evaluated before the window functions,
+		// which can't be referenced by the filter.
+		if origin == node {
+			return nil, errors.New("unsupported: filtering on the result of a window function")
+		}
+		filtered, err := planFilter(pb, node.input, expr, whereType, origin)
+		if err != nil {
+			return nil, err
+		}
+		node.input = filtered
+		return node, nil
+	case *subquery, *orderedAggregate:
+		return newFilter(pb, node, expr)
 	}
 
 	return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "%T.filtering: unreachable", input)
@@ -350,7 +350,7 @@ func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTab
 	if ajoin == nil {
 		return nil
 	}
-	pullouts, _, expr, err := pb.findOrigin(ajoin.Condition.On)
+	pullouts, _, expr, err := pb.findOrigin(ajoin.Condition.On, false)
 	if err != nil {
 		return err
 	}
@@ -54,15 +54,22 @@ func buildInsertPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		vschemaTable = tval.vschemaTable
 	}
 	if !rb.eroute.Keyspace.Sharded {
+		// Analyzing the subqueries resolves the columns of the select
+		// against the insert. A copy is kept in case the select must
+		// be planned on its own.
+		sel, isSelect := ins.Rows.(sqlparser.SelectStatement)
+		if isSelect {
+			sel = sqlparser.CloneSelectStatement(sel)
+		}
 		if !pb.finalizeUnshardedDMLSubqueries(ins) {
-			return nil, errors.New("unsupported: sharded subquery in insert values")
+			if !isSelect {
+				return nil, errors.New("unsupported: sharded subquery in insert values")
+			}
+			return buildInsertUnshardedSelectPlan(ins, sel, vschemaTable, vschema)
 		}
 		return buildInsertUnshardedPlan(ins, vschemaTable)
 	}
-	if ins.Action == sqlparser.ReplaceAct {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
-	}
-	return buildInsertShardedPlan(ins, vschemaTable)
+	return buildInsertShardedPlan(ins, vschemaTable, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -109,7 +116,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -124,6 +131,9 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 		}
 		eins.Opcode = engine.InsertShardedIgnore
 	}
+	if ins.Action == sqlparser.ReplaceAct {
+		eins.Opcode = engine.InsertShardedReplace
+	}
 	if len(ins.Columns) == 0 {
 		if table.ColumnListAuthoritative {
 			populateInsertColumnlist(ins, table)
@@ -139,8 +149,8 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
-	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+	case sqlparser.SelectStatement:
+		return buildInsertSelectPlan(ins, insertValues, eins, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -189,9 +199,108 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	eins.VindexValues = routeValues
 	eins.Query = generateQuery(ins)
 	generateInsertShardedQuery(ins, eins, rows)
+	if eins.Opcode == engine.InsertShardedReplace && len(eins.Table.Owned) != 0 {
+		generateReplaceValues(ins, eins, rows)
+	}
 	return eins, nil
 }
 
+// buildInsertUnshardedSelectPlan builds the plan for an insert into an
+// unsharded table from a select that cannot be sent along with it.
+func buildInsertUnshardedSelectPlan(ins *sqlparser.Insert, sel sqlparser.SelectStatement, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
+	eins := engine.NewSimpleInsert(
+		engine.InsertUnsharded,
+		table,
+		table.Keyspace,
+	)
+	directives := sqlparser.ExtractCommentDirectives(ins.Comments)
+	eins.QueryTimeout = queryTimeout(directives)
+	return buildInsertSelectPlan(ins, sel, eins, vschema)
+}
+
+// buildInsertSelectPlan builds an InsertSelect that reads the rows of
+// sel and inserts them using eins. Vindex and auto-increment columns that
+// are not in the column list are inserted as NULL, which lets the Insert
+// fill them.
+func buildInsertSelectPlan(ins *sqlparser.Insert, sel sqlparser.SelectStatement, eins *engine.Insert, vschema ContextVSchema) (engine.Primitive, error) {
+	if ins.Action == sqlparser.ReplaceAct {
+		return nil, errors.New("unsupported: REPLACE INTO with cross-shard select")
+	}
+	if len(ins.Columns) == 0 {
+		switch {
+		case eins.Table.ColumnListAuthoritative:
+			populateInsertColumnlist(ins, eins.Table)
+		case eins.Opcode != engine.InsertUnsharded || eins.Table.AutoIncrement != nil:
+			return nil, errors.New("unsupported: insert into select without a column list")
+		}
+	}
+	if s, ok := sel.(*sqlparser.Select); ok && len(ins.Columns) != 0 && !hasStarExpr(s.SelectExprs) && len(s.SelectExprs) != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+	input, err := buildInsertSelectInput(sel, vschema)
+	if err != nil {
+		return nil, err
+	}
+	eisel := &engine.InsertSelect{
+		Insert:           eins,
+		InputColumnCount: len(ins.Columns),
+		Input:            input,
+	}
+	if eins.Table.AutoIncrement != nil {
+		eisel.AutoIncrementColumn = findOrAddInsertColumn(ins, eins.Table.AutoIncrement.Column)
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+		}
+	}
+	if eins.Opcode != engine.InsertUnsharded {
+		eisel.VindexColumns = make([][]int, len(eins.Table.ColumnVindexes))
+		for vIdx, colVindex := range eins.Table.ColumnVindexes {
+			for _, col := range colVindex.Columns {
+				eisel.VindexColumns[vIdx] = append(eisel.VindexColumns[vIdx], findOrAddInsertColumn(ins, col))
+			}
+		}
+	}
+	eisel.ColumnCount = len(ins.Columns)
+	eins.Query = generateQuery(ins)
+	generateInsertShardedQuery(ins, eins, nil)
+	return eisel, nil
+}
+
+// buildInsertSelectInput builds the plan for the select of an insert.
+func buildInsertSelectInput(sel sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		return buildSelectPlan("")(sel, vschema)
+	case *sqlparser.Union:
+		return buildUnionPlan(sel, vschema)
+	case *sqlparser.ParenSelect:
+		return buildInsertSelectInput(sel.Select, vschema)
+	}
+	return nil, fmt.Errorf("BUG: unexpected SELECT type: %T", sel)
+}
+
+func hasStarExpr(exprs sqlparser.SelectExprs) bool {
+	for _, expr := range exprs {
+		if _, ok := expr.(*sqlparser.StarExpr); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// findOrAddInsertColumn finds the position of a column in the column
+// list of an insert. If it's absent, it's appended to the list.
+func findOrAddInsertColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
+	for i, column := range ins.Columns {
+		if col.Equal(column) {
+			return i
+		}
+	}
+	ins.Columns = append(ins.Columns, col)
+	return len(ins.Columns) - 1
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -205,8 +314,12 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore.ToString(),
+	action := sqlparser.InsertStr
+	if node.Action == sqlparser.ReplaceAct {
+		action = sqlparser.ReplaceStr
+	}
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		action, node.Comments, node.Ignore.ToString(),
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
@@ -218,6 +331,27 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	eins.Suffix = suffixBuf.String()
 }
 
+// generateReplaceValues sets the columns and the values of every row
+// of a sharded REPLACE, which are needed to find the rows it deletes.
+// A DEFAULT value is changed to the default value of its column.
+func generateReplaceValues(node *sqlparser.Insert, eins *engine.Insert, valueTuples sqlparser.Values) {
+	eins.ReplaceColumns = node.Columns
+	eins.ReplaceValues = make([][]string, len(valueTuples))
+	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	for rowNum, val := range valueTuples {
+		eins.ReplaceValues[rowNum] = make([]string, len(val))
+		for colNum, expr := range val {
+			if _, ok := expr.(*sqlparser.Default); ok {
+				buf.Myprintf("default(%v)", node.Columns[colNum])
+			} else {
+				buf.Myprintf("%v", expr)
+			}
+			eins.ReplaceValues[rowNum][colNum] = buf.String()
+			buf.Reset()
+		}
+	}
+}
+
 // modifyForAutoinc modfies the AST and the plan to generate
 // necessary autoinc values. It must be called only if eins.Table.AutoIncrement
 // is set. Bind variable names are generated using baseName.
@@ -0,0 +1,444 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file has functions to plan UPDATE and DELETE statements
+// that join tables of different routes, or tables of a sharded
+// keyspace.
+//
+// Only one table, the target, can be changed. Its rows are identified
+// by the only column of the target that is referenced by the join
+// conditions and by the predicates that involve other tables: the key.
+// A select through the join fetches the values of the key and of the
+// primary vindex column. Then a single-table DML of the target, which
+// keeps the predicates that only involve the target, is restricted to
+// those values and routed by the primary vindex values.
+
+// isMultiTableDML returns true if the tables of a DML can't be
+// sent as they are to a single unsharded keyspace.
+func isMultiTableDML(vschema ContextVSchema, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs) (bool, error) {
+	if len(tableExprs) == 1 {
+		if _, ok := tableExprs[0].(*sqlparser.AliasedTableExpr); ok {
+			return false, nil
+		}
+	}
+	// The analysis resolves the symbols of the join conditions.
+	// It's performed on a copy to leave the statement untouched.
+	clone, err := cloneDMLStatement(stmt)
+	if err != nil {
+		return false, err
+	}
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(clone)))
+	if err := pb.processTableExprs(dmlTableExprs(clone), nil); err != nil {
+		return false, err
+	}
+	rb, ok := pb.plan.(*route)
+	if !ok {
+		return true, nil
+	}
+	return rb.eroute.Keyspace.Sharded && len(pb.st.tables) > 1, nil
+}
+
+// buildMultiTableDeletePlan builds the instructions for a DELETE
+// that joins tables which can't be sent to a single route.
+func buildMultiTableDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (engine.Primitive, error) {
+	switch len(del.Targets) {
+	case 0:
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "multi-table delete statement without a target table")
+	case 1:
+	default:
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement with more than one target in sharded keyspace")
+	}
+	m, err := newMultiTableDML(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit)
+	if err != nil {
+		return nil, err
+	}
+	for alias, t := range m.st.tables {
+		if alias.Name == del.Targets[0].Name && (del.Targets[0].Qualifier.IsEmpty() || alias.Qualifier == del.Targets[0].Qualifier) {
+			m.target = t
+		}
+	}
+	if m.target == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown table '%s' in MULTI DELETE", del.Targets[0].Name.String())
+	}
+	targetExprs, where, err := m.analyze(del.TableExprs, del.Where)
+	if err != nil {
+		return nil, err
+	}
+	dml, err := buildDeletePlan(&sqlparser.Delete{
+		Ignore:     del.Ignore,
+		Comments:   del.Comments,
+		TableExprs: targetExprs,
+		Where:      where,
+	}, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return m.primitive(dml)
+}
+
+// buildMultiTableUpdatePlan builds the instructions for an UPDATE
+// that joins tables which can't be sent to a single route.
+func buildMultiTableUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (engine.Primitive, error) {
+	m, err := newMultiTableDML(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	// The target is the table of the updated columns.
+	if m.target, err = m.tableOf(upd.Exprs[0].Name); err != nil {
+		return nil, err
+	}
+	for _, expr := range upd.Exprs {
+		t, err := m.tableOf(expr.Name)
+		if err != nil {
+			return nil, err
+		}
+		if t != m.target {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update statement that changes more than one table in sharded keyspace")
+		}
+		_, others, err := m.columns(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if others {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table update statement with values from other tables: %s", sqlparser.String(expr))
+		}
+	}
+	targetExprs, where, err := m.analyze(upd.TableExprs, upd.Where)
+	if err != nil {
+		return nil, err
+	}
+	for _, expr := range upd.Exprs {
+		m.unqualify(expr)
+	}
+	dml, err := buildUpdatePlan(&sqlparser.Update{
+		Ignore:     upd.Ignore,
+		Comments:   upd.Comments,
+		TableExprs: targetExprs,
+		Exprs:      upd.Exprs,
+		Where:      where,
+	}, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return m.primitive(dml)
+}
+
+// multiTableDML holds the analysis of a multi-table DML.
+type multiTableDML struct {
+	vschema ContextVSchema
+	dmlType string
+
+	// st contains the tables of the statement.
+	st     *symtab
+	target *table
+
+	// sel is a copy of the tables and the WHERE clause
+	// of the statement.
+	sel *sqlparser.Select
+
+	// key is the column of the target that identifies the rows to
+	// change, and vindexCol is its primary vindex column. Either can
+	// be empty.
+	key       sqlparser.ColIdent
+	vindexCol sqlparser.ColIdent
+}
+
+func newMultiTableDML(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit) (*multiTableDML, error) {
+	if len(orderBy) != 0 || limit != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: order by or limit in multi-table %s statement", dmlType)
+	}
+	if hasSubquery(stmt) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+	}
+	clone, err := cloneDMLStatement(stmt)
+	if err != nil {
+		return nil, err
+	}
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(clone)))
+	if err := pb.processTableExprs(dmlTableExprs(clone), nil); err != nil {
+		return nil, err
+	}
+	// The select is planned on a copy because the analysis
+	// changes the WHERE clause of the statement.
+	sel, err := sqlparser.Parse(sqlparser.String(&sqlparser.Select{
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.StarExpr{}},
+		From:        tableExprs,
+		Where:       where,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return &multiTableDML{
+		vschema: vschema,
+		dmlType: dmlType,
+		st:      pb.st,
+		sel:     sel.(*sqlparser.Select),
+	}, nil
+}
+
+// analyze finds the key of the target and returns the table expression
+// and the WHERE clause of the single-table DML of the target.
+func (m *multiTableDML) analyze(tableExprs sqlparser.TableExprs, where *sqlparser.Where) (sqlparser.TableExprs, *sqlparser.Where, error) {
+	if m.target.vschemaTable == nil {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement of %s", m.dmlType, sqlparser.String(m.target.alias))
+	}
+	targetExpr, err := m.targetExpr(tableExprs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make(map[string]sqlparser.ColIdent)
+	addKeys := func(cols []sqlparser.ColIdent) {
+		for _, col := range cols {
+			keys[col.Lowered()] = col
+		}
+	}
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		// Join conditions are not filters for outer joins.
+		// They are always treated as join predicates.
+		if join, ok := node.(*sqlparser.JoinTableExpr); ok {
+			cols, _, err := m.columns(join.Condition.On)
+			if err != nil {
+				return false, err
+			}
+			addKeys(cols)
+		}
+		return true, nil
+	}, tableExprs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var filters []sqlparser.Expr
+	if where != nil {
+		for _, filter := range splitAndExpression(nil, where.Expr) {
+			cols, others, err := m.columns(filter)
+			if err != nil {
+				return nil, nil, err
+			}
+			if others {
+				addKeys(cols)
+				continue
+			}
+			m.unqualify(filter)
+			filters = append(filters, filter)
+		}
+	}
+	if len(keys) > 1 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement that joins on more than one column of %s", m.dmlType, sqlparser.String(m.target.alias))
+	}
+	for _, col := range keys {
+		m.key = col
+	}
+
+	if m.target.vschemaTable.Keyspace.Sharded {
+		if m.vindexCol, err = primaryVindexColumn(m.target.vschemaTable); err != nil {
+			return nil, nil, err
+		}
+		if !m.vindexCol.Equal(m.key) {
+			filters = append(filters, &sqlparser.ComparisonExpr{
+				Operator: sqlparser.InOp,
+				Left:     &sqlparser.ColName{Name: m.vindexCol},
+				Right:    sqlparser.ListArg("::" + engine.DMLVindexValuesVarName),
+			})
+		}
+	}
+	if !m.key.IsEmpty() {
+		filters = append(filters, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InOp,
+			Left:     &sqlparser.ColName{Name: m.key},
+			Right:    sqlparser.ListArg("::" + engine.DMLKeysVarName),
+		})
+	}
+	var targetWhere *sqlparser.Where
+	if len(filters) != 0 {
+		expr := filters[0]
+		for _, filter := range filters[1:] {
+			expr = &sqlparser.AndExpr{Left: expr, Right: filter}
+		}
+		targetWhere = sqlparser.NewWhere(sqlparser.WhereClause, expr)
+	}
+	return sqlparser.TableExprs{targetExpr}, targetWhere, nil
+}
+
+// primitive returns the MultiTableDML that selects the key and primary
+// vindex values of the target through the join and executes dml.
+func (m *multiTableDML) primitive(dml engine.Primitive) (engine.Primitive, error) {
+	sel := m.sel
+	sel.SelectExprs = nil
+	// The rows of the other tables are locked like MySQL does.
+	sel.Lock = sqlparser.ShareModeLock
+
+	emdml := &engine.MultiTableDML{
+		KeyColumn:    -1,
+		VindexColumn: -1,
+		DML:          dml,
+	}
+	addColumn := func(col sqlparser.ColIdent) int {
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{
+			Expr: &sqlparser.ColName{Name: col, Qualifier: m.target.alias},
+		})
+		return len(sel.SelectExprs) - 1
+	}
+	if !m.key.IsEmpty() {
+		emdml.KeyColumn = addColumn(m.key)
+	}
+	if !m.vindexCol.IsEmpty() {
+		if m.vindexCol.Equal(m.key) {
+			emdml.VindexColumn = emdml.KeyColumn
+		} else {
+			emdml.VindexColumn = addColumn(m.vindexCol)
+		}
+	}
+	if len(sel.SelectExprs) == 0 {
+		// Only the existence of a row matters.
+		sel.SelectExprs = sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral([]byte("1"))}}
+	}
+
+	var err error
+	if emdml.Input, err = buildSelectPlan("")(sel, m.vschema); err != nil {
+		return nil, err
+	}
+	return emdml, nil
+}
+
+// targetExpr returns the table expression of the target.
+func (m *multiTableDML) targetExpr(tableExprs sqlparser.TableExprs) (sqlparser.TableExpr, error) {
+	var targetExpr *sqlparser.AliasedTableExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok {
+			return false, nil
+		}
+		alias := tableName
+		if !tableExpr.As.IsEmpty() {
+			alias = sqlparser.TableName{Name: tableExpr.As}
+		}
+		if alias == m.target.alias {
+			targetExpr = tableExpr
+		}
+		return false, nil
+	}, tableExprs)
+	if targetExpr == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement of %s", m.dmlType, sqlparser.String(m.target.alias))
+	}
+	return &sqlparser.AliasedTableExpr{
+		Expr:       targetExpr.Expr,
+		Partitions: targetExpr.Partitions,
+	}, nil
+}
+
+// columns returns the columns of the target referenced by node, and
+// whether node references columns of other tables.
+func (m *multiTableDML) columns(node sqlparser.SQLNode) (cols []sqlparser.ColIdent, others bool, err error) {
+	err = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		t, err := m.tableOf(col)
+		if err != nil {
+			return false, err
+		}
+		if t == m.target {
+			cols = append(cols, col.Name)
+		} else {
+			others = true
+		}
+		return false, nil
+	}, node)
+	return cols, others, err
+}
+
+// tableOf returns the table of a column. An unqualified column is
+// resolved only if a single table can contain it.
+func (m *multiTableDML) tableOf(col *sqlparser.ColName) (*table, error) {
+	if !col.Qualifier.IsEmpty() {
+		for alias, t := range m.st.tables {
+			if alias.Name == col.Qualifier.Name && (col.Qualifier.Qualifier.IsEmpty() || alias.Qualifier == col.Qualifier.Qualifier) {
+				return t, nil
+			}
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "symbol %s not found", sqlparser.String(col))
+	}
+	var found *table
+	for _, t := range m.st.tables {
+		if _, ok := t.columns[col.Name.Lowered()]; !ok && t.isAuthoritative {
+			continue
+		}
+		if found != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unqualified column %s in multi-table %s statement", sqlparser.String(col), m.dmlType)
+		}
+		found = t
+	}
+	if found == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "symbol %s not found", sqlparser.String(col))
+	}
+	return found, nil
+}
+
+// unqualify removes the qualifier of the columns of the target in
+// node, which are sent in the single-table DML of the target.
+func (m *multiTableDML) unqualify(node sqlparser.SQLNode) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok && !col.Qualifier.IsEmpty() {
+			col.Qualifier = sqlparser.TableName{}
+		}
+		return true, nil
+	}, node)
+}
+
+// primaryVindexColumn returns the column of the first unique
+// single-column vindex of a table, which is used to route its DMLs.
+func primaryVindexColumn(table *vindexes.Table) (sqlparser.ColIdent, error) {
+	for _, index := range table.Ordered {
+		if _, ok := index.Vindex.(vindexes.SingleColumn); ok && index.Vindex.IsUnique() {
+			return index.Columns[0], nil
+		}
+	}
+	return sqlparser.ColIdent{}, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
+}
+
+// cloneDMLStatement returns a deep copy of an UPDATE or DELETE.
+func cloneDMLStatement(stmt sqlparser.Statement) (sqlparser.Statement, error) {
+	return sqlparser.Parse(sqlparser.String(stmt))
+}
+
+// dmlTableExprs returns the tables of an UPDATE or DELETE.
+func dmlTableExprs(stmt sqlparser.Statement) sqlparser.TableExprs {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Update:
+		return stmt.TableExprs
+	case *sqlparser.Delete:
+		return stmt.TableExprs
+	}
+	return nil
+}
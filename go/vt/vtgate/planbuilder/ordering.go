@@ -24,7 +24,7 @@ import (
 
 func planOrdering(pb *primitiveBuilder, input logicalPlan, orderBy sqlparser.OrderBy) (logicalPlan, error) {
 	switch node := input.(type) {
-	case *subquery, *vindexFunc:
+	case *subquery, *vindexFunc, *window:
 		if len(orderBy) == 0 {
 			return node, nil
 		}
@@ -41,6 +41,23 @@ func planOrdering(pb *primitiveBuilder, input logicalPlan, orderBy sqlparser.Ord
 		}
 		node.underlying = plan
 		return node, nil
+	case *filter:
+		// Filtering preserves the order of the rows.
+		plan, err := planOrdering(pb, node.input, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		node.input = plan
+		return node, nil
+	case *correlatedSubquery:
+		// The rows of the outer plan are filtered in place,
+		// which preserves their order.
+		plan, err := planOrdering(pb, node.outer, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		node.outer = plan
+		return node, nil
 	case *route:
 		return planRouteOrdering(orderBy, node)
 	case *join:
@@ -117,6 +117,11 @@ func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 
 		node.underlying = newUnderlying
 		return false, node, nil
+	case *correlatedSubquery, *filter, *window:
+		// The limit cannot be pushed below the filtering
+		// performed by these primitives, and the window
+		// functions need all the rows of a partition.
+		return false, node, nil
 	case *route:
 		// The route pushes the limit regardless of the plan.
 		// If it's a scatter query, the rows returned will be
@@ -87,6 +87,27 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 			return nil, nil, 0, errors.New("unsupported: in scatter query: complex aggregate expression")
 		}
 
+		newInput, innerRC, _, err := planProjection(pb, node.input, expr, origin)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		node.input = newInput
+		node.resultColumns = append(node.resultColumns, innerRC)
+		return node, innerRC, len(node.resultColumns) - 1, nil
+	case *window:
+		// Window functions are evaluated by the window primitive on
+		// the merge-sorted rows. Other expressions are pushed down.
+		if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok && inner.IsWindowFunc() {
+			rc, colNumber, err := node.pushWindowFunc(pb, expr, origin)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			return node, rc, colNumber, nil
+		}
+		if nodeHasWindowFuncs(expr.Expr) {
+			return nil, nil, 0, errors.New("unsupported: in scatter query: complex window function expression")
+		}
+
 		newInput, innerRC, _, err := planProjection(pb, node.input, expr, origin)
 		if err != nil {
 			return nil, nil, 0, err
@@ -132,14 +153,41 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 			return nil, nil, 0, err
 		}
 		return node, rc, idx, nil
+	case *filter:
+		projectedInput, rc, idx, err := planProjection(pb, node.input, expr, origin)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		node.input = projectedInput
+		node.resultColumns = append(node.resultColumns, rc)
+		return node, rc, idx, nil
+	case *correlatedSubquery:
+		projectedInput, rc, idx, err := planProjection(pb, node.outer, expr, origin)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		err = node.Rewrite(projectedInput, node.subquery)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return node, rc, idx, nil
 	case *subquery:
-		col, ok := expr.Expr.(*sqlparser.ColName)
-		if !ok {
-			return nil, nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+		var inner int
+		if col, ok := expr.Expr.(*sqlparser.ColName); ok {
+			// colNumber should already be set for subquery columns.
+			inner = col.Metadata.(*column).colNumber
+		} else {
+			// Other expressions are evaluated by vtgate on the
+			// rows returned by the subquery.
+			eexpr, err := sqlparser.Convert(expr.Expr, node.findColumn)
+			if err == sqlparser.ErrExprNotSupported {
+				return nil, nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+			}
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			inner = node.addProjection(eexpr, expr)
 		}
-
-		// colNumber should already be set for subquery columns.
-		inner := col.Metadata.(*column).colNumber
 		node.esubquery.Cols = append(node.esubquery.Cols, inner)
 
 		// Build a new column reference to represent the result column.
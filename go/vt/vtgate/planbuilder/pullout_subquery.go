@@ -25,6 +25,13 @@ import (
 
 var _ logicalPlan = (*pulloutSubquery)(nil)
 
+// subqueryWrapper is a logicalPlan that gets built on top of
+// the current plan to evaluate a subquery of an expression.
+type subqueryWrapper interface {
+	logicalPlan
+	setUnderlying(underlying logicalPlan)
+}
+
 // pulloutSubquery is the logicalPlan for engine.PulloutSubquery.
 // This gets built if a subquery is not correlated and can
 // therefore can be pulled out and executed upfront.
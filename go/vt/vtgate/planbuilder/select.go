@@ -92,6 +92,12 @@ func buildSelectPlan(query string) func(sqlparser.Statement, ContextVSchema) (en
 // pushed into a route, then a primitive is created on top of any
 // of the above trees to make it discard unwanted rows.
 func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab, query string) error {
+	if with := sel.With; with != nil {
+		return pb.processWith(sel, with, outer, func() error {
+			return pb.processSelect(sel, outer, query)
+		})
+	}
+
 	// Check and error if there is any locking function present in select expression.
 	for _, expr := range sel.SelectExprs {
 		if aExpr, ok := expr.(*sqlparser.AliasedExpr); ok && sqlparser.IsLockingFunc(aExpr.Expr) {
@@ -150,6 +156,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab,
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
+	if err := pb.checkWindows(sel); err != nil {
+		return err
+	}
 	if err := pb.pushSelectExprs(sel); err != nil {
 		return err
 	}
@@ -266,7 +275,7 @@ func handleDualSelects(sel *sqlparser.Select, vschema ContextVSchema) (engine.Pr
 			return buildLockingPrimitive(sel, vschema)
 
 		}
-		exprs[i], err = sqlparser.Convert(expr.Expr)
+		exprs[i], err = sqlparser.Convert(expr.Expr, nil)
 		if err != nil {
 			return nil, nil
 		}
@@ -319,10 +328,15 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	filters := splitAndExpression(nil, in)
 	reorderBySubquery(filters)
 	for _, filter := range filters {
-		pullouts, origin, expr, err := pb.findOrigin(filter)
+		pullouts, origin, expr, err := pb.findOrigin(filter, whereType == sqlparser.WhereStr)
 		if err != nil {
 			return err
 		}
+		if expr == nil {
+			// The filter was turned into a correlated subquery.
+			pb.addPullouts(pullouts)
+			continue
+		}
 		rut, isRoute := origin.(*route)
 		if isRoute && rut.eroute.Opcode == engine.SelectDBA {
 			err := pb.findSysInfoRoutingPredicates(expr, rut)
@@ -339,6 +353,7 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 		}
 		pb.addPullouts(pullouts)
 	}
+	pb.plan.Reorder(0)
 	return nil
 }
 
@@ -363,7 +378,7 @@ func reorderBySubquery(filters []sqlparser.Expr) {
 }
 
 // addPullouts adds the pullout subqueries to the primitiveBuilder.
-func (pb *primitiveBuilder) addPullouts(pullouts []*pulloutSubquery) {
+func (pb *primitiveBuilder) addPullouts(pullouts []subqueryWrapper) {
 	for _, pullout := range pullouts {
 		pullout.setUnderlying(pb.plan)
 		pb.plan = pullout
@@ -389,7 +404,7 @@ func (pb *primitiveBuilder) pushSelectRoutes(selectExprs sqlparser.SelectExprs)
 	for _, node := range selectExprs {
 		switch node := node.(type) {
 		case *sqlparser.AliasedExpr:
-			pullouts, origin, expr, err := pb.findOrigin(node.Expr)
+			pullouts, origin, expr, err := pb.findOrigin(node.Expr, false)
 			if err != nil {
 				return nil, err
 			}
@@ -21,6 +21,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ logicalPlan = (*subquery)(nil)
@@ -38,6 +39,10 @@ type subquery struct {
 	logicalPlanCommon
 	resultColumns []*resultColumn
 	esubquery     *engine.Subquery
+
+	// projection evaluates the expressions that are selected
+	// from the subquery's results. It's nil if there are none.
+	projection *engine.Projection
 }
 
 // newSubquery builds a new subquery.
@@ -69,10 +74,40 @@ func newSubquery(alias sqlparser.TableIdent, plan logicalPlan) (*subquery, *symt
 
 // Primitive implements the logicalPlan interface
 func (sq *subquery) Primitive() engine.Primitive {
-	sq.esubquery.Subquery = sq.input.Primitive()
+	input := sq.input.Primitive()
+	if sq.projection != nil {
+		sq.projection.Input = input
+		input = sq.projection
+	}
+	sq.esubquery.Subquery = input
 	return sq.esubquery
 }
 
+// findColumn returns the offset of a column of the subquery in
+// the rows it returns. It fails if the column belongs to another table.
+func (sq *subquery) findColumn(col *sqlparser.ColName) (int, error) {
+	c, ok := col.Metadata.(*column)
+	if !ok || c.origin != sq {
+		return 0, fmt.Errorf("unsupported: expression on results of a cross-shard subquery referencing %s", sqlparser.String(col))
+	}
+	return c.colNumber, nil
+}
+
+// addProjection adds an expression to be evaluated on the rows
+// returned by the subquery, and returns the offset of its result.
+func (sq *subquery) addProjection(expr evalengine.Expr, aliased *sqlparser.AliasedExpr) int {
+	if sq.projection == nil {
+		sq.projection = &engine.Projection{}
+	}
+	name := aliased.As.String()
+	if name == "" {
+		name = sqlparser.String(aliased.Expr)
+	}
+	sq.projection.Exprs = append(sq.projection.Exprs, expr)
+	sq.projection.Cols = append(sq.projection.Cols, name)
+	return len(sq.input.ResultColumns()) + len(sq.projection.Exprs) - 1
+}
+
 // ResultColumns implements the logicalPlan interface
 func (sq *subquery) ResultColumns() []*resultColumn {
 	return sq.resultColumns
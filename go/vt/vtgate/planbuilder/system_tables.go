@@ -87,7 +87,7 @@ func extractInfoSchemaRoutingPredicate(in sqlparser.Expr) (bool, evalengine.Expr
 		if cmp.Operator == sqlparser.EqualOp {
 			isSchemaName, col, other, replaceOther := findOtherComparator(cmp)
 			if col != nil && shouldRewrite(other) {
-				evalExpr, err := sqlparser.Convert(other)
+				evalExpr, err := sqlparser.Convert(other, nil)
 				if err != nil {
 					if err == sqlparser.ErrExprNotSupported {
 						// This just means we can't rewrite this particular expression,
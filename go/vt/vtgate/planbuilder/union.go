@@ -43,6 +43,11 @@ func buildUnionPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Pr
 }
 
 func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab) error {
+	if with := union.With; with != nil {
+		return pb.processWith(union, with, outer, func() error {
+			return pb.processUnion(union, outer)
+		})
+	}
 	if err := pb.processPart(union.FirstStatement, outer, false); err != nil {
 		return err
 	}
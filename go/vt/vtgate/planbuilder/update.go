@@ -29,6 +29,13 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
+	multiTable, err := isMultiTableDML(vschema, upd, upd.TableExprs)
+	if err != nil {
+		return nil, err
+	}
+	if multiTable {
+		return buildMultiTableUpdatePlan(upd, vschema)
+	}
 	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
@@ -41,6 +48,14 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		return eupd, nil
 	}
 
+	var input engine.Primitive
+	if eupd.Opcode == engine.Scatter && upd.Limit != nil {
+		if input, upd.Where, err = buildLimitDMLInput(vschema, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, ksidCol); err != nil {
+			return nil, err
+		}
+		eupd.Query = generateQuery(upd)
+	}
+
 	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidCol)
 	if err != nil {
 		return nil, err
@@ -50,6 +65,14 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 	if len(eupd.ChangedVindexValues) != 0 {
 		eupd.KsidVindex = ksidVindex
 	}
+
+	if input != nil {
+		return &engine.LimitDML{
+			Input:  input,
+			Vindex: ksidVindex,
+			DML:    eupd,
+		}, nil
+	}
 	return eupd, nil
 }
 
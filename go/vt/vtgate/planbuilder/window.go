@@ -0,0 +1,429 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ logicalPlan = (*window)(nil)
+
+// window is the logicalPlan for engine.Window.
+// This gets built if a scatter query has window functions
+// whose partitions can span multiple shards. Every window
+// function is sent to the route as a placeholder expression
+// that returns its argument, and the route is asked to order
+// the results by the PARTITION BY expressions followed by
+// the ORDER BY of the window. For example:
+// 'select a, rank() over (partition by b order by c) from t'
+// will be sent to the scatter route as:
+// 'select a, 1 from t order by b asc, c asc'
+// and the window primitive will compute the rank from the
+// merge-sorted results.
+type window struct {
+	resultsBuilder
+	ewindow *engine.Window
+	windows sqlparser.WindowDefinitions
+	spec    *sqlparser.WindowSpec
+}
+
+// checkWindows analyzes the select expressions for window functions.
+// If they can't be pushed down to the route, it builds a window
+// primitive that will evaluate them.
+func (pb *primitiveBuilder) checkWindows(sel *sqlparser.Select) error {
+	var specs []*sqlparser.WindowSpec
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunc() {
+				spec, err := resolveWindow(node.Over, sel.Windows)
+				if err != nil {
+					return false, err
+				}
+				specs = append(specs, spec)
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, sel.SelectExprs)
+	if err != nil {
+		return err
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+
+	rb, isRoute := pb.plan.(*route)
+	if !isRoute {
+		return errors.New("unsupported: cross-shard query with window functions")
+	}
+
+	// If every partition is confined to a single shard, the
+	// window functions can be evaluated by the route.
+	if rb.isSingleShard() || pb.partitionsHaveUniqueVindex(specs, rb) {
+		if err := pb.st.ResolveSymbols(sel.Windows); err != nil {
+			return err
+		}
+		rb.Select.(*sqlparser.Select).Windows = sel.Windows
+		return nil
+	}
+
+	if len(sel.GroupBy) > 0 || sel.Distinct {
+		return errors.New("unsupported: in scatter query: window functions with grouping")
+	}
+	spec := specs[0]
+	for _, other := range specs[1:] {
+		if sqlparser.String(other.PartitionBy) != sqlparser.String(spec.PartitionBy) || sqlparser.String(other.OrderBy) != sqlparser.String(spec.OrderBy) {
+			return errors.New("unsupported: in scatter query: window functions over different partitions or orderings")
+		}
+	}
+	if err := pb.st.ResolveSymbols(spec.PartitionBy); err != nil {
+		return err
+	}
+	if err := pb.st.ResolveSymbols(spec.OrderBy); err != nil {
+		return err
+	}
+	for _, expr := range spec.PartitionBy {
+		if _, ok := expr.(*sqlparser.ColName); !ok {
+			return fmt.Errorf("unsupported: in scatter query: complex partition by expression: %s", sqlparser.String(expr))
+		}
+	}
+	for _, order := range spec.OrderBy {
+		if _, ok := order.Expr.(*sqlparser.ColName); !ok {
+			return fmt.Errorf("unsupported: in scatter query: complex window order by expression: %s", sqlparser.String(order.Expr))
+		}
+	}
+
+	ewindow := &engine.Window{}
+	pb.plan = &window{
+		resultsBuilder: newResultsBuilder(rb, ewindow),
+		ewindow:        ewindow,
+		windows:        sel.Windows,
+		spec:           spec,
+	}
+	pb.plan.Reorder(0)
+	return nil
+}
+
+// partitionsHaveUniqueVindex returns true if the PARTITION BY of
+// every window references a unique vindex of the route.
+func (pb *primitiveBuilder) partitionsHaveUniqueVindex(specs []*sqlparser.WindowSpec, rb *route) bool {
+	for _, spec := range specs {
+		found := false
+		for _, expr := range spec.PartitionBy {
+			vindex := pb.st.Vindex(expr, rb)
+			if vindex != nil && vindex.IsUnique() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveWindow returns the window specification of an OVER clause
+// after replacing the references to named windows by their definition.
+func resolveWindow(over *sqlparser.OverClause, windows sqlparser.WindowDefinitions) (*sqlparser.WindowSpec, error) {
+	spec := over.WindowSpec
+	if spec == nil {
+		spec = &sqlparser.WindowSpec{Name: over.WindowName}
+	}
+	// A named window can reference another one. Each step must
+	// reference a different window, which bounds the iterations.
+	resolved := &sqlparser.WindowSpec{PartitionBy: spec.PartitionBy, OrderBy: spec.OrderBy, Frame: spec.Frame}
+	name := spec.Name
+	for i := 0; !name.IsEmpty(); i++ {
+		var def *sqlparser.WindowDefinition
+		for _, w := range windows {
+			if w.Name.Equal(name) {
+				def = w
+				break
+			}
+		}
+		if def == nil {
+			return nil, fmt.Errorf("unknown window name: %s", name.String())
+		}
+		if i == len(windows) {
+			return nil, fmt.Errorf("circular window reference: %s", name.String())
+		}
+		if len(resolved.PartitionBy) == 0 {
+			resolved.PartitionBy = def.Spec.PartitionBy
+		}
+		if len(resolved.OrderBy) == 0 {
+			resolved.OrderBy = def.Spec.OrderBy
+		}
+		if resolved.Frame == nil {
+			resolved.Frame = def.Spec.Frame
+		}
+		name = def.Spec.Name
+	}
+	return resolved, nil
+}
+
+func nodeHasWindowFuncs(node sqlparser.SQLNode) bool {
+	hasWindowFuncs := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunc() {
+				hasWindowFuncs = true
+				return false, errors.New("unused error")
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return hasWindowFuncs
+}
+
+// pushWindowFunc pushes the argument of a window function to the
+// route, and registers the function to be evaluated on its column.
+func (w *window) pushWindowFunc(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin logicalPlan) (rc *resultColumn, colNumber int, err error) {
+	funcExpr := expr.Expr.(*sqlparser.FuncExpr)
+	opcode, ok := engine.SupportedWindowFuncs[funcExpr.Name.Lowered()]
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: window function %s", funcExpr.Name.String())
+	}
+	if funcExpr.Distinct || funcExpr.FromLast || funcExpr.IgnoreNulls {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+	}
+	spec, err := resolveWindow(funcExpr.Over, w.windows)
+	if err != nil {
+		return nil, 0, err
+	}
+	wf := engine.WindowFunc{
+		Opcode: opcode,
+		Alias:  expr.As.String(),
+	}
+	if wf.Alias == "" {
+		wf.Alias = sqlparser.String(funcExpr)
+	}
+	if wf.Frame, err = convertFrame(spec.Frame); err != nil {
+		return nil, 0, err
+	}
+
+	args, err := windowFuncArgs(funcExpr)
+	if err != nil {
+		return nil, 0, err
+	}
+	// arg is the expression sent to the route.
+	var arg sqlparser.Expr = sqlparser.NewIntLiteral([]byte("1"))
+	switch opcode {
+	case engine.WindowNtile:
+		if len(args) != 1 {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+		}
+		if wf.N, err = windowConstant(args[0]); err != nil {
+			return nil, 0, err
+		}
+		if wf.N < 1 {
+			return nil, 0, fmt.Errorf("invalid number of buckets: %s", sqlparser.String(funcExpr))
+		}
+	case engine.WindowLag, engine.WindowLead:
+		if len(args) < 1 || len(args) > 3 {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+		}
+		arg = args[0]
+		wf.N = 1
+		if len(args) > 1 {
+			if wf.N, err = windowConstant(args[1]); err != nil {
+				return nil, 0, err
+			}
+		}
+		if len(args) > 2 {
+			pv, err := sqlparser.NewPlanValue(args[2])
+			if err != nil || pv.Key != "" || pv.Values != nil {
+				return nil, 0, fmt.Errorf("unsupported: in scatter query: non-constant default value: %s", sqlparser.String(args[2]))
+			}
+			wf.Default = pv.Value
+		}
+	case engine.WindowNthValue:
+		if len(args) != 2 {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+		}
+		arg = args[0]
+		if wf.N, err = windowConstant(args[1]); err != nil {
+			return nil, 0, err
+		}
+		if wf.N < 1 {
+			return nil, 0, fmt.Errorf("invalid row number: %s", sqlparser.String(funcExpr))
+		}
+	case engine.WindowCount:
+		if len(args) == 1 {
+			arg = args[0]
+		}
+	case engine.WindowFirstValue, engine.WindowLastValue, engine.WindowSum, engine.WindowMin, engine.WindowMax:
+		if len(args) != 1 {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+		}
+		arg = args[0]
+	}
+	if nodeHasAggregates(arg) || nodeHasWindowFuncs(arg) {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+	}
+
+	newInput, _, colNumber, err := planProjection(pb, w.input, &sqlparser.AliasedExpr{Expr: arg}, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	w.input = newInput
+	wf.Col = colNumber
+	w.ewindow.Funcs = append(w.ewindow.Funcs, wf)
+
+	rc = newResultColumn(expr, w)
+	w.resultColumns = append(w.resultColumns, rc)
+	return rc, len(w.resultColumns) - 1, nil
+}
+
+// windowFuncArgs returns the arguments of a window function.
+// The '*' of count(*) is not returned.
+func windowFuncArgs(funcExpr *sqlparser.FuncExpr) ([]sqlparser.Expr, error) {
+	var args []sqlparser.Expr
+	for _, selectExpr := range funcExpr.Exprs {
+		switch selectExpr := selectExpr.(type) {
+		case *sqlparser.AliasedExpr:
+			args = append(args, selectExpr.Expr)
+		case *sqlparser.StarExpr:
+			if funcExpr.Name.Lowered() != "count" {
+				return nil, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+			}
+		default:
+			return nil, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+		}
+	}
+	return args, nil
+}
+
+// windowConstant returns the value of an integer constant
+// used as the argument of a window function or frame bound.
+func windowConstant(expr sqlparser.Expr) (int64, error) {
+	lit, ok := expr.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.IntVal {
+		return 0, fmt.Errorf("unsupported: in scatter query: expected integer constant: %s", sqlparser.String(expr))
+	}
+	return strconv.ParseInt(string(lit.Val), 0, 64)
+}
+
+// convertFrame converts the frame clause of a window function.
+func convertFrame(frame *sqlparser.FrameClause) (*engine.WindowFrame, error) {
+	if frame == nil {
+		return nil, nil
+	}
+	eframe := &engine.WindowFrame{Range: frame.Unit == sqlparser.RangeUnit}
+	var err error
+	if eframe.Start, err = convertFramePoint(frame.Start, eframe.Range); err != nil {
+		return nil, err
+	}
+	if frame.End == nil {
+		// A frame with a single bound ends at the current row.
+		return eframe, nil
+	}
+	if eframe.End, err = convertFramePoint(frame.End, eframe.Range); err != nil {
+		return nil, err
+	}
+	return eframe, nil
+}
+
+func convertFramePoint(point *sqlparser.FramePoint, isRange bool) (engine.FrameBound, error) {
+	switch point.Type {
+	case sqlparser.CurrentRow:
+		return engine.FrameBound{}, nil
+	case sqlparser.UnboundedPreceding, sqlparser.UnboundedFollowing:
+		return engine.FrameBound{Unbounded: true}, nil
+	}
+	if isRange {
+		return engine.FrameBound{}, fmt.Errorf("unsupported: in scatter query: range frame with offset: %s", sqlparser.String(point))
+	}
+	offset, err := windowConstant(point.Expr)
+	if err != nil {
+		return engine.FrameBound{}, err
+	}
+	if point.Type == sqlparser.ExprPreceding {
+		offset = -offset
+	}
+	return engine.FrameBound{Offset: offset}, nil
+}
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.ewindow.Input = w.input.Primitive()
+	return w.ewindow
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (w *window) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	if w.resultColumns[colNumber].column.Origin() == w {
+		// The route only has the argument of the function.
+		return 0, errors.New("unsupported: in scatter query: ordering on the text result of a window function")
+	}
+	return w.resultsBuilder.SupplyWeightString(colNumber)
+}
+
+// Wireup implements the logicalPlan interface
+// The route is asked to sort its results by the PARTITION BY
+// expressions followed by the ORDER BY of the window. If any
+// of the columns are Text, the corresponding weight_string is
+// requested from mysql and used to compare the rows instead.
+func (w *window) Wireup(plan logicalPlan, jt *jointab) error {
+	rb := w.input.(*route)
+	sel := rb.Select.(*sqlparser.Select)
+	addKey := func(col *sqlparser.ColName, direction sqlparser.OrderDirection) (int, error) {
+		_, colNumber := rb.SupplyCol(col)
+		sel.AddOrder(&sqlparser.Order{Expr: col, Direction: direction})
+		if sqltypes.IsText(rb.resultColumns[colNumber].column.typ) {
+			return rb.SupplyWeightString(colNumber)
+		}
+		return colNumber, nil
+	}
+	for _, expr := range w.spec.PartitionBy {
+		colNumber, err := addKey(expr.(*sqlparser.ColName), sqlparser.AscOrder)
+		if err != nil {
+			return err
+		}
+		w.ewindow.PartitionBy = append(w.ewindow.PartitionBy, colNumber)
+		rb.eroute.OrderBy = append(rb.eroute.OrderBy, engine.OrderbyParams{Col: colNumber})
+	}
+	for _, order := range w.spec.OrderBy {
+		colNumber, err := addKey(order.Expr.(*sqlparser.ColName), order.Direction)
+		if err != nil {
+			return err
+		}
+		ob := engine.OrderbyParams{
+			Col:  colNumber,
+			Desc: order.Direction == sqlparser.DescOrder,
+		}
+		w.ewindow.OrderBy = append(w.ewindow.OrderBy, ob)
+		rb.eroute.OrderBy = append(rb.eroute.OrderBy, ob)
+	}
+	if len(rb.resultColumns) > len(w.resultColumns) {
+		w.ewindow.TruncateColumnCount = len(w.resultColumns)
+	}
+	return w.input.Wireup(plan, jt)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// processWith plans a statement that has a WITH clause. The common
+// table expressions are inlined as derived tables and the rewritten
+// statement is planned by process. If the statement is not correlated
+// to an outer query and the resulting plan is a single route that
+// targets one shard, the original statement is sent to MySQL
+// unchanged, unless routing rules renamed some of its tables.
+//
+// Recursive common table expressions cannot be inlined. They are only
+// supported if the statement can be passed through to a single shard.
+func (pb *primitiveBuilder) processWith(stmt sqlparser.SelectStatement, with *sqlparser.With, outer *symtab, process func() error) error {
+	original := sqlparser.CloneSelectStatement(stmt)
+	sqlparser.SetWith(stmt, nil)
+	ctes := make(map[string]*sqlparser.CommonTableExpr, len(with.CTEs))
+	for _, cte := range with.CTEs {
+		name := cte.Name.String()
+		if _, ok := ctes[name]; ok {
+			return fmt.Errorf("unsupported: duplicate common table expression name: %s", name)
+		}
+		if with.Recursive {
+			// The routing of a recursive CTE is analyzed by
+			// replacing its self references by its anchor.
+			anchor := &sqlparser.CommonTableExpr{
+				Name:     cte.Name,
+				Columns:  cte.Columns,
+				Subquery: &sqlparser.Subquery{Select: cteAnchor(cte.Subquery.Select)},
+			}
+			if err := replaceCTEReferences(cte.Subquery, inlineCTE, map[string]*sqlparser.CommonTableExpr{name: anchor}); err != nil {
+				return err
+			}
+		}
+		// The body of a CTE can reference the CTEs that precede it.
+		if err := replaceCTEReferences(cte.Subquery, inlineCTE, ctes); err != nil {
+			return err
+		}
+		ctes[name] = cte
+	}
+	if err := replaceCTEReferences(stmt, inlineCTE, ctes); err != nil {
+		return err
+	}
+
+	if err := process(); err != nil {
+		return err
+	}
+	rb, ok := pb.plan.(*route)
+	if !ok || outer != nil || !rb.isSingleShard() {
+		if with.Recursive {
+			return errors.New("unsupported: recursive common table expression in cross-shard query")
+		}
+		return nil
+	}
+	if len(rb.substitutions) != 0 {
+		// The table names of the original statement would not be
+		// substituted: the inlined statement is sent instead.
+		if with.Recursive {
+			return errors.New("unsupported: recursive common table expression on a table with routing rules")
+		}
+		return nil
+	}
+	// The original statement is not resolved against the symbol
+	// table. All its columns belong to the route.
+	sqlparser.Rewrite(original, func(cursor *sqlparser.Cursor) bool {
+		if col, ok := cursor.Node().(*sqlparser.ColName); ok {
+			col.Metadata = &column{origin: rb}
+		}
+		return true
+	}, nil)
+	rb.Select = original
+	return nil
+}
+
+// replaceCTEReferences replaces the unqualified table references of node
+// that name one of ctes by the table expression returned by replace.
+// Statements that define a CTE of the same name are left untouched.
+func replaceCTEReferences(node sqlparser.SQLNode, replace func(*sqlparser.CommonTableExpr) (sqlparser.SimpleTableExpr, error), ctes map[string]*sqlparser.CommonTableExpr) error {
+	var err error
+	sqlparser.Rewrite(node, func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			return !shadowsCTE(node.With, ctes)
+		case *sqlparser.Union:
+			return !shadowsCTE(node.With, ctes)
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				return true
+			}
+			cte, ok := ctes[tableName.Name.String()]
+			if !ok {
+				return true
+			}
+			var expr sqlparser.SimpleTableExpr
+			if expr, err = replace(cte); err != nil {
+				return false
+			}
+			node.Expr = expr
+			if node.As.IsEmpty() {
+				node.As = tableName.Name
+			}
+			// The replacement may reference a table of the same name,
+			// which must not be substituted again.
+			return false
+		}
+		return true
+	}, nil)
+	return err
+}
+
+// inlineCTE returns a derived table for a reference to cte. Every
+// reference gets its own copy of the CTE body.
+func inlineCTE(cte *sqlparser.CommonTableExpr) (sqlparser.SimpleTableExpr, error) {
+	body := sqlparser.CloneSelectStatement(cte.Subquery.Select)
+	if len(cte.Columns) != 0 {
+		if err := setCTEColumns(cte, body); err != nil {
+			return nil, err
+		}
+	}
+	return &sqlparser.DerivedTable{Select: body}, nil
+}
+
+// setCTEColumns applies the column list of cte as aliases to the
+// select expressions of body. For a union, the column names are
+// those of the first statement.
+func setCTEColumns(cte *sqlparser.CommonTableExpr, body sqlparser.SelectStatement) error {
+	for {
+		switch stmt := body.(type) {
+		case *sqlparser.Union:
+			body = stmt.FirstStatement
+			continue
+		case *sqlparser.ParenSelect:
+			body = stmt.Select
+			continue
+		case *sqlparser.Select:
+			if len(stmt.SelectExprs) != len(cte.Columns) {
+				return fmt.Errorf("column count mismatch for common table expression %s", cte.Name.String())
+			}
+			for i, expr := range stmt.SelectExprs {
+				aliased, ok := expr.(*sqlparser.AliasedExpr)
+				if !ok {
+					return fmt.Errorf("unsupported: column list with '%s' for common table expression %s", sqlparser.String(expr), cte.Name.String())
+				}
+				aliased.As = cte.Columns[i]
+			}
+			return nil
+		}
+		return fmt.Errorf("BUG: unexpected SELECT type: %T", body)
+	}
+}
+
+// shadowsCTE returns true if with defines a CTE named like one of ctes.
+func shadowsCTE(with *sqlparser.With, ctes map[string]*sqlparser.CommonTableExpr) bool {
+	if with == nil {
+		return false
+	}
+	for _, cte := range with.CTEs {
+		if _, ok := ctes[cte.Name.String()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// cteAnchor returns the first statement of the body of a recursive
+// CTE, which is the one that does not reference the CTE itself.
+func cteAnchor(body sqlparser.SelectStatement) sqlparser.SelectStatement {
+	for {
+		union, ok := body.(*sqlparser.Union)
+		if !ok {
+			return body
+		}
+		body = union.FirstStatement
+	}
+}
@@ -19,6 +19,7 @@ package vtgate
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
 	"os/signal"
@@ -63,6 +64,10 @@ var (
 	mysqlSslKey  = flag.String("mysql_server_ssl_key", "", "Path to ssl key for mysql server plugin SSL")
 	mysqlSslCa   = flag.String("mysql_server_ssl_ca", "", "Path to ssl CA for mysql server plugin SSL. If specified, server will require and validate client certs.")
 
+	mysqlServerCompression = flag.String("mysql_server_compression_algorithms", "", "Comma separated list of the compression algorithms the MySQL clients can use: zlib, zstd. By default, compression is not supported.")
+
+	mysqlServerRSAKey = flag.String("mysql_server_rsa_key", "", "Path to the RSA private key, in the PEM format, used to receive caching_sha2_password and sha256_password passwords over non-SSL connections. If not set, a key is generated when first needed.")
+
 	mysqlSlowConnectWarnThreshold = flag.Duration("mysql_slow_connect_warn_threshold", 0, "Warn if it takes more than the given threshold for a mysql connection to establish")
 
 	mysqlConnReadTimeout  = flag.Duration("mysql_server_read_timeout", 0, "connection read timeout")
@@ -427,6 +432,20 @@ func initMySQLProtocol() {
 			initTLSConfig(mysqlListener, *mysqlSslCert, *mysqlSslKey, *mysqlSslCa, *mysqlServerRequireSecureTransport)
 		}
 		mysqlListener.AllowClearTextWithoutTLS.Set(*mysqlAllowClearTextWithoutTLS)
+		mysqlListener.Compression, err = mysql.ParseCompressionAlgorithms(*mysqlServerCompression)
+		if err != nil {
+			log.Exitf("Invalid mysql_server_compression_algorithms: %v", err)
+		}
+		if *mysqlServerRSAKey != "" {
+			data, err := ioutil.ReadFile(*mysqlServerRSAKey)
+			if err != nil {
+				log.Exitf("Failed to read mysql_server_rsa_key: %v", err)
+			}
+			mysqlListener.RSAKey, err = mysql.ParseRSAKey(data)
+			if err != nil {
+				log.Exitf("Failed to parse mysql_server_rsa_key: %v", err)
+			}
+		}
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
 			log.Infof("setting mysql slow connection threshold to %v", mysqlSlowConnectWarnThreshold)
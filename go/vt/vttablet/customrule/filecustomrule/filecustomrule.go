@@ -20,9 +20,12 @@ package filecustomrule
 import (
 	"flag"
 	"io/ioutil"
+	"os"
+	"sync"
 	"time"
 
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 )
@@ -32,14 +35,23 @@ var (
 	fileCustomRule = NewFileCustomRule()
 	// Commandline flag to specify rule path
 	fileRulePath = flag.String("filecustomrules", "", "file based custom rule path")
+	// Commandline flag to specify how often the rule file is checked for changes
+	fileRuleReloadInterval = flag.Duration("filecustomrules_reload_interval", 0, "if positive, how often the file based custom rules are reloaded if the file changed")
 )
 
 // FileCustomRule is an implementation of CustomRuleManager, it reads custom query
 // rules from local file for once and push it to vttablet
 type FileCustomRule struct {
+	// mu protects the following fields, which are updated when the file is reloaded.
+	mu                      sync.Mutex
 	path                    string       // Path to the file containing custom query rules
 	currentRuleSet          *rules.Rules // Query rules built from local file
 	currentRuleSetTimestamp int64        // Unix timestamp when currentRuleSet is built from local file
+	modTime                 time.Time    // Modification time of the local file when it was read
+
+	// stop is closed to stop watching the file.
+	stop     chan struct{}
+	stopOnce sync.Once
 }
 
 // FileCustomRuleSource is the name of the file based custom rule source
@@ -50,16 +62,28 @@ func NewFileCustomRule() (fcr *FileCustomRule) {
 	fcr = new(FileCustomRule)
 	fcr.path = ""
 	fcr.currentRuleSet = rules.New()
+	fcr.stop = make(chan struct{})
 	return fcr
 }
 
 // Open try to build query rules from local file and push the rules to vttablet
 func (fcr *FileCustomRule) Open(qsc tabletserver.Controller, rulePath string) error {
+	fcr.mu.Lock()
+	defer fcr.mu.Unlock()
+	return fcr.open(qsc, rulePath)
+}
+
+func (fcr *FileCustomRule) open(qsc tabletserver.Controller, rulePath string) error {
 	fcr.path = rulePath
 	if fcr.path == "" {
 		// Don't go further if path is empty
 		return nil
 	}
+	info, err := os.Stat(fcr.path)
+	if err != nil {
+		log.Warningf("Error reading file %v: %v", fcr.path, err)
+		return err
+	}
 	data, err := ioutil.ReadFile(fcr.path)
 	if err != nil {
 		log.Warningf("Error reading file %v: %v", fcr.path, err)
@@ -74,14 +98,59 @@ func (fcr *FileCustomRule) Open(qsc tabletserver.Controller, rulePath string) er
 	}
 	fcr.currentRuleSetTimestamp = time.Now().Unix()
 	fcr.currentRuleSet = qrs.Copy()
+	fcr.modTime = info.ModTime()
 	// Push query rules to vttablet
 	qsc.SetQueryRules(FileCustomRuleSource, qrs.Copy())
 	log.Infof("Custom rule loaded from file: %s", fcr.path)
 	return nil
 }
 
+// Reload reads the query rules from the local file again if it was
+// modified since it was last read, and pushes them to vttablet.
+// If the new rules can't be built, the current ones stay in place.
+func (fcr *FileCustomRule) Reload(qsc tabletserver.Controller) error {
+	fcr.mu.Lock()
+	defer fcr.mu.Unlock()
+	if fcr.path == "" {
+		return nil
+	}
+	info, err := os.Stat(fcr.path)
+	if err != nil {
+		log.Warningf("Error reading file %v: %v", fcr.path, err)
+		return err
+	}
+	if info.ModTime().Equal(fcr.modTime) {
+		return nil
+	}
+	return fcr.open(qsc, fcr.path)
+}
+
+// watch reloads the query rules from the local file every interval,
+// until stopWatching is called.
+func (fcr *FileCustomRule) watch(qsc tabletserver.Controller, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := fcr.Reload(qsc); err != nil {
+				log.Errorf("Cannot reload the file based custom rules, keeping the current ones: %v", err)
+			}
+		case <-fcr.stop:
+			return
+		}
+	}
+}
+
+// stopWatching stops the watch of the local file.
+func (fcr *FileCustomRule) stopWatching() {
+	fcr.stopOnce.Do(func() { close(fcr.stop) })
+}
+
 // GetRules returns query rules built from local file
 func (fcr *FileCustomRule) GetRules() (qrs *rules.Rules, version int64, err error) {
+	fcr.mu.Lock()
+	defer fcr.mu.Unlock()
 	return fcr.currentRuleSet.Copy(), fcr.currentRuleSetTimestamp, nil
 }
 
@@ -90,6 +159,10 @@ func ActivateFileCustomRules(qsc tabletserver.Controller) {
 	if *fileRulePath != "" {
 		qsc.RegisterQueryRuleSource(FileCustomRuleSource)
 		fileCustomRule.Open(qsc, *fileRulePath)
+		if *fileRuleReloadInterval > 0 {
+			go fileCustomRule.watch(qsc, *fileRuleReloadInterval)
+			servenv.OnTerm(fileCustomRule.stopWatching)
+		}
 	}
 }
 
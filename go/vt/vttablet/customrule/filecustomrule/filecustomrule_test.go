@@ -21,6 +21,7 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
@@ -38,6 +39,16 @@ var customRule1 = `[
 				}
 			]`
 
+var customRule2 = `[
+				{
+					"Name": "r2",
+					"Description": "limit concurrency of selects on t",
+					"TableNames": ["t"],
+					"Action": "CONCURRENCY",
+					"MaxConcurrency": 10
+				}
+			]`
+
 func TestFileCustomRule(t *testing.T) {
 	tqsc := tabletservermock.NewController()
 
@@ -65,3 +76,97 @@ func TestFileCustomRule(t *testing.T) {
 		t.Fatalf("Expect custom rule r1 to be found, but got nothing, qrs=%v", qrs)
 	}
 }
+
+func TestFileCustomRuleReload(t *testing.T) {
+	tqsc := tabletservermock.NewController()
+
+	rulepath := path.Join(os.TempDir(), ".customrule_reload.json")
+	defer os.Remove(rulepath)
+	if err := ioutil.WriteFile(rulepath, []byte(customRule1), os.FileMode(0644)); err != nil {
+		t.Fatalf("Cannot write r1 to rule file %s, err=%v", rulepath, err)
+	}
+
+	fcr := NewFileCustomRule()
+	if err := fcr.Open(tqsc, rulepath); err != nil {
+		t.Fatalf("Cannot open file custom rule service, err=%v", err)
+	}
+
+	// An unmodified file isn't read again.
+	if err := fcr.Reload(tqsc); err != nil {
+		t.Fatalf("Reload returns error: %v", err)
+	}
+
+	if err := ioutil.WriteFile(rulepath, []byte(customRule2), os.FileMode(0644)); err != nil {
+		t.Fatalf("Cannot write r2 to rule file %s, err=%v", rulepath, err)
+	}
+	modTime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(rulepath, modTime, modTime); err != nil {
+		t.Fatalf("Cannot change the modification time of %s, err=%v", rulepath, err)
+	}
+	if err := fcr.Reload(tqsc); err != nil {
+		t.Fatalf("Reload returns error: %v", err)
+	}
+	qrs, _, err := fcr.GetRules()
+	if err != nil {
+		t.Fatalf("GetRules returns error: %v", err)
+	}
+	if qr := qrs.Find("r1"); qr != nil {
+		t.Fatalf("Expect custom rule r1 to be replaced, but got %v", qr)
+	}
+	qr := qrs.Find("r2")
+	if qr == nil {
+		t.Fatalf("Expect custom rule r2 to be found, but got nothing, qrs=%v", qrs)
+	}
+	if qr.Action() != rules.QRConcurrency {
+		t.Fatalf("Expect custom rule r2 to have action CONCURRENCY, got %v", qr.Action())
+	}
+}
+
+func TestFileCustomRuleWatch(t *testing.T) {
+	tqsc := tabletservermock.NewController()
+
+	rulepath := path.Join(os.TempDir(), ".customrule_watch.json")
+	defer os.Remove(rulepath)
+	if err := ioutil.WriteFile(rulepath, []byte(customRule1), os.FileMode(0644)); err != nil {
+		t.Fatalf("Cannot write r1 to rule file %s, err=%v", rulepath, err)
+	}
+
+	fcr := NewFileCustomRule()
+	if err := fcr.Open(tqsc, rulepath); err != nil {
+		t.Fatalf("Cannot open file custom rule service, err=%v", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		fcr.watch(tqsc, time.Millisecond)
+		close(done)
+	}()
+
+	if err := ioutil.WriteFile(rulepath, []byte(customRule2), os.FileMode(0644)); err != nil {
+		t.Fatalf("Cannot write r2 to rule file %s, err=%v", rulepath, err)
+	}
+	modTime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(rulepath, modTime, modTime); err != nil {
+		t.Fatalf("Cannot change the modification time of %s, err=%v", rulepath, err)
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		qrs, _, err := fcr.GetRules()
+		if err != nil {
+			t.Fatalf("GetRules returns error: %v", err)
+		}
+		if qrs.Find("r2") != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expect custom rule r2 to be loaded by the watch, qrs=%v", qrs)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	fcr.stopWatching()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("watch didn't stop")
+	}
+}
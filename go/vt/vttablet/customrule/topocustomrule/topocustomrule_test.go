@@ -49,6 +49,26 @@ var customRule2 = `
   }
 ]`
 
+var customRule3 = `
+[
+  {
+    "Name": "r3",
+    "Description": "rate limit selects on table test",
+    "TableNames" : ["test"],
+    "Plans" : ["Select"],
+    "Action" : "RATE_LIMIT",
+    "MaxRate" : 50
+  },
+  {
+    "Name": "r4",
+    "Description": "delay inserts on table test",
+    "TableNames" : ["test"],
+    "Plans" : ["Insert"],
+    "Action" : "DELAY",
+    "Delay" : "100ms"
+  }
+]`
+
 func waitForValue(t *testing.T, qsc *tabletservermock.Controller, expected *rules.Rules) {
 	start := time.Now()
 	for {
@@ -75,6 +95,10 @@ func TestUpdate(t *testing.T) {
 	if err := custom2.UnmarshalJSON([]byte(customRule2)); err != nil {
 		t.Fatalf("error unmarshaling customRule2: %v", err)
 	}
+	custom3 := rules.New()
+	if err := custom3.UnmarshalJSON([]byte(customRule3)); err != nil {
+		t.Fatalf("error unmarshaling customRule3: %v", err)
+	}
 
 	cell := "cell1"
 	filePath := "/keyspaces/ks1/configs/CustomRules"
@@ -106,4 +130,10 @@ func TestUpdate(t *testing.T) {
 		t.Fatalf("conn.Update failed: %v", err)
 	}
 	waitForValue(t, qsc, custom2)
+
+	// update the value with throttling actions, wait until we get it.
+	if _, err := conn.Update(ctx, filePath, []byte(customRule3), nil); err != nil {
+		t.Fatalf("conn.Update failed: %v", err)
+	}
+	waitForValue(t, qsc, custom3)
 }
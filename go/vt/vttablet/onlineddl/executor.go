@@ -40,6 +40,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/schema"
@@ -50,12 +51,15 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/shlex"
 )
 
@@ -101,6 +105,7 @@ var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
 var migrationNextCheckInterval = 5 * time.Second
+var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long to keep the original table of a completed 'online' migration, which the migration can be reverted to")
 
 const (
 	maxPasswordLength             = 32 // MySQL's *replication* password may not exceed 32 characters
@@ -109,8 +114,15 @@ const (
 	progressPctFull       float64 = 100.0
 	gcHoldHours                   = 72
 	databasePoolSize              = 3
+	// vreplicationCutOverThreshold is how long the cut-over of a vreplication migration
+	// waits for the stream to catch up, both before and after stopping writes.
+	vreplicationCutOverThreshold = 5 * time.Second
 )
 
+// vreplicationCutOverCheckInterval is how often the cut-over of a vreplication migration checks
+// whether its RENAME waits for the lock of the migrated table.
+var vreplicationCutOverCheckInterval = 10 * time.Millisecond
+
 var (
 	migrationLogFileName = "migration.log"
 	onlineDDLUser        = "vt-online-ddl-internal"
@@ -826,6 +838,749 @@ export MYSQL_PWD
 	return nil
 }
 
+// ExecuteWithVReplication creates the shadow table of a migration, and a tablet local vreplication
+// stream which copies the rows of the migrated table into the shadow table and then applies the
+// ongoing changes. The cut-over happens later on, in reviewRunningMigrations, once the copy is done.
+func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	if err := e.validateVReplMigrationStart(ctx); err != nil {
+		return err
+	}
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	vreplTableName := fmt.Sprintf("_%s_%s_vrepl", onlineDDL.UUID, ReadableTimestamp())
+	if err := e.updateArtifacts(ctx, onlineDDL.UUID, vreplTableName); err != nil {
+		return err
+	}
+	{
+		parsed := sqlparser.BuildParsedQuery(sqlCreateTableLike, vreplTableName, onlineDDL.Table)
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			return err
+		}
+	}
+	{
+		// Temporary hack (2020-08-11), see ExecuteWithGhost
+		_, _, alterOptions := schema.ParseAlterTableOptions(onlineDDL.SQL)
+		parsed := sqlparser.BuildParsedQuery(sqlAlterTable, vreplTableName, alterOptions)
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			return err
+		}
+	}
+
+	v := NewVRepl(onlineDDL.UUID, e.keyspace, e.shard, e.dbName, onlineDDL.Table, vreplTableName)
+	return e.startVReplMigration(ctx, onlineDDL, v)
+}
+
+// ExecuteRevert reverts a completed "online" migration. The migration kept the original table, and
+// the position of its cut-over. A tablet local vreplication stream applies the changes made to the
+// migrated table since that position onto the original table, and the cut-over of the revert swaps
+// the original table back in place, like the cut-over of any vreplication migration. The revert is
+// itself an "online" migration, which can be reverted in turn.
+func (e *Executor) ExecuteRevert(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	if err := e.validateVReplMigrationStart(ctx); err != nil {
+		return err
+	}
+	revertUUID, err := onlineDDL.GetRevertUUID()
+	if err != nil {
+		return err
+	}
+	info, err := e.readRevertInfo(ctx, revertUUID)
+	if err != nil {
+		return err
+	}
+	if err := e.validateRevert(ctx, info); err != nil {
+		return err
+	}
+
+	onlineDDL.Table = info.table
+	if err := e.updateMigrationTable(ctx, onlineDDL.UUID, onlineDDL.Table); err != nil {
+		return err
+	}
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The original table becomes the shadow table of the revert, so that the revert owns it
+	// from now on. It is renamed back if the revert fails to start.
+	vreplTableName := fmt.Sprintf("_%s_%s_vrepl", onlineDDL.UUID, ReadableTimestamp())
+	if err := e.updateArtifacts(ctx, onlineDDL.UUID, vreplTableName); err != nil {
+		return err
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlRenameTable, info.vreplTableName, vreplTableName)
+	if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+		return err
+	}
+
+	v := NewVRepl(onlineDDL.UUID, e.keyspace, e.shard, e.dbName, onlineDDL.Table, vreplTableName)
+	v.pos = info.cutOverPos
+	if err := e.startVReplMigration(ctx, onlineDDL, v); err != nil {
+		parsed := sqlparser.BuildParsedQuery(sqlRenameTable, vreplTableName, info.vreplTableName)
+		if _, rerr := conn.ExecuteFetch(parsed.Query, 0, false); rerr != nil {
+			log.Errorf("Executor.ExecuteRevert: could not rename %s back to %s: %v", vreplTableName, info.vreplTableName, rerr)
+		}
+		return err
+	}
+	return nil
+}
+
+// validateVReplMigrationStart checks a vreplication migration can start on this tablet
+func (e *Executor) validateVReplMigrationStart(ctx context.Context) error {
+	if atomic.LoadInt64(&e.migrationRunning) > 0 {
+		return ErrExecutorMigrationAlreadyRunning
+	}
+
+	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+		return ErrExecutorNotWritableTablet
+	}
+	_, _, readOnly, err := e.readMySQLVariables(ctx)
+	if err != nil {
+		log.Errorf("Error before running vreplication migration: %+v", err)
+		return err
+	}
+	if readOnly {
+		err := fmt.Errorf("Error before running vreplication migration: MySQL server is read_only")
+		log.Errorf(err.Error())
+		return err
+	}
+	return nil
+}
+
+// startVReplMigration analyzes the source and shadow tables of a vreplication migration, and creates
+// its stream.
+func (e *Executor) startVReplMigration(ctx context.Context, onlineDDL *schema.OnlineDDL, v *VRepl) error {
+	sourceColumns, err := e.readTableColumns(ctx, v.sourceTable)
+	if err != nil {
+		return err
+	}
+	targetColumns, err := e.readTableColumns(ctx, v.targetTable)
+	if err != nil {
+		return err
+	}
+	sourcePKColumns, err := e.readTablePrimaryKeyColumns(ctx, v.sourceTable)
+	if err != nil {
+		return err
+	}
+	targetPKColumns, err := e.readTablePrimaryKeyColumns(ctx, v.targetTable)
+	if err != nil {
+		return err
+	}
+	if err := v.analyze(sourceColumns, targetColumns, sourcePKColumns, targetPKColumns); err != nil {
+		return err
+	}
+
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	if _, err := e.vreplicationExec(ctx, tablet.Tablet, v.generateInsertStatement()); err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(&e.migrationRunning, 1)
+	e.lastMigrationUUID = onlineDDL.UUID
+	startedMigrations.Add(1)
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted)
+	return nil
+}
+
+// revertInfo is what a REVERT needs to know about the migration it reverts
+type revertInfo struct {
+	uuid           string
+	id             int64
+	table          string
+	strategy       schema.DDLStrategy
+	status         schema.OnlineDDLStatus
+	artifacts      []string
+	cutOverPos     string
+	vreplTableName string
+}
+
+// readRevertInfo reads the migration a REVERT reverts
+func (e *Executor) readRevertInfo(ctx context.Context, uuid string) (*revertInfo, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectRevertInfo, "_vt", ":migration_uuid")
+	bindVars := map[string]*querypb.BindVariable{
+		"migration_uuid": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "cannot revert migration %s: migration not found", uuid)
+	}
+	info := &revertInfo{
+		uuid:       uuid,
+		id:         row.AsInt64("id", 0),
+		table:      row.AsString("mysql_table", ""),
+		strategy:   schema.DDLStrategy(row.AsString("strategy", "")),
+		status:     schema.OnlineDDLStatus(row.AsString("migration_status", "")),
+		artifacts:  textutil.SplitDelimitedList(row.AsString("artifacts", "")),
+		cutOverPos: row.AsString("cut_over_pos", ""),
+	}
+	info.vreplTableName = revertedVReplTableName(uuid, info.artifacts)
+	return info, nil
+}
+
+// validateRevert checks the migration can be reverted: it is a completed "online" migration, which
+// kept its original table, and it is the last migration which completed on its table.
+func (e *Executor) validateRevert(ctx context.Context, info *revertInfo) error {
+	if info.strategy != schema.DDLStrategyOnline {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot revert migration %s: only migrations of the %s strategy can be reverted", info.uuid, schema.DDLStrategyOnline)
+	}
+	if info.status != schema.OnlineDDLStatusComplete {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot revert migration %s: migration is %s", info.uuid, info.status)
+	}
+	if info.cutOverPos == "" || info.vreplTableName == "" {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot revert migration %s: migration did not keep its original table", info.uuid)
+	}
+	exists, err := e.tableExists(ctx, info.vreplTableName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot revert migration %s: original table %s was collected", info.uuid, info.vreplTableName)
+	}
+
+	parsed := sqlparser.BuildParsedQuery(sqlSelectCompletedMigrationsAfter, "_vt", ":mysql_table", ":id")
+	bindVars := map[string]*querypb.BindVariable{
+		"mysql_table": sqltypes.StringBindVariable(info.table),
+		"id":          sqltypes.Int64BindVariable(info.id),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return err
+	}
+	if row := r.Named().Row(); row != nil {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot revert migration %s: migration %s completed on table %s since", info.uuid, row.AsString("migration_uuid", ""), info.table)
+	}
+	return nil
+}
+
+// restoreRevertedTable renames the original table back to the shadow table of the reverted migration,
+// when a REVERT fails. The reverted migration can then be reverted again.
+func (e *Executor) restoreRevertedTable(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	revertUUID, err := onlineDDL.GetRevertUUID()
+	if err != nil {
+		return err
+	}
+	s, err := e.readVReplStream(ctx, onlineDDL.UUID)
+	if err != nil {
+		return err
+	}
+	info, err := e.readRevertInfo(ctx, revertUUID)
+	if err != nil {
+		return err
+	}
+	if info.vreplTableName == "" {
+		return nil
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlRenameTable, s.vreplTableName(), info.vreplTableName)
+	_, err = e.execQuery(ctx, parsed.Query)
+	return err
+}
+
+// vreplStream is the state of the vreplication stream of a migration, as read from _vt.vreplication
+type vreplStream struct {
+	id       int64
+	workflow string
+	bls      *binlogdatapb.BinlogSource
+	pos      string
+	state    string
+	message  string
+}
+
+// vreplTableName returns the name of the shadow table the stream writes to
+func (s *vreplStream) vreplTableName() string {
+	return s.bls.Filter.Rules[0].Match
+}
+
+// vreplicationExec runs a statement on the VReplication engine of this tablet.
+func (e *Executor) vreplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	tmClient := tmclient.NewTabletManagerClient()
+	defer tmClient.Close()
+
+	return tmClient.VReplicationExec(ctx, tablet, query)
+}
+
+// readVReplStream reads the vreplication stream of a migration
+func (e *Executor) readVReplStream(ctx context.Context, uuid string) (*vreplStream, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlReadVReplStream, ":db_name", ":workflow")
+	bindVars := map[string]*querypb.BindVariable{
+		"db_name":  sqltypes.StringBindVariable(e.dbName),
+		"workflow": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return nil, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "vreplication stream not found for migration %s", uuid)
+	}
+	s := &vreplStream{
+		id:       row.AsInt64("id", 0),
+		workflow: row.AsString("workflow", ""),
+		bls:      &binlogdatapb.BinlogSource{},
+		pos:      row.AsString("pos", ""),
+		state:    row.AsString("state", ""),
+		message:  row.AsString("message", ""),
+	}
+	if err := proto.UnmarshalText(row.AsString("source", ""), s.bls); err != nil {
+		return nil, err
+	}
+	if len(s.bls.GetFilter().GetRules()) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected source for vreplication stream of migration %s: %v", uuid, s.bls)
+	}
+	return s, nil
+}
+
+// isVReplMigrationReadyToCutOver returns true once the stream copied all the rows and applies the changes.
+func (e *Executor) isVReplMigrationReadyToCutOver(ctx context.Context, s *vreplStream) (bool, error) {
+	if s.state != binlogplayer.BlpRunning {
+		return false, nil
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlReadCountCopyState, ":vrepl_id")
+	bindVars := map[string]*querypb.BindVariable{
+		"vrepl_id": sqltypes.Int64BindVariable(s.id),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return false, err
+	}
+	r, err := e.execQuery(ctx, bound)
+	if err != nil {
+		return false, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return false, nil
+	}
+	return row.AsInt64("cnt", 0) == 0, nil
+}
+
+// waitForPos waits, for a limited time, until the stream applied all the changes up to the given position.
+func (e *Executor) waitForPos(ctx context.Context, tmClient tmclient.TabletManagerClient, tablet *topodatapb.Tablet, s *vreplStream, pos mysql.Position) error {
+	ctx, cancel := context.WithTimeout(ctx, vreplicationCutOverThreshold)
+	defer cancel()
+	return tmClient.VReplicationWaitForPos(ctx, tablet, int(s.id), mysql.EncodePosition(pos))
+}
+
+// cutOverVReplMigration swaps the shadow table in place of the migrated table, see vreplCutOver.
+// The two tables trade names in one RENAME, through a table-GC HOLD name which is only used within
+// that RENAME: the original table takes the name of the shadow table, and is kept for
+// -retain_online_ddl_tables before it is collected as an artifact. The position of the swap is recorded: a REVERT applies the
+// changes made since that position onto the original table.
+func (e *Executor) cutOverVReplMigration(ctx context.Context, onlineDDL *schema.OnlineDDL, s *vreplStream) error {
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	tmClient := tmclient.NewTabletManagerClient()
+	defer tmClient.Close()
+
+	lockConn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer lockConn.Close()
+	renameConn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer renameConn.Close()
+
+	_, stowawayTableName, err := schema.GenerateRenameStatementWithUUID(onlineDDL.Table, schema.HoldTableGCState, onlineDDL.GetGCUUID(), time.Now().UTC().Add(gcHoldHours*time.Hour))
+	if err != nil {
+		return err
+	}
+	c := &vreplCutOver{
+		table:         onlineDDL.Table,
+		vreplTable:    s.vreplTableName(),
+		stowawayTable: stowawayTableName,
+		lockConn:      lockConn,
+		renameConn:    renameConn,
+		waitForPos: func(ctx context.Context, pos mysql.Position) error {
+			return e.waitForPos(ctx, tmClient, tablet.Tablet, s, pos)
+		},
+		stopStream: func(ctx context.Context) error {
+			return e.stopVReplStream(ctx, tablet.Tablet, onlineDDL.UUID, "stopped for online DDL cut-over")
+		},
+		startStream: func(ctx context.Context) error {
+			return e.startVReplStream(ctx, tablet.Tablet, onlineDDL.UUID)
+		},
+		saveCutOverPos: func(ctx context.Context, pos mysql.Position) error {
+			return e.updateMigrationCutOverPos(ctx, onlineDDL.UUID, mysql.EncodePosition(pos))
+		},
+	}
+	return c.run(ctx)
+}
+
+// cutOverConn is the part of a database connection used by a vreplCutOver.
+type cutOverConn interface {
+	ExecuteFetch(query string, maxrows int, wantfields bool) (*sqltypes.Result, error)
+	MasterPosition() (mysql.Position, error)
+	ID() int64
+}
+
+// vreplCutOver swaps the shadow table of a vreplication migration in place of the migrated table,
+// without the migrated table ever going missing:
+//   - lockConn write-locks the migrated table: writes to it now wait, instead of failing.
+//   - the stream applies the last changes, and is stopped.
+//   - renameConn issues the swap, as one atomic RENAME TABLE of the migrated table to stowawayTable,
+//     the shadow table to the migrated table, and stowawayTable to the shadow table. It waits for
+//     the lock of lockConn.
+//   - once the RENAME is seen waiting, lockConn unlocks the table. The RENAME takes precedence
+//     over the writes that were waiting, which then apply to the new table.
+//
+// If anything goes wrong before the swap, the table is unlocked and the stream is started again,
+// so the cut-over can be retried.
+type vreplCutOver struct {
+	table         string
+	vreplTable    string
+	stowawayTable string
+
+	lockConn   cutOverConn
+	renameConn cutOverConn
+
+	waitForPos     func(ctx context.Context, pos mysql.Position) error
+	stopStream     func(ctx context.Context) error
+	startStream    func(ctx context.Context) error
+	saveCutOverPos func(ctx context.Context, pos mysql.Position) error
+}
+
+func (c *vreplCutOver) run(ctx context.Context) (err error) {
+	// Make sure the stream keeps up before locking the table, so that writes only wait briefly.
+	pos, err := c.lockConn.MasterPosition()
+	if err != nil {
+		return err
+	}
+	if err := c.waitForPos(ctx, pos); err != nil {
+		return vterrors.Wrapf(err, "vreplication stream is behind, postponing cut-over")
+	}
+
+	parsed := sqlparser.BuildParsedQuery(sqlLockTableWrite, c.table)
+	if _, err := c.lockConn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+		return err
+	}
+	locked := true
+	unlock := func() error {
+		if !locked {
+			return nil
+		}
+		locked = false
+		_, err := c.lockConn.ExecuteFetch(sqlUnlockTables, 0, false)
+		return err
+	}
+	defer func() {
+		if uerr := unlock(); uerr != nil {
+			log.Errorf("vreplCutOver: could not unlock %s: %v", c.table, uerr)
+		}
+	}()
+
+	// Writes to the migrated table now wait for the lock.
+	pos, err = c.lockConn.MasterPosition()
+	if err != nil {
+		return err
+	}
+	if err := c.waitForPos(ctx, pos); err != nil {
+		return vterrors.Wrapf(err, "vreplication stream did not catch up with the locked table, postponing cut-over")
+	}
+	if err := c.stopStream(ctx); err != nil {
+		return err
+	}
+	// From here on, the stream must be started again if the swap doesn't happen.
+	swapped := false
+	defer func() {
+		if swapped {
+			return
+		}
+		if uerr := unlock(); uerr != nil {
+			log.Errorf("vreplCutOver: could not unlock %s: %v", c.table, uerr)
+		}
+		if serr := c.startStream(ctx); serr != nil {
+			log.Errorf("vreplCutOver: could not start the stream again: %v", serr)
+		}
+	}()
+
+	// There are no writes to either table until the swap, hence the swap immediately follows this position.
+	cutOverPos, err := c.lockConn.MasterPosition()
+	if err != nil {
+		return err
+	}
+	if err := c.saveCutOverPos(ctx, cutOverPos); err != nil {
+		return err
+	}
+
+	renameErr := make(chan error, 1)
+	go func() {
+		parsed := sqlparser.BuildParsedQuery(sqlSwapTables, c.table, c.stowawayTable, c.vreplTable, c.table, c.stowawayTable, c.vreplTable)
+		_, err := c.renameConn.ExecuteFetch(parsed.Query, 0, false)
+		renameErr <- err
+	}()
+	if err := c.waitForRenameToBlock(ctx, renameErr); err != nil {
+		// The RENAME must not run once the table is unlocked: the stream is stopped, and the writes
+		// that were waiting would be lost. The whole connection is killed, in case the RENAME didn't
+		// even reach the server yet.
+		parsed := sqlparser.BuildParsedQuery(sqlKillConnection, fmt.Sprintf("%d", c.renameConn.ID()))
+		if _, kerr := c.lockConn.ExecuteFetch(parsed.Query, 0, false); kerr != nil {
+			log.Errorf("vreplCutOver: could not kill the swap of %s: %v", c.table, kerr)
+		}
+		if rerr := <-renameErr; rerr == nil {
+			// The swap went through anyway.
+			swapped = true
+			return nil
+		}
+		return err
+	}
+	if err := unlock(); err != nil {
+		// Closing the connection releases the lock as well.
+		log.Errorf("vreplCutOver: could not unlock %s: %v", c.table, err)
+	}
+	if err := <-renameErr; err != nil {
+		return vterrors.Wrapf(err, "could not swap %s and %s", c.table, c.vreplTable)
+	}
+	swapped = true
+	return nil
+}
+
+// waitForRenameToBlock waits, for a limited time, until the RENAME of renameConn waits for the lock of lockConn.
+func (c *vreplCutOver) waitForRenameToBlock(ctx context.Context, renameErr chan error) error {
+	ctx, cancel := context.WithTimeout(ctx, vreplicationCutOverThreshold)
+	defer cancel()
+	parsed := sqlparser.BuildParsedQuery(sqlProcessWaitingForMetadataLock, fmt.Sprintf("%d", c.renameConn.ID()))
+	ticker := time.NewTicker(vreplicationCutOverCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-renameErr:
+			// renameErr is buffered: put the result back for the caller.
+			renameErr <- err
+			if err == nil {
+				return fmt.Errorf("swap of %s completed while the table was locked", c.table)
+			}
+			return vterrors.Wrapf(err, "could not swap %s and %s", c.table, c.vreplTable)
+		default:
+		}
+		r, err := c.lockConn.ExecuteFetch(parsed.Query, 1, true)
+		if err != nil {
+			return err
+		}
+		if row := r.Named().Row(); row != nil && row.AsInt64("cnt", 0) > 0 {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return vterrors.Wrapf(ctx.Err(), "swap of %s did not wait for the lock in time, postponing cut-over", c.table)
+		}
+	}
+}
+
+func (e *Executor) stopVReplStream(ctx context.Context, tablet *topodatapb.Tablet, uuid string, message string) error {
+	parsed := sqlparser.BuildParsedQuery(sqlStopVReplStream, ":message", ":db_name", ":workflow")
+	bindVars := map[string]*querypb.BindVariable{
+		"message":  sqltypes.StringBindVariable(message),
+		"db_name":  sqltypes.StringBindVariable(e.dbName),
+		"workflow": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.vreplicationExec(ctx, tablet, bound)
+	return err
+}
+
+func (e *Executor) startVReplStream(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	parsed := sqlparser.BuildParsedQuery(sqlStartVReplStream, ":db_name", ":workflow")
+	bindVars := map[string]*querypb.BindVariable{
+		"db_name":  sqltypes.StringBindVariable(e.dbName),
+		"workflow": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.vreplicationExec(ctx, tablet, bound)
+	return err
+}
+
+func (e *Executor) deleteVReplStream(ctx context.Context, uuid string) error {
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	parsed := sqlparser.BuildParsedQuery(sqlDeleteVReplStream, ":db_name", ":workflow")
+	bindVars := map[string]*querypb.BindVariable{
+		"db_name":  sqltypes.StringBindVariable(e.dbName),
+		"workflow": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.vreplicationExec(ctx, tablet.Tablet, bound)
+	return err
+}
+
+// endVReplMigration deletes the stream of a migration, and marks the migration as complete or failed.
+// A failed REVERT gives the original table back to the reverted migration.
+func (e *Executor) endVReplMigration(ctx context.Context, uuid string, status schema.OnlineDDLStatus) error {
+	if status == schema.OnlineDDLStatusFailed {
+		onlineDDL, err := e.readMigration(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		if onlineDDL.IsRevert() {
+			if err := e.restoreRevertedTable(ctx, onlineDDL); err != nil {
+				log.Errorf("Executor.endVReplMigration: could not restore the original table of reverted migration: %v", err)
+			}
+		}
+	}
+	if err := e.deleteVReplStream(ctx, uuid); err != nil {
+		return err
+	}
+	if uuid == e.lastMigrationUUID {
+		atomic.StoreInt64(&e.migrationRunning, 0)
+	}
+	switch status {
+	case schema.OnlineDDLStatusComplete:
+		successfulMigrations.Add(1)
+	case schema.OnlineDDLStatusFailed:
+		failedMigrations.Add(1)
+	}
+	return e.onSchemaMigrationStatus(ctx, uuid, status, false, progressPctStarted)
+}
+
+// reviewVReplMigration keeps track of a running vreplication migration: it reports its liveness and
+// progress, and cuts over once the stream is ready.
+func (e *Executor) reviewVReplMigration(ctx context.Context, uuid string) error {
+	onlineDDL, err := e.readMigration(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	s, err := e.readVReplStream(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	switch s.state {
+	case binlogplayer.BlpError, binlogplayer.BlpStopped:
+		log.Errorf("Executor.reviewVReplMigration: vreplication stream of migration %s is %s: %s", uuid, s.state, s.message)
+		return e.endVReplMigration(ctx, uuid, schema.OnlineDDLStatusFailed)
+	}
+	if err := e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid); err != nil {
+		return err
+	}
+	if err := e.updateMigrationProgress(ctx, uuid, e.vreplProgress(ctx, onlineDDL.Table, s.vreplTableName())); err != nil {
+		return err
+	}
+
+	ready, err := e.isVReplMigrationReadyToCutOver(ctx, s)
+	if err != nil || !ready {
+		return err
+	}
+	if err := e.cutOverVReplMigration(ctx, onlineDDL, s); err != nil {
+		// The cut-over is retried soon. If the stream could not be started again, the next review fails the migration.
+		e.triggerNextCheckInterval()
+		return err
+	}
+	return e.endVReplMigration(ctx, uuid, schema.OnlineDDLStatusComplete)
+}
+
+// vreplProgress estimates the progress of the copy by the number of rows of both tables.
+// The numbers are estimates by InnoDB.
+func (e *Executor) vreplProgress(ctx context.Context, sourceTableName, targetTableName string) float64 {
+	sourceRows, err := e.readTableRows(ctx, sourceTableName)
+	if err != nil || sourceRows == 0 {
+		return 0
+	}
+	targetRows, err := e.readTableRows(ctx, targetTableName)
+	if err != nil {
+		return 0
+	}
+	return math.Min(progressPctFull*float64(targetRows)/float64(sourceRows), progressPctFull)
+}
+
+// readTableColumns returns the columns of a table, excluding generated columns, which can't be written.
+func (e *Executor) readTableColumns(ctx context.Context, tableName string) (columns []string, err error) {
+	r, err := e.readTableInformation(ctx, sqlSelectTableColumns, tableName)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range r.Named().Rows {
+		if strings.Contains(strings.ToUpper(row.AsString("extra", "")), "GENERATED") {
+			continue
+		}
+		columns = append(columns, row.AsString("column_name", ""))
+	}
+	return columns, nil
+}
+
+// readTablePrimaryKeyColumns returns the PRIMARY KEY columns of a table, in order.
+func (e *Executor) readTablePrimaryKeyColumns(ctx context.Context, tableName string) (columns []string, err error) {
+	r, err := e.readTableInformation(ctx, sqlSelectTablePrimaryKeyColumns, tableName)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range r.Named().Rows {
+		columns = append(columns, row.AsString("column_name", ""))
+	}
+	return columns, nil
+}
+
+// readTableRows returns the estimated number of rows of a table
+func (e *Executor) readTableRows(ctx context.Context, tableName string) (int64, error) {
+	r, err := e.readTableInformation(ctx, sqlSelectTableRows, tableName)
+	if err != nil {
+		return 0, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "table %s not found", tableName)
+	}
+	return row.AsInt64("table_rows", 0), nil
+}
+
+// readTableInformation runs an INFORMATION_SCHEMA query which takes the schema and the table name.
+func (e *Executor) readTableInformation(ctx context.Context, query string, tableName string) (*sqltypes.Result, error) {
+	parsed := sqlparser.BuildParsedQuery(query, ":table_schema", ":table_name")
+	bindVars := map[string]*querypb.BindVariable{
+		"table_schema": sqltypes.StringBindVariable(e.dbName),
+		"table_name":   sqltypes.StringBindVariable(tableName),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	return e.execQuery(ctx, bound)
+}
+
 func (e *Executor) readMigration(ctx context.Context, uuid string) (onlineDDL *schema.OnlineDDL, err error) {
 
 	parsed := sqlparser.BuildParsedQuery(sqlSelectMigration, "_vt", ":migration_uuid")
@@ -870,6 +1625,14 @@ func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.Onl
 		}
 	}
 	switch onlineDDL.Strategy {
+	case schema.DDLStrategyOnline:
+		// Deleting the stream stops it. The shadow table is then collected as an artifact.
+		if onlineDDL.Status == schema.OnlineDDLStatusRunning {
+			foundRunning = true
+			if err := e.endVReplMigration(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed); err != nil {
+				return foundRunning, fmt.Errorf("Error cancelling migration, vreplication error: %+v", err)
+			}
+		}
 	case schema.DDLStrategyPTOSC:
 		// see if pt-osc is running (could have been executed by this vttablet or one that crashed in the past)
 		if running, pid, _ := e.isPTOSCMigrationRunning(ctx, onlineDDL.UUID); running {
@@ -1016,6 +1779,14 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 		return err
 	}
 
+	if onlineDDL.IsRevert() {
+		go func() {
+			if err := e.ExecuteRevert(ctx, onlineDDL); err != nil {
+				failMigration(err)
+			}
+		}()
+		return nil
+	}
 	ddlAction, err := onlineDDL.GetAction()
 	if err != nil {
 		return failMigration(err)
@@ -1065,6 +1836,12 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 		}()
 	case sqlparser.AlterDDLAction:
 		switch onlineDDL.Strategy {
+		case schema.DDLStrategyOnline:
+			go func() {
+				if err := e.ExecuteWithVReplication(ctx, onlineDDL); err != nil {
+					failMigration(err)
+				}
+			}()
 		case schema.DDLStrategyGhost:
 			go func() {
 				if err := e.ExecuteWithGhost(ctx, onlineDDL); err != nil {
@@ -1193,24 +1970,35 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	parsed := sqlparser.BuildParsedQuery(sqlSelectRunningMigrations, "_vt", ":strategy")
-	bindVars := map[string]*querypb.BindVariable{
-		"strategy": sqltypes.StringBindVariable(string(schema.DDLStrategyPTOSC)),
-	}
-	bound, err := parsed.GenerateQuery(bindVars, nil)
-	if err != nil {
-		return countRunnning, runningNotByThisProcess, err
-	}
-	r, err := e.execQuery(ctx, bound)
+	parsed := sqlparser.BuildParsedQuery(sqlSelectRunningMigrations, "_vt")
+	r, err := e.execQuery(ctx, parsed.Query)
 	if err != nil {
 		return countRunnning, runningNotByThisProcess, err
 	}
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
-		// Since pt-osc doesn't have a "liveness" plugin entry point, we do it externally:
-		// if the process is alive, we update the `liveness_timestamp` for this migration.
-		if running, _, _ := e.isPTOSCMigrationRunning(ctx, uuid); running {
-			_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
+		switch schema.DDLStrategy(row["strategy"].ToString()) {
+		case schema.DDLStrategyPTOSC:
+			// Since pt-osc doesn't have a "liveness" plugin entry point, we do it externally:
+			// if the process is alive, we update the `liveness_timestamp` for this migration.
+			if running, _, _ := e.isPTOSCMigrationRunning(ctx, uuid); running {
+				_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
+			}
+		case schema.DDLStrategyOnline:
+			// The vreplication stream survives a vttablet restart. If this tablet started the
+			// migration before it restarted, the migration is adopted rather than cancelled.
+			if atomic.LoadInt64(&e.migrationRunning) == 0 && row["tablet"].ToString() == e.TabletAliasString() {
+				atomic.StoreInt64(&e.migrationRunning, 1)
+				e.lastMigrationUUID = uuid
+			}
+			if uuid == e.lastMigrationUUID {
+				if err := e.reviewVReplMigration(ctx, uuid); err != nil {
+					log.Errorf("Executor.reviewRunningMigrations: migration %s: %v", uuid, err)
+				}
+			}
+		default:
+			// gh-ost reports its own liveness, via hooks.
+			continue
 		}
 		countRunnning++
 
@@ -1306,8 +2094,17 @@ func (e *Executor) gcArtifacts(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	parsed := sqlparser.BuildParsedQuery(sqlSelectUncollectedArtifacts, "_vt")
-	r, err := e.execQuery(ctx, parsed.Query)
+	// The original table of a completed "online" migration is kept for a while, so that the
+	// migration can be reverted.
+	parsed := sqlparser.BuildParsedQuery(sqlSelectUncollectedArtifacts, "_vt", ":retain_seconds")
+	bindVars := map[string]*querypb.BindVariable{
+		"retain_seconds": sqltypes.Int64BindVariable(int64(retainOnlineDDLTables.Seconds())),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	r, err := e.execQuery(ctx, bound)
 	if err != nil {
 		return err
 	}
@@ -1447,6 +2244,42 @@ func (e *Executor) updateArtifacts(ctx context.Context, uuid string, artifacts .
 	return err
 }
 
+// updateMigrationTable sets the table of a migration, which a REVERT only learns when it starts
+func (e *Executor) updateMigrationTable(ctx context.Context, uuid string, tableName string) error {
+	parsed := sqlparser.BuildParsedQuery(sqlUpdateMigrationTable, "_vt",
+		":mysql_table",
+		":migration_uuid",
+	)
+	bindVars := map[string]*querypb.BindVariable{
+		"mysql_table":    sqltypes.StringBindVariable(tableName),
+		"migration_uuid": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, bound)
+	return err
+}
+
+// updateMigrationCutOverPos records the position of the cut-over of a vreplication migration
+func (e *Executor) updateMigrationCutOverPos(ctx context.Context, uuid string, pos string) error {
+	parsed := sqlparser.BuildParsedQuery(sqlUpdateMigrationCutOverPos, "_vt",
+		":cut_over_pos",
+		":migration_uuid",
+	)
+	bindVars := map[string]*querypb.BindVariable{
+		"cut_over_pos":   sqltypes.StringBindVariable(pos),
+		"migration_uuid": sqltypes.StringBindVariable(uuid),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, bound)
+	return err
+}
+
 // updateTabletFailure marks a given migration as "tablet_failed"
 func (e *Executor) updateTabletFailure(ctx context.Context, uuid string) error {
 	parsed := sqlparser.BuildParsedQuery(sqlUpdateTabletFailure, "_vt",
@@ -0,0 +1,322 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// cutOverLog records the steps of a cut-over, in order.
+type cutOverLog struct {
+	mu    sync.Mutex
+	steps []string
+}
+
+func (l *cutOverLog) add(step string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.steps = append(l.steps, step)
+}
+
+func (l *cutOverLog) get() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.steps...)
+}
+
+// fakeCutOverDB emulates the lock of the migrated table: a RENAME waits
+// until the table is unlocked, or its connection is killed. If tables
+// is set, it maps the name of each table to its content, and the RENAMEs
+// apply to it.
+type fakeCutOverDB struct {
+	log *cutOverLog
+
+	mu       sync.Mutex
+	tables   map[string]string
+	locked   bool
+	waiting  bool
+	unlocked chan struct{}
+	killed   chan struct{}
+	// renameBlocks is false to emulate a RENAME that never shows up
+	// as waiting for the lock.
+	renameBlocks bool
+}
+
+func newFakeCutOverDB(log *cutOverLog) *fakeCutOverDB {
+	return &fakeCutOverDB{
+		log:          log,
+		unlocked:     make(chan struct{}),
+		killed:       make(chan struct{}),
+		renameBlocks: true,
+	}
+}
+
+type fakeCutOverConn struct {
+	db *fakeCutOverDB
+	id int64
+}
+
+func (c *fakeCutOverConn) ID() int64 {
+	return c.id
+}
+
+func (c *fakeCutOverConn) MasterPosition() (mysql.Position, error) {
+	return mysql.Position{}, nil
+}
+
+func (c *fakeCutOverConn) ExecuteFetch(query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	db := c.db
+	switch {
+	case strings.HasPrefix(query, "LOCK TABLES"):
+		db.mu.Lock()
+		db.locked = true
+		db.mu.Unlock()
+	case query == sqlUnlockTables:
+		db.mu.Lock()
+		if db.locked {
+			db.locked = false
+			close(db.unlocked)
+		}
+		db.mu.Unlock()
+	case strings.HasPrefix(query, "KILL"):
+		db.log.add(fmt.Sprintf("conn %d: %s", c.id, query))
+		close(db.killed)
+		return &sqltypes.Result{}, nil
+	case strings.HasPrefix(query, "RENAME TABLE"):
+		db.mu.Lock()
+		locked := db.locked
+		db.waiting = db.renameBlocks
+		db.mu.Unlock()
+		if locked {
+			select {
+			case <-db.unlocked:
+			case <-db.killed:
+				db.log.add("killed: " + query)
+				return nil, errors.New("connection killed")
+			}
+		}
+		if err := db.rename(query); err != nil {
+			return nil, err
+		}
+	case strings.Contains(query, "information_schema.processlist"):
+		db.mu.Lock()
+		waiting := db.waiting
+		db.mu.Unlock()
+		cnt := "0"
+		if waiting {
+			cnt = "1"
+		}
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("cnt", "int64"), cnt), nil
+	}
+	db.log.add(fmt.Sprintf("conn %d: %s", c.id, query))
+	return &sqltypes.Result{}, nil
+}
+
+var renamePairRegexp = regexp.MustCompile("`([^`]+)` TO `([^`]+)`")
+
+// rename applies a RENAME TABLE to db.tables, atomically: the tables are
+// only renamed if all the renames are valid, one after the other.
+func (db *fakeCutOverDB) rename(query string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if db.tables == nil {
+		return nil
+	}
+	tables := make(map[string]string, len(db.tables))
+	for name, content := range db.tables {
+		tables[name] = content
+	}
+	for _, pair := range renamePairRegexp.FindAllStringSubmatch(query, -1) {
+		from, to := pair[1], pair[2]
+		content, ok := tables[from]
+		if !ok {
+			return fmt.Errorf("table %s doesn't exist", from)
+		}
+		if _, ok := tables[to]; ok {
+			return fmt.Errorf("table %s already exists", to)
+		}
+		delete(tables, from)
+		tables[to] = content
+	}
+	db.tables = tables
+	return nil
+}
+
+func (db *fakeCutOverDB) getTables() map[string]string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.tables
+}
+
+func newTestCutOver(db *fakeCutOverDB, log *cutOverLog) *vreplCutOver {
+	return &vreplCutOver{
+		table:         "t",
+		vreplTable:    "t_vrepl",
+		stowawayTable: "t_hold",
+		lockConn:      &fakeCutOverConn{db: db, id: 1},
+		renameConn:    &fakeCutOverConn{db: db, id: 2},
+		waitForPos: func(ctx context.Context, pos mysql.Position) error {
+			log.add("wait for pos")
+			return nil
+		},
+		stopStream: func(ctx context.Context) error {
+			log.add("stop stream")
+			return nil
+		},
+		startStream: func(ctx context.Context) error {
+			log.add("start stream")
+			return nil
+		},
+		saveCutOverPos: func(ctx context.Context, pos mysql.Position) error {
+			log.add("save cut-over pos")
+			return nil
+		},
+	}
+}
+
+func TestVReplCutOver(t *testing.T) {
+	log := &cutOverLog{}
+	db := newFakeCutOverDB(log)
+	c := newTestCutOver(db, log)
+
+	require.NoError(t, c.run(context.Background()))
+	assert.Equal(t, []string{
+		"wait for pos",
+		"conn 1: LOCK TABLES `t` WRITE",
+		"wait for pos",
+		"stop stream",
+		"save cut-over pos",
+		"conn 1: UNLOCK TABLES",
+		"conn 2: RENAME TABLE `t` TO `t_hold`, `t_vrepl` TO `t`, `t_hold` TO `t_vrepl`",
+	}, log.get())
+}
+
+func TestVReplCutOverBehind(t *testing.T) {
+	log := &cutOverLog{}
+	db := newFakeCutOverDB(log)
+	c := newTestCutOver(db, log)
+	c.waitForPos = func(ctx context.Context, pos mysql.Position) error {
+		log.add("wait for pos")
+		return context.DeadlineExceeded
+	}
+
+	err := c.run(context.Background())
+	assert.EqualError(t, err, "vreplication stream is behind, postponing cut-over: context deadline exceeded")
+	// The table is never locked.
+	assert.Equal(t, []string{"wait for pos"}, log.get())
+}
+
+func TestVReplCutOverCatchUpTimeout(t *testing.T) {
+	log := &cutOverLog{}
+	db := newFakeCutOverDB(log)
+	c := newTestCutOver(db, log)
+	calls := 0
+	c.waitForPos = func(ctx context.Context, pos mysql.Position) error {
+		log.add("wait for pos")
+		calls++
+		if calls == 2 {
+			return context.DeadlineExceeded
+		}
+		return nil
+	}
+
+	err := c.run(context.Background())
+	assert.EqualError(t, err, "vreplication stream did not catch up with the locked table, postponing cut-over: context deadline exceeded")
+	// The table is unlocked, and the stream, which was not stopped, keeps running.
+	assert.Equal(t, []string{
+		"wait for pos",
+		"conn 1: LOCK TABLES `t` WRITE",
+		"wait for pos",
+		"conn 1: UNLOCK TABLES",
+	}, log.get())
+}
+
+func TestVReplCutOverRenameNotWaiting(t *testing.T) {
+	savedInterval := vreplicationCutOverCheckInterval
+	defer func() { vreplicationCutOverCheckInterval = savedInterval }()
+	vreplicationCutOverCheckInterval = time.Millisecond
+
+	log := &cutOverLog{}
+	db := newFakeCutOverDB(log)
+	db.renameBlocks = false
+	c := newTestCutOver(db, log)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := c.run(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "did not wait for the lock in time, postponing cut-over")
+	// The RENAME is killed before the table is unlocked, and the stream is started again.
+	assert.Equal(t, []string{
+		"wait for pos",
+		"conn 1: LOCK TABLES `t` WRITE",
+		"wait for pos",
+		"stop stream",
+		"save cut-over pos",
+		"conn 1: KILL 2",
+		"killed: RENAME TABLE `t` TO `t_hold`, `t_vrepl` TO `t`, `t_hold` TO `t_vrepl`",
+		"conn 1: UNLOCK TABLES",
+		"start stream",
+	}, log.get())
+}
+
+func TestVReplCutOverThenRevert(t *testing.T) {
+	uuid := "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"
+	vreplTable := "_a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a_20201210101010_vrepl"
+	log := &cutOverLog{}
+	db := newFakeCutOverDB(log)
+	db.tables = map[string]string{"t": "original", vreplTable: "altered"}
+	c := newTestCutOver(db, log)
+	c.vreplTable = vreplTable
+	require.NoError(t, c.run(context.Background()))
+
+	// The original table is kept under the name of the shadow table, where
+	// a REVERT looks for it.
+	assert.Equal(t, map[string]string{"t": "altered", vreplTable: "original"}, db.getTables())
+	revertedTable := revertedVReplTableName(uuid, []string{vreplTable})
+	require.Equal(t, vreplTable, revertedTable)
+
+	// The revert makes the original table its shadow table, and cuts over.
+	revertVreplTable := "_b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a_20201210111111_vrepl"
+	parsed := sqlparser.BuildParsedQuery(sqlRenameTable, revertedTable, revertVreplTable)
+	_, err := (&fakeCutOverConn{db: db, id: 3}).ExecuteFetch(parsed.Query, 0, false)
+	require.NoError(t, err)
+	tables := db.getTables()
+	assert.Equal(t, map[string]string{"t": "altered", revertVreplTable: "original"}, tables)
+	// A fresh lock for the cut-over of the revert.
+	db = newFakeCutOverDB(log)
+	db.tables = tables
+	c = newTestCutOver(db, log)
+	c.vreplTable = revertVreplTable
+	require.NoError(t, c.run(context.Background()))
+
+	assert.Equal(t, map[string]string{"t": "original", revertVreplTable: "altered"}, db.getTables())
+}
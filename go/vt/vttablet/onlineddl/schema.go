@@ -58,6 +58,7 @@ const (
 	alterSchemaMigrationsTableProgress           = "ALTER TABLE %s.schema_migrations add column progress float NOT NULL DEFAULT 0"
 	alterSchemaMigrationsTableContext            = "ALTER TABLE %s.schema_migrations add column migration_context varchar(1024) NOT NULL DEFAULT ''"
 	alterSchemaMigrationsTableDDLAction          = "ALTER TABLE %s.schema_migrations add column ddl_action varchar(16) NOT NULL DEFAULT ''"
+	alterSchemaMigrationsTableCutOverPos         = "ALTER TABLE %s.schema_migrations add column cut_over_pos TEXT NOT NULL"
 
 	sqlScheduleSingleMigration = `UPDATE %s.schema_migrations
 		SET
@@ -99,6 +100,16 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlUpdateMigrationTable = `UPDATE %s.schema_migrations
+			SET mysql_table=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationCutOverPos = `UPDATE %s.schema_migrations
+			SET cut_over_pos=%a
+		WHERE
+			migration_uuid=%a
+	`
 	sqlUpdateTabletFailure = `UPDATE %s.schema_migrations
 			SET tablet_failure=1
 		WHERE
@@ -126,11 +137,12 @@ const (
 		AND retries=0
 	`
 	sqlSelectRunningMigrations = `SELECT
-			migration_uuid
+			migration_uuid,
+			strategy,
+			tablet
 		FROM %s.schema_migrations
 		WHERE
 			migration_status='running'
-			AND strategy=%a
 	`
 	sqlSelectCountReadyMigrations = `SELECT
 			count(*) as count_ready
@@ -158,6 +170,11 @@ const (
 		WHERE
 			migration_status IN ('complete', 'failed')
 			AND cleanup_timestamp IS NULL
+			AND (
+				strategy != 'online'
+				OR migration_status != 'complete'
+				OR completed_timestamp < NOW() - INTERVAL %a SECOND
+			)
 	`
 	sqlSelectMigration = `SELECT
 			id,
@@ -182,6 +199,25 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlSelectRevertInfo = `SELECT
+			id,
+			mysql_table,
+			strategy,
+			migration_status,
+			artifacts,
+			cut_over_pos
+		FROM %s.schema_migrations
+		WHERE
+			migration_uuid=%a
+	`
+	sqlSelectCompletedMigrationsAfter = `SELECT
+			migration_uuid
+		FROM %s.schema_migrations
+		WHERE
+			mysql_table=%a
+			AND migration_status='complete'
+			AND id > %a
+	`
 	sqlSelectReadyMigration = `SELECT
 			id,
 			migration_uuid,
@@ -216,8 +252,71 @@ const (
 			AND ACTION_TIMING='AFTER'
 			AND LEFT(TRIGGER_NAME, 7)='pt_osc_'
 		`
-	sqlDropTrigger    = "DROP TRIGGER IF EXISTS `%a`.`%a`"
-	sqlShowTablesLike = "SHOW TABLES LIKE '%a'"
+	sqlSelectTableColumns = `SELECT
+			COLUMN_NAME as column_name,
+			EXTRA as extra
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+		ORDER BY
+			ORDINAL_POSITION
+	`
+	sqlSelectTablePrimaryKeyColumns = `SELECT
+			COLUMN_NAME as column_name
+		FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+			AND CONSTRAINT_NAME='PRIMARY'
+		ORDER BY
+			ORDINAL_POSITION
+	`
+	sqlSelectTableRows = `SELECT
+			TABLE_ROWS as table_rows
+		FROM INFORMATION_SCHEMA.TABLES
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+	`
+	sqlReadVReplStream = `SELECT
+			id,
+			workflow,
+			source,
+			pos,
+			state,
+			message
+		FROM _vt.vreplication
+		WHERE
+			db_name=%a
+			AND workflow=%a
+	`
+	sqlReadCountCopyState = `SELECT
+			count(*) as cnt
+		FROM _vt.copy_state
+		WHERE
+			vrepl_id=%a
+	`
+	sqlStopVReplStream   = "UPDATE _vt.vreplication SET state='Stopped', message=%a WHERE db_name=%a AND workflow=%a"
+	sqlStartVReplStream  = "UPDATE _vt.vreplication SET state='Running', message='' WHERE db_name=%a AND workflow=%a"
+	sqlDeleteVReplStream = "DELETE FROM _vt.vreplication WHERE db_name=%a AND workflow=%a"
+	sqlDropTrigger       = "DROP TRIGGER IF EXISTS `%a`.`%a`"
+	sqlShowTablesLike    = "SHOW TABLES LIKE '%a'"
+	sqlCreateTableLike   = "CREATE TABLE `%a` LIKE `%a`"
+	sqlAlterTable        = "ALTER TABLE `%a` %s"
+	sqlRenameTable       = "RENAME TABLE `%a` TO `%a`"
+	sqlSwapTables        = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
+	sqlLockTableWrite    = "LOCK TABLES `%a` WRITE"
+	sqlUnlockTables      = "UNLOCK TABLES"
+	sqlKillConnection    = "KILL %a"
+
+	sqlProcessWaitingForMetadataLock = `SELECT
+			COUNT(*) as cnt
+		FROM information_schema.processlist
+		WHERE
+			id=%a
+			AND state LIKE 'Waiting for table metadata lock'
+	`
 )
 
 const (
@@ -249,4 +348,5 @@ var applyDDL = []string{
 	fmt.Sprintf(alterSchemaMigrationsTableProgress, "_vt"),
 	fmt.Sprintf(alterSchemaMigrationsTableContext, "_vt"),
 	fmt.Sprintf(alterSchemaMigrationsTableDDLAction, "_vt"),
+	fmt.Sprintf(alterSchemaMigrationsTableCutOverPos, "_vt"),
 }
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// VRepl is an online DDL helper for VReplication based migrations (ddl_strategy="online").
+// The migration copies the rows of the source table into a shadow table, which has
+// the new schema, and then tails the changes made to the source table. Both are
+// done by a tablet local vreplication stream, whose workflow is the migration UUID.
+// A stream which starts at a given position, as the stream of a REVERT does, skips the copy.
+type VRepl struct {
+	workflow    string
+	keyspace    string
+	shard       string
+	dbName      string
+	sourceTable string
+	targetTable string
+	pos         string
+
+	sharedColumns []string
+	filterQuery   string
+	bls           *binlogdatapb.BinlogSource
+}
+
+// NewVRepl creates a VReplication handler for Online DDL
+func NewVRepl(workflow, keyspace, shard, dbName, sourceTable, targetTable string) *VRepl {
+	return &VRepl{
+		workflow:    workflow,
+		keyspace:    keyspace,
+		shard:       shard,
+		dbName:      dbName,
+		sourceTable: sourceTable,
+		targetTable: targetTable,
+	}
+}
+
+// analyze computes the columns to copy, which are the columns both tables have,
+// and validates the primary keys. vreplication identifies the rows of the target
+// table by their primary key, which must therefore be populated from the source table.
+func (v *VRepl) analyze(sourceColumns, targetColumns, sourcePKColumns, targetPKColumns []string) error {
+	if len(sourcePKColumns) == 0 {
+		return fmt.Errorf("Table %s has no PRIMARY KEY, which is required by the %s strategy", v.sourceTable, schema.DDLStrategyOnline)
+	}
+	if len(targetPKColumns) == 0 {
+		return fmt.Errorf("Migration on %s drops the PRIMARY KEY, which is required by the %s strategy", v.sourceTable, schema.DDLStrategyOnline)
+	}
+	v.sharedColumns = sharedColumns(sourceColumns, targetColumns)
+	for _, pkColumn := range targetPKColumns {
+		if !containsColumn(v.sharedColumns, pkColumn) {
+			return fmt.Errorf("PRIMARY KEY column %s of the migrated table is not a column of %s", pkColumn, v.sourceTable)
+		}
+	}
+	v.filterQuery = v.generateFilterQuery()
+	v.bls = &binlogdatapb.BinlogSource{
+		Keyspace: v.keyspace,
+		Shard:    v.shard,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  v.targetTable,
+				Filter: v.filterQuery,
+			}},
+		},
+	}
+	return nil
+}
+
+// generateFilterQuery returns the query vreplication reads the source table with.
+func (v *VRepl) generateFilterQuery() string {
+	var selectExprs sqlparser.SelectExprs
+	for _, column := range v.sharedColumns {
+		selectExprs = append(selectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(column)}})
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %v from %v", selectExprs, sqlparser.NewTableIdent(v.sourceTable))
+	return buf.String()
+}
+
+// generateInsertStatement returns the statement which creates the vreplication stream.
+// The stream reads from the master, which is this very tablet.
+func (v *VRepl) generateInsertStatement() string {
+	ig := vreplication.NewInsertGenerator(binlogplayer.BlpRunning, v.dbName)
+	ig.AddRow(v.workflow, v.bls, v.pos, "", topodatapb.TabletType_MASTER.String())
+	return ig.String()
+}
+
+// revertedVReplTableName returns the shadow table of a vreplication migration among its artifacts.
+// After the cut-over, the shadow table holds the original table.
+func revertedVReplTableName(uuid string, artifacts []string) string {
+	for _, artifact := range artifacts {
+		if strings.HasPrefix(artifact, "_"+uuid+"_") && strings.HasSuffix(artifact, "_vrepl") {
+			return artifact
+		}
+	}
+	return ""
+}
+
+// sharedColumns returns the columns of the source table which the target table also has,
+// in the order of the source table. MySQL column names are case insensitive.
+func sharedColumns(sourceColumns, targetColumns []string) (shared []string) {
+	for _, column := range sourceColumns {
+		if containsColumn(targetColumns, column) {
+			shared = append(shared, column)
+		}
+	}
+	return shared
+}
+
+func containsColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if strings.EqualFold(c, column) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVReplAnalyze(t *testing.T) {
+	uuid := "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"
+	vreplTable := "_a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a_20201210101010_vrepl"
+	v := NewVRepl(uuid, "ks", "0", "vt_ks", "t", vreplTable)
+
+	// Column c is dropped, column d is added, and the case of column B changes.
+	err := v.analyze([]string{"id", "b", "c"}, []string{"id", "B", "d"}, []string{"id"}, []string{"id"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "b"}, v.sharedColumns)
+	assert.Equal(t, "select id, b from t", v.filterQuery)
+	require.Len(t, v.bls.Filter.Rules, 1)
+	assert.Equal(t, vreplTable, v.bls.Filter.Rules[0].Match)
+	assert.Equal(t, "ks", v.bls.Keyspace)
+	assert.Equal(t, "0", v.bls.Shard)
+
+	insert := v.generateInsertStatement()
+	assert.True(t, strings.HasPrefix(insert, "insert into _vt.vreplication("), insert)
+	assert.Contains(t, insert, "'"+uuid+"'")
+	assert.Contains(t, insert, "'MASTER'")
+	assert.Contains(t, insert, "'vt_ks'")
+}
+
+func TestVReplStartPos(t *testing.T) {
+	v := NewVRepl("uuid", "ks", "0", "vt_ks", "t", "t_vrepl")
+	err := v.analyze([]string{"id", "b"}, []string{"id", "b"}, []string{"id"}, []string{"id"})
+	require.NoError(t, err)
+	assert.Contains(t, v.generateInsertStatement(), "'', 9223372036854775807")
+
+	v.pos = "MySQL56/00000000-0000-0000-0000-000000000000:1-5"
+	assert.Contains(t, v.generateInsertStatement(), "'"+v.pos+"', 9223372036854775807")
+}
+
+func TestVReplAnalyzeErrors(t *testing.T) {
+	v := NewVRepl("uuid", "ks", "0", "vt_ks", "t", "t_vrepl")
+
+	err := v.analyze([]string{"id"}, []string{"id"}, nil, []string{"id"})
+	assert.Error(t, err)
+
+	err = v.analyze([]string{"id"}, []string{"id"}, []string{"id"}, nil)
+	assert.Error(t, err)
+
+	// The new PRIMARY KEY column can't be populated from the source table.
+	err = v.analyze([]string{"id", "b"}, []string{"id", "b", "c"}, []string{"id"}, []string{"id", "c"})
+	assert.Error(t, err)
+}
+
+func TestVReplFilterQueryEscaping(t *testing.T) {
+	v := NewVRepl("uuid", "ks", "0", "vt_ks", "order", "t_vrepl")
+	err := v.analyze([]string{"id", "select"}, []string{"id", "select"}, []string{"id"}, []string{"id"})
+	require.NoError(t, err)
+	assert.Equal(t, "select id, `select` from `order`", v.filterQuery)
+}
+
+func TestRevertedVReplTableName(t *testing.T) {
+	uuid := "a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a"
+	vreplTable := "_a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a_20201210101010_vrepl"
+	assert.Equal(t, vreplTable, revertedVReplTableName(uuid, []string{vreplTable}))
+	assert.Equal(t, vreplTable, revertedVReplTableName(uuid, []string{"_b0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a_20201210101010_vrepl", vreplTable}))
+	assert.Equal(t, "", revertedVReplTableName(uuid, []string{"_a0638f6b_ec7b_11ea_9bf8_000d3a9b8a9a_20201210101010_gho"}))
+	assert.Equal(t, "", revertedVReplTableName(uuid, nil))
+}
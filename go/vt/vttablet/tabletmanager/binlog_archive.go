@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file handles the binlog archive used for point-in-time restores.
+// It is only enabled if binlog_archive_interval is set.
+
+var (
+	binlogArchiveInterval = flag.Duration("binlog_archive_interval", 0, "if greater than 0, the master tablet copies its rotated binlogs to the BackupStorage at this interval, so they can be used by point-in-time restores (-restore_to_time and -restore_to_pos)")
+)
+
+// archiveBinlogsLoop archives the binlogs of the tablet at every
+// binlog_archive_interval, while it is the master. Overlapping
+// archives from previous masters are fine: restores skip the
+// transactions that were already applied.
+func (tm *TabletManager) archiveBinlogsLoop(ctx context.Context) {
+	ticker := time.NewTicker(*binlogArchiveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		tablet := tm.Tablet()
+		if tablet.Type != topodatapb.TabletType_MASTER {
+			continue
+		}
+		count, err := mysqlctl.ArchiveBinlogs(ctx, mysqlctl.ArchiveBinlogsParams{
+			Cnf:         tm.Cnf,
+			Mysqld:      tm.MysqlDaemon,
+			Logger:      logutil.NewConsoleLogger(),
+			Keyspace:    tablet.Keyspace,
+			Shard:       tablet.Shard,
+			TabletAlias: topoproto.TabletAliasString(tablet.Alias),
+		})
+		if err != nil {
+			log.Errorf("Failed to archive binlogs: %v", err)
+			continue
+		}
+		if count > 0 {
+			log.Infof("Archived %v binlogs", count)
+		}
+	}
+}
@@ -63,6 +63,10 @@ var (
 	binlogSslCert        = flag.String("binlog_ssl_cert", "", "PITR restore parameter: Filename containing mTLS client certificate to present to binlog server as authentication.")
 	binlogSslKey         = flag.String("binlog_ssl_key", "", "PITR restore parameter: Filename containing mTLS client private key for use in binlog server authentication.")
 	binlogSslServerName  = flag.String("binlog_ssl_server_name", "", "PITR restore parameter: TLS server name (common name) to verify against for the binlog server we are connecting to (If not set: use the hostname or IP supplied in -binlog_host).")
+
+	// Flags for PITR from the binlog archive
+	restoreToTime = flag.String("restore_to_time", "", "(init restore parameter) if set, restore the most recent backup that finished before this time (RFC 3339 format), then replay the archived binlogs up to it. Replication is not started after such a restore.")
+	restoreToPos  = flag.String("restore_to_pos", "", "(init restore parameter) if set, restore the most recent backup taken before this MySQL 5.6+ GTID position, then replay the archived binlogs up to it. Replication is not started after such a restore.")
 )
 
 // RestoreData is the main entry point for backup restore.
@@ -119,6 +123,20 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
 	}
 
+	if *restoreToTime != "" {
+		params.RestoreToTime, err = time.Parse(time.RFC3339, *restoreToTime)
+		if err != nil {
+			return vterrors.Wrapf(err, "invalid -restore_to_time %v", *restoreToTime)
+		}
+	}
+	if *restoreToPos != "" {
+		params.RestoreToPosition, err = mysql.ParsePosition(mysql.Mysql56FlavorID, *restoreToPos)
+		if err != nil {
+			return vterrors.Wrapf(err, "invalid -restore_to_pos %v", *restoreToPos)
+		}
+	}
+	pointInTime := !params.RestoreToTime.IsZero() || !params.RestoreToPosition.IsZero()
+
 	// Check whether we're going to restore before changing to RESTORE type,
 	// so we keep our MasterTermStartTime (if any) if we aren't actually restoring.
 	ok, err := mysqlctl.ShouldRestore(ctx, params)
@@ -173,8 +191,9 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
-		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
-			// Reconnect to master only for "NORMAL" keyspaces
+		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL && !pointInTime {
+			// Reconnect to master only for "NORMAL" keyspaces, and never
+			// after a point-in-time restore, which would undo it
 			if err := tm.startReplication(context.Background(), pos, originalType); err != nil {
 				return err
 			}
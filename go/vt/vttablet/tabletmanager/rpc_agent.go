@@ -128,7 +128,7 @@ type RPCTM interface {
 
 	// Backup / restore related methods
 
-	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error
+	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster, incremental bool) error
 
 	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
 
@@ -34,8 +34,10 @@ const (
 	backupModeOffline = "offline"
 )
 
-// Backup takes a db backup and sends it to the BackupStorage
-func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
+// Backup takes a db backup and sends it to the BackupStorage.
+// An incremental backup only copies the binlogs since the previous
+// backup, so it doesn't need to drain the tablet.
+func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster, incremental bool) error {
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform backup without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
@@ -62,8 +64,9 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	}
 
 	// prevent concurrent backups, and record stats
+	shouldDrain := engine.ShouldDrainForBackup() && !incremental
 	backupMode := backupModeOnline
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		backupMode = backupModeOffline
 	}
 	if err := tm.beginBackup(backupMode); err != nil {
@@ -72,7 +75,7 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	defer tm.endBackup(backupMode)
 
 	var originalType topodatapb.TabletType
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		if err := tm.lock(ctx); err != nil {
 			return err
 		}
@@ -103,11 +106,12 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 		Shard:        tablet.Shard,
 		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
 		BackupTime:   time.Now(),
+		Incremental:  incremental,
 	}
 
 	returnErr := mysqlctl.Backup(ctx, backupParams)
 
-	if engine.ShouldDrainForBackup() {
+	if shouldDrain {
 		bgCtx := context.Background()
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. It is also possible that the context already timed out during the
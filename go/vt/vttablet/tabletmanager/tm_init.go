@@ -294,6 +294,9 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		go tm.orc.DiscoverLoop(tm)
 	}
 	servenv.OnRun(tm.registerTabletManager)
+	if *binlogArchiveInterval > 0 && tm.Cnf != nil {
+		go tm.archiveBinlogsLoop(tm.BatchCtx)
+	}
 
 	restoring, err := tm.handleRestore(tm.BatchCtx)
 	if err != nil {
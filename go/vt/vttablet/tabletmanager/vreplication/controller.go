@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -62,6 +63,9 @@ type controller struct {
 	source       binlogdatapb.BinlogSource
 	stopPos      string
 	tabletPicker *discovery.TabletPicker
+	// unhealthyTablet is the source tablet that became unhealthy while
+	// streaming. The next pick excludes it. It's only used by run.
+	unhealthyTablet *topodatapb.TabletAlias
 
 	cancel context.CancelFunc
 	done   chan struct{}
@@ -108,7 +112,8 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 	ct.stopPos = params["stop_pos"]
 
 	if ct.source.GetExternalMysql() == "" {
-		// tabletPicker
+		// tabletPicker, which prefers the cell of this tablet
+		localCell := cell
 		if v := params["cell"]; v != "" {
 			cell = v
 		}
@@ -117,7 +122,14 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 		}
 		log.Infof("creating tablet picker for source keyspace/shard %v/%v with cell: %v and tabletTypes: %v", ct.source.Keyspace, ct.source.Shard, cell, tabletTypesStr)
 		cells := strings.Split(cell, ",")
-		tp, err := discovery.NewTabletPicker(ts, cells, ct.source.Keyspace, ct.source.Shard, tabletTypesStr)
+		options := discovery.TabletPickerOptions{LocalCell: localCell}
+		if vre != nil {
+			// The streams of the Engine share the health checks of their sources.
+			if options.HealthCheck, err = vre.sourceHealthCheck(ctx, cells, ct.source.Keyspace, ct.source.Shard); err != nil {
+				return nil, err
+			}
+		}
+		tp, err := discovery.NewTabletPickerWithOptions(ts, cells, ct.source.Keyspace, ct.source.Shard, tabletTypesStr, options)
 		if err != nil {
 			return nil, err
 		}
@@ -163,6 +175,24 @@ func (ct *controller) run(ctx context.Context) {
 	}
 }
 
+// pickSourceTablet picks the tablet to stream from. After the source
+// tablet became unhealthy, the next pick excludes it, for up to
+// vreplication_retry_delay if there is no other tablet to pick.
+func (ct *controller) pickSourceTablet(ctx context.Context) (*topodatapb.Tablet, error) {
+	if ct.unhealthyTablet == nil {
+		return ct.tabletPicker.PickForStreaming(ctx)
+	}
+	unhealthy := ct.unhealthyTablet
+	ct.unhealthyTablet = nil
+	pickCtx, cancel := context.WithTimeout(ctx, *retryDelay)
+	defer cancel()
+	tablet, err := ct.tabletPicker.WithExclude(unhealthy).PickForStreaming(pickCtx)
+	if err != nil && ctx.Err() == nil {
+		return nil, vterrors.Wrapf(err, "no source tablet other than %v, which became unhealthy", topoproto.TabletAliasString(unhealthy))
+	}
+	return tablet, err
+}
+
 func (ct *controller) runBlp(ctx context.Context) (err error) {
 	defer func() {
 		ct.sourceTablet.Set("")
@@ -193,7 +223,7 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 	var tablet *topodatapb.Tablet
 	if ct.source.GetExternalMysql() == "" {
 		log.Infof("trying to find a tablet eligible for vreplication. stream id: %v", ct.id)
-		tablet, err = ct.tabletPicker.PickForStreaming(ctx)
+		tablet, err = ct.pickSourceTablet(ctx)
 		if err != nil {
 			select {
 			case <-ctx.Done():
@@ -206,6 +236,29 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		ct.setMessage(dbClient, fmt.Sprintf("Picked source tablet: %s", tablet.Alias.String()))
 		log.Infof("found a tablet eligible for vreplication. stream id: %v  tablet: %s", ct.id, tablet.Alias.String())
 		ct.sourceTablet.Set(tablet.Alias.String())
+
+		// Stop streaming from the tablet if it becomes unhealthy,
+		// so the next run picks another one.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		unhealthy := make(chan error, 1)
+		go func() {
+			if err := ct.tabletPicker.WatchHealth(ctx, tablet); err != nil {
+				log.Warningf("stream %v: %v, picking another source tablet", ct.id, err)
+				unhealthy <- err
+				cancel()
+			}
+		}()
+		defer func() {
+			select {
+			case healthErr := <-unhealthy:
+				ct.blpStats.ErrorCounts.Add([]string{"Unhealthy Source Tablet"}, 1)
+				ct.unhealthyTablet = tablet.Alias
+				err = healthErr
+			default:
+			}
+		}()
 	}
 	switch {
 	case len(ct.source.Tables) > 0:
@@ -248,7 +301,7 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		}
 		defer vsClient.Close(ctx)
 
-		vr := newVReplicator(ct.id, &ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
+		vr := newVReplicator(ct.id, ct.workflow, &ct.source, vsClient, ct.blpStats, dbClient, ct.mysqld, ct.vre)
 
 		return vr.Replicate(ctx)
 	}
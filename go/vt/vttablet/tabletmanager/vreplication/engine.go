@@ -21,14 +21,17 @@ import (
 	"flag"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/withddl"
 
 	"context"
@@ -108,6 +111,15 @@ type Engine struct {
 
 	journaler map[string]*journalEvent
 	ec        *externalConnector
+
+	// lagThrottler is checked by online DDL streams. It can be nil.
+	lagThrottler *throttle.Throttler
+
+	// healthChecks are the health checks of the tablet pickers of the
+	// streams, by source shard and cells. They are shared by the streams
+	// of the same source, and closed with the Engine.
+	hcMu         sync.Mutex
+	healthChecks map[string]discovery.HealthCheck
 }
 
 type journalEvent struct {
@@ -118,14 +130,16 @@ type journalEvent struct {
 
 // NewEngine creates a new Engine.
 // A nil ts means that the Engine is disabled.
-func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon) *Engine {
+func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, lagThrottler *throttle.Throttler) *Engine {
 	vre := &Engine{
-		controllers: make(map[int]*controller),
-		ts:          ts,
-		cell:        cell,
-		mysqld:      mysqld,
-		journaler:   make(map[string]*journalEvent),
-		ec:          newExternalConnector(config.ExternalConnections),
+		controllers:  make(map[int]*controller),
+		healthChecks: make(map[string]discovery.HealthCheck),
+		ts:           ts,
+		cell:         cell,
+		mysqld:       mysqld,
+		journaler:    make(map[string]*journalEvent),
+		ec:           newExternalConnector(config.ExternalConnections),
+		lagThrottler: lagThrottler,
 	}
 	return vre
 }
@@ -146,6 +160,7 @@ func (vre *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
 func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, dbClientFactory func() binlogplayer.DBClient, dbname string, externalConfig map[string]*dbconfigs.DBConfigs) *Engine {
 	vre := &Engine{
 		controllers:     make(map[int]*controller),
+		healthChecks:    make(map[string]discovery.HealthCheck),
 		ts:              ts,
 		cell:            cell,
 		mysqld:          mysqld,
@@ -277,6 +292,7 @@ func (vre *Engine) Close() {
 
 	// Wait for long-running functions to exit.
 	vre.wg.Wait()
+	vre.closeHealthChecks()
 
 	vre.mysqld.DisableBinlogPlayback()
 	vre.isOpen = false
@@ -285,6 +301,34 @@ func (vre *Engine) Close() {
 	log.Infof("VReplication Engine: closed")
 }
 
+// sourceHealthCheck returns the health check of the tablet pickers of
+// the streams from keyspace/shard in cells.
+func (vre *Engine) sourceHealthCheck(ctx context.Context, cells []string, keyspace, shard string) (discovery.HealthCheck, error) {
+	vre.hcMu.Lock()
+	defer vre.hcMu.Unlock()
+	key := fmt.Sprintf("%s/%s@%s", keyspace, shard, strings.Join(cells, ","))
+	if hc, ok := vre.healthChecks[key]; ok {
+		return hc, nil
+	}
+	hc, err := discovery.NewTabletPickerHealthCheck(ctx, vre.ts, cells, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	vre.healthChecks[key] = hc
+	return hc, nil
+}
+
+func (vre *Engine) closeHealthChecks() {
+	vre.hcMu.Lock()
+	defer vre.hcMu.Unlock()
+	for key, hc := range vre.healthChecks {
+		if err := hc.Close(); err != nil {
+			log.Warningf("VReplication Engine: can't close the health check of %v: %v", key, err)
+		}
+	}
+	vre.healthChecks = make(map[string]discovery.HealthCheck)
+}
+
 // Exec executes the query and the related actions.
 // Example insert statement:
 // insert into _vt.vreplication
@@ -0,0 +1,547 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// errTxnAborted is the error of a transaction that was rolled back because
+// a transaction it had to wait for failed.
+var errTxnAborted = errors.New("transaction aborted because an earlier transaction failed")
+
+// errPrevNotCommitted is returned by waitPrev if the previous transaction was
+// not committed in time.
+var errPrevNotCommitted = errors.New("previous transaction not committed in time")
+
+// parallelApplierPrevWait is how long a worker holds the locks of the rows it
+// changed while waiting for the previous transaction to commit. If that takes
+// longer, the previous transaction may be waiting for these locks: the worker
+// rolls back, and applies its transaction again once the previous one has
+// committed.
+var parallelApplierPrevWait = 1 * time.Second
+
+// parallelApplier applies the row based transactions received by a vplayer
+// on a pool of connections.
+//
+// The vplayer's apply thread buffers each transaction until its COMMIT, and
+// then dispatches it to a worker. Two transactions conflict if they change
+// the same target row, which is known from the values of the primary key
+// columns in their row events. A transaction only starts once the earlier
+// transactions it conflicts with have committed, so independent transactions
+// are applied concurrently.
+//
+// Transactions are still committed in the order in which they were received,
+// each one along with the update of the position in _vt.vreplication: a worker
+// applies its transaction, waits for the previous transaction to commit, and
+// only then updates the position and commits. So the stored position always
+// covers exactly the transactions that were committed, and resuming from it
+// after a crash is as safe as with the serial applier.
+//
+// The primary keys don't reveal all the conflicts, for example on a unique
+// secondary key. So a transaction may hold the locks that the previous one is
+// waiting for, or fail because it ran too early. In both cases, the worker
+// rolls it back and applies it again after the previous transaction has
+// committed: it does so if one of its statements fails before then, or if the
+// previous transaction is not committed within parallelApplierPrevWait.
+//
+// If a transaction fails nevertheless, all the transactions after it are rolled
+// back. If the failure was a lock conflict, the failed transactions are applied
+// again serially. Any other error stops the vplayer.
+//
+// Anything that is not a row based transaction, like DDLs, journals, statement
+// based transactions, or tables without a primary key, is applied serially by
+// the vplayer once all the dispatched transactions have committed.
+type parallelApplier struct {
+	vp      *vplayer
+	ctx     context.Context
+	cancel  context.CancelFunc
+	clients []*vdbClient
+	txns    chan *applierTxn
+	wg      sync.WaitGroup
+	// failed is set by a worker when a transaction fails.
+	failed sync2.AtomicBool
+
+	// The following fields are only accessed by the vplayer's apply thread.
+
+	// pending is the transaction being received.
+	pending     []*binlogdatapb.VEvent
+	pendingRows int
+	// serial is set if the transaction being received is applied serially.
+	serial bool
+	// inflight are the dispatched transactions, in commit order.
+	inflight []*applierTxn
+	// lastTouched is the last inflight transaction that changed a row.
+	lastTouched map[string]*applierTxn
+}
+
+// applierTxn is a transaction applied by a parallelApplier worker.
+type applierTxn struct {
+	pos       mysql.Position
+	timestamp int64
+	rows      []*applierRowEvent
+	keys      []string
+	// deps are the earlier transactions that change the same rows.
+	deps []*applierTxn
+	// prev is the transaction that must be committed before this one.
+	prev *applierTxn
+	// done is closed once the transaction is committed or has failed.
+	done chan struct{}
+	err  error
+}
+
+// applierRowEvent is a row event along with the table plan that was current
+// when it was received.
+type applierRowEvent struct {
+	tplan    *TablePlan
+	rowEvent *binlogdatapb.RowEvent
+}
+
+// newParallelApplier opens the worker connections and starts the workers.
+func newParallelApplier(ctx context.Context, vp *vplayer, workers int) (*parallelApplier, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	pa := &parallelApplier{
+		vp:          vp,
+		ctx:         ctx,
+		cancel:      cancel,
+		txns:        make(chan *applierTxn, workers),
+		lastTouched: make(map[string]*applierTxn),
+	}
+	for i := 0; i < workers; i++ {
+		dbClient := vp.vr.vre.dbClientFactory()
+		if err := dbClient.Connect(); err != nil {
+			pa.close()
+			return nil, vterrors.Wrap(err, "can't connect to database")
+		}
+		pa.clients = append(pa.clients, newVDBClient(dbClient, vp.vr.stats))
+		// The session settings must match the ones of the vreplicator's own connection.
+		for _, query := range []string{"set @@session.time_zone = '+00:00'", "set names binary"} {
+			if _, err := dbClient.ExecuteFetch(query, 10000); err != nil {
+				pa.close()
+				return nil, err
+			}
+		}
+	}
+	for _, client := range pa.clients {
+		pa.wg.Add(1)
+		go pa.work(client)
+	}
+	return pa, nil
+}
+
+// close stops the workers, rolling back any transaction that was not committed,
+// and closes their connections.
+func (pa *parallelApplier) close() {
+	pa.cancel()
+	close(pa.txns)
+	pa.wg.Wait()
+	for _, client := range pa.clients {
+		client.Close()
+	}
+}
+
+// applyEvent is called by the vplayer's apply thread for every event it receives.
+func (pa *parallelApplier) applyEvent(ctx context.Context, event *binlogdatapb.VEvent) error {
+	vp := pa.vp
+	switch event.Type {
+	case binlogdatapb.VEventType_GTID, binlogdatapb.VEventType_BEGIN, binlogdatapb.VEventType_FIELD, binlogdatapb.VEventType_ROW:
+		if pa.serial {
+			return vp.applyEvent(ctx, event, false)
+		}
+		if event.Type == binlogdatapb.VEventType_GTID {
+			// A new position should not be saved until a saveable event occurs.
+			vp.unsavedEvent = nil
+		}
+		pa.pending = append(pa.pending, event)
+		if event.Type == binlogdatapb.VEventType_ROW {
+			pa.pendingRows += len(event.RowEvent.RowChanges)
+		}
+		// Don't buffer transactions that are bigger than the relay log.
+		if pa.pendingRows > *relayLogMaxItems {
+			return pa.applyPendingSerially(ctx)
+		}
+		return nil
+	case binlogdatapb.VEventType_COMMIT:
+		if pa.serial {
+			pa.serial = false
+			return vp.applyEvent(ctx, event, false)
+		}
+		events := append(pa.pending, event)
+		pa.pending = nil
+		pa.pendingRows = 0
+		return pa.dispatch(ctx, events)
+	case binlogdatapb.VEventType_HEARTBEAT:
+		return vp.applyEvent(ctx, event, false)
+	}
+	// Statement based transactions, DDLs, journals and the like.
+	pa.pending = append(pa.pending, event)
+	return pa.applyPendingSerially(ctx)
+}
+
+// applyPendingSerially applies the events received so far serially, once all the
+// dispatched transactions have committed. If they leave a transaction open, the
+// rest of it is applied serially as well.
+func (pa *parallelApplier) applyPendingSerially(ctx context.Context) error {
+	if err := pa.drain(ctx); err != nil {
+		return err
+	}
+	events := pa.pending
+	pa.pending = nil
+	pa.pendingRows = 0
+	for _, event := range events {
+		if err := pa.vp.applyEvent(ctx, event, false); err != nil {
+			return err
+		}
+	}
+	pa.serial = pa.vp.vr.dbClient.InTransaction
+	return nil
+}
+
+// dispatch hands a complete transaction over to the workers.
+func (pa *parallelApplier) dispatch(ctx context.Context, events []*binlogdatapb.VEvent) error {
+	vp := pa.vp
+	txn := &applierTxn{
+		pos:  vp.pos,
+		done: make(chan struct{}),
+	}
+	independent := true
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_GTID:
+			pos, err := mysql.DecodePosition(event.Gtid)
+			if err != nil {
+				return err
+			}
+			txn.pos = pos
+		case binlogdatapb.VEventType_FIELD:
+			tplan, err := vp.replicatorPlan.buildExecutionPlan(event.FieldEvent)
+			if err != nil {
+				return err
+			}
+			vp.tablePlans[event.FieldEvent.TableName] = tplan
+		case binlogdatapb.VEventType_ROW:
+			tplan := vp.tablePlans[event.RowEvent.TableName]
+			if tplan == nil {
+				return fmt.Errorf("unexpected event on table %s", event.RowEvent.TableName)
+			}
+			keys, ok := conflictKeys(tplan, event.RowEvent)
+			if !ok {
+				independent = false
+			}
+			txn.keys = append(txn.keys, keys...)
+			txn.rows = append(txn.rows, &applierRowEvent{tplan: tplan, rowEvent: event.RowEvent})
+		case binlogdatapb.VEventType_COMMIT:
+			txn.timestamp = event.Timestamp
+		}
+	}
+	vp.pos = txn.pos
+
+	if len(txn.rows) == 0 {
+		// We're skipping an empty transaction. Like the serial applier, we may have
+		// to save the position on inactivity.
+		vp.unsavedEvent = events[len(events)-1]
+		return nil
+	}
+	if !independent {
+		// A table without a primary key: any of its rows may be changed by any transaction.
+		if err := pa.drain(ctx); err != nil {
+			return err
+		}
+		return pa.applySerially(ctx, txn)
+	}
+
+	pa.prune()
+	for _, key := range txn.keys {
+		if dep, ok := pa.lastTouched[key]; ok && dep != txn {
+			txn.deps = append(txn.deps, dep)
+		}
+		pa.lastTouched[key] = txn
+	}
+	if len(pa.inflight) > 0 {
+		txn.prev = pa.inflight[len(pa.inflight)-1]
+	}
+	pa.inflight = append(pa.inflight, txn)
+	// The position of the transaction is going to be saved.
+	vp.timeLastSaved = time.Now()
+
+	select {
+	case pa.txns <- txn:
+	case <-ctx.Done():
+		return io.EOF
+	}
+	if pa.failed.Get() {
+		return pa.drain(ctx)
+	}
+	return nil
+}
+
+// prune forgets about the inflight transactions that are done.
+func (pa *parallelApplier) prune() {
+	i := 0
+	for ; i < len(pa.inflight); i++ {
+		txn := pa.inflight[i]
+		select {
+		case <-txn.done:
+		default:
+			pa.inflight = pa.inflight[i:]
+			return
+		}
+		if txn.err != nil {
+			// Failed transactions are recovered by drain.
+			pa.inflight = pa.inflight[i:]
+			return
+		}
+		for _, key := range txn.keys {
+			if pa.lastTouched[key] == txn {
+				delete(pa.lastTouched, key)
+			}
+		}
+	}
+	pa.inflight = nil
+}
+
+// drain waits for all the dispatched transactions to be done. Transactions that
+// failed because of a lock conflict are applied again serially.
+func (pa *parallelApplier) drain(ctx context.Context) error {
+	for _, txn := range pa.inflight {
+		select {
+		case <-txn.done:
+		case <-ctx.Done():
+			return io.EOF
+		}
+	}
+	inflight := pa.inflight
+	pa.inflight = nil
+	pa.lastTouched = make(map[string]*applierTxn)
+	pa.failed.Set(false)
+
+	for i, txn := range inflight {
+		if txn.err == nil {
+			continue
+		}
+		if !isLockConflict(txn.err) {
+			return txn.err
+		}
+		log.Infof("Lock conflict between parallel transactions: %v, applying %d transactions serially", txn.err, len(inflight)-i)
+		for _, txn := range inflight[i:] {
+			if err := pa.applySerially(ctx, txn); err != nil {
+				return err
+			}
+		}
+		break
+	}
+	return nil
+}
+
+// applySerially applies a transaction on the vplayer's own connection.
+func (pa *parallelApplier) applySerially(ctx context.Context, txn *applierTxn) error {
+	vp := pa.vp
+	if err := vp.vr.dbClient.Begin(); err != nil {
+		return err
+	}
+	for _, row := range txn.rows {
+		if err := vp.applyRowChanges(row.tplan, row.rowEvent, func(sql string) (*sqltypes.Result, error) {
+			return vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
+		}); err != nil {
+			return err
+		}
+	}
+	vp.pos = txn.pos
+	if _, err := vp.updatePos(txn.timestamp); err != nil {
+		return err
+	}
+	return vp.vr.dbClient.Commit()
+}
+
+// work applies the transactions it receives on the given connection.
+func (pa *parallelApplier) work(client *vdbClient) {
+	defer pa.wg.Done()
+	for txn := range pa.txns {
+		txn.err = pa.apply(client, txn)
+		if txn.err != nil {
+			client.Rollback()
+			pa.failed.Set(true)
+		}
+		close(txn.done)
+	}
+}
+
+func (pa *parallelApplier) apply(client *vdbClient, txn *applierTxn) error {
+	vp := pa.vp
+	if pa.ctx.Err() != nil {
+		return io.EOF
+	}
+	for _, dep := range txn.deps {
+		if err := pa.wait(dep); err != nil {
+			return err
+		}
+	}
+	prevCommitted := pa.committed(txn.prev)
+	err := pa.applyRows(client, txn)
+	if err == nil {
+		err = pa.waitPrev(txn, parallelApplierPrevWait)
+	}
+	if err != nil {
+		if prevCommitted || err == errTxnAborted || err == io.EOF {
+			return err
+		}
+		// The previous transaction may be waiting for our locks, or our
+		// statements may depend on it: apply them again once it has committed.
+		if err := client.Rollback(); err != nil {
+			return err
+		}
+		if err := pa.wait(txn.prev); err != nil {
+			return err
+		}
+		if err := pa.applyRows(client, txn); err != nil {
+			return err
+		}
+	}
+	update := binlogplayer.GenerateUpdatePos(vp.vr.id, txn.pos, time.Now().Unix(), txn.timestamp)
+	if _, err := client.Execute(update); err != nil {
+		return fmt.Errorf("error %v updating position", err)
+	}
+	if err := client.Commit(); err != nil {
+		return err
+	}
+	vp.vr.stats.SetLastPosition(txn.pos)
+	return nil
+}
+
+// applyRows starts a transaction and applies the row events of txn.
+func (pa *parallelApplier) applyRows(client *vdbClient, txn *applierTxn) error {
+	if err := client.Begin(); err != nil {
+		return err
+	}
+	for _, row := range txn.rows {
+		// Lock conflicts are not retried: the transaction we conflict with may be
+		// waiting for us to commit.
+		if err := pa.vp.applyRowChanges(row.tplan, row.rowEvent, client.Execute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitPrev waits for the previous transaction of txn to be committed, at most
+// for the given time.
+func (pa *parallelApplier) waitPrev(txn *applierTxn, timeout time.Duration) error {
+	if txn.prev == nil {
+		return nil
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return errPrevNotCommitted
+	case <-txn.prev.done:
+	}
+	return pa.wait(txn.prev)
+}
+
+// committed returns true if txn is nil or was committed.
+func (pa *parallelApplier) committed(txn *applierTxn) bool {
+	if txn == nil {
+		return true
+	}
+	select {
+	case <-txn.done:
+		return txn.err == nil
+	default:
+		return false
+	}
+}
+
+// wait waits for an earlier transaction to be committed.
+func (pa *parallelApplier) wait(txn *applierTxn) error {
+	select {
+	case <-txn.done:
+	case <-pa.ctx.Done():
+		return io.EOF
+	}
+	if txn.err != nil {
+		return errTxnAborted
+	}
+	return nil
+}
+
+// conflictKeys returns the keys of the target rows changed by a row event: the
+// target table name and the values of the columns the primary key is computed
+// from, for both the before and after images. Values are lower cased and right
+// trimmed, so that rows that are equal under a case insensitive or PAD SPACE
+// collation get the same key. This can only make independent transactions look
+// conflicting, which is safe. It returns false if the rows can't be identified,
+// which is the case for tables without a primary key.
+func conflictKeys(tplan *TablePlan, rowEvent *binlogdatapb.RowEvent) ([]string, bool) {
+	if len(tplan.PKReferences) == 0 {
+		return nil, false
+	}
+	pkIndexes := make([]int, 0, len(tplan.PKReferences))
+	for _, pkref := range tplan.PKReferences {
+		index := -1
+		for i, field := range tplan.Fields {
+			if field.Name == pkref {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return nil, false
+		}
+		pkIndexes = append(pkIndexes, index)
+	}
+	var keys []string
+	for _, change := range rowEvent.RowChanges {
+		for _, row := range []*querypb.Row{change.Before, change.After} {
+			if row == nil {
+				continue
+			}
+			vals := sqltypes.MakeRowTrusted(tplan.Fields, row)
+			var key strings.Builder
+			key.WriteString(tplan.TargetName)
+			for _, index := range pkIndexes {
+				val := strings.ToLower(strings.TrimRight(vals[index].ToString(), " "))
+				fmt.Fprintf(&key, ":%d:%s", len(val), val)
+			}
+			keys = append(keys, key.String())
+		}
+	}
+	return keys, true
+}
+
+// isLockConflict returns true if the error is a lock wait timeout or a deadlock.
+func isLockConflict(err error) bool {
+	sqlErr, ok := err.(*mysql.SQLError)
+	return ok && (sqlErr.Number() == mysql.ERLockDeadlock || sqlErr.Number() == mysql.ERLockWaitTimeout)
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestConflictKeys(t *testing.T) {
+	fields := []*querypb.Field{{
+		Name: "id",
+		Type: sqltypes.Int64,
+	}, {
+		Name: "name",
+		Type: sqltypes.VarChar,
+	}, {
+		Name: "val",
+		Type: sqltypes.VarBinary,
+	}}
+	row := func(values ...string) *querypb.Row {
+		var vals []sqltypes.Value
+		for i, v := range values {
+			vals = append(vals, sqltypes.MakeTrusted(fields[i].Type, []byte(v)))
+		}
+		return sqltypes.RowToProto3(vals)
+	}
+
+	testcases := []struct {
+		name   string
+		pkrefs []string
+		event  *binlogdatapb.RowEvent
+		keys   []string
+		ok     bool
+	}{{
+		name:   "insert",
+		pkrefs: []string{"id"},
+		event: &binlogdatapb.RowEvent{
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: row("1", "a", "x"),
+			}, {
+				After: row("2", "b", "y"),
+			}},
+		},
+		keys: []string{"t1:1:1", "t1:1:2"},
+		ok:   true,
+	}, {
+		name:   "pk update",
+		pkrefs: []string{"id"},
+		event: &binlogdatapb.RowEvent{
+			RowChanges: []*binlogdatapb.RowChange{{
+				Before: row("1", "a", "x"),
+				After:  row("12", "a", "x"),
+			}},
+		},
+		keys: []string{"t1:1:1", "t1:2:12"},
+		ok:   true,
+	}, {
+		name:   "delete with composite pk",
+		pkrefs: []string{"id", "name"},
+		event: &binlogdatapb.RowEvent{
+			RowChanges: []*binlogdatapb.RowChange{{
+				Before: row("1", "Ab ", "x"),
+			}},
+		},
+		keys: []string{"t1:1:1:2:ab"},
+		ok:   true,
+	}, {
+		name: "no pk",
+		event: &binlogdatapb.RowEvent{
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: row("1", "a", "x"),
+			}},
+		},
+	}, {
+		name:   "pk not in fields",
+		pkrefs: []string{"other"},
+		event: &binlogdatapb.RowEvent{
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: row("1", "a", "x"),
+			}},
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			tplan := &TablePlan{
+				TargetName:   "t1",
+				Fields:       fields,
+				PKReferences: tcase.pkrefs,
+			}
+			keys, ok := conflictKeys(tplan, tcase.event)
+			assert.Equal(t, tcase.ok, ok)
+			assert.Equal(t, tcase.keys, keys)
+		})
+	}
+}
+
+func TestIsLockConflict(t *testing.T) {
+	assert.True(t, isLockConflict(mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSLockDeadlock, "deadlock")))
+	assert.True(t, isLockConflict(mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "lock wait timeout")))
+	assert.False(t, isLockConflict(mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "duplicate entry")))
+	assert.False(t, isLockConflict(errors.New("deadlock")))
+}
+
+// fakeApplierDB emulates the row locks of a table with a unique key on val,
+// which the parallel applier doesn't know about.
+type fakeApplierDB struct {
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+	log   []string
+	// before is called before a statement is executed.
+	before func(query string)
+	// after is called once a statement holds its lock.
+	after func(query string)
+}
+
+var fakeApplierValRe = regexp.MustCompile(`,'(\w+)'\)$`)
+
+func (db *fakeApplierDB) add(entry string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.log = append(db.log, entry)
+}
+
+func (db *fakeApplierDB) getLog() []string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return append([]string(nil), db.log...)
+}
+
+type fakeApplierConn struct {
+	db   *fakeApplierDB
+	held map[string]chan struct{}
+}
+
+func (c *fakeApplierConn) DBName() string { return "db" }
+func (c *fakeApplierConn) Connect() error { return nil }
+func (c *fakeApplierConn) Begin() error   { return nil }
+func (c *fakeApplierConn) Close()         {}
+
+func (c *fakeApplierConn) Commit() error {
+	c.db.add("commit")
+	c.release()
+	return nil
+}
+
+func (c *fakeApplierConn) Rollback() error {
+	c.db.add("rollback")
+	c.release()
+	return nil
+}
+
+func (c *fakeApplierConn) release() {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	for val, ch := range c.held {
+		delete(c.db.locks, val)
+		close(ch)
+	}
+	c.held = make(map[string]chan struct{})
+}
+
+func (c *fakeApplierConn) ExecuteFetch(query string, maxrows int) (*sqltypes.Result, error) {
+	db := c.db
+	switch {
+	case strings.HasPrefix(query, "insert"):
+		if db.before != nil {
+			db.before(query)
+		}
+		val := fakeApplierValRe.FindStringSubmatch(query)[1]
+		for {
+			db.mu.Lock()
+			ch, locked := db.locks[val]
+			if !locked || c.held[val] != nil {
+				if !locked {
+					ch = make(chan struct{})
+					db.locks[val] = ch
+					c.held[val] = ch
+				}
+				db.log = append(db.log, query)
+				db.mu.Unlock()
+				break
+			}
+			db.mu.Unlock()
+			select {
+			case <-ch:
+				continue
+			case <-time.After(5 * time.Second):
+				return nil, mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "lock wait timeout")
+			}
+		}
+		if db.after != nil {
+			db.after(query)
+		}
+	case strings.HasPrefix(query, "update _vt.vreplication"):
+		db.add(query)
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func TestParallelApplierCommitOrder(t *testing.T) {
+	savedWait := parallelApplierPrevWait
+	defer func() { parallelApplierPrevWait = savedWait }()
+	parallelApplierPrevWait = 10 * time.Millisecond
+
+	db := &fakeApplierDB{locks: make(map[string]chan struct{})}
+	vr := &vreplicator{
+		id:    1,
+		stats: binlogplayer.NewStats(),
+		vre: &Engine{
+			dbClientFactory: func() binlogplayer.DBClient {
+				return &fakeApplierConn{db: db, held: make(map[string]chan struct{})}
+			},
+		},
+	}
+	vp := &vplayer{vr: vr}
+
+	rp, err := buildReplicatorPlan(&binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{Match: "t1"}},
+	}, map[string][]*PrimaryKeyInfo{"t1": {{Name: "id"}}}, nil)
+	require.NoError(t, err)
+	fields := []*querypb.Field{{Name: "id", Type: sqltypes.Int64}, {Name: "val", Type: sqltypes.VarBinary}}
+	tplan, err := rp.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t1", Fields: fields})
+	require.NoError(t, err)
+	insert := func(id int64, val string) *applierRowEvent {
+		return &applierRowEvent{
+			tplan: tplan,
+			rowEvent: &binlogdatapb.RowEvent{
+				TableName: "t1",
+				RowChanges: []*binlogdatapb.RowChange{{
+					After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarBinary(val)}),
+				}},
+			},
+		}
+	}
+	pos1, err := mysql.DecodePosition("MySQL56/00000000-0000-0000-0000-000000000001:1-10")
+	require.NoError(t, err)
+	pos2, err := mysql.DecodePosition("MySQL56/00000000-0000-0000-0000-000000000001:1-11")
+	require.NoError(t, err)
+
+	// txn2 takes the lock on 'x' first, and txn1, which commits before it,
+	// waits for that lock.
+	xLocked := make(chan struct{})
+	db.before = func(query string) {
+		if strings.HasSuffix(query, "(1,'a')") {
+			<-xLocked
+		}
+	}
+	var once sync.Once
+	db.after = func(query string) {
+		if strings.HasSuffix(query, "(2,'x')") {
+			once.Do(func() { close(xLocked) })
+		}
+	}
+	txn1 := &applierTxn{
+		pos:  pos1,
+		rows: []*applierRowEvent{insert(1, "a"), insert(3, "x")},
+		done: make(chan struct{}),
+	}
+	txn2 := &applierTxn{
+		pos:  pos2,
+		rows: []*applierRowEvent{insert(2, "x")},
+		prev: txn1,
+		done: make(chan struct{}),
+	}
+
+	pa, err := newParallelApplier(context.Background(), vp, 2)
+	require.NoError(t, err)
+	defer pa.close()
+	pa.txns <- txn1
+	pa.txns <- txn2
+	for _, txn := range []*applierTxn{txn1, txn2} {
+		select {
+		case <-txn.done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("transaction is blocked")
+		}
+	}
+	require.NoError(t, txn1.err)
+	require.NoError(t, txn2.err)
+
+	// txn2 is rolled back, so txn1 can take the lock. Both are committed in
+	// order, each one with its position.
+	var commits []string
+	inserts := 0
+	for _, entry := range db.getLog() {
+		switch {
+		case strings.HasPrefix(entry, "update _vt.vreplication"):
+			pos := "pos1"
+			if strings.Contains(entry, mysql.EncodePosition(pos2)) {
+				pos = "pos2"
+			}
+			commits = append(commits, pos)
+		case entry == "commit", entry == "rollback":
+			commits = append(commits, entry)
+		case strings.HasSuffix(entry, "(2,'x')"):
+			inserts++
+		}
+	}
+	assert.Equal(t, []string{"rollback", "pos1", "commit", "pos2", "commit"}, commits)
+	assert.Equal(t, 2, inserts)
+	assert.Equal(t, pos2, vr.stats.LastPosition())
+}
@@ -59,6 +59,8 @@ type vplayer struct {
 	timeOffsetNs int64
 	// canAcceptStmtEvents is set to true if the current player can accept events in statement mode. Only true for filters that are match all.
 	canAcceptStmtEvents bool
+	// applier is set if row based transactions are applied in parallel.
+	applier *parallelApplier
 
 	phase string
 }
@@ -117,6 +119,16 @@ func (vp *vplayer) play(ctx context.Context) error {
 		}
 	}
 
+	// Transactions can't be applied in parallel if we have to stop at a specific position.
+	if *parallelApplyWorkers > 1 && vp.stopPos.IsZero() && vp.vr.vre != nil {
+		applier, err := newParallelApplier(ctx, vp, *parallelApplyWorkers)
+		if err != nil {
+			return err
+		}
+		defer applier.close()
+		vp.applier = applier
+	}
+
 	return vp.fetchAndApply(ctx)
 }
 
@@ -207,11 +219,18 @@ func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.Row
 	if tplan == nil {
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
+	return vp.applyRowChanges(tplan, rowEvent, func(sql string) (*sqltypes.Result, error) {
+		return vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
+	})
+}
+
+// applyRowChanges applies the changes of a row event using the given executor.
+func (vp *vplayer) applyRowChanges(tplan *TablePlan, rowEvent *binlogdatapb.RowEvent, executor func(string) (*sqltypes.Result, error)) error {
 	for _, change := range rowEvent.RowChanges {
 		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
 			stats := NewVrLogStats("ROWCHANGE")
 			start := time.Now()
-			qr, err := vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
+			qr, err := executor(sql)
 			vp.vr.stats.QueryCount.Add(vp.phase, 1)
 			vp.vr.stats.QueryTimings.Record(vp.phase, start)
 			stats.Send(sql)
@@ -316,6 +335,7 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 	defer vp.vr.stats.SecondsBehindMaster.Set(math.MaxInt64)
 	var sbm int64 = -1
 	for {
+		vp.vr.throttle(ctx)
 		items, err := relay.Fetch()
 		if err != nil {
 			return err
@@ -333,6 +353,12 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 		// In both cases, now > timeLastSaved. If so, the GTID of the last unsavedEvent
 		// must be saved.
 		if time.Since(vp.timeLastSaved) >= idleTimeout && vp.unsavedEvent != nil {
+			// The transactions before the unsaved event must be committed first.
+			if vp.applier != nil {
+				if err := vp.applier.drain(ctx); err != nil {
+					return err
+				}
+			}
 			posReached, err := vp.updatePos(vp.unsavedEvent.Timestamp)
 			if err != nil {
 				return err
@@ -349,6 +375,16 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 					vp.timeOffsetNs = time.Now().UnixNano() - event.CurrentTime
 					sbm = event.CurrentTime/1e9 - event.Timestamp
 				}
+				if vp.applier != nil {
+					if err := vp.applier.applyEvent(ctx, event); err != nil {
+						if err != io.EOF {
+							vp.vr.stats.ErrorCounts.Add([]string{"Apply"}, 1)
+							log.Errorf("Error applying event: %s", err.Error())
+						}
+						return err
+					}
+					continue
+				}
 				mustSave := false
 				switch event.Type {
 				case binlogdatapb.VEventType_COMMIT:
@@ -1809,6 +1809,102 @@ func TestPlayerIdleUpdate(t *testing.T) {
 	}
 }
 
+func TestPlayerParallelApply(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+
+	savedWorkers := *parallelApplyWorkers
+	defer func() { *parallelApplyWorkers = savedWorkers }()
+	*parallelApplyWorkers = 4
+
+	execStatements(t, []string{
+		"create table t1(id int, val varbinary(128), primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+
+	execStatements(t, []string{
+		"insert into t1 values(1, 'aaa')",
+	})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id,val) values (1,'aaa')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	// Independent transactions may be applied in any order, but the
+	// ones that change the same row must be applied in order.
+	var input, dmls []string
+	for i := 2; i <= 10; i++ {
+		input = append(input, fmt.Sprintf("insert into t1 values(%d, 'aaa')", i))
+		dmls = append(dmls, fmt.Sprintf("insert into t1(id,val) values (%d,'aaa')", i))
+		input = append(input, fmt.Sprintf("update t1 set val='%d' where id=1", i))
+		dmls = append(dmls, fmt.Sprintf("update t1 set val='%d' where id=1", i))
+	}
+	execStatements(t, input)
+
+	var got []string
+	for len(got) < 4*len(input) {
+		select {
+		case query := <-globalDBQueries:
+			if heartbeatRe.MatchString(query) {
+				continue
+			}
+			got = append(got, query)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d queries, expecting %d: %v", len(got), 4*len(input), got)
+		}
+	}
+	var gotDMLs, gotUpdates []string
+	commits := 0
+	for _, query := range got {
+		switch {
+		case query == "begin":
+		case query == "commit":
+			commits++
+		case strings.HasPrefix(query, "update _vt.vreplication set pos="):
+		case strings.HasPrefix(query, "update t1"):
+			gotUpdates = append(gotUpdates, query)
+			gotDMLs = append(gotDMLs, query)
+		default:
+			gotDMLs = append(gotDMLs, query)
+		}
+	}
+	require.Equal(t, len(input), commits)
+	require.ElementsMatch(t, dmls, gotDMLs)
+	var wantUpdates []string
+	for _, dml := range dmls {
+		if strings.HasPrefix(dml, "update t1") {
+			wantUpdates = append(wantUpdates, dml)
+		}
+	}
+	require.Equal(t, wantUpdates, gotUpdates)
+
+	want := [][]string{{"1", "10"}}
+	for i := 2; i <= 10; i++ {
+		want = append(want, []string{fmt.Sprintf("%d", i), "aaa"})
+	}
+	expectData(t, "t1", want)
+}
+
 func TestPlayerSplitTransaction(t *testing.T) {
 	defer deleteTablet(addTablet(100))
 	flag.Set("vstream_packet_size", "10")
@@ -19,6 +19,7 @@ package vreplication
 import (
 	"flag"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -33,6 +34,8 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -41,18 +44,27 @@ var (
 	// idleTimeout is set to slightly above 1s, compared to heartbeatTime
 	// set by VStreamer at slightly below 1s. This minimizes conflicts
 	// between the two timeouts.
-	idleTimeout         = 1100 * time.Millisecond
-	dbLockRetryDelay    = 1 * time.Second
-	relayLogMaxSize     = flag.Int("relay_log_max_size", 250000, "Maximum buffer size (in bytes) for VReplication target buffering. If single rows are larger than this, a single row is buffered at a time.")
-	relayLogMaxItems    = flag.Int("relay_log_max_items", 5000, "Maximum number of rows for VReplication target buffering.")
-	copyTimeout         = 1 * time.Hour
-	replicaLagTolerance = 10 * time.Second
+	idleTimeout          = 1100 * time.Millisecond
+	dbLockRetryDelay     = 1 * time.Second
+	relayLogMaxSize      = flag.Int("relay_log_max_size", 250000, "Maximum buffer size (in bytes) for VReplication target buffering. If single rows are larger than this, a single row is buffered at a time.")
+	relayLogMaxItems     = flag.Int("relay_log_max_items", 5000, "Maximum number of rows for VReplication target buffering.")
+	parallelApplyWorkers = flag.Int("vreplication_parallel_apply_workers", 0, "Number of connections used by VReplication to apply independent transactions in parallel while replicating. Transactions that change the same rows are still applied in order. 0 or 1 applies all transactions serially.")
+	copyTimeout          = 1 * time.Hour
+	replicaLagTolerance  = 10 * time.Second
+	// throttleCheckDuration controls both how frequently the lag throttler
+	// is checked, and how long to sleep if it asks us to hold back.
+	throttleCheckDuration = 250 * time.Millisecond
 )
 
+var throttleFlags = &throttle.CheckFlags{
+	LowPriority: true,
+}
+
 // vreplicator provides the core logic to start vreplication streams
 type vreplicator struct {
 	vre      *Engine
 	id       uint32
+	workflow string
 	dbClient *vdbClient
 	// source
 	source          *binlogdatapb.BinlogSource
@@ -64,6 +76,10 @@ type vreplicator struct {
 	pkInfoMap map[string][]*PrimaryKeyInfo
 
 	originalFKCheckSetting int64
+
+	// lastSuccessfulThrottleCheck is the last time the lag throttler
+	// let us through.
+	lastSuccessfulThrottleCheck time.Time
 }
 
 // newVReplicator creates a new vreplicator. The valid fields from the source are:
@@ -86,10 +102,11 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
-func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+func newVReplicator(id uint32, workflow string, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
 	return &vreplicator{
 		vre:             vre,
 		id:              id,
+		workflow:        workflow,
 		source:          source,
 		sourceVStreamer: sourceVStreamer,
 		stats:           stats,
@@ -192,6 +209,27 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 	}
 }
 
+// throttle blocks for as long as the lag throttler asks online DDL streams
+// to hold back. Other streams are not throttled.
+func (vr *vreplicator) throttle(ctx context.Context) {
+	if vr.vre.lagThrottler == nil || !schema.IsOnlineDDLUUID(vr.workflow) {
+		return
+	}
+	appName := fmt.Sprintf("online-ddl:vreplication:%s", vr.workflow)
+	for time.Since(vr.lastSuccessfulThrottleCheck) > throttleCheckDuration {
+		checkResult := vr.vre.lagThrottler.Check(ctx, appName, "", throttleFlags)
+		if checkResult.StatusCode == http.StatusOK {
+			vr.lastSuccessfulThrottleCheck = time.Now()
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(throttleCheckDuration):
+		}
+	}
+}
+
 // PrimaryKeyInfo is used to store charset and collation for primary keys where applicable
 type PrimaryKeyInfo struct {
 	Name       string
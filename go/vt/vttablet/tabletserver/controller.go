@@ -27,6 +27,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	"time"
 
@@ -85,6 +86,9 @@ type Controller interface {
 	// OnlineDDLExecutor the online DDL executor used by this Controller
 	OnlineDDLExecutor() *onlineddl.Executor
 
+	// LagThrottler returns the lag throttler used by this Controller
+	LagThrottler() *throttle.Throttler
+
 	// SchemaEngine returns the SchemaEngine object used by this Controller
 	SchemaEngine() *schema.Engine
 
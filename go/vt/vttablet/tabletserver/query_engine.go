@@ -155,6 +155,7 @@ type QueryEngine struct {
 
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
+	queryTagCounts, queryTagTimes, queryTagErrorCounts        *stats.CountersWithMultiLabels
 
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
@@ -222,6 +223,9 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.queryTimes = env.Exporter().NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 	qe.queryRowCounts = env.Exporter().NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
+	qe.queryTagCounts = env.Exporter().NewCountersWithMultiLabels("QueryTagCounts", "query counts by query rule tag", []string{"Tag", "Plan"})
+	qe.queryTagTimes = env.Exporter().NewCountersWithMultiLabels("QueryTagTimesNs", "query times in ns by query rule tag", []string{"Tag", "Plan"})
+	qe.queryTagErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryTagErrorCounts", "query error counts by query rule tag", []string{"Tag", "Plan"})
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
@@ -430,6 +434,14 @@ func (qe *QueryEngine) AddStats(planName, tableName string, queryCount int64, du
 	qe.queryErrorCounts.Add(keys, errorCount)
 }
 
+// AddTagStats adds the given stats for the queries tagged by a query rule.
+func (qe *QueryEngine) AddTagStats(tag, planName string, queryCount int64, duration time.Duration, errorCount int64) {
+	keys := []string{tag, planName}
+	qe.queryTagCounts.Add(keys, queryCount)
+	qe.queryTagTimes.Add(keys, int64(duration))
+	qe.queryTagErrorCounts.Add(keys, errorCount)
+}
+
 type perQueryStats struct {
 	Query      string
 	Table      string
@@ -57,6 +57,10 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// firedRules are the query rules that fired for the query,
+	// if none of them fails the query.
+	firedRules []*rules.Rule
 }
 
 var sequenceFields = []*querypb.Field{
@@ -74,6 +78,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		duration := time.Since(start)
 		qre.tsv.stats.QueryTimings.Add(planName, duration)
 		qre.recordUserQuery("Execute", int64(duration))
+		qre.recordTagStats(planName, duration, err != nil)
 
 		mysqlTime := qre.logStats.MysqlResponseTime
 		tableName := qre.plan.TableName().String()
@@ -81,11 +86,16 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			tableName = "Join"
 		}
 
-		if reply == nil {
+		if err != nil {
 			qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, 0, 1)
 			qre.plan.AddStats(1, duration, mysqlTime, 0, 1)
 			return
 		}
+		if reply == nil {
+			qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, 0, 0)
+			qre.plan.AddStats(1, duration, mysqlTime, 0, 0)
+			return
+		}
 		qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, int64(reply.RowsAffected), 0)
 		qre.plan.AddStats(1, duration, mysqlTime, int64(reply.RowsAffected), 0)
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
@@ -96,6 +106,11 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	release, err := qre.throttle()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	switch qre.plan.PlanID {
 	case planbuilder.PlanNextval:
@@ -229,17 +244,23 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 }
 
 // Stream performs a streaming query execution.
-func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
+func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) (err error) {
 	qre.logStats.PlanType = qre.plan.PlanID.String()
 
 	defer func(start time.Time) {
 		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
+		qre.recordTagStats(qre.plan.PlanID.String(), time.Since(start), err != nil)
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	release, err := qre.throttle()
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	// if we have a transaction id, let's use the txPool for this query
 	var conn *connpool.DBConn
@@ -311,13 +332,18 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
-	switch action {
-	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
-	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	// All the rules that fire are evaluated: a rule that fails the query
+	// wins over any other rule, and the other actions combine.
+	fired := qre.plan.Rules.GetRules(remoteAddr, username, qre.bindVars)
+	for _, qr := range fired {
+		switch qr.Action() {
+		case rules.QRFail:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
+		case rules.QRFailRetry:
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+		}
 	}
+	qre.firedRules = fired
 
 	// Skip ACL check for queries against the dummy dual table
 	if qre.plan.TableName().String() == "dual" {
@@ -354,6 +380,44 @@ func (qre *QueryExecutor) checkPermissions() error {
 	return nil
 }
 
+// throttle enforces the actions of the query rules that fired, that
+// limit the concurrency or the rate of the query, or delay it.
+// The returned release function must be called once the query is done.
+func (qre *QueryExecutor) throttle() (release func(), err error) {
+	var releases []func()
+	release = func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+	for _, qr := range qre.firedRules {
+		r, err := qr.Throttle(qre.ctx)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, r)
+	}
+	return release, nil
+}
+
+// recordTagStats records the stats of the query under the tags of
+// the query rules that fired with the TAG action.
+func (qre *QueryExecutor) recordTagStats(planName string, duration time.Duration, failed bool) {
+	var errorCount int64
+	if failed {
+		errorCount = 1
+	}
+	recorded := make(map[string]bool)
+	for _, qr := range qre.firedRules {
+		if qr.Action() != rules.QRTag || recorded[qr.Tag()] {
+			continue
+		}
+		recorded[qr.Tag()] = true
+		qre.tsv.qe.AddTagStats(qr.Tag(), planName, 1, duration, errorCount)
+	}
+}
+
 func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
@@ -1095,6 +1095,122 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorQueryRuleConcurrency(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+
+	limitRule := rules.NewQueryRule("limit test_table", "limit_test_table", rules.QRConcurrency)
+	limitRule.SetMaxConcurrency(1)
+	limitRule.AddTableCond("test_table")
+
+	rulesName := "concurrencyRules"
+	qrs := rules.New()
+	qrs.Add(limitRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	// Take the only slot of the rule.
+	release, err := limitRule.Throttle(ctx)
+	require.NoError(t, err)
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	release()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+}
+
+func TestQueryExecutorQueryRuleTag(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+
+	tagRule := rules.NewQueryRule("tag test_table", "tag_test_table", rules.QRTag)
+	tagRule.SetTag("incident")
+	tagRule.AddTableCond("test_table")
+
+	rulesName := "tagRules"
+	qrs := rules.New()
+	qrs.Add(tagRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, qrs))
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), tsv.qe.queryTagCounts.Counts()["incident.Select"])
+	assert.Equal(t, int64(0), tsv.qe.queryTagErrorCounts.Counts()["incident.Select"])
+}
+
+func TestQueryExecutorQueryRuleFailWins(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+
+	// The tag and concurrency rules come first, from another source.
+	tagRule := rules.NewQueryRule("tag test_table", "tag_test_table", rules.QRTag)
+	tagRule.SetTag("incident")
+	tagRule.AddTableCond("test_table")
+	limitRule := rules.NewQueryRule("limit test_table", "limit_test_table", rules.QRConcurrency)
+	limitRule.SetMaxConcurrency(1)
+	limitRule.AddTableCond("test_table")
+	tagRules := rules.New()
+	tagRules.Add(tagRule)
+	tagRules.Add(limitRule)
+	failRule := rules.NewQueryRule("blacklist test_table", "blacklist_test_table", rules.QRFail)
+	failRule.AddTableCond("test_table")
+	failRules := rules.New()
+	failRules.Add(failRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.queryRuleSources.RegisterSource("tagRules")
+	defer tsv.qe.queryRuleSources.UnRegisterSource("tagRules")
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules("tagRules", tagRules))
+
+	// Without the FAIL rule, both the tag and the concurrency limit apply.
+	tagCount := tsv.qe.queryTagCounts.Counts()["incident.Select"]
+	tagErrorCount := tsv.qe.queryTagErrorCounts.Counts()["incident.Select"]
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, tagCount+1, tsv.qe.queryTagCounts.Counts()["incident.Select"])
+	release, err := limitRule.Throttle(ctx)
+	require.NoError(t, err)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Equal(t, tagErrorCount+1, tsv.qe.queryTagErrorCounts.Counts()["incident.Select"])
+	release()
+
+	tsv.qe.queryRuleSources.RegisterSource("failRules")
+	defer tsv.qe.queryRuleSources.UnRegisterSource("failRules")
+	require.NoError(t, tsv.SetQueryRules("failRules", failRules))
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "disallowed due to rule: blacklist test_table")
+}
+
 type executorFlags int64
 
 const (
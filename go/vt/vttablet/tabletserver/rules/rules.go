@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
@@ -156,13 +157,31 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// If a FAIL or FAIL_RETRY rule fires, its action is returned, whatever other rules fire.
+// Otherwise, the action of the first rule that fires is returned.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
+	fired := qrs.GetRules(ip, user, bindVars)
+	if len(fired) == 0 {
+		return QRContinue, ""
+	}
+	for _, qr := range fired {
+		if qr.act.IsFail() {
+			return qr.act, qr.Description
+		}
+	}
+	return fired[0].act, fired[0].Description
+}
+
+// GetRules runs the input against the rules engine and returns all
+// the rules that fire, in order.
+func (qrs *Rules) GetRules(ip, user string, bindVars map[string]*querypb.BindVariable) []*Rule {
+	var fired []*Rule
 	for _, qr := range qrs.rules {
 		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
-			return act, qr.Description
+			fired = append(fired, qr)
 		}
 	}
-	return QRContinue, ""
+	return fired
 }
 
 //-----------------------------------------------
@@ -195,6 +214,17 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the CONCURRENCY, RATE_LIMIT, DELAY and TAG actions.
+	maxConcurrency int
+	maxRate        int
+	delay          time.Duration
+	tag            string
+
+	// throttle is the state of the CONCURRENCY and RATE_LIMIT actions
+	// of a rule without a name. It is shared by the copies of the rule.
+	// Named rules find theirs in throttles.
+	throttle *ruleThrottle
 }
 
 type namedRegexp struct {
@@ -218,7 +248,7 @@ func (nr namedRegexp) Equal(other namedRegexp) bool {
 // NewQueryRule creates a new Rule.
 func NewQueryRule(description, name string, act Action) (qr *Rule) {
 	// We ignore act because there's only one action right now
-	return &Rule{Description: description, Name: name, act: act}
+	return &Rule{Description: description, Name: name, act: act, throttle: &ruleThrottle{}}
 }
 
 // Equal returns true if other is equal to this Rule, otherwise false.
@@ -234,7 +264,11 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.maxRate == other.maxRate &&
+		qr.delay == other.delay &&
+		qr.tag == other.tag)
 }
 
 // Copy performs a deep copy of a Rule.
@@ -246,6 +280,12 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		user:        qr.user,
 		query:       qr.query,
 		act:         qr.act,
+
+		maxConcurrency: qr.maxConcurrency,
+		maxRate:        qr.maxRate,
+		delay:          qr.delay,
+		tag:            qr.tag,
+		throttle:       qr.throttle,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -288,10 +328,56 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.maxRate != 0 {
+		safeEncode(b, `,"MaxRate":`, qr.maxRate)
+	}
+	if qr.delay != 0 {
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	}
+	if qr.tag != "" {
+		safeEncode(b, `,"Tag":`, qr.tag)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
 
+// Action returns the action of the rule.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// SetMaxConcurrency sets the number of queries that a rule with the
+// CONCURRENCY action lets run at the same time.
+func (qr *Rule) SetMaxConcurrency(maxConcurrency int) {
+	qr.maxConcurrency = maxConcurrency
+}
+
+// SetMaxRate sets the number of queries per second that a rule with
+// the RATE_LIMIT action lets run.
+func (qr *Rule) SetMaxRate(maxRate int) {
+	qr.maxRate = maxRate
+}
+
+// SetDelay sets the delay that a rule with the DELAY action adds
+// to every query.
+func (qr *Rule) SetDelay(delay time.Duration) {
+	qr.delay = delay
+}
+
+// SetTag sets the tag that a rule with the TAG action gives to
+// queries, under which their stats are recorded.
+func (qr *Rule) SetTag(tag string) {
+	qr.tag = tag
+}
+
+// Tag returns the tag of a rule with the TAG action.
+func (qr *Rule) Tag() string {
+	return qr.tag
+}
+
 // SetIPCond adds a regular expression condition for the client IP.
 // It has to be a full match (not substring).
 func (qr *Rule) SetIPCond(pattern string) (err error) {
@@ -476,22 +562,38 @@ func bvMatch(bvcond BindVarCond, bindVars map[string]*querypb.BindVariable) bool
 type Action int
 
 // These are actions.
+// QRConcurrency limits the number of matching queries that run at
+// the same time, and QRRateLimit the number of matching queries per
+// second. QRDelay delays matching queries. QRTag records the stats of
+// matching queries under a tag.
 const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRConcurrency
+	QRRateLimit
+	QRDelay
+	QRTag
 )
 
+// IsFail returns true if the action fails the query.
+func (act Action) IsFail() bool {
+	return act == QRFail || act == QRFailRetry
+}
+
+var actionNames = map[Action]string{
+	QRFail:        "FAIL",
+	QRFailRetry:   "FAIL_RETRY",
+	QRConcurrency: "CONCURRENCY",
+	QRRateLimit:   "RATE_LIMIT",
+	QRDelay:       "DELAY",
+	QRTag:         "TAG",
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
-	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
+	str, ok := actionNames[act]
+	if !ok {
 		str = "INVALID"
 	}
 	return json.Marshal(str)
@@ -779,9 +881,10 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var iv int64
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "Delay", "Tag":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
@@ -791,6 +894,15 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
 			}
+		case "MaxConcurrency", "MaxRate":
+			nv, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int for %s", k)
+			}
+			iv, err = nv.Int64()
+			if err != nil || iv <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive int for %s: %v", k, nv)
+			}
 		default:
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s", k)
 		}
@@ -846,19 +958,68 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				}
 			}
 		case "Action":
-			switch sv {
-			case "FAIL":
-				qr.act = QRFail
-			case "FAIL_RETRY":
-				qr.act = QRFailRetry
-			default:
+			act, ok := actionByName(sv)
+			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+			qr.act = act
+		case "MaxConcurrency":
+			qr.SetMaxConcurrency(int(iv))
+		case "MaxRate":
+			qr.SetMaxRate(int(iv))
+		case "Delay":
+			delay, err := time.ParseDuration(sv)
+			if err != nil || delay <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive duration for Delay: %s", sv)
+			}
+			qr.SetDelay(delay)
+		case "Tag":
+			qr.SetTag(sv)
 		}
 	}
+	if err := qr.validateAction(); err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
+func actionByName(name string) (Action, bool) {
+	for act, actName := range actionNames {
+		if actName == name {
+			return act, true
+		}
+	}
+	return QRContinue, false
+}
+
+// validateAction returns an error if the parameter of the action
+// of the rule is missing.
+func (qr *Rule) validateAction() error {
+	var param string
+	switch qr.act {
+	case QRConcurrency:
+		if qr.maxConcurrency == 0 {
+			param = "MaxConcurrency"
+		}
+	case QRRateLimit:
+		if qr.maxRate == 0 {
+			param = "MaxRate"
+		}
+	case QRDelay:
+		if qr.delay == 0 {
+			param = "Delay"
+		}
+	case QRTag:
+		if qr.tag == "" {
+			param = "Tag"
+		}
+	}
+	if param != "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s missing for Action %s", param, actionNames[qr.act])
+	}
+	return nil
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
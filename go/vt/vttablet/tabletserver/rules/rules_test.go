@@ -23,6 +23,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -576,6 +577,52 @@ const (
 	REGEXP
 )
 
+func TestImportThrottleActions(t *testing.T) {
+	var qrs = New()
+	jsondata := `[{
+		"Description": "desc1",
+		"Name": "name1",
+		"Action": "CONCURRENCY",
+		"MaxConcurrency": 2
+	},{
+		"Description": "desc2",
+		"Name": "name2",
+		"Action": "RATE_LIMIT",
+		"MaxRate": 100
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"Action": "DELAY",
+		"Delay": "50ms"
+	},{
+		"Description": "desc4",
+		"Name": "name4",
+		"Action": "TAG",
+		"Tag": "incident"
+	}]`
+	err := qrs.UnmarshalJSON([]byte(jsondata))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := marshalled(qrs)
+	want := compacted(jsondata)
+	if got != want {
+		t.Errorf("qrs:\n%s, want\n%s", got, want)
+	}
+	if qr := qrs.Find("name4"); qr.Action() != QRTag || qr.Tag() != "incident" {
+		t.Errorf("name4: %v %s, want TAG incident", qr.Action(), qr.Tag())
+	}
+
+	other := qrs.Copy()
+	if !qrs.Equal(other) {
+		t.Errorf("qrs.Equal(qrs.Copy()): false, want true")
+	}
+	other.Find("name1").SetMaxConcurrency(3)
+	if qrs.Equal(other) {
+		t.Errorf("qrs.Equal(other): true, want false")
+	}
+}
+
 var validjsons = []ValidJSONCase{
 	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "==", "Value": 18446744073709551615}]}]`, QREqual, UINT},
 	{`[{"BindVarConds": [{"Name": "bvname1", "OnAbsent": true, "OnMismatch": true, "Operator": "!=", "Value": 18446744073709551615}]}]`, QRNotEqual, UINT},
@@ -672,6 +719,14 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"MaxConcurrency": "1" }]`, "want int for MaxConcurrency"},
+	{`[{"MaxRate": 0 }]`, "want positive int for MaxRate: 0"},
+	{`[{"Delay": 1 }]`, "want string for Delay"},
+	{`[{"Delay": "1" }]`, "want positive duration for Delay: 1"},
+	{`[{"Action": "CONCURRENCY" }]`, "MaxConcurrency missing for Action CONCURRENCY"},
+	{`[{"Action": "RATE_LIMIT" }]`, "MaxRate missing for Action RATE_LIMIT"},
+	{`[{"Action": "DELAY" }]`, "Delay missing for Action DELAY"},
+	{`[{"Action": "TAG" }]`, "Tag missing for Action TAG"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -750,3 +805,38 @@ func marshalled(in interface{}) string {
 	}
 	return string(b)
 }
+
+func TestGetActionFailWins(t *testing.T) {
+	qrs := New()
+	tag := NewQueryRule("tag everything", "tag", QRTag)
+	tag.SetTag("all")
+	qrs.Add(tag)
+	delay := NewQueryRule("delay everything", "delay", QRDelay)
+	delay.SetDelay(time.Millisecond)
+	qrs.Add(delay)
+
+	action, desc := qrs.GetAction("123", "user1", nil)
+	if action != QRTag || desc != "tag everything" {
+		t.Errorf("want QRTag, tag everything, got %v, %s", action, desc)
+	}
+	if fired := qrs.GetRules("123", "user1", nil); len(fired) != 2 {
+		t.Errorf("want 2 rules to fire, got %d", len(fired))
+	}
+
+	// A FAIL_RETRY rule wins over the rules that come before it.
+	fail := NewQueryRule("fail user1", "fail", QRFailRetry)
+	if err := fail.SetUserCond("user1"); err != nil {
+		t.Fatal(err)
+	}
+	qrs.Add(fail)
+	action, desc = qrs.GetAction("123", "user1", nil)
+	if action != QRFailRetry || desc != "fail user1" {
+		t.Errorf("want QRFailRetry, fail user1, got %v, %s", action, desc)
+	}
+	if fired := qrs.GetRules("123", "user1", nil); len(fired) != 3 {
+		t.Errorf("want 3 rules to fire, got %d", len(fired))
+	}
+	if action, _ = qrs.GetAction("123", "user2", nil); action != QRTag {
+		t.Errorf("want QRTag, got %v", action)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/ratelimiter"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// throttles holds the ruleThrottle of every named rule. The rules
+// are built again whenever they're reloaded, so the state of their
+// CONCURRENCY and RATE_LIMIT actions is kept here, by rule name, for
+// the queries that are running to still count once the rules change.
+var throttles = struct {
+	mu     sync.Mutex
+	byName map[string]*ruleThrottle
+}{byName: make(map[string]*ruleThrottle)}
+
+// ruleThrottle holds the number of running queries and the rate
+// limiter of a rule. The rate limiter is created on first use, and
+// again if the rate of the rule changed.
+type ruleThrottle struct {
+	mu       sync.Mutex
+	inFlight int
+	maxRate  int
+	limiter  *ratelimiter.RateLimiter
+}
+
+// throttleState returns the ruleThrottle of the rule. Rules without
+// a name can't be told apart across reloads, so they only share it
+// with their copies.
+func (qr *Rule) throttleState() *ruleThrottle {
+	if qr.Name == "" {
+		return qr.throttle
+	}
+	throttles.mu.Lock()
+	defer throttles.mu.Unlock()
+	rt, ok := throttles.byName[qr.Name]
+	if !ok {
+		rt = &ruleThrottle{}
+		throttles.byName[qr.Name] = rt
+	}
+	return rt
+}
+
+// tryAcquire takes a slot if fewer than maxConcurrency queries are
+// running. It returns false otherwise.
+func (rt *ruleThrottle) tryAcquire(maxConcurrency int) bool {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.inFlight >= maxConcurrency {
+		return false
+	}
+	rt.inFlight++
+	return true
+}
+
+func (rt *ruleThrottle) release() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.inFlight--
+}
+
+func (rt *ruleThrottle) rateLimiter(maxRate int) *ratelimiter.RateLimiter {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if rt.limiter == nil || rt.maxRate != maxRate {
+		rt.maxRate = maxRate
+		rt.limiter = ratelimiter.NewRateLimiter(maxRate, time.Second)
+	}
+	return rt.limiter
+}
+
+// Throttle enforces the CONCURRENCY, RATE_LIMIT and DELAY actions of
+// the rule for a query. A query that exceeds the concurrency or the
+// rate of the rule fails with RESOURCE_EXHAUSTED. The returned release
+// function must be called once the query is done.
+func (qr *Rule) Throttle(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	switch qr.act {
+	case QRConcurrency:
+		rt := qr.throttleState()
+		if !rt.tryAcquire(qr.maxConcurrency) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "concurrency limit of %d exceeded due to rule: %s", qr.maxConcurrency, qr.Description)
+		}
+		release = rt.release
+	case QRRateLimit:
+		if !qr.throttleState().rateLimiter(qr.maxRate).Allow() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limit of %d per second exceeded due to rule: %s", qr.maxRate, qr.Description)
+		}
+	case QRDelay:
+		tmr := time.NewTimer(qr.delay)
+		defer tmr.Stop()
+		select {
+		case <-tmr.C:
+		case <-ctx.Done():
+			return nil, vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "delayed due to rule: %s: %v", qr.Description, ctx.Err())
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestThrottleConcurrency(t *testing.T) {
+	qr := NewQueryRule("limit concurrency", "throttle_concurrency", QRConcurrency)
+	qr.SetMaxConcurrency(1)
+	ctx := context.Background()
+
+	release, err := qr.Throttle(ctx)
+	require.NoError(t, err)
+
+	// Copies of the rule share the same slots.
+	_, err = qr.Copy().Throttle(ctx)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualError(t, err, "concurrency limit of 1 exceeded due to rule: limit concurrency")
+
+	release()
+	release, err = qr.Throttle(ctx)
+	require.NoError(t, err)
+
+	// Raising the limit takes effect right away.
+	qr.SetMaxConcurrency(2)
+	release2, err := qr.Throttle(ctx)
+	require.NoError(t, err)
+	release()
+	release2()
+}
+
+func TestThrottleReloadedRules(t *testing.T) {
+	ruleInfo := map[string]interface{}{
+		"Name":           "throttle_reloaded",
+		"Description":    "limit concurrency",
+		"Action":         "CONCURRENCY",
+		"MaxConcurrency": json.Number("1"),
+	}
+	qr, err := BuildQueryRule(ruleInfo)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	release, err := qr.Throttle(ctx)
+	require.NoError(t, err)
+
+	// The rules are built again when they're reloaded, and the
+	// running queries still hold their slots.
+	reloaded, err := BuildQueryRule(ruleInfo)
+	require.NoError(t, err)
+	_, err = reloaded.Throttle(ctx)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	// Slots are released to the reloaded rule.
+	release()
+	release, err = reloaded.Throttle(ctx)
+	require.NoError(t, err)
+
+	// Lowering the limit keeps counting the running queries.
+	reloaded.SetMaxConcurrency(2)
+	release2, err := reloaded.Throttle(ctx)
+	require.NoError(t, err)
+	reloaded.SetMaxConcurrency(1)
+	release()
+	_, err = reloaded.Throttle(ctx)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	release2()
+}
+
+func TestThrottleUnnamedRules(t *testing.T) {
+	// Rules without a name don't share their slots.
+	qr1 := NewQueryRule("limit t1", "", QRConcurrency)
+	qr1.SetMaxConcurrency(1)
+	qr2 := NewQueryRule("limit t2", "", QRConcurrency)
+	qr2.SetMaxConcurrency(1)
+	ctx := context.Background()
+
+	release1, err := qr1.Throttle(ctx)
+	require.NoError(t, err)
+	release2, err := qr2.Throttle(ctx)
+	require.NoError(t, err)
+	release1()
+	release2()
+}
+
+func TestThrottleRateLimit(t *testing.T) {
+	qr := NewQueryRule("limit rate", "throttle_rate", QRRateLimit)
+	qr.SetMaxRate(2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		release, err := qr.Throttle(ctx)
+		require.NoError(t, err)
+		release()
+	}
+	_, err := qr.Throttle(ctx)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.EqualError(t, err, "rate limit of 2 per second exceeded due to rule: limit rate")
+}
+
+func TestThrottleDelay(t *testing.T) {
+	qr := NewQueryRule("delay", "throttle_delay", QRDelay)
+	qr.SetDelay(10 * time.Millisecond)
+
+	start := time.Now()
+	release, err := qr.Throttle(context.Background())
+	require.NoError(t, err)
+	release()
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(10*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	qr.SetDelay(time.Hour)
+	_, err = qr.Throttle(ctx)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+}
+
+func TestThrottleOtherActions(t *testing.T) {
+	qr := NewQueryRule("tag", "throttle_tag", QRTag)
+	qr.SetTag("incident")
+	release, err := qr.Throttle(context.Background())
+	require.NoError(t, err)
+	release()
+}
@@ -33,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -180,6 +181,11 @@ func (tqsc *Controller) OnlineDDLExecutor() *onlineddl.Executor {
 	return nil
 }
 
+// LagThrottler is part of the tabletserver.Controller interface
+func (tqsc *Controller) LagThrottler() *throttle.Throttler {
+	return nil
+}
+
 //ClearQueryPlanCache is part of the tabletserver.Controller interface
 func (tqsc *Controller) ClearQueryPlanCache() {
 }
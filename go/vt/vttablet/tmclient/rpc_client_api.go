@@ -198,8 +198,9 @@ type TabletManagerClient interface {
 	// Backup / restore related methods
 	//
 
-	// Backup creates a database backup
-	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
+	// Backup creates a database backup. An incremental backup only
+	// stores the binlogs since the previous backup.
+	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster, incremental bool) (logutil.EventStream, error)
 
 	// RestoreFromBackup deletes local data and restores database from backup
 	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
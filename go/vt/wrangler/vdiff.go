@@ -507,37 +507,13 @@ func (df *vdiff) selectTablets(ctx context.Context) error {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err1 = df.forAll(df.sources, func(shard string, source *shardStreamer) error {
-			tp, err := discovery.NewTabletPicker(df.ts.wr.ts, []string{df.sourceCell}, df.ts.sourceKeyspace, shard, df.tabletTypesStr)
-			if err != nil {
-				return err
-			}
-
-			tablet, err := tp.PickForStreaming(ctx)
-			if err != nil {
-				return err
-			}
-			source.tablet = tablet
-			return nil
-		})
+		err1 = df.pickTablets(ctx, df.sourceCell, df.ts.sourceKeyspace, df.sources)
 	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err2 = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
-			tp, err := discovery.NewTabletPicker(df.ts.wr.ts, []string{df.targetCell}, df.ts.targetKeyspace, shard, df.tabletTypesStr)
-			if err != nil {
-				return err
-			}
-
-			tablet, err := tp.PickForStreaming(ctx)
-			if err != nil {
-				return err
-			}
-			target.tablet = tablet
-			return nil
-		})
+		err2 = df.pickTablets(ctx, df.targetCell, df.ts.targetKeyspace, df.targets)
 	}()
 
 	wg.Wait()
@@ -547,6 +523,33 @@ func (df *vdiff) selectTablets(ctx context.Context) error {
 	return err2
 }
 
+// pickTablets picks a tablet of cell for each of the shards of keyspace
+// in participants. The pickers share a health check of those shards.
+func (df *vdiff) pickTablets(ctx context.Context, cell, keyspace string, participants map[string]*shardStreamer) error {
+	var shards []string
+	for shard := range participants {
+		shards = append(shards, shard)
+	}
+	hc, err := discovery.NewTabletPickerHealthCheck(ctx, df.ts.wr.ts, []string{cell}, keyspace, shards...)
+	if err != nil {
+		return err
+	}
+	defer hc.Close()
+	return df.forAll(participants, func(shard string, participant *shardStreamer) error {
+		tp, err := discovery.NewTabletPickerWithOptions(df.ts.wr.ts, []string{cell}, keyspace, shard, df.tabletTypesStr, discovery.TabletPickerOptions{HealthCheck: hc})
+		if err != nil {
+			return err
+		}
+
+		tablet, err := tp.PickForStreaming(ctx)
+		if err != nil {
+			return err
+		}
+		participant.tablet = tablet
+		return nil
+	})
+}
+
 // stopTargets stops all the targets and records their source positions.
 func (df *vdiff) stopTargets(ctx context.Context) error {
 	var mu sync.Mutex
@@ -193,6 +193,8 @@ func (env *testVDiffEnv) addTablet(id int, keyspace, shard string, tabletType to
 		Type:     tabletType,
 		PortMap: map[string]int32{
 			"test": int32(id),
+			// The health check only checks tablets with a grpc port.
+			"grpc": int32(id),
 		},
 	}
 	env.tablets[id] = newTestVDiffTablet(tablet)
@@ -230,7 +232,8 @@ func newTestVDiffTablet(tablet *topodatapb.Tablet) *testVDiffTablet {
 
 func (tvt *testVDiffTablet) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
 	return callback(&querypb.StreamHealthResponse{
-		Serving: true,
+		TabletAlias: tvt.tablet.Alias,
+		Serving:     true,
 		Target: &querypb.Target{
 			Keyspace:   tvt.tablet.Keyspace,
 			Shard:      tvt.tablet.Shard,